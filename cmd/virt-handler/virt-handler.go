@@ -115,6 +115,9 @@ const (
 	// Default ConfigMap name of CA
 	defaultCAConfigMapName = "kubevirt-ca"
 
+	// ConfigMap name of the CAs of the peer clusters of cross-cluster migrations
+	migrationPeerCAConfigMapName = "kubevirt-migration-peer-ca"
+
 	// Default certificate and key paths
 	defaultClientCertFilePath = "/etc/virt-handler/clientcertificates/tls.crt"
 	defaultClientKeyFilePath  = "/etc/virt-handler/clientcertificates/tls.key"
@@ -148,6 +151,8 @@ type virtHandlerApp struct {
 
 	serverTLSConfig       *tls.Config
 	clientTLSConfig       *tls.Config
+	peerServerTLSConfig   *tls.Config
+	peerClientTLSConfig   *tls.Config
 	consoleServerPort     int
 	clientcertmanager     certificate.Manager
 	servercertmanager     certificate.Manager
@@ -284,7 +289,7 @@ func (app *virtHandlerApp) Run() {
 
 	app.clusterConfig.SetConfigModifiedCallback(vsockConfigCallback)
 
-	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.clusterConfig)
+	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.peerServerTLSConfig, app.peerClientTLSConfig, app.clusterConfig)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -529,6 +534,7 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestmetrics").To(lifecycleHandler.GetGuestMetrics).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestMetrics{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
@@ -622,6 +628,11 @@ func (app *virtHandlerApp) setupTLS(factory controller.KubeInformerFactory) erro
 	app.serverTLSConfig = kvtls.SetupTLSForVirtHandlerServer(app.caManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
 	app.clientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(app.caManager, app.clientcertmanager, app.externallyManaged)
 
+	// cross-cluster migrations authenticate the virt-handlers of the peer clusters with their CAs
+	peerCAManager := kvtls.NewCAManager(factory.KubeVirtMigrationPeerCAConfigMap().GetStore(), app.namespace, migrationPeerCAConfigMapName)
+	app.peerServerTLSConfig = kvtls.SetupTLSForVirtHandlerServer(peerCAManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
+	app.peerClientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(peerCAManager, app.clientcertmanager, app.externallyManaged)
+
 	return nil
 }
 
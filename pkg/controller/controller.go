@@ -569,9 +569,27 @@ func GetHotplugVolumes(vmi *v1.VirtualMachineInstance, virtlauncherPod *k8sv1.Po
 		podVolumeMap[podVolume.Name] = podVolume
 	}
 	for _, vmiVolume := range vmiVolumes {
-		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil || vmiVolume.MemoryDump != nil) {
+		podVolume, ok := podVolumeMap[vmiVolume.Name]
+		if !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil || vmiVolume.MemoryDump != nil || vmiVolume.Backup != nil) {
+			hotplugVolumes = append(hotplugVolumes, vmiVolume.DeepCopy())
+		} else if ok && isReplacedPodVolume(vmiVolume, podVolume) {
 			hotplugVolumes = append(hotplugVolumes, vmiVolume.DeepCopy())
 		}
 	}
 	return hotplugVolumes
 }
+
+// isReplacedPodVolume returns true if a hotpluggable volume took over the name of a different volume of the
+// virt-launcher pod, like a new media inserted into a CD-ROM.
+func isReplacedPodVolume(vmiVolume v1.Volume, podVolume k8sv1.Volume) bool {
+	var claimName string
+	switch {
+	case vmiVolume.DataVolume != nil && vmiVolume.DataVolume.Hotpluggable:
+		claimName = vmiVolume.DataVolume.Name
+	case vmiVolume.PersistentVolumeClaim != nil && vmiVolume.PersistentVolumeClaim.Hotpluggable:
+		claimName = vmiVolume.PersistentVolumeClaim.ClaimName
+	default:
+		return false
+	}
+	return podVolume.PersistentVolumeClaim == nil || podVolume.PersistentVolumeClaim.ClaimName != claimName
+}
@@ -235,7 +235,23 @@ var _ = Describe("Controller", func() {
 				Entry("with DataVolume", &v1.Volume{Name: "new", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{}}}),
 				Entry("with PersistentVolumeClaim", &v1.Volume{Name: "new", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}}),
 				Entry("with MemoryDump", &v1.Volume{Name: "new", VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{}}}),
+				Entry("with hotpluggable DataVolume replacing an existing volume", &v1.Volume{Name: "existing", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv", Hotpluggable: true}}}),
+				Entry("with hotpluggable PersistentVolumeClaim replacing an existing volume", &v1.Volume{Name: "existing", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{Hotpluggable: true}}}),
 			)
+
+			It("should not return a hotpluggable volume already backing the pod volume", func() {
+				vmi := &v1.VirtualMachineInstance{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{{Name: "existing", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv", Hotpluggable: true}}}},
+					},
+				}
+				pod := &k8sv1.Pod{
+					Spec: k8sv1.PodSpec{
+						Volumes: []k8sv1.Volume{{Name: "existing", VolumeSource: k8sv1.VolumeSource{PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "dv"}}}},
+					},
+				}
+				Expect(controller.GetHotplugVolumes(vmi, pod)).To(BeEmpty())
+			})
 		})
 	})
 })
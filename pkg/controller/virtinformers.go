@@ -54,6 +54,7 @@ import (
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	"kubevirt.io/api/core"
 	kubev1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
@@ -135,6 +136,15 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineBackup objects
+	VirtualMachineBackup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineBackupTracker objects
+	VirtualMachineBackupTracker() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
@@ -162,6 +172,9 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export CA config map
 	KubeVirtExportCAConfigMap() cache.SharedIndexInformer
 
+	// Watches for the config map with the CAs of the peer clusters of cross-cluster migrations
+	KubeVirtMigrationPeerCAConfigMap() cache.SharedIndexInformer
+
 	// Watches for the export route config map
 	ExportRouteConfigMap() cache.SharedIndexInformer
 
@@ -644,6 +657,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if schedule, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, schedule)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -694,7 +719,11 @@ func GetVirtualMachineRestoreInformerIndexers() cache.Indexers {
 			if vmr.Spec.Target.APIGroup != nil &&
 				*vmr.Spec.Target.APIGroup == core.GroupName &&
 				vmr.Spec.Target.Kind == "VirtualMachine" {
-				return []string{fmt.Sprintf("%s/%s", vmr.Namespace, vmr.Spec.Target.Name)}, nil
+				namespace := vmr.Namespace
+				if vmr.Spec.TargetNamespace != nil && *vmr.Spec.TargetNamespace != "" {
+					namespace = *vmr.Spec.TargetNamespace
+				}
+				return []string{fmt.Sprintf("%s/%s", namespace, vmr.Spec.Target.Name)}, nil
 			}
 
 			return nil, nil
@@ -709,6 +738,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackup() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackup{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackupTracker() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupTrackerInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackuptrackers", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupTracker{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -942,6 +992,15 @@ func (f *kubeInformerFactory) KubeVirtExportCAConfigMap() cache.SharedIndexInfor
 	})
 }
 
+func (f *kubeInformerFactory) KubeVirtMigrationPeerCAConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("extensionsKubeVirtMigrationPeerCAConfigMapInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		fieldSelector := fields.OneTermEqualSelector("metadata.name", "kubevirt-migration-peer-ca")
+		lw := cache.NewListWatchFromClient(restClient, "configmaps", f.kubevirtNamespace, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ExportRouteConfigMap() cache.SharedIndexInformer {
 	return f.getInformer("extensionsExportRouteConfigMapInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
@@ -41,6 +41,7 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	BackupRequest
 */
 package v1
 
@@ -932,6 +933,30 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type BackupRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -964,6 +989,7 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1002,6 +1028,7 @@ type CmdClient interface {
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
 	GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error)
 	VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error)
+	VirtualMachineBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 	GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error)
 	SyncVirtualMachineCPUs(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	SyncVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
@@ -1243,6 +1270,15 @@ func (c *cmdClient) VirtualMachineMemoryDump(ctx context.Context, in *MemoryDump
 	return out, nil
 }
 
+func (c *cmdClient) VirtualMachineBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/VirtualMachineBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error) {
 	out := new(QemuVersionResponse)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetQemuVersion", in, out, c.cc, opts...)
@@ -1325,6 +1361,7 @@ type CmdServer interface {
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
 	GuestPing(context.Context, *GuestPingRequest) (*GuestPingResponse, error)
 	VirtualMachineMemoryDump(context.Context, *MemoryDumpRequest) (*Response, error)
+	VirtualMachineBackup(context.Context, *BackupRequest) (*Response, error)
 	GetQemuVersion(context.Context, *EmptyRequest) (*QemuVersionResponse, error)
 	SyncVirtualMachineCPUs(context.Context, *VMIRequest) (*Response, error)
 	SyncVirtualMachineMemory(context.Context, *VMIRequest) (*Response, error)
@@ -1787,6 +1824,24 @@ func _Cmd_VirtualMachineMemoryDump_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_VirtualMachineBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).VirtualMachineBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/VirtualMachineBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).VirtualMachineBackup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_GetQemuVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -1999,6 +2054,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VirtualMachineMemoryDump",
 			Handler:    _Cmd_VirtualMachineMemoryDump_Handler,
 		},
+		{
+			MethodName: "VirtualMachineBackup",
+			Handler:    _Cmd_VirtualMachineBackup_Handler,
+		},
 		{
 			MethodName: "GetQemuVersion",
 			Handler:    _Cmd_GetQemuVersion_Handler,
@@ -2031,119 +2090,119 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1809 bytes of a gzipped FileDescriptorProto
+	// 1823 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0x17, 0x45, 0x4a, 0x22, 0x47, 0x7f, 0x62, 0xaf, 0x25, 0xe5, 0xa4, 0xd6, 0xb6, 0xba, 0x28,
+	0x11, 0x37, 0x45, 0x4a, 0x22, 0x47, 0x7f, 0x62, 0xaf, 0x25, 0xe5, 0xa4, 0xd6, 0xb6, 0xba, 0x28,
 	0x0c, 0xa5, 0x48, 0xa4, 0xda, 0x71, 0x82, 0xc2, 0x28, 0x02, 0x47, 0x14, 0xa5, 0x28, 0xb1, 0x6c,
-	0xe6, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0xad, 0xa8, 0xad, 0xee, 0x76, 0x99, 0xdb, 0x3d,
-	0xd6, 0xcc, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0x0f, 0xd6, 0x4f, 0xd0, 0xb7, 0x7e, 0x8b, 0xbe,
-	0x07, 0xbb, 0xb7, 0x47, 0x1d, 0x79, 0x77, 0x92, 0x05, 0xf2, 0x89, 0xb7, 0x3b, 0x33, 0xbf, 0x99,
-	0xdd, 0x9d, 0x99, 0xfd, 0xdd, 0x11, 0x3e, 0xea, 0x5d, 0x75, 0x77, 0x2f, 0x09, 0xf7, 0x03, 0x1a,
-	0x7d, 0x12, 0x90, 0x98, 0x7b, 0x97, 0x34, 0xfa, 0xc4, 0x13, 0xe1, 0xae, 0x17, 0xfa, 0xbb, 0xfd,
-	0xa7, 0xfa, 0x67, 0xa7, 0x17, 0x09, 0x25, 0xd0, 0x07, 0x57, 0xf1, 0x39, 0xed, 0xb3, 0x48, 0xed,
-	0xe8, 0xb9, 0xfe, 0x53, 0x7c, 0x01, 0x0f, 0xbe, 0xa5, 0x61, 0x7c, 0x46, 0x23, 0xc9, 0x04, 0x77,
-	0xa9, 0xec, 0x09, 0x2e, 0x29, 0xfa, 0x0c, 0xea, 0x91, 0x7d, 0x76, 0x2a, 0x5b, 0x95, 0xed, 0xc5,
-	0x67, 0x1b, 0x3b, 0x63, 0xa6, 0x3b, 0xa9, 0xb2, 0x3b, 0x54, 0x45, 0x0e, 0x2c, 0xf4, 0x13, 0x24,
-	0x67, 0x76, 0xab, 0xb2, 0xdd, 0x70, 0xd3, 0x21, 0x7e, 0x0c, 0xd5, 0xb3, 0xe3, 0x23, 0xa3, 0x10,
-	0xb2, 0xaf, 0xa5, 0xe0, 0x06, 0x76, 0xc9, 0x4d, 0x87, 0xf8, 0x29, 0x54, 0x9b, 0xed, 0x53, 0xb4,
-	0x02, 0xb3, 0xcc, 0x37, 0xb2, 0x65, 0x77, 0x96, 0xf9, 0x68, 0x13, 0xea, 0x92, 0x9d, 0x07, 0x8c,
-	0x77, 0xa5, 0x33, 0xbb, 0x55, 0xdd, 0x5e, 0x76, 0x87, 0x63, 0xbc, 0x0b, 0x0b, 0x9d, 0xe4, 0x39,
-	0x67, 0xb6, 0x0a, 0x73, 0x7d, 0x12, 0xc4, 0xd4, 0x84, 0x51, 0x73, 0x93, 0x01, 0x6e, 0xc1, 0x5c,
-	0x9b, 0x74, 0xa9, 0xd4, 0x62, 0x4f, 0xc4, 0x5c, 0x19, 0x8b, 0x9a, 0x9b, 0x0c, 0x10, 0x82, 0x5a,
-	0xcc, 0x99, 0xb2, 0xa1, 0x9b, 0x67, 0x3d, 0x27, 0xd9, 0x4f, 0xd4, 0xa9, 0x1a, 0x68, 0xf3, 0x8c,
-	0x9f, 0xc3, 0xfc, 0x31, 0x0d, 0x45, 0x34, 0x40, 0xeb, 0x30, 0x4f, 0xc2, 0x0c, 0x90, 0x1d, 0x15,
-	0x21, 0xe1, 0xff, 0x56, 0xa0, 0xd6, 0xa4, 0x41, 0x90, 0x8b, 0x75, 0x17, 0xe6, 0x43, 0x03, 0x67,
-	0xd4, 0x17, 0x9f, 0x7d, 0x98, 0xdb, 0xe9, 0xc4, 0x9b, 0x6b, 0xd5, 0xd0, 0xc7, 0x30, 0xd7, 0xd3,
-	0xcb, 0x70, 0xaa, 0x5b, 0xd5, 0xed, 0xc5, 0x67, 0xeb, 0x39, 0x7d, 0xb3, 0x48, 0x37, 0x51, 0x42,
-	0x9f, 0x43, 0xc3, 0x67, 0x52, 0x11, 0xee, 0x51, 0xe9, 0xd4, 0x8c, 0x85, 0x93, 0xb3, 0xb0, 0xfb,
-	0xe8, 0x5e, 0xab, 0xa2, 0x6d, 0xa8, 0x79, 0xbd, 0x58, 0x3a, 0x73, 0xc6, 0x64, 0x35, 0x67, 0xd2,
-	0x6c, 0x9f, 0xba, 0x46, 0x03, 0xbf, 0x84, 0xfa, 0x89, 0xe8, 0x89, 0x40, 0x74, 0x07, 0xe8, 0x39,
-	0x00, 0x8f, 0x43, 0xf2, 0x83, 0x47, 0x83, 0x40, 0x3a, 0x15, 0x63, 0xbb, 0x96, 0xb7, 0xa5, 0x41,
-	0xe0, 0x36, 0xb4, 0xa2, 0x7e, 0x92, 0xf8, 0x5f, 0x15, 0x98, 0xef, 0x1c, 0xef, 0x31, 0x21, 0x11,
-	0x86, 0xa5, 0x90, 0xf0, 0xf8, 0x82, 0x78, 0x2a, 0x8e, 0x68, 0x64, 0xf6, 0xa9, 0xe1, 0x8e, 0xcc,
-	0xe9, 0x2c, 0xea, 0x45, 0xc2, 0x8f, 0xbd, 0x74, 0x87, 0xd3, 0x61, 0x36, 0x01, 0xab, 0x23, 0x09,
-	0x88, 0xee, 0x41, 0x55, 0x5e, 0xc5, 0x4e, 0xcd, 0xcc, 0xea, 0x47, 0x7d, 0x78, 0x17, 0x24, 0x64,
-	0xc1, 0xc0, 0x99, 0x33, 0x93, 0x76, 0x84, 0xff, 0x59, 0x81, 0xfa, 0x3e, 0x93, 0x57, 0x47, 0xfc,
-	0x42, 0x18, 0x25, 0x11, 0x85, 0x44, 0xd9, 0x40, 0xec, 0x08, 0x6d, 0xc1, 0xe2, 0x39, 0xf1, 0xae,
-	0x18, 0xef, 0x1e, 0xb0, 0x80, 0xda, 0x30, 0xb2, 0x53, 0xe8, 0x11, 0x80, 0x8e, 0x97, 0x04, 0x9d,
-	0x34, 0x7f, 0x6a, 0x6e, 0x66, 0x46, 0x23, 0xe8, 0x2d, 0x49, 0x15, 0x6a, 0x46, 0x21, 0x3b, 0x85,
-	0xff, 0x5f, 0x81, 0xe5, 0x66, 0x10, 0x4b, 0x45, 0xa3, 0xa6, 0xe0, 0x17, 0xac, 0x8b, 0x76, 0x00,
-	0xb5, 0xde, 0xf5, 0x08, 0xf7, 0x75, 0x7c, 0xb2, 0xc5, 0xc9, 0x79, 0x40, 0x93, 0x54, 0xaa, 0xbb,
-	0x05, 0x12, 0xf4, 0x47, 0xd8, 0x38, 0x88, 0x28, 0xd5, 0xf9, 0xe0, 0xd2, 0x9e, 0x88, 0x14, 0xe3,
-	0xdd, 0x7d, 0x26, 0x13, 0xb3, 0x59, 0x63, 0x56, 0xae, 0x80, 0x5e, 0x80, 0xb3, 0x27, 0xbc, 0x4b,
-	0xb9, 0xcf, 0x64, 0x2f, 0x20, 0x83, 0x03, 0x11, 0xb5, 0x0e, 0x8e, 0x0e, 0x63, 0x2a, 0x95, 0x34,
-	0xeb, 0xa9, 0xbb, 0xa5, 0x72, 0x6d, 0xdb, 0xa1, 0x11, 0x23, 0x41, 0x53, 0x70, 0x29, 0x02, 0xfa,
-	0x4a, 0x5c, 0x3b, 0xae, 0x25, 0xb6, 0x65, 0x72, 0xfc, 0x29, 0x6c, 0x1c, 0x71, 0x45, 0xa3, 0x0b,
-	0xe2, 0xd1, 0x3d, 0xc6, 0x7d, 0xc6, 0xbb, 0xc7, 0xac, 0x1b, 0x11, 0xa5, 0xcf, 0x71, 0x5d, 0x17,
-	0x9f, 0xba, 0x14, 0x7e, 0x7a, 0x20, 0xc9, 0x08, 0xff, 0x6f, 0x01, 0xd6, 0xce, 0x92, 0xcd, 0x3b,
-	0x26, 0xde, 0x25, 0xe3, 0xf4, 0x4d, 0x4f, 0x1b, 0x48, 0xf4, 0x0d, 0xac, 0x8e, 0x0a, 0x92, 0x4c,
-	0xb3, 0x7d, 0x2d, 0x5f, 0x6d, 0x89, 0xd8, 0x2d, 0x34, 0x42, 0xcf, 0x61, 0xed, 0x98, 0x86, 0x7b,
-	0x24, 0x08, 0x84, 0xe0, 0x1d, 0x45, 0x94, 0x6c, 0xd3, 0x88, 0x89, 0x64, 0x37, 0x97, 0xdd, 0x62,
-	0x21, 0xfa, 0x3d, 0x3c, 0x68, 0x47, 0x54, 0xcf, 0x7b, 0x44, 0x51, 0xff, 0x4c, 0x04, 0x71, 0x68,
-	0xeb, 0xb7, 0xe1, 0x16, 0x89, 0x74, 0x03, 0x56, 0xb6, 0xa6, 0xcc, 0x7e, 0x15, 0x35, 0xe0, 0xb4,
-	0xe8, 0xdc, 0xa1, 0x2a, 0xea, 0x40, 0xc3, 0x24, 0x80, 0xce, 0x5d, 0x5b, 0xb9, 0x9f, 0xe5, 0xec,
-	0x0a, 0xb7, 0x69, 0x67, 0x68, 0xd7, 0xe2, 0x2a, 0x1a, 0xb8, 0xd7, 0x38, 0x25, 0x59, 0x37, 0x5f,
-	0x9a, 0x75, 0xfb, 0xb0, 0xec, 0x65, 0xd3, 0xd6, 0x59, 0x30, 0x0b, 0x78, 0x94, 0x6f, 0x03, 0x59,
-	0x2d, 0x77, 0xd4, 0x08, 0xfd, 0x5c, 0x81, 0x0d, 0x96, 0xa6, 0xc1, 0xbe, 0x08, 0x09, 0xe3, 0x5f,
-	0x2a, 0x45, 0xbc, 0xcb, 0x90, 0x72, 0xe5, 0xd4, 0xcd, 0xda, 0x5a, 0xef, 0xb9, 0xb6, 0xa3, 0x32,
-	0x9c, 0x64, 0xad, 0xe5, 0x7e, 0x10, 0x07, 0x34, 0x14, 0x0e, 0x93, 0xd0, 0x69, 0x18, 0xef, 0x5f,
-	0xdc, 0xd5, 0xfb, 0x10, 0x20, 0x71, 0x5b, 0x80, 0xbc, 0xf9, 0x16, 0x56, 0x46, 0x0f, 0x42, 0x37,
-	0xae, 0x2b, 0x3a, 0xb0, 0xd9, 0xae, 0x1f, 0xd1, 0x6e, 0xf6, 0x72, 0x2b, 0x4a, 0x8c, 0xb4, 0x7b,
-	0xd9, 0x7b, 0xef, 0xc5, 0xec, 0x1f, 0x2a, 0x9b, 0xaf, 0xe0, 0xd1, 0xcd, 0xbb, 0x50, 0xe0, 0x68,
-	0xe4, 0x16, 0x6d, 0x64, 0xd1, 0x7e, 0x84, 0x0f, 0x4b, 0x56, 0x55, 0x00, 0xf3, 0x72, 0x34, 0xde,
-	0xdf, 0xe5, 0xe2, 0x2d, 0xad, 0xf6, 0x8c, 0x4b, 0xdc, 0x07, 0x38, 0x3b, 0x3e, 0x72, 0xe9, 0x8f,
-	0xba, 0xc1, 0xa0, 0x27, 0x50, 0xed, 0x87, 0xcc, 0xd6, 0x70, 0xfe, 0x72, 0xd2, 0x9a, 0x5a, 0x01,
-	0xbd, 0x84, 0x05, 0x91, 0x1c, 0x83, 0xf5, 0xfe, 0xe4, 0xfd, 0x0e, 0xcd, 0x4d, 0xcd, 0xf0, 0x09,
-	0xdc, 0xbb, 0x8e, 0xe7, 0x8e, 0xde, 0x9d, 0x51, 0xef, 0x4b, 0xd7, 0xa8, 0x3f, 0x57, 0x60, 0xb1,
-	0xf5, 0x8e, 0x7a, 0x29, 0xe2, 0x23, 0x00, 0xdf, 0x9c, 0xca, 0x6b, 0x12, 0x52, 0xbb, 0x79, 0x99,
-	0x19, 0x8d, 0xd4, 0x14, 0x61, 0x48, 0xb8, 0x9f, 0x5e, 0x79, 0x76, 0xa8, 0xb9, 0xc6, 0x97, 0x51,
-	0x37, 0x6d, 0x26, 0xe6, 0x19, 0x3d, 0x81, 0x15, 0xc5, 0x42, 0x2a, 0x62, 0xd5, 0xa1, 0x9e, 0xe0,
-	0xbe, 0x34, 0x3d, 0x64, 0xce, 0x1d, 0x9b, 0xc5, 0x2b, 0xb0, 0xd4, 0x0a, 0x7b, 0x6a, 0x60, 0xa3,
-	0xc0, 0x5f, 0x40, 0xdd, 0xcd, 0x70, 0x39, 0x19, 0x7b, 0x1e, 0x95, 0xd2, 0x5e, 0x30, 0xe9, 0x50,
-	0x4b, 0x42, 0x2a, 0x25, 0xe9, 0xa6, 0x89, 0x91, 0x0e, 0xf1, 0x0f, 0xb0, 0x92, 0xe4, 0xd6, 0xa4,
-	0x44, 0x72, 0x1d, 0xe6, 0x93, 0xc5, 0x5b, 0x0f, 0x76, 0x84, 0x39, 0x3c, 0x48, 0x1c, 0x98, 0xee,
-	0x3a, 0xa9, 0x97, 0x2d, 0x58, 0xf4, 0xaf, 0xd1, 0xd2, 0x4b, 0x3c, 0x33, 0x85, 0xdf, 0xc1, 0x7d,
-	0x73, 0xa1, 0x99, 0x6a, 0x9a, 0xd0, 0xdb, 0xc7, 0x70, 0xbf, 0x3b, 0x8e, 0x65, 0x7d, 0xe6, 0x05,
-	0xf8, 0x1f, 0x15, 0x58, 0x33, 0xae, 0x4f, 0x25, 0x8d, 0x5e, 0x31, 0xa9, 0x26, 0x75, 0xff, 0x1c,
-	0xd6, 0xba, 0x45, 0x78, 0x36, 0x84, 0x62, 0x21, 0xfe, 0x77, 0x05, 0x1c, 0x13, 0x86, 0xe6, 0x34,
-	0x72, 0x20, 0x15, 0x0d, 0x27, 0xde, 0xf6, 0x17, 0xe0, 0x74, 0x4b, 0x20, 0x6d, 0x30, 0xa5, 0x72,
-	0x3c, 0x80, 0xa5, 0xa4, 0x6c, 0x26, 0x0b, 0x61, 0x13, 0xea, 0xf4, 0x1d, 0x53, 0x4d, 0xe1, 0x27,
-	0x2e, 0xe7, 0xdc, 0xe1, 0x58, 0xe7, 0x9e, 0x54, 0xfe, 0x9b, 0x58, 0x59, 0x0a, 0x69, 0x47, 0xf8,
-	0x3b, 0xb8, 0x67, 0x76, 0xa2, 0xad, 0x89, 0xf2, 0x7b, 0x96, 0x6d, 0xbe, 0x10, 0x67, 0x0b, 0x0b,
-	0xf1, 0x6b, 0x9b, 0x67, 0x09, 0xf6, 0x44, 0x6b, 0xc3, 0x02, 0x96, 0x35, 0xa7, 0xfb, 0x89, 0xde,
-	0xb5, 0x5b, 0x7d, 0x0e, 0xeb, 0x31, 0xbf, 0x30, 0xa6, 0x27, 0x45, 0x41, 0x97, 0x48, 0xf1, 0x5b,
-	0xb8, 0x9f, 0xbc, 0xa1, 0xec, 0xc7, 0x61, 0xef, 0xae, 0x4e, 0x37, 0xa1, 0xee, 0xc7, 0x61, 0xaf,
-	0x4d, 0xd4, 0xa5, 0x3d, 0xfc, 0xe1, 0x18, 0x9f, 0xc3, 0x07, 0x9d, 0xd6, 0xd9, 0x34, 0x6a, 0x4f,
-	0x37, 0x33, 0xda, 0x37, 0xac, 0xc8, 0x36, 0x62, 0x3b, 0xc4, 0x7f, 0xaf, 0xc0, 0xc6, 0x2b, 0xf3,
-	0xce, 0x7c, 0x4c, 0x89, 0x8c, 0x23, 0xaa, 0x2f, 0xc4, 0x29, 0x94, 0x7a, 0x30, 0x8e, 0x69, 0x1d,
-	0xe7, 0x05, 0xf8, 0x7b, 0xcd, 0x77, 0xff, 0x4a, 0x3d, 0x95, 0xc4, 0xd1, 0xa1, 0x5e, 0x44, 0xd5,
-	0xd4, 0xae, 0x9a, 0x67, 0xff, 0x59, 0x85, 0x6a, 0x33, 0xf4, 0xd1, 0x6b, 0x40, 0x9d, 0x01, 0xf7,
-	0x46, 0xaf, 0x3b, 0xf4, 0xab, 0x42, 0xc8, 0xc4, 0xf9, 0x66, 0xf9, 0x62, 0xf1, 0x0c, 0x7a, 0x03,
-	0x0f, 0xda, 0x24, 0x96, 0x74, 0x6a, 0x80, 0xdf, 0xc2, 0xda, 0x29, 0xef, 0x4d, 0x15, 0xb2, 0x03,
-	0xab, 0x49, 0x2d, 0x8c, 0x21, 0xe6, 0xb9, 0xe8, 0x48, 0xc9, 0xdc, 0x0c, 0xea, 0xc2, 0xfa, 0xa9,
-	0xad, 0x84, 0x69, 0x6e, 0xa6, 0x4b, 0x25, 0x55, 0x53, 0x03, 0x3c, 0x01, 0xa7, 0x23, 0x2e, 0x94,
-	0x4b, 0xcf, 0x85, 0x98, 0x1e, 0xaa, 0x0b, 0xeb, 0x9d, 0xcb, 0x58, 0xf9, 0xe2, 0x6f, 0x7c, 0x6a,
-	0x98, 0xaf, 0x01, 0x7d, 0xc3, 0x82, 0x60, 0x6a, 0x78, 0x6d, 0x58, 0xdd, 0xa7, 0x01, 0x55, 0xd3,
-	0x3b, 0x9c, 0xb7, 0xb0, 0x96, 0x50, 0xc0, 0x71, 0xc8, 0xdf, 0xe4, 0x3f, 0xd5, 0x8c, 0x51, 0xc5,
-	0x5b, 0x4f, 0x5d, 0x97, 0xe4, 0xd0, 0xe8, 0x84, 0x44, 0x5d, 0xaa, 0x26, 0x88, 0xf4, 0x4f, 0xf0,
-	0xb0, 0x49, 0xb8, 0x47, 0xc7, 0x76, 0xf3, 0xfa, 0xf5, 0x79, 0xb2, 0xa3, 0x67, 0x5d, 0x4e, 0x82,
-	0x24, 0xc8, 0xb6, 0xf0, 0x9b, 0x01, 0x25, 0x3c, 0xee, 0x4d, 0x80, 0xf9, 0x67, 0x78, 0x7c, 0xc0,
-	0x38, 0x09, 0xd8, 0x78, 0x25, 0x4d, 0x23, 0xe0, 0xd7, 0x80, 0xbe, 0x12, 0xaa, 0x17, 0xc4, 0xdd,
-	0xaf, 0x84, 0x54, 0xfb, 0xb4, 0xcf, 0x3c, 0x2a, 0x27, 0xc0, 0x3b, 0x86, 0xc6, 0x21, 0x55, 0x09,
-	0xfd, 0x44, 0x0f, 0x73, 0x9a, 0x59, 0x22, 0xbd, 0xf9, 0x38, 0xff, 0x4e, 0x36, 0xc2, 0x8b, 0x4d,
-	0x52, 0xad, 0x0c, 0xe1, 0x0c, 0xd9, 0xbc, 0x0d, 0xf3, 0xb7, 0x25, 0x98, 0x23, 0x54, 0xd8, 0xf4,
-	0xbc, 0xa5, 0x43, 0xaa, 0x86, 0xb4, 0xf5, 0x36, 0x58, 0x9c, 0x13, 0xe7, 0x18, 0xaf, 0x01, 0xad,
-	0x1f, 0x52, 0x43, 0x0f, 0x6f, 0x8d, 0xf3, 0x49, 0x31, 0x60, 0x8e, 0x5a, 0xce, 0xa0, 0xbf, 0x98,
-	0x2d, 0xc8, 0xd0, 0xbc, 0xdb, 0xa0, 0x3f, 0x2a, 0x86, 0x2e, 0x22, 0x8a, 0x33, 0x68, 0x0f, 0x6a,
-	0x9a, 0x4e, 0xdd, 0x86, 0x79, 0xe3, 0x99, 0xb7, 0xa0, 0xa6, 0xe9, 0x26, 0xfa, 0x75, 0x1e, 0xe3,
-	0xfa, 0xe5, 0x6d, 0xf3, 0x61, 0x89, 0x34, 0xd3, 0x8c, 0x1b, 0x43, 0x7a, 0x57, 0xd0, 0x34, 0xc6,
-	0x69, 0x65, 0xd9, 0x99, 0x64, 0xd9, 0xa1, 0xa9, 0x1e, 0x67, 0xac, 0x6a, 0x86, 0x2c, 0x0c, 0xe1,
-	0x92, 0x8f, 0xc8, 0x19, 0x8a, 0x76, 0x5b, 0xcf, 0xd3, 0x67, 0x93, 0xf9, 0x6f, 0xe0, 0xee, 0xe9,
-	0x59, 0xf0, 0xc7, 0x82, 0xed, 0x23, 0x39, 0x1a, 0xd2, 0x6c, 0x9f, 0xca, 0x09, 0x2f, 0xbb, 0x1c,
-	0xa6, 0xfd, 0x46, 0x3f, 0xc9, 0x9d, 0x0c, 0x87, 0x54, 0x59, 0x06, 0x7a, 0xdb, 0xf2, 0xb7, 0xf2,
-	0xdf, 0x11, 0x47, 0xa9, 0x2b, 0x9e, 0x41, 0x04, 0x56, 0x0f, 0xa9, 0xca, 0xb1, 0xcd, 0x9b, 0x43,
-	0xcc, 0x7f, 0x2e, 0x29, 0xa5, 0xab, 0x78, 0x06, 0x7d, 0x0f, 0x28, 0xcf, 0x25, 0x51, 0xd1, 0x27,
-	0x97, 0x12, 0xc2, 0x79, 0xe3, 0x96, 0xec, 0xd5, 0xbe, 0x9b, 0xed, 0x3f, 0x3d, 0x9f, 0x37, 0x7f,
-	0x26, 0x7d, 0xfa, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcc, 0xab, 0xa3, 0x79, 0x1a, 0x00,
-	0x00,
+	0xfa, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0x2d, 0xa9, 0xad, 0xee, 0x76, 0x2f, 0xb7, 0x7b,
+	0xac, 0xe9, 0xa7, 0x02, 0x29, 0xfa, 0x50, 0xa0, 0x5f, 0xa1, 0x5f, 0xab, 0x6f, 0xfd, 0x16, 0x7d,
+	0x0f, 0x76, 0xef, 0x8e, 0x3a, 0xf2, 0xee, 0x44, 0x0b, 0xe4, 0x13, 0x77, 0x76, 0x66, 0x7e, 0x33,
+	0xbb, 0x3b, 0xb3, 0xfb, 0x23, 0x09, 0x9f, 0x04, 0x57, 0xbd, 0xbd, 0x4b, 0xc2, 0x5d, 0x8f, 0x86,
+	0x9f, 0x79, 0x24, 0xe2, 0xce, 0x25, 0x0d, 0x3f, 0x73, 0x84, 0xbf, 0xe7, 0xf8, 0xee, 0x5e, 0xff,
+	0x89, 0xfe, 0xd8, 0x0d, 0x42, 0xa1, 0x04, 0xfa, 0xe8, 0x2a, 0xba, 0xa0, 0x7d, 0x16, 0xaa, 0x5d,
+	0x3d, 0xd7, 0x7f, 0x82, 0xbb, 0x70, 0xff, 0x0d, 0xf5, 0xa3, 0x73, 0x1a, 0x4a, 0x26, 0xb8, 0x4d,
+	0x65, 0x20, 0xb8, 0xa4, 0xe8, 0x0b, 0xa8, 0x87, 0xc9, 0xd8, 0xaa, 0x6c, 0x57, 0x76, 0x96, 0x9e,
+	0x6e, 0xee, 0x8e, 0xb9, 0xee, 0xa6, 0xc6, 0xf6, 0xd0, 0x14, 0x59, 0xb0, 0xd8, 0x8f, 0x91, 0xac,
+	0xb9, 0xed, 0xca, 0x4e, 0xc3, 0x4e, 0x45, 0xfc, 0x08, 0xaa, 0xe7, 0x27, 0xc7, 0xc6, 0xc0, 0x67,
+	0xdf, 0x4a, 0xc1, 0x0d, 0xec, 0xb2, 0x9d, 0x8a, 0xf8, 0x09, 0x54, 0x9b, 0xed, 0x33, 0xb4, 0x0a,
+	0x73, 0xcc, 0x35, 0xba, 0x15, 0x7b, 0x8e, 0xb9, 0x68, 0x0b, 0xea, 0x92, 0x5d, 0x78, 0x8c, 0xf7,
+	0xa4, 0x35, 0xb7, 0x5d, 0xdd, 0x59, 0xb1, 0x87, 0x32, 0xde, 0x83, 0xc5, 0x4e, 0x3c, 0xce, 0xb9,
+	0xad, 0xc1, 0x7c, 0x9f, 0x78, 0x11, 0x35, 0x69, 0xd4, 0xec, 0x58, 0xc0, 0x2d, 0x98, 0x6f, 0x93,
+	0x1e, 0x95, 0x5a, 0xed, 0x88, 0x88, 0x2b, 0xe3, 0x51, 0xb3, 0x63, 0x01, 0x21, 0xa8, 0x45, 0x9c,
+	0xa9, 0x24, 0x75, 0x33, 0xd6, 0x73, 0x92, 0xbd, 0xa7, 0x56, 0xd5, 0x40, 0x9b, 0x31, 0x7e, 0x06,
+	0x0b, 0x27, 0xd4, 0x17, 0xe1, 0x00, 0x6d, 0xc0, 0x02, 0xf1, 0x33, 0x40, 0x89, 0x54, 0x84, 0x84,
+	0xff, 0x5b, 0x81, 0x5a, 0x93, 0x7a, 0x5e, 0x2e, 0xd7, 0x3d, 0x58, 0xf0, 0x0d, 0x9c, 0x31, 0x5f,
+	0x7a, 0xfa, 0x71, 0x6e, 0xa7, 0xe3, 0x68, 0x76, 0x62, 0x86, 0x3e, 0x85, 0xf9, 0x40, 0x2f, 0xc3,
+	0xaa, 0x6e, 0x57, 0x77, 0x96, 0x9e, 0x6e, 0xe4, 0xec, 0xcd, 0x22, 0xed, 0xd8, 0x08, 0x7d, 0x09,
+	0x0d, 0x97, 0x49, 0x45, 0xb8, 0x43, 0xa5, 0x55, 0x33, 0x1e, 0x56, 0xce, 0x23, 0xd9, 0x47, 0xfb,
+	0xda, 0x14, 0xed, 0x40, 0xcd, 0x09, 0x22, 0x69, 0xcd, 0x1b, 0x97, 0xb5, 0x9c, 0x4b, 0xb3, 0x7d,
+	0x66, 0x1b, 0x0b, 0xfc, 0x02, 0xea, 0xa7, 0x22, 0x10, 0x9e, 0xe8, 0x0d, 0xd0, 0x33, 0x00, 0x1e,
+	0xf9, 0xe4, 0x47, 0x87, 0x7a, 0x9e, 0xb4, 0x2a, 0xc6, 0x77, 0x3d, 0xef, 0x4b, 0x3d, 0xcf, 0x6e,
+	0x68, 0x43, 0x3d, 0x92, 0xf8, 0x5f, 0x15, 0x58, 0xe8, 0x9c, 0xec, 0x33, 0x21, 0x11, 0x86, 0x65,
+	0x9f, 0xf0, 0xa8, 0x4b, 0x1c, 0x15, 0x85, 0x34, 0x34, 0xfb, 0xd4, 0xb0, 0x47, 0xe6, 0x74, 0x15,
+	0x05, 0xa1, 0x70, 0x23, 0x27, 0xdd, 0xe1, 0x54, 0xcc, 0x16, 0x60, 0x75, 0xa4, 0x00, 0xd1, 0x5d,
+	0xa8, 0xca, 0xab, 0xc8, 0xaa, 0x99, 0x59, 0x3d, 0xd4, 0x87, 0xd7, 0x25, 0x3e, 0xf3, 0x06, 0xd6,
+	0xbc, 0x99, 0x4c, 0x24, 0xfc, 0xcf, 0x0a, 0xd4, 0x0f, 0x98, 0xbc, 0x3a, 0xe6, 0x5d, 0x61, 0x8c,
+	0x44, 0xe8, 0x13, 0x95, 0x24, 0x92, 0x48, 0x68, 0x1b, 0x96, 0x2e, 0x88, 0x73, 0xc5, 0x78, 0xef,
+	0x90, 0x79, 0x34, 0x49, 0x23, 0x3b, 0x85, 0x1e, 0x02, 0xe8, 0x7c, 0x89, 0xd7, 0x49, 0xeb, 0xa7,
+	0x66, 0x67, 0x66, 0x34, 0x82, 0xde, 0x92, 0xd4, 0xa0, 0x66, 0x0c, 0xb2, 0x53, 0xf8, 0xff, 0x15,
+	0x58, 0x69, 0x7a, 0x91, 0x54, 0x34, 0x6c, 0x0a, 0xde, 0x65, 0x3d, 0xb4, 0x0b, 0xa8, 0xf5, 0x2e,
+	0x20, 0xdc, 0xd5, 0xf9, 0xc9, 0x16, 0x27, 0x17, 0x1e, 0x8d, 0x4b, 0xa9, 0x6e, 0x17, 0x68, 0xd0,
+	0x1f, 0x61, 0xf3, 0x30, 0xa4, 0x54, 0xd7, 0x83, 0x4d, 0x03, 0x11, 0x2a, 0xc6, 0x7b, 0x07, 0x4c,
+	0xc6, 0x6e, 0x73, 0xc6, 0xad, 0xdc, 0x00, 0x3d, 0x07, 0x6b, 0x5f, 0x38, 0x97, 0xf2, 0x80, 0xc9,
+	0xc0, 0x23, 0x83, 0x43, 0x11, 0xb6, 0x0e, 0x8f, 0x8f, 0x22, 0x2a, 0x95, 0x34, 0xeb, 0xa9, 0xdb,
+	0xa5, 0x7a, 0xed, 0xdb, 0xa1, 0x21, 0x23, 0x5e, 0x53, 0x70, 0x29, 0x3c, 0xfa, 0x52, 0x5c, 0x07,
+	0xae, 0xc5, 0xbe, 0x65, 0x7a, 0xfc, 0x39, 0x6c, 0x1e, 0x73, 0x45, 0xc3, 0x2e, 0x71, 0xe8, 0x3e,
+	0xe3, 0x2e, 0xe3, 0xbd, 0x13, 0xd6, 0x0b, 0x89, 0xd2, 0xe7, 0xb8, 0xa1, 0x9b, 0x4f, 0x5d, 0x0a,
+	0x37, 0x3d, 0x90, 0x58, 0xc2, 0xff, 0x5b, 0x84, 0xf5, 0xf3, 0x78, 0xf3, 0x4e, 0x88, 0x73, 0xc9,
+	0x38, 0x7d, 0x1d, 0x68, 0x07, 0x89, 0xbe, 0x83, 0xb5, 0x51, 0x45, 0x5c, 0x69, 0x56, 0xa5, 0xa4,
+	0xdb, 0x62, 0xb5, 0x5d, 0xe8, 0x84, 0x9e, 0xc1, 0xfa, 0x09, 0xf5, 0xf7, 0x89, 0xe7, 0x09, 0xc1,
+	0x3b, 0x8a, 0x28, 0xd9, 0xa6, 0x21, 0x13, 0xf1, 0x6e, 0xae, 0xd8, 0xc5, 0x4a, 0xf4, 0x7b, 0xb8,
+	0xdf, 0x0e, 0xa9, 0x9e, 0x77, 0x88, 0xa2, 0xee, 0xb9, 0xf0, 0x22, 0x3f, 0xe9, 0xdf, 0x86, 0x5d,
+	0xa4, 0xd2, 0x17, 0xb0, 0x4a, 0x7a, 0xca, 0xaa, 0x95, 0x5c, 0xc0, 0x69, 0xd3, 0xd9, 0x43, 0x53,
+	0xd4, 0x81, 0x86, 0x29, 0x00, 0x5d, 0xbb, 0x49, 0xe7, 0x7e, 0x91, 0xf3, 0x2b, 0xdc, 0xa6, 0xdd,
+	0xa1, 0x5f, 0x8b, 0xab, 0x70, 0x60, 0x5f, 0xe3, 0x94, 0x54, 0xdd, 0x42, 0x69, 0xd5, 0x1d, 0xc0,
+	0x8a, 0x93, 0x2d, 0x5b, 0x6b, 0xd1, 0x2c, 0xe0, 0x61, 0xfe, 0x1a, 0xc8, 0x5a, 0xd9, 0xa3, 0x4e,
+	0xe8, 0xe7, 0x0a, 0x6c, 0xb2, 0xb4, 0x0c, 0x0e, 0x84, 0x4f, 0x18, 0xff, 0x5a, 0x29, 0xe2, 0x5c,
+	0xfa, 0x94, 0x2b, 0xab, 0x6e, 0xd6, 0xd6, 0xfa, 0xc0, 0xb5, 0x1d, 0x97, 0xe1, 0xc4, 0x6b, 0x2d,
+	0x8f, 0x83, 0x38, 0xa0, 0xa1, 0x72, 0x58, 0x84, 0x56, 0xc3, 0x44, 0xff, 0xea, 0xb6, 0xd1, 0x87,
+	0x00, 0x71, 0xd8, 0x02, 0xe4, 0xad, 0xb7, 0xb0, 0x3a, 0x7a, 0x10, 0xfa, 0xe2, 0xba, 0xa2, 0x83,
+	0xa4, 0xda, 0xf5, 0x10, 0xed, 0x65, 0x1f, 0xb7, 0xa2, 0xc2, 0x48, 0x6f, 0xaf, 0xe4, 0xdd, 0x7b,
+	0x3e, 0xf7, 0x87, 0xca, 0xd6, 0x4b, 0x78, 0x78, 0xf3, 0x2e, 0x14, 0x04, 0x1a, 0x79, 0x45, 0x1b,
+	0x59, 0xb4, 0x9f, 0xe0, 0xe3, 0x92, 0x55, 0x15, 0xc0, 0xbc, 0x18, 0xcd, 0xf7, 0x77, 0xb9, 0x7c,
+	0x4b, 0xbb, 0x3d, 0x13, 0x12, 0xf7, 0x01, 0xce, 0x4f, 0x8e, 0x6d, 0xfa, 0x93, 0xbe, 0x60, 0xd0,
+	0x63, 0xa8, 0xf6, 0x7d, 0x96, 0xf4, 0x70, 0xfe, 0x71, 0xd2, 0x96, 0xda, 0x00, 0xbd, 0x80, 0x45,
+	0x11, 0x1f, 0x43, 0x12, 0xfd, 0xf1, 0x87, 0x1d, 0x9a, 0x9d, 0xba, 0xe1, 0x53, 0xb8, 0x7b, 0x9d,
+	0xcf, 0x2d, 0xa3, 0x5b, 0xa3, 0xd1, 0x97, 0xaf, 0x51, 0x7f, 0xae, 0xc0, 0x52, 0xeb, 0x1d, 0x75,
+	0x52, 0xc4, 0x87, 0x00, 0xae, 0x39, 0x95, 0x57, 0xc4, 0xa7, 0xc9, 0xe6, 0x65, 0x66, 0x34, 0x52,
+	0x53, 0xf8, 0x3e, 0xe1, 0x6e, 0xfa, 0xe4, 0x25, 0xa2, 0xe6, 0x1a, 0x5f, 0x87, 0xbd, 0xf4, 0x32,
+	0x31, 0x63, 0xf4, 0x18, 0x56, 0x15, 0xf3, 0xa9, 0x88, 0x54, 0x87, 0x3a, 0x82, 0xbb, 0xd2, 0xdc,
+	0x21, 0xf3, 0xf6, 0xd8, 0x2c, 0x5e, 0x85, 0xe5, 0x96, 0x1f, 0xa8, 0x41, 0x92, 0x05, 0xfe, 0x0a,
+	0xea, 0x76, 0x86, 0xcb, 0xc9, 0xc8, 0x71, 0xa8, 0x94, 0xc9, 0x03, 0x93, 0x8a, 0x5a, 0xe3, 0x53,
+	0x29, 0x49, 0x2f, 0x2d, 0x8c, 0x54, 0xc4, 0x3f, 0xc2, 0x6a, 0x5c, 0x5b, 0xd3, 0x12, 0xc9, 0x0d,
+	0x58, 0x88, 0x17, 0x9f, 0x44, 0x48, 0x24, 0xcc, 0xe1, 0x7e, 0x1c, 0xc0, 0xdc, 0xae, 0xd3, 0x46,
+	0xd9, 0x86, 0x25, 0xf7, 0x1a, 0x2d, 0x7d, 0xc4, 0x33, 0x53, 0xf8, 0x1d, 0xdc, 0x33, 0x0f, 0x9a,
+	0xe9, 0xa6, 0x29, 0xa3, 0x7d, 0x0a, 0xf7, 0x7a, 0xe3, 0x58, 0x49, 0xcc, 0xbc, 0x02, 0xff, 0xa3,
+	0x02, 0xeb, 0x26, 0xf4, 0x99, 0xa4, 0xe1, 0x4b, 0x26, 0xd5, 0xb4, 0xe1, 0x9f, 0xc1, 0x7a, 0xaf,
+	0x08, 0x2f, 0x49, 0xa1, 0x58, 0x89, 0xff, 0x5d, 0x01, 0xcb, 0xa4, 0xa1, 0x39, 0x8d, 0x1c, 0x48,
+	0x45, 0xfd, 0xa9, 0xb7, 0xfd, 0x39, 0x58, 0xbd, 0x12, 0xc8, 0x24, 0x99, 0x52, 0x3d, 0x1e, 0xc0,
+	0x72, 0xdc, 0x36, 0xd3, 0xa5, 0xb0, 0x05, 0x75, 0xfa, 0x8e, 0xa9, 0xa6, 0x70, 0xe3, 0x90, 0xf3,
+	0xf6, 0x50, 0xd6, 0xb5, 0x27, 0x95, 0xfb, 0x3a, 0x52, 0x09, 0x85, 0x4c, 0x24, 0xfc, 0x3d, 0xdc,
+	0x35, 0x3b, 0xd1, 0xd6, 0x44, 0xf9, 0x03, 0xdb, 0x36, 0xdf, 0x88, 0x73, 0x85, 0x8d, 0xf8, 0x2d,
+	0xdc, 0xcb, 0x60, 0x4f, 0xb5, 0x36, 0x2c, 0x60, 0x45, 0x73, 0xba, 0xf7, 0xf4, 0xb6, 0xb7, 0xd5,
+	0x97, 0xb0, 0x11, 0xf1, 0xae, 0x71, 0x3d, 0x2d, 0x4a, 0xba, 0x44, 0x8b, 0xdf, 0xc2, 0xbd, 0xf8,
+	0x1b, 0xca, 0x41, 0xe4, 0x07, 0xb7, 0x0d, 0xba, 0x05, 0x75, 0x37, 0xf2, 0x83, 0x36, 0x51, 0x97,
+	0xc9, 0xe1, 0x0f, 0x65, 0x7c, 0x01, 0x1f, 0x75, 0x5a, 0xe7, 0xb3, 0xe8, 0x3d, 0x7d, 0x99, 0xd1,
+	0xbe, 0x61, 0x45, 0xc9, 0x45, 0x9c, 0x88, 0xf8, 0xef, 0x15, 0xd8, 0x7c, 0x69, 0xbe, 0x33, 0x9f,
+	0x50, 0x22, 0xa3, 0x90, 0xea, 0x07, 0x71, 0x06, 0xad, 0xee, 0x8d, 0x63, 0x26, 0x81, 0xf3, 0x0a,
+	0xfc, 0x83, 0xe6, 0xbb, 0x7f, 0xa5, 0x8e, 0x8a, 0xf3, 0xe8, 0x50, 0x27, 0xa4, 0x6a, 0x76, 0x4f,
+	0xcd, 0x1b, 0x58, 0xd9, 0x27, 0xce, 0x55, 0x14, 0xcc, 0x0c, 0xf2, 0xe9, 0x7f, 0xd6, 0xa1, 0xda,
+	0xf4, 0x5d, 0xf4, 0x0a, 0x50, 0x67, 0xc0, 0x9d, 0xd1, 0x17, 0x14, 0xfd, 0xaa, 0x10, 0x32, 0x0e,
+	0xbe, 0x55, 0xbe, 0x7f, 0xf8, 0x0e, 0x7a, 0x0d, 0xf7, 0xdb, 0x24, 0x92, 0x74, 0x66, 0x80, 0x6f,
+	0x60, 0xfd, 0x8c, 0x07, 0x33, 0x85, 0xec, 0xc0, 0x5a, 0xdc, 0x5e, 0x63, 0x88, 0x79, 0x7a, 0x3b,
+	0xd2, 0x85, 0x37, 0x83, 0xda, 0xb0, 0x71, 0xc6, 0xbb, 0x45, 0xb0, 0x53, 0x6d, 0xa6, 0x4d, 0x25,
+	0x55, 0x33, 0x03, 0x3c, 0x05, 0xab, 0x23, 0xba, 0xca, 0xa6, 0x17, 0x42, 0xcc, 0x0e, 0xd5, 0x86,
+	0x8d, 0xce, 0x65, 0xa4, 0x5c, 0xf1, 0x37, 0x3e, 0x33, 0xcc, 0x57, 0x80, 0xbe, 0x63, 0x9e, 0x37,
+	0x33, 0xbc, 0x36, 0xac, 0x1d, 0x50, 0x8f, 0xaa, 0xd9, 0x1d, 0xce, 0x5b, 0x58, 0x8f, 0x59, 0xe5,
+	0x38, 0xe4, 0x6f, 0x72, 0x5e, 0xe3, 0xec, 0x73, 0xe2, 0xa9, 0xeb, 0x96, 0x1c, 0x3a, 0x9d, 0x92,
+	0xb0, 0x47, 0xd5, 0x14, 0x99, 0xfe, 0x09, 0x1e, 0x34, 0xf5, 0x2f, 0x42, 0x63, 0xbb, 0x39, 0x0c,
+	0x30, 0xe5, 0xd1, 0xb3, 0x1e, 0x27, 0x5e, 0x9c, 0x64, 0x5b, 0xb8, 0x4d, 0x8f, 0x12, 0x1e, 0x05,
+	0x53, 0x60, 0xfe, 0x19, 0x1e, 0x1d, 0x32, 0x4e, 0x3c, 0xf6, 0x9e, 0xce, 0x3e, 0xe1, 0x57, 0x80,
+	0xbe, 0x11, 0x2a, 0xf0, 0xa2, 0xde, 0x37, 0x42, 0xaa, 0x03, 0xda, 0x67, 0x0e, 0x95, 0x53, 0xe0,
+	0x9d, 0x40, 0xe3, 0x88, 0xaa, 0x98, 0xd1, 0xa2, 0x07, 0x39, 0xcb, 0x2c, 0x37, 0xdf, 0x7a, 0x94,
+	0xff, 0x9a, 0x37, 0x42, 0xb5, 0x4d, 0x51, 0xad, 0x0e, 0xe1, 0x0c, 0x7f, 0x9d, 0x84, 0xf9, 0xdb,
+	0x12, 0xcc, 0x11, 0x76, 0x6d, 0xee, 0xbc, 0xe5, 0x23, 0xaa, 0x86, 0x4c, 0x78, 0x12, 0x2c, 0xce,
+	0xa9, 0x73, 0x24, 0xda, 0x80, 0xd6, 0x8f, 0xa8, 0x61, 0x9c, 0x13, 0xf3, 0x7c, 0x5c, 0x0c, 0x98,
+	0x63, 0xab, 0x77, 0xd0, 0x5f, 0xcc, 0x16, 0x64, 0x98, 0xe3, 0x24, 0xe8, 0x4f, 0x8a, 0xa1, 0x8b,
+	0xb8, 0xe7, 0x1d, 0xb4, 0x0f, 0x35, 0xcd, 0xd0, 0x26, 0x61, 0xde, 0x78, 0xe6, 0x2d, 0xa8, 0x69,
+	0x06, 0x8b, 0x7e, 0x9d, 0xc7, 0xb8, 0xfe, 0x3e, 0xb8, 0xf5, 0xa0, 0x44, 0x9b, 0xb9, 0x8c, 0x1b,
+	0x43, 0xc6, 0x58, 0x70, 0x69, 0x8c, 0x33, 0xd5, 0x2d, 0x7c, 0x93, 0x49, 0xa6, 0x7b, 0xac, 0xb1,
+	0xae, 0x19, 0x12, 0x3b, 0x84, 0x4b, 0x7e, 0x97, 0xce, 0xb0, 0xbe, 0x89, 0x2f, 0xe7, 0x28, 0x78,
+	0x4c, 0x4b, 0x0a, 0x5e, 0xce, 0x11, 0xbe, 0x32, 0xe9, 0x22, 0xd5, 0x07, 0x9e, 0xf9, 0x0f, 0xe3,
+	0xf6, 0x35, 0x5f, 0xf0, 0x07, 0x48, 0x72, 0x39, 0xe5, 0xb8, 0x4d, 0xb3, 0x7d, 0x26, 0xa7, 0x7c,
+	0x41, 0x73, 0x98, 0xc9, 0x7f, 0x09, 0xd3, 0x3c, 0xf4, 0x70, 0x44, 0x55, 0xc2, 0x94, 0x27, 0x2d,
+	0x7f, 0x3b, 0xa7, 0x1e, 0xa3, 0xd8, 0xf8, 0x0e, 0x22, 0xb0, 0x76, 0x44, 0x55, 0x8e, 0x15, 0xdf,
+	0x9c, 0x62, 0xfe, 0x67, 0x9d, 0x52, 0x5a, 0x8d, 0xef, 0xa0, 0x1f, 0x00, 0xe5, 0x39, 0x2f, 0x2a,
+	0xfa, 0x69, 0xa8, 0x84, 0x18, 0xdf, 0xb8, 0x25, 0xfb, 0xb5, 0xef, 0xe7, 0xfa, 0x4f, 0x2e, 0x16,
+	0xcc, 0x9f, 0x5e, 0x9f, 0xff, 0x32, 0x00, 0x8d, 0x18, 0x36, 0x98, 0x21, 0x1b, 0x00, 0x00,
 }
@@ -430,6 +430,22 @@ func (_mr *_MockCmdClientRecorder) VirtualMachineMemoryDump(arg0, arg1 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineMemoryDump", _s...)
 }
 
+func (_m *MockCmdClient) VirtualMachineBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", _s...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) VirtualMachineBackup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", _s...)
+}
+
 func (_m *MockCmdClient) GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error) {
 	_s := []interface{}{ctx, in}
 	for _, _x := range opts {
@@ -822,6 +838,17 @@ func (_mr *_MockCmdServerRecorder) VirtualMachineMemoryDump(arg0, arg1 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineMemoryDump", arg0, arg1)
 }
 
+func (_m *MockCmdServer) VirtualMachineBackup(_param0 context.Context, _param1 *BackupRequest) (*Response, error) {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", _param0, _param1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) VirtualMachineBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", arg0, arg1)
+}
+
 func (_m *MockCmdServer) GetQemuVersion(_param0 context.Context, _param1 *EmptyRequest) (*QemuVersionResponse, error) {
 	ret := _m.ctrl.Call(_m, "GetQemuVersion", _param0, _param1)
 	ret0, _ := ret[0].(*QemuVersionResponse)
@@ -19,6 +19,8 @@
 package virt_controller
 
 import (
+	"time"
+
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +38,7 @@ const (
 var (
 	migrationMetrics = []operatormetrics.Metric{
 		vmiMigrationPhaseTransitionTimeFromCreation,
+		vmiMigrationDowntime,
 	}
 
 	vmiMigrationPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
@@ -51,12 +54,42 @@ var (
 			"phase",
 		},
 	)
+
+	vmiMigrationDowntime = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_downtime_seconds",
+			Help: "Histogram of the guest downtime of succeeded VMI migrations in seconds, as measured by the hypervisor.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: migrationDowntimeBuckets(),
+		},
+		[]string{
+			// mode the vmi migration completed in
+			"mode",
+		},
+	)
 )
 
+func migrationDowntimeBuckets() []float64 {
+	return []float64{
+		10 * time.Millisecond.Seconds(),
+		25 * time.Millisecond.Seconds(),
+		50 * time.Millisecond.Seconds(),
+		100 * time.Millisecond.Seconds(),
+		250 * time.Millisecond.Seconds(),
+		500 * time.Millisecond.Seconds(),
+		1 * time.Second.Seconds(),
+		2.5 * time.Second.Seconds(),
+		5 * time.Second.Seconds(),
+		10 * time.Second.Seconds(),
+	}
+}
+
 func CreateVMIMigrationHandler(informer cache.SharedIndexInformer) error {
 	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(oldVMIMigration, newVMIMigration interface{}) {
 			updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
+			updateVMIMigrationDowntime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
 		},
 	})
 
@@ -99,3 +132,31 @@ func getVMIMigrationTransitionTimeSeconds(newVMIMigration *v1.VirtualMachineInst
 
 	return getTransitionTimeSeconds(oldTime, newTime)
 }
+
+func updateVMIMigrationDowntime(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
+	downtime, measured := getVMIMigrationDowntimeSeconds(oldVMIMigration, newVMIMigration)
+	if !measured {
+		return
+	}
+
+	histogram, err := vmiMigrationDowntime.GetMetricWithLabelValues(string(newVMIMigration.Status.MigrationState.Mode))
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get a histogram for the VMI migration downtime")
+		return
+	}
+
+	histogram.Observe(downtime)
+}
+
+// getVMIMigrationDowntimeSeconds returns the downtime of a succeeded migration once the final
+// migration state is stored on the migration, so that every migration is observed once
+func getVMIMigrationDowntimeSeconds(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) (float64, bool) {
+	if newVMIMigration.Status.Phase != v1.MigrationSucceeded ||
+		newVMIMigration.Status.MigrationState == nil || newVMIMigration.Status.MigrationState.Downtime == nil {
+		return 0, false
+	}
+	if oldVMIMigration != nil && oldVMIMigration.Status.MigrationState != nil && oldVMIMigration.Status.MigrationState.Downtime != nil {
+		return 0, false
+	}
+	return newVMIMigration.Status.MigrationState.Downtime.Seconds(), true
+}
@@ -55,6 +55,35 @@ var _ = Describe("VMI migration phase transition time histogram", func() {
 	})
 })
 
+var _ = Describe("VMI migration downtime histogram", func() {
+	newMigration := func(phase v1.VirtualMachineInstanceMigrationPhase, downtime *metav1.Duration) *v1.VirtualMachineInstanceMigration {
+		return &v1.VirtualMachineInstanceMigration{
+			Status: v1.VirtualMachineInstanceMigrationStatus{
+				Phase:          phase,
+				MigrationState: &v1.VirtualMachineInstanceMigrationState{Downtime: downtime},
+			},
+		}
+	}
+
+	It("should observe the downtime once it is stored on the succeeded migration", func() {
+		downtime, measured := getVMIMigrationDowntimeSeconds(
+			newMigration(v1.MigrationRunning, nil),
+			newMigration(v1.MigrationSucceeded, &metav1.Duration{Duration: 250 * time.Millisecond}),
+		)
+		Expect(measured).To(BeTrue())
+		Expect(downtime).To(Equal(0.25))
+	})
+
+	DescribeTable("should not observe the downtime", func(oldMigration, newMigration *v1.VirtualMachineInstanceMigration) {
+		_, measured := getVMIMigrationDowntimeSeconds(oldMigration, newMigration)
+		Expect(measured).To(BeFalse())
+	},
+		Entry("of a failed migration", newMigration(v1.MigrationRunning, nil), newMigration(v1.MigrationFailed, &metav1.Duration{Duration: time.Second})),
+		Entry("which was not measured", newMigration(v1.MigrationRunning, nil), newMigration(v1.MigrationSucceeded, nil)),
+		Entry("which was already observed", newMigration(v1.MigrationSucceeded, &metav1.Duration{Duration: time.Second}), newMigration(v1.MigrationSucceeded, &metav1.Duration{Duration: time.Second})),
+	)
+})
+
 func createVMIMigrationSForPhaseTransitionTime(phase v1.VirtualMachineInstanceMigrationPhase, offset float64) *v1.VirtualMachineInstanceMigration {
 	now := metav1.NewTime(time.Now())
 	old := metav1.NewTime(now.Time.Add(-time.Duration(int64(offset)) * time.Millisecond))
@@ -58,6 +58,9 @@ var (
 			vmiAddresses,
 			vmiMigrationStartTime,
 			vmiMigrationEndTime,
+			vmiMigrationDirtyRate,
+			vmiMigrationPredictedCompletionTime,
+			vmiMigrationConverging,
 			vmiVnicInfo,
 		},
 		CollectCallback: vmiStatsCollectorCallback,
@@ -118,6 +121,31 @@ var (
 		[]string{"node", "namespace", "name", "migration_name", "status"},
 	)
 
+	vmiMigrationDirtyRate = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_measured_dirty_rate_bytes",
+			Help: "The rate at which the guest dirties its memory measured during a running migration, in bytes per second.",
+		},
+		[]string{"node", "namespace", "name", "migration_name"},
+	)
+
+	vmiMigrationPredictedCompletionTime = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_predicted_completion_time_seconds",
+			Help: "The time at which a running migration is predicted to complete at its transfer rate and the measured dirty rate.",
+		},
+		[]string{"node", "namespace", "name", "migration_name"},
+	)
+
+	vmiMigrationConverging = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_converging",
+			Help: "Indication whether a running migration transfers the guest memory faster than the guest dirties it. " +
+				"A non converging migration only completes with post-copy or by pausing the guest.",
+		},
+		[]string{"node", "namespace", "name", "migration_name"},
+	)
+
 	vmiVnicInfo = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vnic_info",
@@ -152,6 +180,7 @@ func reportVmisStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.Col
 		crs = append(crs, getEvictionBlocker(vmi))
 		crs = append(crs, collectVMIInterfacesInfo(vmi)...)
 		crs = append(crs, collectVMIMigrationTime(vmi)...)
+		crs = append(crs, collectVMIMigrationConvergence(vmi)...)
 		crs = append(crs, CollectVmisVnicInfo(vmi)...)
 	}
 
@@ -408,6 +437,48 @@ func collectVMIMigrationTime(vmi *k6tv1.VirtualMachineInstance) []operatormetric
 	return cr
 }
 
+func collectVMIMigrationConvergence(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.EndTimestamp != nil || migrationState.Convergence == nil {
+		return cr
+	}
+
+	convergence := migrationState.Convergence
+	labels := []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name,
+		getMigrationNameFromMigrationUID(vmi.Namespace, migrationState.MigrationUID),
+	}
+
+	cr = append(cr, operatormetrics.CollectorResult{
+		Metric: vmiMigrationDirtyRate,
+		Value:  float64(convergence.DirtyRateBytesPerSecond),
+		Labels: labels,
+	})
+
+	if convergence.Converging != nil {
+		converging := 0.0
+		if *convergence.Converging {
+			converging = 1.0
+		}
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmiMigrationConverging,
+			Value:  converging,
+			Labels: labels,
+		})
+	}
+
+	if convergence.PredictedCompletionTimestamp != nil {
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmiMigrationPredictedCompletionTime,
+			Value:  float64(convergence.PredictedCompletionTimestamp.Time.Unix()),
+			Labels: labels,
+		})
+	}
+
+	return cr
+}
+
 func calculateMigrationStatus(migrationState *k6tv1.VirtualMachineInstanceMigrationState) string {
 	if !migrationState.Completed {
 		return ""
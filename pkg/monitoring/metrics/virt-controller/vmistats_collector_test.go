@@ -521,6 +521,74 @@ var _ = Describe("VMI Stats Collector", func() {
 		})
 	})
 
+	Context("VMI migration convergence metrics", func() {
+		now := metav1.Unix(1000, 0)
+		nowFloatValue := float64(now.Unix())
+
+		newMigratingVMI := func(convergence *k6tv1.MigrationConvergence) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "testvmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "testNode",
+					MigrationState: &k6tv1.VirtualMachineInstanceMigrationState{
+						MigrationUID:   "test-migration-uid",
+						StartTimestamp: &now,
+						Convergence:    convergence,
+					},
+				},
+			}
+		}
+
+		It("should not create convergence metrics before the dirty rate is measured", func() {
+			Expect(collectVMIMigrationConvergence(newMigratingVMI(nil))).To(BeEmpty())
+		})
+
+		It("should not create convergence metrics for a completed migration", func() {
+			vmi := newMigratingVMI(&k6tv1.MigrationConvergence{DirtyRateBytesPerSecond: 1024})
+			vmi.Status.MigrationState.EndTimestamp = &now
+			vmi.Status.MigrationState.Completed = true
+
+			Expect(collectVMIMigrationConvergence(vmi)).To(BeEmpty())
+		})
+
+		It("should create the convergence metrics of a converging migration", func() {
+			converging := true
+			metrics := collectVMIMigrationConvergence(newMigratingVMI(&k6tv1.MigrationConvergence{
+				DirtyRateBytesPerSecond:      1024,
+				TransferRateBytesPerSecond:   4096,
+				Converging:                   &converging,
+				PredictedCompletionTimestamp: &now,
+			}))
+			Expect(metrics).To(HaveLen(3))
+
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_measured_dirty_rate_bytes"))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1024))
+			Expect(metrics[0].Labels).To(Equal([]string{"testNode", "test-ns", "testvmi", "test-migration"}))
+
+			Expect(metrics[1].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_converging"))
+			Expect(metrics[1].Value).To(BeEquivalentTo(1))
+
+			Expect(metrics[2].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_predicted_completion_time_seconds"))
+			Expect(metrics[2].Value).To(BeEquivalentTo(nowFloatValue))
+		})
+
+		It("should report a migration which does not converge", func() {
+			converging := false
+			metrics := collectVMIMigrationConvergence(newMigratingVMI(&k6tv1.MigrationConvergence{
+				DirtyRateBytesPerSecond:    4096,
+				TransferRateBytesPerSecond: 1024,
+				Converging:                 &converging,
+			}))
+			Expect(metrics).To(HaveLen(2))
+
+			Expect(metrics[1].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_migration_converging"))
+			Expect(metrics[1].Value).To(BeEquivalentTo(0))
+		})
+	})
+
 	Context("VMI vNIC info", func() {
 		It("should collect kubevirt_vmi_vnic_info metric with correct labels", func() {
 			vmi := &k6tv1.VirtualMachineInstance{
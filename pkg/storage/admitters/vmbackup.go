@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/api/core"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMBackupAdmitter validates VirtualMachineBackups
+type VMBackupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupAdmitter creates a VMBackupAdmitter
+func NewVMBackupAdmitter(config *virtconfig.ClusterConfig) *VMBackupAdmitter {
+	return &VMBackupAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMBackupAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("incremental backup feature gate not enabled"))
+	}
+
+	vmBackup := &backupv1.VirtualMachineBackup{}
+	err := json.Unmarshal(ar.Request.Object.Raw, vmBackup)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateVMBackupSpec(k8sfield.NewPath("spec"), &vmBackup.Spec)
+	case admissionv1.Update:
+		prevObj := &backupv1.VirtualMachineBackup{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, vmBackup.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateVMBackupSpec(field *k8sfield.Path, spec *backupv1.VirtualMachineBackupSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	sourceField := field.Child("source")
+
+	switch {
+	case spec.Source.APIGroup == nil:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: "missing apiGroup",
+			Field:   sourceField.Child("apiGroup").String(),
+		})
+	case *spec.Source.APIGroup != core.GroupName:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "invalid apiGroup",
+			Field:   sourceField.Child("apiGroup").String(),
+		})
+	case spec.Source.Kind != "VirtualMachine":
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "invalid kind",
+			Field:   sourceField.Child("kind").String(),
+		})
+	case spec.Source.Name == "":
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing name",
+			Field:   sourceField.Child("name").String(),
+		})
+	}
+
+	if spec.PVCName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing pvcName",
+			Field:   field.Child("pvcName").String(),
+		})
+	}
+
+	if spec.Type != nil && *spec.Type != backupv1.Full && *spec.Type != backupv1.Incremental {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("type must be %s or %s", backupv1.Full, backupv1.Incremental),
+			Field:   field.Child("type").String(),
+		})
+	}
+
+	return causes
+}
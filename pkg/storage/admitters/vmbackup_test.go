@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineBackup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newBackup := func() *backupv1.VirtualMachineBackup {
+		return &backupv1.VirtualMachineBackup{
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     "vm",
+				},
+				PVCName: "backup-pvc",
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createBackupAdmissionReview(newBackup())
+			resp := NewVMBackupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("incremental backup feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"IncrementalBackup"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMBackupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should allow a valid backup", func() {
+			backup := newBackup()
+			backup.Spec.Type = pointer.P(backupv1.Full)
+
+			ar := createBackupAdmissionReview(backup)
+			resp := NewVMBackupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*backupv1.VirtualMachineBackup), field string) {
+			backup := newBackup()
+			mutate(backup)
+
+			ar := createBackupAdmissionReview(backup)
+			resp := NewVMBackupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("a missing apiGroup", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.Source.APIGroup = nil
+			}, "spec.source.apiGroup"),
+			Entry("an invalid apiGroup", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.Source.APIGroup = pointer.P("foo.bar")
+			}, "spec.source.apiGroup"),
+			Entry("an invalid kind", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.Source.Kind = "VirtualMachineInstance"
+			}, "spec.source.kind"),
+			Entry("a missing name", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.Source.Name = ""
+			}, "spec.source.name"),
+			Entry("a missing pvcName", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.PVCName = ""
+			}, "spec.pvcName"),
+			Entry("an invalid type", func(b *backupv1.VirtualMachineBackup) {
+				b.Spec.Type = pointer.P(backupv1.BackupType("Differential"))
+			}, "spec.type"),
+		)
+
+		It("should reject spec updates", func() {
+			oldBackup := newBackup()
+			backup := newBackup()
+			backup.Spec.PVCName = "other-pvc"
+
+			ar := createBackupAdmissionReview(backup)
+			ar.Request.Operation = admissionv1.Update
+			oldBytes, _ := json.Marshal(oldBackup)
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMBackupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createBackupAdmissionReview(backup *backupv1.VirtualMachineBackup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(backup)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "backup.kubevirt.io",
+				Resource: "virtualmachinebackups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -98,6 +99,9 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 				},
 			}
 		}
+		if vmExport.Spec.Destination != nil {
+			causes = append(causes, admitter.validateDestination(k8sfield.NewPath("spec", "destination"), vmExport.Spec.Destination)...)
+		}
 
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
@@ -212,3 +216,50 @@ func (admitter *VMExportAdmitter) validateVMApiGroup(field *k8sfield.Path, apigr
 
 	return []metav1.StatusCause{}
 }
+
+func (admitter *VMExportAdmitter) validateDestination(field *k8sfield.Path, destination *exportv1.VirtualMachineExportDestination) []metav1.StatusCause {
+	if destination.S3 == nil {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "destination must specify s3",
+				Field:   field.Child("s3").String(),
+			},
+		}
+	}
+
+	var causes []metav1.StatusCause
+	s3Field := field.Child("s3")
+	s3 := destination.S3
+	if u, err := url.Parse(s3.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("endpoint %q must be a http or https URL", s3.Endpoint),
+			Field:   s3Field.Child("endpoint").String(),
+		})
+	}
+	if s3.Bucket == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "bucket must not be empty",
+			Field:   s3Field.Child("bucket").String(),
+		})
+	}
+	if s3.CredentialsSecretRef == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "credentialsSecretRef must not be empty",
+			Field:   s3Field.Child("credentialsSecretRef").String(),
+		})
+	}
+	switch s3.Format {
+	case "", exportv1.KubeVirtRaw, exportv1.KubeVirtGz, exportv1.KubeVirtZstd, exportv1.KubeVirtQcow2:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("format %q is not supported, must be one of raw, gzip, zstd or qcow2", s3.Format),
+			Field:   s3Field.Child("format").String(),
+		})
+	}
+	return causes
+}
@@ -257,6 +257,66 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine snapshot", "invalid", vmSnapshotKind),
 			Entry("virtual machine", "invalid", vmKind),
 		)
+
+		createDestinationExport := func(destination *exportv1.VirtualMachineExportDestination) *exportv1.VirtualMachineExport {
+			return &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &kubevirtApiGroup,
+						Kind:     vmKind,
+						Name:     "test",
+					},
+					Destination: destination,
+				},
+			}
+		}
+
+		validS3Destination := func() *exportv1.VirtualMachineExportS3Destination {
+			return &exportv1.VirtualMachineExportS3Destination{
+				Endpoint:             "https://minio.example.com:9000",
+				Bucket:               "exports",
+				CredentialsSecretRef: "s3-credentials",
+				Format:               exportv1.KubeVirtQcow2,
+			}
+		}
+
+		It("should allow a valid s3 destination", func() {
+			ar := createExportAdmissionReview(createDestinationExport(&exportv1.VirtualMachineExportDestination{S3: validS3Destination()}))
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("it should reject invalid destinations", func(updateS3 func(*exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination, expectedField string) {
+			ar := createExportAdmissionReview(createDestinationExport(&exportv1.VirtualMachineExportDestination{S3: updateS3(validS3Destination())}))
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("without s3", func(*exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				return nil
+			}, "spec.destination.s3"),
+			Entry("with a relative endpoint", func(s3 *exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				s3.Endpoint = "minio:9000"
+				return s3
+			}, "spec.destination.s3.endpoint"),
+			Entry("with a non http endpoint", func(s3 *exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				s3.Endpoint = "ftp://minio.example.com"
+				return s3
+			}, "spec.destination.s3.endpoint"),
+			Entry("without bucket", func(s3 *exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				s3.Bucket = ""
+				return s3
+			}, "spec.destination.s3.bucket"),
+			Entry("without credentials", func(s3 *exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				s3.CredentialsSecretRef = ""
+				return s3
+			}, "spec.destination.s3.credentialsSecretRef"),
+			Entry("with an unsupported format", func(s3 *exportv1.VirtualMachineExportS3Destination) *exportv1.VirtualMachineExportS3Destination {
+				s3.Format = exportv1.Dir
+				return s3
+			}, "spec.destination.s3.format"),
+		)
 	})
 })
 
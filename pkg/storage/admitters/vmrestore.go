@@ -26,9 +26,13 @@ import (
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
@@ -95,7 +99,7 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			case core.GroupName:
 				switch vmRestore.Spec.Target.Kind {
 				case "VirtualMachine":
-					causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore)
+					causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore, &ar.Request.UserInfo)
 					if err != nil {
 						return webhookutils.ToAdmissionResponseError(err)
 					}
@@ -127,6 +131,7 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 		for _, obj := range objects {
 			r := obj.(*snapshotv1.VirtualMachineRestore)
 			if equality.Semantic.DeepEqual(r.Spec.Target, vmRestore.Spec.Target) &&
+				targetNamespace(r) == targetNamespace(vmRestore) &&
 				(r.Status == nil || r.Status.Complete == nil || !*r.Status.Complete) {
 				cause := metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -167,12 +172,29 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 	return &reviewResponse
 }
 
-func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, err error) {
+func targetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace != nil && *vmRestore.Spec.TargetNamespace != "" {
+		return *vmRestore.Spec.TargetNamespace
+	}
+	return vmRestore.Namespace
+}
+
+func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, userInfo *authenticationv1.UserInfo) (causes []metav1.StatusCause, err error) {
 	targetName := vmRestore.Spec.Target.Name
 	namespace := vmRestore.Namespace
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
+	if targetNamespace(vmRestore) != namespace {
+		namespaceCauses, err := admitter.validateTargetNamespace(ctx, field.Child("targetNamespace"), targetNamespace(vmRestore), userInfo)
+		if err != nil {
+			return nil, err
+		}
+		if len(namespaceCauses) > 0 {
+			return append(causes, namespaceCauses...), nil
+		}
+	}
+
 	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -181,38 +203,194 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 		return nil, err
 	}
 
-	target, err := admitter.Client.VirtualMachine(namespace).Get(ctx, targetName, metav1.GetOptions{})
+	target, err := admitter.Client.VirtualMachine(targetNamespace(vmRestore)).Get(ctx, targetName, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, err
 	}
-
-	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
-	if sourceTargetVmsAreDifferent {
-		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
-		if contentName == nil {
-			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
-		}
-
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+	targetExists := !errors.IsNotFound(err)
+
+	if targetExists && targetNamespace(vmRestore) != namespace {
+		allowed, err := admitter.isUserAllowed(ctx, userInfo, &authv1.ResourceAttributes{
+			Namespace: targetNamespace(vmRestore),
+			Verb:      "update",
+			Group:     core.GroupName,
+			Resource:  "virtualmachines",
+			Name:      targetName,
+		})
 		if err != nil {
 			return nil, err
 		}
+		if !allowed {
+			return append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("user %s is not allowed to update VirtualMachine %s in namespace %s", userInfo.Username, targetName, targetNamespace(vmRestore)),
+				Field:   field.Child("targetNamespace").String(),
+			}), nil
+		}
+	}
+
+	sourceTargetVmsAreDifferent := !targetExists || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
+	selectsVolumes := len(vmRestore.Spec.Volumes) > 0 || len(vmRestore.Spec.VolumeRestoreOverrides) > 0
+	if !sourceTargetVmsAreDifferent && !selectsVolumes {
+		return causes, nil
+	}
+
+	contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
+	if contentName == nil {
+		return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
+	}
 
-		snapshotVM := vmSnapshotContent.Spec.Source.VirtualMachine
-		if snapshotVM == nil {
-			return nil, fmt.Errorf("unexpected snapshot source")
+	vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotVM := vmSnapshotContent.Spec.Source.VirtualMachine
+	if snapshotVM == nil {
+		return nil, fmt.Errorf("unexpected snapshot source")
+	}
+
+	if sourceTargetVmsAreDifferent && backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Restore to a different VM not supported when using backend storage",
+			Field:   field.String(),
+		})
+	}
+
+	if len(vmRestore.Spec.Volumes) > 0 && !targetExists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Restoring a subset of the volumes requires an existing target",
+			Field:   field.Child("volumes").String(),
+		})
+	}
+
+	causes = append(causes, validateRestoreVolumes(field, vmRestore, vmSnapshotContent)...)
+
+	return causes, nil
+}
+
+func validateRestoreVolumes(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) (causes []metav1.StatusCause) {
+	backups := make(map[string]struct{}, len(content.Spec.VolumeBackups))
+	for _, vb := range content.Spec.VolumeBackups {
+		backups[vb.VolumeName] = struct{}{}
+	}
+
+	restored := make(map[string]struct{}, len(vmRestore.Spec.Volumes))
+	for i, volume := range vmRestore.Spec.Volumes {
+		if _, ok := backups[volume]; !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("volume %s is not part of VirtualMachineSnapshot %s", volume, vmRestore.Spec.VirtualMachineSnapshotName),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
 		}
+		restored[volume] = struct{}{}
+	}
 
-		if backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
+	restoreNames := make(map[string]struct{}, len(vmRestore.Spec.VolumeRestoreOverrides))
+	for i, override := range vmRestore.Spec.VolumeRestoreOverrides {
+		overrideField := field.Child("volumeRestoreOverrides").Index(i)
+		if _, ok := backups[override.VolumeName]; !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("volume %s is not part of VirtualMachineSnapshot %s", override.VolumeName, vmRestore.Spec.VirtualMachineSnapshotName),
+				Field:   overrideField.Child("volumeName").String(),
+			})
+		} else if _, ok := restored[override.VolumeName]; len(restored) > 0 && !ok {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Restore to a different VM not supported when using backend storage",
-				Field:   field.String(),
+				Message: fmt.Sprintf("volume %s is not restored", override.VolumeName),
+				Field:   overrideField.Child("volumeName").String(),
+			})
+		}
+
+		if override.RestoreName == "" {
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(override.RestoreName); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("restoreName %s is not a valid PVC name: %s", override.RestoreName, strings.Join(errs, ", ")),
+				Field:   overrideField.Child("restoreName").String(),
 			})
 		}
+		if _, ok := restoreNames[override.RestoreName]; ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("restoreName %s is used more than once", override.RestoreName),
+				Field:   overrideField.Child("restoreName").String(),
+			})
+		}
+		restoreNames[override.RestoreName] = struct{}{}
 	}
 
-	return causes, nil
+	return causes
+}
+
+// validateTargetNamespace makes sure the target namespace exists and that the user
+// creating the restore is allowed to create the VirtualMachine and its volumes there
+func (admitter *VMRestoreAdmitter) validateTargetNamespace(ctx context.Context, field *k8sfield.Path, namespace string, userInfo *authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	_, err := admitter.Client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("namespace %s does not exist", namespace),
+			Field:   field.String(),
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range []struct {
+		group, name, kind string
+	}{
+		{core.GroupName, "virtualmachines", "VirtualMachines"},
+		{k8sv1.GroupName, "persistentvolumeclaims", "PersistentVolumeClaims"},
+	} {
+		allowed, err := admitter.isUserAllowed(ctx, userInfo, &authv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "create",
+			Group:     resource.group,
+			Resource:  resource.name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("user %s is not allowed to create %s in namespace %s", userInfo.Username, resource.kind, namespace),
+				Field:   field.String(),
+			}}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isUserAllowed runs a SubjectAccessReview for the user creating the restore
+func (admitter *VMRestoreAdmitter) isUserAllowed(ctx context.Context, userInfo *authenticationv1.UserInfo, attributes *authv1.ResourceAttributes) (bool, error) {
+	extra := make(map[string]authv1.ExtraValue, len(userInfo.Extra))
+	for k, v := range userInfo.Extra {
+		extra[k] = authv1.ExtraValue(v)
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			UID:                userInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: attributes,
+		},
+	}
+	sar, err := admitter.Client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
 }
 
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -35,6 +36,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -493,6 +496,177 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Entry("target exists", true),
 			)
 
+			Context("when selecting volumes", func() {
+				var (
+					restore         *snapshotv1.VirtualMachineRestore
+					snapshotContent *snapshotv1.VirtualMachineSnapshotContent
+					snapshotCopy    *snapshotv1.VirtualMachineSnapshot
+				)
+
+				BeforeEach(func() {
+					vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+					snapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec:       vm.Spec,
+								},
+							},
+							VolumeBackups: []snapshotv1.VolumeBackup{
+								{VolumeName: "rootdisk"},
+								{VolumeName: "datadisk"},
+							},
+						},
+					}
+					snapshotCopy = snapshot.DeepCopy()
+					snapshotCopy.Status.VirtualMachineSnapshotContentName = pointer.P(snapshotContent.Name)
+
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				It("should allow restoring a subset of the volumes with overrides", func() {
+					restore.Spec.Volumes = []string{"datadisk"}
+					restore.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{
+						{VolumeName: "datadisk", RestoreName: "restored-datadisk"},
+					}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject volumes that are not part of the snapshot", func() {
+					restore.Spec.Volumes = []string{"datadisk", "unknown"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumes[1]"))
+				})
+
+				It("should reject restoring a subset of the volumes to a target that does not exist", func() {
+					restore.Spec.Target.Name = "new-vm"
+					restore.Spec.Volumes = []string{"datadisk"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumes"))
+				})
+
+				DescribeTable("should reject volume restore overrides", func(overrides []snapshotv1.VolumeRestoreOverride, field string) {
+					restore.Spec.Volumes = []string{"datadisk"}
+					restore.Spec.VolumeRestoreOverrides = overrides
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				},
+					Entry("of unknown volumes", []snapshotv1.VolumeRestoreOverride{
+						{VolumeName: "unknown"},
+					}, "spec.volumeRestoreOverrides[0].volumeName"),
+					Entry("of volumes that are not restored", []snapshotv1.VolumeRestoreOverride{
+						{VolumeName: "rootdisk", RestoreName: "restored-rootdisk"},
+					}, "spec.volumeRestoreOverrides[0].volumeName"),
+					Entry("with an invalid restore name", []snapshotv1.VolumeRestoreOverride{
+						{VolumeName: "datadisk", RestoreName: "Invalid_Name"},
+					}, "spec.volumeRestoreOverrides[0].restoreName"),
+					Entry("with a duplicate restore name", []snapshotv1.VolumeRestoreOverride{
+						{VolumeName: "datadisk", RestoreName: "restored"},
+						{VolumeName: "datadisk", RestoreName: "restored"},
+					}, "spec.volumeRestoreOverrides[1].restoreName"),
+				)
+
+				Context("to another namespace", func() {
+					const targetNamespace = "target-ns"
+
+					var namespace *k8sv1.Namespace
+
+					BeforeEach(func() {
+						namespace = &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: targetNamespace}}
+						restore.Spec.TargetNamespace = pointer.P(targetNamespace)
+						restore.Spec.Target.Name = "new-vm"
+					})
+
+					It("should allow when the user can create VirtualMachines there", func() {
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = allowedUser
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent, namespace).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeTrue())
+					})
+
+					It("should reject when the user can't create VirtualMachines there", func() {
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = "someone"
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent, namespace).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("not allowed to create VirtualMachines"))
+					})
+
+					It("should reject when the user can't create PersistentVolumeClaims there", func() {
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = cannotCreatePVCsUser
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent, namespace).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("not allowed to create PersistentVolumeClaims"))
+					})
+
+					It("should allow a new VirtualMachine when the user can't update VirtualMachines there", func() {
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = cannotUpdateVMsUser
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent, namespace).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeTrue())
+					})
+
+					It("should reject an existing VirtualMachine when the user can't update it", func() {
+						restore.Spec.Target.Name = vmName
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = cannotUpdateVMsUser
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent, namespace).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("not allowed to update VirtualMachine " + vmName))
+					})
+
+					It("should reject when the namespace does not exist", func() {
+						ar := createRestoreAdmissionReview(restore)
+						ar.Request.UserInfo.Username = allowedUser
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotCopy, snapshotContent).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("does not exist"))
+					})
+				})
+			})
+
 			Context("when using Patches", func() {
 
 				var restore *snapshotv1.VirtualMachineRestore
@@ -609,6 +783,12 @@ func createRestoreUpdateAdmissionReview(old, current *snapshotv1.VirtualMachineR
 	return ar
 }
 
+const (
+	allowedUser          = "allowed-user"
+	cannotCreatePVCsUser = "cannot-create-pvcs-user"
+	cannotUpdateVMsUser  = "cannot-update-vms-user"
+)
+
 func createTestVMRestoreAdmitter(
 	config *virtconfig.ClusterConfig,
 	objs ...runtime.Object,
@@ -616,7 +796,32 @@ func createTestVMRestoreAdmitter(
 	ctrl := gomock.NewController(GinkgoT())
 	virtClient := kubecli.NewMockKubevirtClient(ctrl)
 	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
-	kubevirtClient := kubevirtfake.NewSimpleClientset(objs...)
+
+	var kubevirtObjs, k8sObjs []runtime.Object
+	for _, obj := range objs {
+		if _, ok := obj.(*k8sv1.Namespace); ok {
+			k8sObjs = append(k8sObjs, obj)
+		} else {
+			kubevirtObjs = append(kubevirtObjs, obj)
+		}
+	}
+	kubevirtClient := kubevirtfake.NewSimpleClientset(kubevirtObjs...)
+	k8sClient := k8sfake.NewSimpleClientset(k8sObjs...)
+	k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+		sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+		attributes := sar.Spec.ResourceAttributes
+		switch sar.Spec.User {
+		case allowedUser:
+			sar.Status.Allowed = true
+		case cannotCreatePVCsUser:
+			sar.Status.Allowed = attributes.Resource != "persistentvolumeclaims"
+		case cannotUpdateVMsUser:
+			sar.Status.Allowed = attributes.Verb != "update"
+		}
+		return true, sar, nil
+	})
+	virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+	virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
 
 	virtClient.EXPECT().VirtualMachineSnapshot("default").
 		Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots("default")).AnyTimes()
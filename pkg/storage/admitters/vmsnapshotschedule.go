@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotScheduleAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	err := json.Unmarshal(ar.Request.Object.Raw, schedule)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	switch ar.Request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	causes := validateVMSnapshotScheduleName(k8sfield.NewPath("metadata", "name"), schedule.Name)
+	causes = append(causes, validateVMSnapshotScheduleSpec(k8sfield.NewPath("spec"), &schedule.Spec)...)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+// validateVMSnapshotScheduleName makes sure the name fits into the schedule label of the created snapshots
+func validateVMSnapshotScheduleName(field *k8sfield.Path, name string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, msg := range validation.IsValidLabelValue(name) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("name has to be a valid value of the %s label: %s", snapshotv1.SnapshotScheduleLabel, msg),
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func validateVMSnapshotScheduleSpec(field *k8sfield.Path, spec *snapshotv1.VirtualMachineSnapshotScheduleSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if _, err := metav1.LabelSelectorAsSelector(&spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if _, err := cron.Parse(spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule: %v", err),
+			Field:   field.Child("schedule").String(),
+		})
+	}
+
+	if retention := spec.Retention; retention != nil {
+		retentionField := field.Child("retention")
+		keepsSnapshots := false
+		for _, rule := range []struct {
+			name  string
+			value *int32
+		}{
+			{"keepLast", retention.KeepLast},
+			{"keepDaily", retention.KeepDaily},
+			{"keepWeekly", retention.KeepWeekly},
+		} {
+			if rule.value != nil && *rule.value < 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must not be negative", rule.name),
+					Field:   retentionField.Child(rule.name).String(),
+				})
+			}
+			if rule.value != nil && *rule.value > 0 {
+				keepsSnapshots = true
+			}
+		}
+		// a retention without a positive rule would delete all snapshots
+		if !keepsSnapshots && len(causes) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "retention has to keep snapshots, at least one of keepLast, keepDaily and keepWeekly must be positive",
+				Field:   retentionField.String(),
+			})
+		}
+	}
+
+	if template := spec.SnapshotTemplate; template != nil &&
+		template.FailureDeadline != nil && template.FailureDeadline.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failureDeadline must not be negative",
+			Field:   field.Child("snapshotTemplate", "failureDeadline").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				Schedule: "0 2 * * *",
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should allow a valid schedule", func() {
+			schedule := newSchedule()
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepLast:  pointer.P(int32(3)),
+				KeepDaily: pointer.P(int32(7)),
+			}
+
+			ar := createSnapshotScheduleAdmissionReview(schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			mutate(schedule)
+
+			ar := createSnapshotScheduleAdmissionReview(schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("a name which does not fit into the schedule label", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Name = strings.Repeat("a", 64)
+			}, "metadata.name"),
+			Entry("an invalid schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every night"
+			}, "spec.schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "backup", Operator: "Like"}}
+			}, "spec.selector"),
+			Entry("a negative retention", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{KeepWeekly: pointer.P(int32(-1))}
+			}, "spec.retention.keepWeekly"),
+			Entry("an empty retention", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{}
+			}, "spec.retention"),
+			Entry("a retention which keeps no snapshots", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					KeepLast:  pointer.P(int32(0)),
+					KeepDaily: pointer.P(int32(0)),
+				}
+			}, "spec.retention"),
+			Entry("a negative failure deadline", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.SnapshotTemplate = &snapshotv1.VirtualMachineSnapshotScheduleTemplate{
+					FailureDeadline: &metav1.Duration{Duration: -time.Minute},
+				}
+			}, "spec.snapshotTemplate.failureDeadline"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
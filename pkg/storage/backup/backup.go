@@ -0,0 +1,582 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	vmBackupStartedEvent = "VirtualMachineBackupStarted"
+
+	vmBackupSucceededEvent = "VirtualMachineBackupSucceeded"
+
+	vmBackupFailedEvent = "VirtualMachineBackupFailed"
+)
+
+// variable so can be overridden in tests
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+// VMBackupController backs up running VMs by hotplugging the target PVC of a
+// VirtualMachineBackup into the VMI, the backup itself is taken by virt-launcher
+type VMBackupController struct {
+	Client kubecli.KubevirtClient
+
+	VMBackupInformer        cache.SharedIndexInformer
+	VMBackupTrackerInformer cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+	PVCInformer             cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmBackupQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the backup controller
+func (ctrl *VMBackupController) Init() error {
+	ctrl.vmBackupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-backup-vmbackup"},
+	)
+
+	_, err := ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.PVCInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: ctrl.handlePVC,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmBackupQueue.ShutDown()
+
+	log.Log.Info("Starting backup controller.")
+	defer log.Log.Info("Shutting down backup controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMBackupInformer.HasSynced,
+		ctrl.VMBackupTrackerInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmBackupWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupController) vmBackupWorker() {
+	for ctrl.processVMBackupWorkItem() {
+	}
+}
+
+func (ctrl *VMBackupController) processVMBackupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmBackupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmBackup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmBackup, ok := storeObj.(*backupv1.VirtualMachineBackup)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateVMBackup(vmBackup.DeepCopy())
+	})
+}
+
+func (ctrl *VMBackupController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackup, ok := obj.(*backupv1.VirtualMachineBackup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmBackup)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmBackup)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmBackupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMBackupController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		ctrl.enqueueVMBackups(vmi.Namespace, func(vmBackup *backupv1.VirtualMachineBackup) bool {
+			return vmBackup.Spec.Source.Name == vmi.Name
+		})
+	}
+}
+
+func (ctrl *VMBackupController) handlePVC(obj interface{}) {
+	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+		ctrl.enqueueVMBackups(pvc.Namespace, func(vmBackup *backupv1.VirtualMachineBackup) bool {
+			return vmBackup.Spec.PVCName == pvc.Name
+		})
+	}
+}
+
+func (ctrl *VMBackupController) enqueueVMBackups(namespace string, matches func(*backupv1.VirtualMachineBackup) bool) {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Log.Errorf("failed to list VirtualMachineBackups in namespace %s: %v", namespace, err)
+		return
+	}
+
+	for _, obj := range objs {
+		vmBackup := obj.(*backupv1.VirtualMachineBackup)
+		if !matches(vmBackup) {
+			continue
+		}
+		k := cacheKeyFunc(vmBackup.Namespace, vmBackup.Name)
+		log.Log.V(3).Infof("enqueued %q for sync", k)
+		ctrl.vmBackupQueue.Add(k)
+	}
+}
+
+func cacheKeyFunc(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func vmBackupFinished(vmBackup *backupv1.VirtualMachineBackup) bool {
+	return vmBackup.Status != nil &&
+		(vmBackup.Status.Phase == backupv1.BackupSucceeded || vmBackup.Status.Phase == backupv1.BackupFailed)
+}
+
+func (ctrl *VMBackupController) updateVMBackup(vmBackup *backupv1.VirtualMachineBackup) error {
+	log.Log.V(3).Infof("Updating VirtualMachineBackup %s/%s", vmBackup.Namespace, vmBackup.Name)
+
+	// the target volume is detached before the backup is released
+	if vmBackup.DeletionTimestamp != nil || vmBackupFinished(vmBackup) {
+		if err := ctrl.removeBackupVolume(vmBackup); err != nil {
+			return err
+		}
+		return ctrl.removeFinalizer(vmBackup)
+	}
+
+	if !controller.HasFinalizer(vmBackup, backupv1.VMBackupProtectionFinalizer) {
+		return ctrl.addFinalizer(vmBackup)
+	}
+
+	status := &backupv1.VirtualMachineBackupStatus{Phase: backupv1.BackupPending}
+	if vmBackup.Status != nil {
+		status = vmBackup.Status.DeepCopy()
+	}
+
+	var err error
+	switch status.Phase {
+	case backupv1.BackupPending:
+		err = ctrl.startBackup(vmBackup, status)
+	case backupv1.BackupInProgress:
+		err = ctrl.syncBackupProgress(vmBackup, status)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctrl.updateBackupStatus(vmBackup, status)
+}
+
+func (ctrl *VMBackupController) getVMI(vmBackup *backupv1.VirtualMachineBackup) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*kubevirtv1.VirtualMachineInstance), nil
+}
+
+func (ctrl *VMBackupController) getTracker(namespace, name string) (*backupv1.VirtualMachineBackupTracker, error) {
+	obj, exists, err := ctrl.VMBackupTrackerInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*backupv1.VirtualMachineBackupTracker), nil
+}
+
+func findBackupVolume(vmi *kubevirtv1.VirtualMachineInstance) *kubevirtv1.Volume {
+	for i, volume := range vmi.Spec.Volumes {
+		if volume.Backup != nil {
+			return &vmi.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// startBackup hotplugs the target PVC into the VMI once the VMI is running and
+// no other backup of the VM is in progress
+func (ctrl *VMBackupController) startBackup(vmBackup *backupv1.VirtualMachineBackup, status *backupv1.VirtualMachineBackupStatus) error {
+	vmi, err := ctrl.getVMI(vmBackup)
+	if err != nil {
+		return err
+	}
+	if vmi == nil || vmi.DeletionTimestamp != nil || vmi.Status.Phase != kubevirtv1.Running {
+		status.Message = fmt.Sprintf("Waiting for VirtualMachine %s to be running", vmBackup.Spec.Source.Name)
+		return nil
+	}
+
+	if volume := findBackupVolume(vmi); volume != nil {
+		status.Message = fmt.Sprintf("Waiting for the backup with checkpoint %s to finish", volume.Backup.Checkpoint)
+		return nil
+	}
+
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == vmBackup.Spec.PVCName {
+			status.Phase = backupv1.BackupFailed
+			status.Message = fmt.Sprintf("PVC %s is already used by the VirtualMachineInstance", vmBackup.Spec.PVCName)
+			ctrl.Recorder.Event(vmBackup, corev1.EventTypeWarning, vmBackupFailedEvent, status.Message)
+			return nil
+		}
+	}
+
+	_, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.PVCName))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		status.Message = fmt.Sprintf("Waiting for PVC %s", vmBackup.Spec.PVCName)
+		return nil
+	}
+
+	baseCheckpoint, err := ctrl.baseCheckpoint(vmBackup, vmi)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.addBackupVolume(vmBackup, vmi, baseCheckpoint); err != nil {
+		return err
+	}
+
+	status.Phase = backupv1.BackupInProgress
+	status.Checkpoint = vmBackup.Name
+	status.BaseCheckpoint = baseCheckpoint
+	status.StartTimestamp = currentTime()
+	status.Message = ""
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, vmBackupStartedEvent, "Started backup of VirtualMachine %s", vmBackup.Spec.Source.Name)
+
+	return nil
+}
+
+// baseCheckpoint returns the checkpoint an incremental backup is based on, or an
+// empty string when a full backup has to be taken
+func (ctrl *VMBackupController) baseCheckpoint(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) (string, error) {
+	if vmBackup.Spec.Type != nil && *vmBackup.Spec.Type == backupv1.Full {
+		return "", nil
+	}
+
+	tracker, err := ctrl.getTracker(vmBackup.Namespace, vmBackup.Spec.Source.Name)
+	if err != nil || tracker == nil || tracker.Status == nil || tracker.Status.LatestCheckpoint == nil {
+		return "", err
+	}
+
+	// checkpoints don't survive a restart of the VM
+	if tracker.Status.VirtualMachineInstanceUID != vmi.UID {
+		return "", nil
+	}
+
+	return tracker.Status.LatestCheckpoint.Name, nil
+}
+
+func (ctrl *VMBackupController) syncBackupProgress(vmBackup *backupv1.VirtualMachineBackup, status *backupv1.VirtualMachineBackupStatus) error {
+	vmi, err := ctrl.getVMI(vmBackup)
+	if err != nil {
+		return err
+	}
+	// a VMI created after the backup started doesn't have the backup volume anymore
+	if vmi == nil || vmi.IsFinal() ||
+		(status.StartTimestamp != nil && status.StartTimestamp.Before(&vmi.CreationTimestamp)) {
+		status.Phase = backupv1.BackupFailed
+		status.EndTimestamp = currentTime()
+		status.Message = fmt.Sprintf("VirtualMachine %s stopped during the backup", vmBackup.Spec.Source.Name)
+		ctrl.Recorder.Event(vmBackup, corev1.EventTypeWarning, vmBackupFailedEvent, status.Message)
+		return nil
+	}
+
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.BackupVolume == nil || volumeStatus.BackupVolume.Checkpoint != status.Checkpoint {
+			continue
+		}
+
+		switch volumeStatus.Phase {
+		case kubevirtv1.BackupVolumeCompleted:
+			status.Type = backupv1.Full
+			if volumeStatus.BackupVolume.Incremental {
+				status.Type = backupv1.Incremental
+			} else {
+				status.BaseCheckpoint = ""
+			}
+			status.EndTimestamp = currentTime()
+			untrackedVolumes := volumeStatus.BackupVolume.UntrackedVolumes
+			if err := ctrl.updateTracker(vmBackup, vmi, status, len(untrackedVolumes) == 0); err != nil {
+				return err
+			}
+			status.Phase = backupv1.BackupSucceeded
+			status.Message = ""
+			if len(untrackedVolumes) > 0 {
+				status.Message = fmt.Sprintf("Volumes %s are raw images which cannot track their changed blocks, no checkpoint was created and the next backup is full as well",
+					strings.Join(untrackedVolumes, ", "))
+			}
+			ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, vmBackupSucceededEvent, "%s backup of VirtualMachine %s succeeded", status.Type, vmBackup.Spec.Source.Name)
+		case kubevirtv1.BackupVolumeFailed:
+			status.Phase = backupv1.BackupFailed
+			status.EndTimestamp = currentTime()
+			status.Message = volumeStatus.Message
+			ctrl.Recorder.Event(vmBackup, corev1.EventTypeWarning, vmBackupFailedEvent, status.Message)
+		}
+	}
+
+	return nil
+}
+
+// updateTracker records the checkpoint of a successful backup, a full backup starts a new chain.
+// A backup without a checkpoint clears the chain, the next backup has to be a full one.
+func (ctrl *VMBackupController) updateTracker(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance, status *backupv1.VirtualMachineBackupStatus, checkpointCreated bool) error {
+	tracker, err := ctrl.getTracker(vmBackup.Namespace, vmBackup.Spec.Source.Name)
+	if err != nil {
+		return err
+	}
+
+	if tracker == nil {
+		tracker = &backupv1.VirtualMachineBackupTracker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmBackup.Spec.Source.Name,
+				Namespace: vmBackup.Namespace,
+			},
+			Spec: backupv1.VirtualMachineBackupTrackerSpec{
+				Source: vmBackup.Spec.Source,
+			},
+		}
+		tracker, err = ctrl.Client.VirtualMachineBackupTracker(tracker.Namespace).Create(context.Background(), tracker, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		tracker = tracker.DeepCopy()
+	}
+
+	trackerStatus := &backupv1.VirtualMachineBackupTrackerStatus{}
+	if tracker.Status != nil {
+		trackerStatus = tracker.Status.DeepCopy()
+	}
+	if trackerStatus.LatestCheckpoint != nil && trackerStatus.LatestCheckpoint.Name == status.Checkpoint {
+		return nil
+	}
+
+	if checkpointCreated {
+		checkpoint := backupv1.BackupCheckpoint{
+			Name:              status.Checkpoint,
+			BackupName:        vmBackup.Name,
+			Type:              status.Type,
+			CreationTimestamp: status.EndTimestamp,
+		}
+		if checkpoint.Type == backupv1.Full || trackerStatus.VirtualMachineInstanceUID != vmi.UID {
+			trackerStatus.Checkpoints = nil
+		}
+		trackerStatus.Checkpoints = append(trackerStatus.Checkpoints, checkpoint)
+		trackerStatus.LatestCheckpoint = &checkpoint
+	} else {
+		trackerStatus.Checkpoints = nil
+		trackerStatus.LatestCheckpoint = nil
+	}
+	trackerStatus.VirtualMachineInstanceUID = vmi.UID
+
+	tracker.Status = trackerStatus
+	_, err = ctrl.Client.VirtualMachineBackupTracker(tracker.Namespace).UpdateStatus(context.Background(), tracker, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMBackupController) addBackupVolume(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance, baseCheckpoint string) error {
+	volume := kubevirtv1.Volume{
+		Name: vmBackup.Spec.PVCName,
+		VolumeSource: kubevirtv1.VolumeSource{
+			Backup: &kubevirtv1.BackupVolumeSource{
+				PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: vmBackup.Spec.PVCName,
+					},
+					Hotpluggable: true,
+				},
+				Checkpoint:     vmBackup.Name,
+				BaseCheckpoint: baseCheckpoint,
+			},
+		},
+	}
+
+	volumes := append(append([]kubevirtv1.Volume{}, vmi.Spec.Volumes...), volume)
+	return ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+func (ctrl *VMBackupController) removeBackupVolume(vmBackup *backupv1.VirtualMachineBackup) error {
+	vmi, err := ctrl.getVMI(vmBackup)
+	if err != nil || vmi == nil {
+		return err
+	}
+
+	var volumes []kubevirtv1.Volume
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Backup != nil && volume.Backup.Checkpoint == vmBackup.Name {
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+	if len(volumes) == len(vmi.Spec.Volumes) {
+		return nil
+	}
+
+	return ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+func (ctrl *VMBackupController) patchVMIVolumes(vmi *kubevirtv1.VirtualMachineInstance, volumes []kubevirtv1.Volume) error {
+	patchset := patch.New(
+		patch.WithTest("/spec/volumes", vmi.Spec.Volumes),
+	)
+	if len(vmi.Spec.Volumes) > 0 {
+		patchset.AddOption(patch.WithReplace("/spec/volumes", volumes))
+	} else {
+		patchset.AddOption(patch.WithAdd("/spec/volumes", volumes))
+	}
+
+	patchBytes, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func generateFinalizerPatch(test, replace []string) ([]byte, error) {
+	return patch.New(
+		patch.WithTest("/metadata/finalizers", test),
+		patch.WithReplace("/metadata/finalizers", replace),
+	).GeneratePayload()
+}
+
+func (ctrl *VMBackupController) addFinalizer(vmBackup *backupv1.VirtualMachineBackup) error {
+	cpy := vmBackup.DeepCopy()
+	controller.AddFinalizer(cpy, backupv1.VMBackupProtectionFinalizer)
+
+	patchBytes, err := generateFinalizerPatch(vmBackup.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.Client.VirtualMachineBackup(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (ctrl *VMBackupController) removeFinalizer(vmBackup *backupv1.VirtualMachineBackup) error {
+	if !controller.HasFinalizer(vmBackup, backupv1.VMBackupProtectionFinalizer) {
+		return nil
+	}
+
+	cpy := vmBackup.DeepCopy()
+	controller.RemoveFinalizer(cpy, backupv1.VMBackupProtectionFinalizer)
+
+	patchBytes, err := generateFinalizerPatch(vmBackup.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.Client.VirtualMachineBackup(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (ctrl *VMBackupController) updateBackupStatus(vmBackup *backupv1.VirtualMachineBackup, status *backupv1.VirtualMachineBackupStatus) error {
+	if vmBackup.Status != nil && equality.Semantic.DeepEqual(vmBackup.Status, status) {
+		return nil
+	}
+
+	vmBackupCpy := vmBackup.DeepCopy()
+	vmBackupCpy.Status = status
+	_, err := ctrl.Client.VirtualMachineBackup(vmBackupCpy.Namespace).UpdateStatus(context.Background(), vmBackupCpy, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,408 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backup
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace = "default"
+	vmName        = "testvm"
+	backupName    = "backup-1"
+	pvcName       = "backup-pvc"
+	vmiUID        = types.UID("vmi-uid")
+)
+
+var _ = Describe("Backup controller", func() {
+	var (
+		controller      *VMBackupController
+		vmiStore        cache.Store
+		pvcStore        cache.Store
+		trackerStore    cache.Store
+		recorder        *record.FakeRecorder
+		kubevirtClient  *kubevirtfake.Clientset
+		now             time.Time
+		vmiPatches      *[][]byte
+		backupStatus    **backupv1.VirtualMachineBackupStatus
+		backupPatches   *[][]byte
+		trackerCreated  *bool
+		trackerStatuses *[]*backupv1.VirtualMachineBackupTrackerStatus
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		vmBackupInformer, _ := testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackup{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		trackerInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupTracker{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+		vmiStore = vmiInformer.GetStore()
+		pvcStore = pvcInformer.GetStore()
+		trackerStore = trackerInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMBackupController{
+			Client:                  virtClient,
+			VMBackupInformer:        vmBackupInformer,
+			VMBackupTrackerInformer: trackerInformer,
+			VMIInformer:             vmiInformer,
+			PVCInformer:             pvcInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupTracker(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(testNamespace)).AnyTimes()
+		kubevirtClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+
+		vmiPatches = &[][]byte{}
+		kubevirtClient.Fake.PrependReactor("patch", "virtualmachineinstances", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			*vmiPatches = append(*vmiPatches, action.(testing.PatchAction).GetPatch())
+			return true, nil, nil
+		})
+		backupPatches = &[][]byte{}
+		kubevirtClient.Fake.PrependReactor("patch", "virtualmachinebackups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			*backupPatches = append(*backupPatches, action.(testing.PatchAction).GetPatch())
+			return true, nil, nil
+		})
+		var status *backupv1.VirtualMachineBackupStatus
+		backupStatus = &status
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinebackups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update := action.(testing.UpdateAction)
+			Expect(update.GetSubresource()).To(Equal("status"))
+			*backupStatus = update.GetObject().(*backupv1.VirtualMachineBackup).Status
+			return true, update.GetObject(), nil
+		})
+		trackerCreated = pointer.P(false)
+		kubevirtClient.Fake.PrependReactor("create", "virtualmachinebackuptrackers", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			*trackerCreated = true
+			return true, action.(testing.CreateAction).GetObject(), nil
+		})
+		trackerStatuses = &[]*backupv1.VirtualMachineBackupTrackerStatus{}
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinebackuptrackers", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update := action.(testing.UpdateAction)
+			Expect(update.GetSubresource()).To(Equal("status"))
+			*trackerStatuses = append(*trackerStatuses, update.GetObject().(*backupv1.VirtualMachineBackupTracker).Status)
+			return true, update.GetObject(), nil
+		})
+
+		now = time.Date(2024, time.January, 10, 2, 0, 0, 0, time.UTC)
+		currentTime = func() *metav1.Time {
+			return &metav1.Time{Time: now}
+		}
+	})
+
+	newVMBackup := func(phase backupv1.VirtualMachineBackupPhase) *backupv1.VirtualMachineBackup {
+		vmBackup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       backupName,
+				Namespace:  testNamespace,
+				Finalizers: []string{backupv1.VMBackupProtectionFinalizer},
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				PVCName: pvcName,
+			},
+		}
+		if phase != "" {
+			vmBackup.Status = &backupv1.VirtualMachineBackupStatus{
+				Phase:          phase,
+				Checkpoint:     backupName,
+				StartTimestamp: &metav1.Time{Time: now.Add(-time.Minute)},
+			}
+		}
+		return vmBackup
+	}
+
+	newVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              vmName,
+				Namespace:         testNamespace,
+				UID:               vmiUID,
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{{
+					Name: "rootdisk",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "rootdisk"},
+						},
+					},
+				}},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase: v1.Running,
+			},
+		}
+	}
+
+	withBackupVolume := func(vmi *v1.VirtualMachineInstance, phase v1.VolumePhase, incremental bool) *v1.VirtualMachineInstance {
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: pvcName,
+			VolumeSource: v1.VolumeSource{
+				Backup: &v1.BackupVolumeSource{
+					PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+						Hotpluggable:                      true,
+					},
+					Checkpoint: backupName,
+				},
+			},
+		})
+		vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+			Name:    pvcName,
+			Phase:   phase,
+			Message: "backup message",
+			BackupVolume: &v1.DomainBackupInfo{
+				ClaimName:   pvcName,
+				Checkpoint:  backupName,
+				Incremental: incremental,
+			},
+		})
+		return vmi
+	}
+
+	newTracker := func(latest string, uid types.UID) *backupv1.VirtualMachineBackupTracker {
+		checkpoint := backupv1.BackupCheckpoint{Name: latest, BackupName: latest, Type: backupv1.Full}
+		return &backupv1.VirtualMachineBackupTracker{
+			ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: testNamespace},
+			Status: &backupv1.VirtualMachineBackupTrackerStatus{
+				VirtualMachineInstanceUID: uid,
+				LatestCheckpoint:          &checkpoint,
+				Checkpoints:               []backupv1.BackupCheckpoint{checkpoint},
+			},
+		}
+	}
+
+	addedBackupVolume := func() *v1.Volume {
+		Expect(*vmiPatches).To(HaveLen(1))
+		var ops []struct {
+			Op    string      `json:"op"`
+			Value []v1.Volume `json:"value"`
+		}
+		Expect(json.Unmarshal((*vmiPatches)[0], &ops)).To(Succeed())
+		Expect(ops).To(HaveLen(2))
+		Expect(ops[1].Op).To(Equal("replace"))
+		volumes := ops[1].Value
+		Expect(volumes).To(HaveLen(2))
+		return &volumes[1]
+	}
+
+	It("should add the finalizer first", func() {
+		vmBackup := newVMBackup("")
+		vmBackup.Finalizers = nil
+
+		Expect(controller.updateVMBackup(vmBackup)).To(Succeed())
+		Expect(*backupPatches).To(HaveLen(1))
+		Expect(string((*backupPatches)[0])).To(ContainSubstring(backupv1.VMBackupProtectionFinalizer))
+		Expect(*backupStatus).To(BeNil())
+	})
+
+	It("should wait for the VMI to be running", func() {
+		Expect(controller.updateVMBackup(newVMBackup(""))).To(Succeed())
+		Expect(*vmiPatches).To(BeEmpty())
+		Expect((*backupStatus).Phase).To(Equal(backupv1.BackupPending))
+		Expect((*backupStatus).Message).To(ContainSubstring("to be running"))
+	})
+
+	It("should wait for the target PVC", func() {
+		Expect(vmiStore.Add(newVMI())).To(Succeed())
+
+		Expect(controller.updateVMBackup(newVMBackup(""))).To(Succeed())
+		Expect(*vmiPatches).To(BeEmpty())
+		Expect((*backupStatus).Message).To(ContainSubstring("Waiting for PVC"))
+	})
+
+	Context("with the VMI running and the PVC present", func() {
+		BeforeEach(func() {
+			Expect(vmiStore.Add(newVMI())).To(Succeed())
+			Expect(pvcStore.Add(&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: testNamespace},
+			})).To(Succeed())
+		})
+
+		It("should attach a full backup volume without a tracker", func() {
+			Expect(controller.updateVMBackup(newVMBackup(""))).To(Succeed())
+
+			volume := addedBackupVolume()
+			Expect(volume.Name).To(Equal(pvcName))
+			Expect(volume.Backup.ClaimName).To(Equal(pvcName))
+			Expect(volume.Backup.Hotpluggable).To(BeTrue())
+			Expect(volume.Backup.Checkpoint).To(Equal(backupName))
+			Expect(volume.Backup.BaseCheckpoint).To(BeEmpty())
+
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupInProgress))
+			Expect((*backupStatus).Checkpoint).To(Equal(backupName))
+			Expect((*backupStatus).StartTimestamp.Time).To(Equal(now))
+			testutils.ExpectEvent(recorder, vmBackupStartedEvent)
+		})
+
+		DescribeTable("should base the backup on", func(tracker *backupv1.VirtualMachineBackupTracker, backupType *backupv1.BackupType, expected string) {
+			Expect(trackerStore.Add(tracker)).To(Succeed())
+			vmBackup := newVMBackup("")
+			vmBackup.Spec.Type = backupType
+
+			Expect(controller.updateVMBackup(vmBackup)).To(Succeed())
+			Expect(addedBackupVolume().Backup.BaseCheckpoint).To(Equal(expected))
+			Expect((*backupStatus).BaseCheckpoint).To(Equal(expected))
+		},
+			Entry("the latest checkpoint", newTracker("backup-0", vmiUID), nil, "backup-0"),
+			Entry("nothing when a full backup is requested", newTracker("backup-0", vmiUID), pointer.P(backupv1.Full), ""),
+			Entry("nothing when the VM was restarted", newTracker("backup-0", "old-uid"), nil, ""),
+		)
+
+		It("should wait for another backup of the VM", func() {
+			vmi := withBackupVolume(newVMI(), v1.BackupVolumeInProgress, false)
+			vmi.Spec.Volumes[1].Backup.Checkpoint = "backup-0"
+			Expect(vmiStore.Update(vmi)).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(""))).To(Succeed())
+			Expect(*vmiPatches).To(BeEmpty())
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupPending))
+			Expect((*backupStatus).Message).To(ContainSubstring("backup-0"))
+		})
+
+		It("should record the checkpoint when the backup completes", func() {
+			Expect(vmiStore.Update(withBackupVolume(newVMI(), v1.BackupVolumeCompleted, false))).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupInProgress))).To(Succeed())
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupSucceeded))
+			Expect((*backupStatus).Type).To(Equal(backupv1.Full))
+			Expect((*backupStatus).EndTimestamp.Time).To(Equal(now))
+			testutils.ExpectEvent(recorder, vmBackupSucceededEvent)
+
+			Expect(*trackerCreated).To(BeTrue())
+			Expect(*trackerStatuses).To(HaveLen(1))
+			trackerStatus := (*trackerStatuses)[0]
+			Expect(trackerStatus.VirtualMachineInstanceUID).To(Equal(vmiUID))
+			Expect(trackerStatus.LatestCheckpoint.Name).To(Equal(backupName))
+			Expect(trackerStatus.Checkpoints).To(HaveLen(1))
+		})
+
+		It("should extend the checkpoint chain with an incremental backup", func() {
+			Expect(trackerStore.Add(newTracker("backup-0", vmiUID))).To(Succeed())
+			Expect(vmiStore.Update(withBackupVolume(newVMI(), v1.BackupVolumeCompleted, true))).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupInProgress))).To(Succeed())
+			Expect((*backupStatus).Type).To(Equal(backupv1.Incremental))
+			Expect(*trackerCreated).To(BeFalse())
+			trackerStatus := (*trackerStatuses)[0]
+			Expect(trackerStatus.LatestCheckpoint.Name).To(Equal(backupName))
+			Expect(trackerStatus.Checkpoints).To(HaveLen(2))
+		})
+
+		It("should report a full backup and clear the checkpoint chain when volumes cannot track their changed blocks", func() {
+			Expect(trackerStore.Add(newTracker("backup-0", vmiUID))).To(Succeed())
+			vmi := withBackupVolume(newVMI(), v1.BackupVolumeCompleted, false)
+			backupVolume := vmi.Status.VolumeStatus[len(vmi.Status.VolumeStatus)-1].BackupVolume
+			backupVolume.UntrackedVolumes = []string{"rootdisk", "datadisk"}
+			Expect(vmiStore.Update(vmi)).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupInProgress))).To(Succeed())
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupSucceeded))
+			Expect((*backupStatus).Type).To(Equal(backupv1.Full))
+			Expect((*backupStatus).Message).To(HavePrefix("Volumes rootdisk, datadisk are raw images which cannot track their changed blocks"))
+			testutils.ExpectEvent(recorder, vmBackupSucceededEvent)
+
+			Expect(*trackerStatuses).To(HaveLen(1))
+			trackerStatus := (*trackerStatuses)[0]
+			Expect(trackerStatus.LatestCheckpoint).To(BeNil())
+			Expect(trackerStatus.Checkpoints).To(BeEmpty())
+		})
+
+		It("should fail when the launcher fails the backup", func() {
+			Expect(vmiStore.Update(withBackupVolume(newVMI(), v1.BackupVolumeFailed, false))).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupInProgress))).To(Succeed())
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupFailed))
+			Expect((*backupStatus).Message).To(Equal("backup message"))
+			Expect(*trackerStatuses).To(BeEmpty())
+			testutils.ExpectEvent(recorder, vmBackupFailedEvent)
+		})
+
+		It("should fail when the VM restarts during the backup", func() {
+			vmi := newVMI()
+			vmi.CreationTimestamp = metav1.NewTime(now)
+			Expect(vmiStore.Update(vmi)).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupInProgress))).To(Succeed())
+			Expect((*backupStatus).Phase).To(Equal(backupv1.BackupFailed))
+			testutils.ExpectEvent(recorder, vmBackupFailedEvent)
+		})
+
+		It("should detach the volume and release a finished backup", func() {
+			Expect(vmiStore.Update(withBackupVolume(newVMI(), v1.BackupVolumeCompleted, false))).To(Succeed())
+
+			Expect(controller.updateVMBackup(newVMBackup(backupv1.BackupSucceeded))).To(Succeed())
+			Expect(*vmiPatches).To(HaveLen(1))
+			Expect(string((*vmiPatches)[0])).To(ContainSubstring(`{"op":"replace","path":"/spec/volumes","value":[{"name":"rootdisk","persistentVolumeClaim":{"claimName":"rootdisk"}}]}`))
+			Expect(*backupPatches).To(HaveLen(1))
+			Expect(string((*backupPatches)[0])).To(ContainSubstring(`{"op":"replace","path":"/metadata/finalizers","value":[]}`))
+		})
+	})
+
+	It("should enqueue the backups of a VMI", func() {
+		Expect(controller.VMBackupInformer.GetStore().Add(newVMBackup(""))).To(Succeed())
+		controller.handleVMI(newVMI())
+		Expect(controller.vmBackupQueue.Len()).To(Equal(1))
+		key, _ := controller.vmBackupQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + backupName))
+	})
+})
@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package export
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	destinationCredentials = "destination-credentials"
+
+	// uploadStatusRequeueTime is how often the upload status is polled while uploading
+	uploadStatusRequeueTime = time.Second * 10
+)
+
+// getUploadStatus fetches the upload status from the exporter pod, variable so can be overridden in tests
+var getUploadStatus = func(url, token, caBundle string) (*exportv1.VirtualMachineExportDestinationStatus, error) {
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+		return nil, fmt.Errorf("no valid certificates in the export CA bundle")
+	}
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+		},
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-kubevirt-export-token", token)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	status := &exportv1.VirtualMachineExportDestinationStatus{}
+	if err := json.NewDecoder(resp.Body).Decode(status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// addDestinationToPod configures the exporter pod to upload the volumes to the destination
+func addDestinationToPod(vmExport *exportv1.VirtualMachineExport, podManifest *corev1.Pod, pvcs []*corev1.PersistentVolumeClaim, getVolumeName getExportVolumeName) {
+	s3 := vmExport.Spec.Destination.S3
+	exportContainer := &podManifest.Spec.Containers[0]
+	for i, pvc := range pvcs {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_NAME", i),
+			Value: getVolumeName(pvc, vmExport),
+		})
+	}
+	format := s3.Format
+	if format == "" {
+		format = exportv1.KubeVirtGz
+	}
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  destinationEndpointEnv,
+		Value: s3.Endpoint,
+	}, corev1.EnvVar{
+		Name:  destinationBucketEnv,
+		Value: s3.Bucket,
+	}, corev1.EnvVar{
+		Name:  destinationKeyPrefixEnv,
+		Value: path.Join(s3.Prefix, vmExport.Name),
+	}, corev1.EnvVar{
+		Name:  destinationRegionEnv,
+		Value: s3.Region,
+	}, corev1.EnvVar{
+		Name:  destinationFormatEnv,
+		Value: string(format),
+	}, corev1.EnvVar{
+		Name:  uploadStatusTokenEnv,
+		Value: rand.String(32),
+	})
+
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: destinationCredentials,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: s3.CredentialsSecretRef,
+			},
+		},
+	})
+	exportContainer.VolumeMounts = append(exportContainer.VolumeMounts, corev1.VolumeMount{
+		Name:      destinationCredentials,
+		ReadOnly:  true,
+		MountPath: DestinationCredentialsPath,
+	})
+}
+
+func hasDestination(vmExport *exportv1.VirtualMachineExport) bool {
+	return vmExport.Spec.Destination != nil && vmExport.Spec.Destination.S3 != nil
+}
+
+// isUploading returns true until the upload to the destination either succeeded or failed
+func isUploading(vmExport *exportv1.VirtualMachineExport) bool {
+	if !hasDestination(vmExport) {
+		return false
+	}
+	if vmExport.Status == nil || vmExport.Status.Destination == nil {
+		return true
+	}
+	phase := vmExport.Status.Destination.Phase
+	return phase != exportv1.UploadSucceeded && phase != exportv1.UploadFailed
+}
+
+// updateDestinationStatus copies the upload status of the exporter pod into the export status.
+// The last known status is kept if the exporter pod cannot be reached.
+func (ctrl *VMExportController) updateDestinationStatus(vmExportCopy *exportv1.VirtualMachineExport, exporterPod *corev1.Pod, service *corev1.Service) {
+	if !isUploading(vmExportCopy) {
+		return
+	}
+
+	paths := CreateServerPaths(ContainerEnvToMap(exporterPod.Spec.Containers[0].Env))
+	if paths.Destination == nil {
+		return
+	}
+	caBundle, err := ctrl.internalExportCa()
+	if err != nil {
+		log.Log.Reason(err).Errorf("error getting export CA bundle")
+		return
+	}
+	url := fmt.Sprintf("https://%s.%s.svc%s", service.Name, service.Namespace, path.Join("/", internal, UploadStatusPath))
+	status, err := getUploadStatus(url, paths.Destination.StatusToken, caBundle)
+	if err != nil {
+		log.Log.Reason(err).Infof("unable to get upload status of %s/%s", vmExportCopy.Namespace, vmExportCopy.Name)
+		return
+	}
+	vmExportCopy.Status.Destination = status
+}
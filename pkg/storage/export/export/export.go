@@ -157,6 +157,14 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func rawZstdURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.img.zst", urlBasePath, pvc.Name))
+}
+
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -562,7 +570,12 @@ func (ctrl *VMExportController) handleSource(vmExport *exportv1.VirtualMachineEx
 		return 0, err
 	}
 
-	return updateStatus(vmExport, pod, service, sourceVolumes)
+	requeue, err := updateStatus(vmExport, pod, service, sourceVolumes)
+	if err == nil && pod != nil && isUploading(vmExport) && (requeue == 0 || requeue > uploadStatusRequeueTime) {
+		// poll the upload progress until the upload is done
+		requeue = uploadStatusRequeueTime
+	}
+	return requeue, err
 }
 
 func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, sourceVolumes *sourceVolumes) (*corev1.Pod, error) {
@@ -1025,6 +1038,14 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		PeriodSeconds:       5,
 	}
 
+	if hasDestination(vmExport) {
+		exportVolumeName := getVolumeName
+		if ctrl.isSourceVMSnapshot(&vmExport.Spec) {
+			exportVolumeName = getSnapshotVolumeName
+		}
+		addDestinationToPod(vmExport, podManifest, pvcs, exportVolumeName)
+	}
+
 	if vm, err := ctrl.getVmFromExport(vmExport); err != nil {
 		return nil, err
 	} else {
@@ -1175,6 +1196,12 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_ZSTD_URI", index),
+			Value: rawZstdURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1184,6 +1211,12 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_ZSTD_URI", index),
+				Value: rawZstdURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -1229,6 +1262,11 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 
 	vmExportCopy.Status.ServiceName = service.Name
 	vmExportCopy.Status.Links = &exportv1.VirtualMachineExportLinks{}
+	if hasDestination(vmExport) && vmExportCopy.Status.Destination == nil {
+		vmExportCopy.Status.Destination = &exportv1.VirtualMachineExportDestinationStatus{
+			Phase: exportv1.UploadPending,
+		}
+	}
 	if exporterPod == nil {
 		vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, inUseReason, sourceVolumes.availableMessage))
 		vmExportCopy.Status.Phase = exportv1.Pending
@@ -1244,6 +1282,9 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 			if err != nil {
 				return err
 			}
+			if hasDestination(vmExport) {
+				ctrl.updateDestinationStatus(vmExportCopy, exporterPod, service)
+			}
 		} else if exporterPod.Status.Phase == corev1.PodSucceeded {
 			vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, podCompletedReason, ""))
 			vmExportCopy.Status.Phase = exportv1.Terminated
@@ -24,6 +24,8 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -955,6 +957,84 @@ var _ = Describe("Export controller", func() {
 		Entry("Snapshot", populateVmExportVMSnapshot, controller.getPVCFromSourceVMSnapshot, 4),
 	)
 
+	It("Should configure the exporter pod to upload to the destination", func() {
+		testPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPVCName,
+				Namespace: testNamespace,
+			},
+		}
+		testVMExport := populateVmExportVMSnapshot()
+		testVMExport.Spec.Destination = &exportv1.VirtualMachineExportDestination{
+			S3: &exportv1.VirtualMachineExportS3Destination{
+				Endpoint:             "https://s3.example.com",
+				Bucket:               "exports",
+				Prefix:               "backups",
+				Region:               "eu-west-1",
+				CredentialsSecretRef: "s3-credentials",
+				Format:               exportv1.KubeVirtZstd,
+			},
+		}
+		populateInitialVMExportStatus(testVMExport)
+		Expect(controller.handleVMExportToken(testVMExport, controller.getPVCFromSourceVMSnapshot)).To(Succeed())
+		service := controller.createServiceManifest(testVMExport)
+		pod, err := controller.createExporterPodManifest(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+			Name: destinationCredentials,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: "s3-credentials",
+				},
+			},
+		}))
+		Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+			Name:      destinationCredentials,
+			ReadOnly:  true,
+			MountPath: DestinationCredentialsPath,
+		}))
+		paths := CreateServerPaths(ContainerEnvToMap(pod.Spec.Containers[0].Env))
+		Expect(paths.Volumes).To(HaveLen(1))
+		Expect(paths.Volumes[0].Name).To(Equal(getSnapshotVolumeName(testPVC, testVMExport)))
+		Expect(paths.Destination).ToNot(BeNil())
+		Expect(paths.Destination.StatusToken).ToNot(BeEmpty())
+		paths.Destination.StatusToken = ""
+		Expect(paths.Destination).To(Equal(&Destination{
+			Endpoint:  "https://s3.example.com",
+			Bucket:    "exports",
+			KeyPrefix: "backups/" + testVMExport.Name,
+			Region:    "eu-west-1",
+			Format:    "zstd",
+		}))
+	})
+
+	It("getUploadStatus should fetch the status with the status token", func() {
+		expected := &exportv1.VirtualMachineExportDestinationStatus{
+			Phase:       exportv1.UploadSucceeded,
+			ManifestKey: "export/virtualmachine.yaml",
+		}
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("x-kubevirt-export-token") != "status-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(json.NewEncoder(w).Encode(expected)).To(Succeed())
+		}))
+		defer server.Close()
+		caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+		status, err := getUploadStatus(server.URL, "status-token", caBundle)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(Equal(expected))
+
+		_, err = getUploadStatus(server.URL, "wrong", caBundle)
+		Expect(err).To(MatchError(ContainSubstring("401")))
+
+		_, err = getUploadStatus(server.URL, "status-token", "not a certificate")
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("Should create a secret based on the vm export", func() {
 		cp := &CertParams{Duration: 24 * time.Hour, RenewBefore: 2 * time.Hour}
 		scp, err := serializeCertParams(cp)
@@ -1441,25 +1521,18 @@ func verifyLinksInternal(vmExport *exportv1.VirtualMachineExport, expectedVolume
 	Expect(vmExport.Status.Links).ToNot(BeNil())
 	Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 	Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-	Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+	var volumeFormats []exportv1.VirtualMachineExportVolumeFormat
 	for _, volume := range vmExport.Status.Links.Internal.Volumes {
-		Expect(volume.Formats).To(HaveLen(2))
-		Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
+		volumeFormats = append(volumeFormats, volume.Formats...)
 	}
+	Expect(volumeFormats).To(ConsistOf(expectedVolumeFormats))
 }
 
-func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, link1Format exportv1.ExportVolumeFormat, link1Url string, link2Format exportv1.ExportVolumeFormat, link2Url string) {
+func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, expectedVolumeFormats ...exportv1.VirtualMachineExportVolumeFormat) {
 	Expect(vmExport.Status.Links.External).ToNot(BeNil())
 	Expect(vmExport.Status.Links.External.Cert).To(BeEmpty())
 	Expect(vmExport.Status.Links.External.Volumes).To(HaveLen(1))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(HaveLen(2))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ContainElements(exportv1.VirtualMachineExportVolumeFormat{
-		Format: link1Format,
-		Url:    link1Url,
-	}, exportv1.VirtualMachineExportVolumeFormat{
-		Format: link2Format,
-		Url:    link2Url,
-	}))
+	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ConsistOf(expectedVolumeFormats))
 }
 
 func verifyKubevirtInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
@@ -1473,16 +1546,34 @@ func verifyKubevirtInternal(vmExport *exportv1.VirtualMachineExport, exportName,
 			Format: exportv1.KubeVirtGz,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtZstd,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.zst", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+		})
 	}
 	verifyLinksInternal(vmExport, exportVolumeFormats...)
 }
 
 func verifyKubevirtExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
+	volumeUrl := fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s", currentVersion, namespace, exportName, volumeName)
 	verifyLinksExternal(vmExport,
-		exportv1.KubeVirtRaw,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img", currentVersion, namespace, exportName, volumeName),
-		exportv1.KubeVirtGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img.gz", currentVersion, namespace, exportName, volumeName))
+		exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtRaw,
+			Url:    volumeUrl + "/disk.img",
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtGz,
+			Url:    volumeUrl + "/disk.img.gz",
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtZstd,
+			Url:    volumeUrl + "/disk.img.zst",
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    volumeUrl + "/disk.qcow2",
+		})
 }
 
 func verifyArchiveInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
@@ -1558,10 +1649,13 @@ func ingressToHost() *networkingv1.Ingress {
 
 func verifyArchiveExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
 	verifyLinksExternal(vmExport,
-		exportv1.Dir,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
-		exportv1.ArchiveGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName))
+		exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.Dir,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.ArchiveGz,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName),
+		})
 }
 
 func writeCertsToDir(dir string) {
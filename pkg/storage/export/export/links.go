@@ -125,6 +125,18 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.RawZstdURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtZstd,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawZstdURI),
+			})
+		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
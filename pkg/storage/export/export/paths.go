@@ -8,20 +8,55 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+const (
+	// UploadStatusPath is the path of the upload status, relative to the internal base path
+	UploadStatusPath = "/upload/status"
+	// DestinationCredentialsPath is where the credentials of the destination are mounted
+	DestinationCredentialsPath = "/destination-credentials"
+	// DestinationAccessKeyIDKey is the key of the access key id in the credentials secret
+	DestinationAccessKeyIDKey = "accessKeyId"
+	// DestinationSecretAccessKeyKey is the key of the secret access key in the credentials secret
+	DestinationSecretAccessKeyKey = "secretAccessKey"
+
+	destinationEndpointEnv  = "EXPORT_DESTINATION_S3_ENDPOINT"
+	destinationBucketEnv    = "EXPORT_DESTINATION_S3_BUCKET"
+	destinationKeyPrefixEnv = "EXPORT_DESTINATION_S3_KEY_PREFIX"
+	destinationRegionEnv    = "EXPORT_DESTINATION_S3_REGION"
+	destinationFormatEnv    = "EXPORT_DESTINATION_FORMAT"
+	uploadStatusTokenEnv    = "EXPORT_UPLOAD_STATUS_TOKEN"
+)
+
 // VolumeInfo contains paths for a volume
 type VolumeInfo struct {
+	// Name is the name of the exported volume, it is only set when uploading to a destination
+	Name       string
 	Path       string
 	ArchiveURI string
 	DirURI     string
 	RawURI     string
 	RawGzURI   string
+	RawZstdURI string
+	Qcow2URI   string
+}
+
+// Destination is the object storage the volumes are uploaded to
+type Destination struct {
+	Endpoint  string
+	Bucket    string
+	KeyPrefix string
+	Region    string
+	// Format is the format of the uploaded disk images
+	Format string
+	// StatusToken protects the upload status endpoint
+	StatusToken string
 }
 
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
-	VMURI     string
-	SecretURI string
-	Volumes   []VolumeInfo
+	VMURI       string
+	SecretURI   string
+	Volumes     []VolumeInfo
+	Destination *Destination
 }
 
 // EnvironToMap converts the environment variables to a map
@@ -53,15 +88,28 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
 			envPrefix := strings.TrimSuffix(k, "_EXPORT_PATH")
 			vi := VolumeInfo{
+				Name:       env[envPrefix+"_EXPORT_NAME"],
 				Path:       v,
 				ArchiveURI: env[envPrefix+"_EXPORT_ARCHIVE_URI"],
 				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
 				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
 				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				RawZstdURI: env[envPrefix+"_EXPORT_RAW_ZSTD_URI"],
+				Qcow2URI:   env[envPrefix+"_EXPORT_QCOW2_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
 	}
+	if endpoint, ok := env[destinationEndpointEnv]; ok {
+		result.Destination = &Destination{
+			Endpoint:    endpoint,
+			Bucket:      env[destinationBucketEnv],
+			KeyPrefix:   env[destinationKeyPrefixEnv],
+			Region:      env[destinationRegionEnv],
+			Format:      env[destinationFormatEnv],
+			StatusToken: env[uploadStatusTokenEnv],
+		}
+	}
 	return result
 }
 
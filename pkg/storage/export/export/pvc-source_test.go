@@ -361,6 +361,111 @@ var _ = Describe("PVC source", func() {
 		Expect(service.Name).To(Equal(fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name)))
 	})
 
+	Context("with a destination", func() {
+		var orgGetUploadStatus = getUploadStatus
+
+		BeforeEach(func() {
+			DeferCleanup(func() {
+				getUploadStatus = orgGetUploadStatus
+			})
+		})
+
+		createDestinationVMExport := func() *exportv1.VirtualMachineExport {
+			vmExport := createPVCVMExport()
+			vmExport.Spec.Destination = &exportv1.VirtualMachineExportDestination{
+				S3: &exportv1.VirtualMachineExportS3Destination{
+					Endpoint:             "http://minio:9000",
+					Bucket:               "exports",
+					CredentialsSecretRef: "s3-credentials",
+				},
+			}
+			return vmExport
+		}
+
+		captureStatusUpdate := func() *exportv1.VirtualMachineExport {
+			updated := &exportv1.VirtualMachineExport{}
+			vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(testing.UpdateAction)
+				Expect(ok).To(BeTrue())
+				vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+				Expect(ok).To(BeTrue())
+				vmExport.DeepCopyInto(updated)
+				return true, vmExport, nil
+			})
+			return updated
+		}
+
+		It("Should report the upload status and requeue while uploading", func() {
+			testVMExport := createDestinationVMExport()
+			pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+			expectExporterCreate(k8sClient, k8sv1.PodRunning)
+			uploadStatus := &exportv1.VirtualMachineExportDestinationStatus{
+				Phase: exportv1.UploadInProgress,
+				Volumes: []exportv1.VirtualMachineExportVolumeUploadStatus{{
+					Name:          testPVCName,
+					Key:           fmt.Sprintf("%s/%s/disk.img.gz", testVMExport.Name, testPVCName),
+					Format:        exportv1.KubeVirtGz,
+					Progress:      "42.00%",
+					UploadedBytes: 1024,
+				}},
+			}
+			getUploadStatus = func(url, token, caBundle string) (*exportv1.VirtualMachineExportDestinationStatus, error) {
+				Expect(url).To(Equal(fmt.Sprintf("https://%s-%s.%s.svc/internal/upload/status", exportPrefix, testVMExport.Name, testNamespace)))
+				Expect(token).ToNot(BeEmpty())
+				Expect(caBundle).To(Equal("replace me with ca cert"))
+				return uploadStatus.DeepCopy(), nil
+			}
+			updated := captureStatusUpdate()
+
+			retry, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(uploadStatusRequeueTime))
+			Expect(updated.Status).ToNot(BeNil())
+			Expect(updated.Status.Destination).To(Equal(uploadStatus))
+			verifyKubevirtInternal(updated, updated.Name, testNamespace, testVMExport.Spec.Source.Name)
+		})
+
+		It("Should keep the last known status if the exporter cannot be reached", func() {
+			testVMExport := createDestinationVMExport()
+			pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+			expectExporterCreate(k8sClient, k8sv1.PodRunning)
+			getUploadStatus = func(url, token, caBundle string) (*exportv1.VirtualMachineExportDestinationStatus, error) {
+				return nil, fmt.Errorf("connection refused")
+			}
+			updated := captureStatusUpdate()
+
+			retry, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(uploadStatusRequeueTime))
+			Expect(updated.Status.Destination).To(Equal(&exportv1.VirtualMachineExportDestinationStatus{
+				Phase: exportv1.UploadPending,
+			}))
+		})
+
+		DescribeTable("Should stop polling once the upload is done", func(phase exportv1.VirtualMachineExportUploadPhase) {
+			testVMExport := createDestinationVMExport()
+			populateInitialVMExportStatus(testVMExport)
+			testVMExport.Status.Destination = &exportv1.VirtualMachineExportDestinationStatus{
+				Phase: phase,
+			}
+			pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+			expectExporterCreate(k8sClient, k8sv1.PodRunning)
+			getUploadStatus = func(url, token, caBundle string) (*exportv1.VirtualMachineExportDestinationStatus, error) {
+				Fail("the upload status should not be polled")
+				return nil, nil
+			}
+			updated := captureStatusUpdate()
+
+			retry, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(BeEquivalentTo(0))
+			Expect(updated.Status.Destination.Phase).To(Equal(phase))
+		},
+			Entry("succeeded", exportv1.UploadSucceeded),
+			Entry("failed", exportv1.UploadFailed),
+		)
+	})
+
 	DescribeTable("should detect content type properly", func(key, contentType string, expectedRes bool) {
 		pvc := &k8sv1.PersistentVolumeClaim{
 			ObjectMeta: metav1.ObjectMeta{
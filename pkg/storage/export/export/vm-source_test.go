@@ -388,6 +388,14 @@ var _ = Describe("PVC source", func() {
 			Format: exportv1.KubeVirtGz,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
 		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtZstd,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.zst", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
+		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
+		})
 		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
 			Format: exportv1.Dir,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
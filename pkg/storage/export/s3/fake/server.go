@@ -0,0 +1,219 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package fake provides an in memory stand-in for an S3 compatible object storage
+package fake
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/s3"
+)
+
+// Server is a minimal S3 compatible object storage which verifies the request
+// signatures and supports single and multipart uploads with path style addressing
+type Server struct {
+	*httptest.Server
+
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+
+	lock         sync.Mutex
+	buckets      map[string]map[string][]byte
+	uploads      map[string]map[int][]byte
+	nextUploadID int
+	failParts    int
+}
+
+// NewServer starts a server with the given buckets, use Close to stop it.
+// The region defaults to the default region of the client.
+func NewServer(region, accessKeyID, secretAccessKey string, buckets ...string) *Server {
+	if region == "" {
+		region = s3.DefaultRegion
+	}
+	s := &Server{
+		Region:          region,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		buckets:         map[string]map[string][]byte{},
+		uploads:         map[string]map[int][]byte{},
+		failParts:       -1,
+	}
+	for _, bucket := range buckets {
+		s.buckets[bucket] = map[string][]byte{}
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Object returns the content of an object, or nil if it does not exist
+func (s *Server) Object(bucket, key string) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.buckets[bucket][key]
+}
+
+// Keys returns the keys of the objects in a bucket
+func (s *Server) Keys(bucket string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var keys []string
+	for key := range s.buckets[bucket] {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// PendingUploads returns the number of multipart uploads which were neither completed nor aborted
+func (s *Server) PendingUploads() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.uploads)
+}
+
+// FailPartsAfter makes every part upload fail after n parts were uploaded
+func (s *Server) FailPartsAfter(n int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failParts = n
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+	payloadHash := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(payloadHash[:]) {
+		writeError(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch", "payload hash does not match")
+		return
+	}
+	if r.Header.Get("Authorization") != s3.Signature(r, s.Region, s.AccessKeyID, s.SecretAccessKey) {
+		writeError(w, http.StatusForbidden, "SignatureDoesNotMatch", "signature does not match")
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", bucket)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextUploadID++
+		uploadID := strconv.Itoa(s.nextUploadID)
+		s.uploads[uploadID] = map[int][]byte{}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", bucket, key, uploadID)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload", query.Get("uploadId"))
+			return
+		}
+		if s.failParts >= 0 && len(parts) >= s.failParts {
+			writeError(w, http.StatusInternalServerError, "InternalError", "part upload failed")
+			return
+		}
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+			return
+		}
+		parts[partNumber] = body
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		s.completeUpload(w, objects, key, query.Get("uploadId"), body)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		objects[key] = body
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodGet:
+		data, ok := objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey", key)
+			return
+		}
+		w.Write(data)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", r.Method)
+	}
+}
+
+func (s *Server) completeUpload(w http.ResponseWriter, objects map[string][]byte, key, uploadID string, body []byte) {
+	parts, ok := s.uploads[uploadID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchUpload", uploadID)
+		return
+	}
+	complete := struct {
+		Parts []struct {
+			PartNumber int
+			ETag       string
+		} `xml:"Part"`
+	}{}
+	if err := xml.Unmarshal(body, &complete); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedXML", err.Error())
+		return
+	}
+
+	var data bytes.Buffer
+	for i, part := range complete.Parts {
+		content, ok := parts[part.PartNumber]
+		if !ok || part.PartNumber != i+1 || part.ETag != etag(content) {
+			// the error of a complete request is reported with status 200
+			fmt.Fprintf(w, "<Error><Code>InvalidPart</Code><Message>part %d</Message></Error>", part.PartNumber)
+			return
+		}
+		data.Write(content)
+	}
+	objects[key] = data.Bytes()
+	delete(s.uploads, uploadID)
+	fmt.Fprintf(w, "<CompleteMultipartUploadResult><Key>%s</Key></CompleteMultipartUploadResult>", key)
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
@@ -0,0 +1,453 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package s3 implements the subset of the S3 API needed to upload exports
+// to S3 compatible object storage.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRegion = "us-east-1"
+
+	// DefaultPartSize is the smallest size of the parts of a multipart upload,
+	// objects which do not fit in MaxParts parts of this size use larger parts.
+	DefaultPartSize = 16 * 1024 * 1024
+	// MaxParts is the maximum number of parts of a multipart upload
+	MaxParts = 10000
+	// MaxPartSize is the maximum size of a part of a multipart upload
+	MaxPartSize = 5 * 1024 * 1024 * 1024
+	// DefaultMaxBufferedPartSize is the memory budget of an upload, larger parts are spooled to a temporary file
+	DefaultMaxBufferedPartSize = 64 * 1024 * 1024
+	// partSizeGrowthInterval is the number of parts after which the parts of an object of unknown size double in size
+	partSizeGrowthInterval = 1000
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat    = "20060102T150405Z"
+	shortDateFormat  = "20060102"
+)
+
+// Client uploads objects to an S3 compatible object storage, using path style bucket addressing
+type Client struct {
+	Endpoint        *url.URL
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	HTTPClient      *http.Client
+	// PartSize is the smallest size of the parts of a multipart upload
+	PartSize int
+	// MaxBufferedPartSize is the largest size of the parts kept in memory
+	MaxBufferedPartSize int
+	// SpoolDir is the directory larger parts are spooled to, the temporary directory
+	// if empty. It needs room for a part of MaxPartSize.
+	SpoolDir string
+
+	now func() time.Time
+}
+
+// NewClient returns a client for the object storage at endpoint
+func NewClient(endpoint, region, accessKeyID, secretAccessKey string, httpClient *http.Client) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint %q must be a http or https URL", endpoint)
+	}
+	if region == "" {
+		region = DefaultRegion
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		Endpoint:            u,
+		Region:              region,
+		AccessKeyID:         accessKeyID,
+		SecretAccessKey:     secretAccessKey,
+		HTTPClient:          httpClient,
+		PartSize:            DefaultPartSize,
+		MaxBufferedPartSize: DefaultMaxBufferedPartSize,
+		now:                 time.Now,
+	}, nil
+}
+
+// Error is an error returned by the object storage
+type Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("s3 request failed with status %d: %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+// PutObject uploads a small object in a single request
+func (c *Client) PutObject(ctx context.Context, bucket, key string, body []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, bucket, key, nil, body)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Upload uploads the content of r to the object, using a multipart upload so the
+// size does not have to be known in advance. size is the size of the object if it is
+// known and 0 otherwise, it determines the size of the parts. The upload is aborted if it fails.
+func (c *Client) Upload(ctx context.Context, bucket, key string, r io.Reader, size int64) error {
+	resp, err := c.do(ctx, http.MethodPost, bucket, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	initiateResult := &initiateMultipartUploadResult{}
+	err = decodeAndClose(resp, initiateResult)
+	if err != nil {
+		return err
+	}
+	uploadID := initiateResult.UploadID
+
+	if err := c.uploadParts(ctx, bucket, key, uploadID, r, size); err != nil {
+		if resp, abortErr := c.do(ctx, http.MethodDelete, bucket, key, url.Values{"uploadId": {uploadID}}, nil); abortErr == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Client) uploadParts(ctx context.Context, bucket, key, uploadID string, r io.Reader, size int64) error {
+	complete := completeMultipartUpload{}
+	parts := &partReader{reader: r, maxBufferedSize: c.MaxBufferedPartSize, spoolDir: c.SpoolDir}
+	defer parts.Close()
+	for partNumber := 1; ; partNumber++ {
+		partSize := PartSize(c.PartSize, size, partNumber)
+		part, err := parts.next(partSize)
+		if err != nil {
+			return err
+		}
+		if part.size == 0 && partNumber > 1 {
+			break
+		}
+		if partNumber > MaxParts {
+			return fmt.Errorf("object %s exceeds %d parts", key, MaxParts)
+		}
+
+		query := url.Values{
+			"partNumber": {fmt.Sprint(partNumber)},
+			"uploadId":   {uploadID},
+		}
+		resp, err := c.doPayload(ctx, http.MethodPut, bucket, key, query, part)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		complete.Parts = append(complete.Parts, completedPart{PartNumber: partNumber, ETag: resp.Header.Get("ETag")})
+
+		if part.size < int64(partSize) {
+			break
+		}
+	}
+
+	body, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(ctx, http.MethodPost, bucket, key, url.Values{"uploadId": {uploadID}}, body)
+	if err != nil {
+		return err
+	}
+	// CompleteMultipartUpload can fail after returning 200
+	return decodeAndClose(resp, &struct{}{})
+}
+
+// PartSize returns the size of a part of a multipart upload. The parts of an object of known size
+// have the same size, the smallest one which fits the object in MaxParts parts. The size of an object
+// which is compressed while it is uploaded is not known, so its parts grow with the part number.
+func PartSize(minPartSize int, objectSize int64, partNumber int) int {
+	partSize := int64(minPartSize)
+	if objectSize > 0 {
+		partSize = max(partSize, (objectSize+MaxParts-1)/MaxParts)
+	} else {
+		partSize <<= (partNumber - 1) / partSizeGrowthInterval
+	}
+	return int(min(partSize, MaxPartSize))
+}
+
+// payload is the body of a request with its SHA256 hash, which is part of the signature
+type payload struct {
+	body io.ReadSeeker
+	size int64
+	hash []byte
+}
+
+// partReader reads the parts of a multipart upload. Parts up to maxBufferedSize are kept
+// in memory, larger parts are spooled to a temporary file so the memory use stays bounded.
+type partReader struct {
+	reader          io.Reader
+	maxBufferedSize int
+	spoolDir        string
+	buf             []byte
+	spool           *os.File
+}
+
+// next reads the next part of up to partSize bytes, the part is shorter at the end of the reader
+func (p *partReader) next(partSize int) (*payload, error) {
+	if partSize > p.maxBufferedSize {
+		return p.nextSpooled(partSize)
+	}
+	if len(p.buf) != partSize {
+		p.buf = make([]byte, partSize)
+	}
+	n, err := io.ReadFull(p.reader, p.buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	hash := sha256.Sum256(p.buf[:n])
+	return &payload{body: bytes.NewReader(p.buf[:n]), size: int64(n), hash: hash[:]}, nil
+}
+
+func (p *partReader) nextSpooled(partSize int) (*payload, error) {
+	// the memory of the buffered parts is not needed anymore, parts only grow
+	p.buf = nil
+	if p.spool == nil {
+		spool, err := os.CreateTemp(p.spoolDir, "s3-part-")
+		if err != nil {
+			return nil, err
+		}
+		// the spool file is only referenced by its descriptor, it is deleted once it is closed
+		if err := os.Remove(spool.Name()); err != nil {
+			spool.Close()
+			return nil, err
+		}
+		p.spool = spool
+	}
+	if _, err := p.spool.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	n, err := io.CopyN(io.MultiWriter(p.spool, hash), p.reader, int64(partSize))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	// a section reader does not close the spool file when the request is sent
+	return &payload{body: io.NewSectionReader(p.spool, 0, n), size: n, hash: hash.Sum(nil)}, nil
+}
+
+// Close releases the spool file
+func (p *partReader) Close() error {
+	if p.spool == nil {
+		return nil
+	}
+	return p.spool.Close()
+}
+
+func decodeAndClose(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	s3Err := &Error{StatusCode: resp.StatusCode}
+	if xml.Unmarshal(body, s3Err) == nil && s3Err.Code != "" {
+		return s3Err
+	}
+	return xml.Unmarshal(body, v)
+}
+
+func (c *Client) do(ctx context.Context, method, bucket, key string, query url.Values, body []byte) (*http.Response, error) {
+	hash := sha256.Sum256(body)
+	return c.doPayload(ctx, method, bucket, key, query, &payload{body: bytes.NewReader(body), size: int64(len(body)), hash: hash[:]})
+}
+
+func (c *Client) doPayload(ctx context.Context, method, bucket, key string, query url.Values, body *payload) (*http.Response, error) {
+	u := *c.Endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket + "/" + key
+	// send the path encoded the same way it is signed
+	u.RawPath = canonicalURI(&u)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body.body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = body.size
+	c.sign(req, body.hash)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		s3Err := &Error{StatusCode: resp.StatusCode}
+		if body, err := io.ReadAll(resp.Body); err == nil {
+			_ = xml.Unmarshal(body, s3Err)
+		}
+		return nil, s3Err
+	}
+	return resp, nil
+}
+
+// sign adds a AWS signature version 4 to the request
+func (c *Client) sign(req *http.Request, payloadHash []byte) {
+	now := c.now().UTC()
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash))
+	req.Header.Set("Authorization", Signature(req, c.Region, c.AccessKeyID, c.SecretAccessKey))
+}
+
+// Signature returns the AWS signature version 4 authorization header of a request.
+// The x-amz-date and x-amz-content-sha256 headers have to be set already.
+func Signature(req *http.Request, region, accessKeyID, secretAccessKey string) string {
+	amzDate := req.Header.Get("X-Amz-Date")
+	date := amzDate
+	if t, err := time.Parse(amzDateFormat, amzDate); err == nil {
+		date = t.Format(shortDateFormat)
+	}
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, s := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	return fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", signingAlgorithm, accessKeyID, scope, signedHeaders, signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalHeaders signs the host, range, content-type and all x-amz headers
+func canonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") || name == "range" || name == "content-type" {
+			headers[name] = strings.Join(values, ",")
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func canonicalURI(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	if uri := strings.Join(segments, "/"); uri != "" {
+		return uri
+	}
+	return "/"
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func uriEncode(s string) string {
+	var encoded strings.Builder
+	for _, b := range []byte(s) {
+		if 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' || '0' <= b && b <= '9' || b == '-' || b == '.' || b == '_' || b == '~' {
+			encoded.WriteByte(b)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
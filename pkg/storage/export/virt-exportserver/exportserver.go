@@ -36,8 +36,10 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	flag "github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -86,12 +88,16 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	ZstdHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
 	PermissionChecker func(string) bool
 
 	TokenGetter TokenGetterFunc
+
+	DestinationCredentialsPath string
 }
 
 type execReader struct {
@@ -102,7 +108,8 @@ type execReader struct {
 
 type exportServer struct {
 	ExportServerConfig
-	handler http.Handler
+	handler  http.Handler
+	uploader *uploader
 }
 
 func (er *execReader) Read(p []byte) (int, error) {
@@ -140,6 +147,18 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 	}
+	if s.Paths.Destination != nil {
+		uploader, err := newUploader(s.Paths.Destination, s.Paths.Volumes, s.DestinationCredentialsPath)
+		if err != nil {
+			golog.Fatalf("unable to configure upload destination: %v", err)
+		}
+		s.uploader = uploader
+		// The upload status is polled by the export controller, which cannot read the export token
+		statusTokenGetter := func() (string, error) {
+			return s.Paths.Destination.StatusToken, nil
+		}
+		mux.Handle(filepath.Join(internal, export.UploadStatusPath), tokenChecker(statusTokenGetter, uploadStatusHandler(uploader)))
+	}
 	// Readiness probe
 	mux.HandleFunc(export.ReadinessPath, s.readyHandler)
 
@@ -184,6 +203,14 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.RawZstdURI != "" {
+		result[vi.RawZstdURI] = s.ZstdHandler(p)
+	}
+
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
 	return result
 }
 
@@ -200,6 +227,12 @@ func (s *exportServer) Run() {
 
 	ch := make(chan error)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if s.uploader != nil {
+		go s.uploader.Run(ctx)
+	}
+
 	go func() {
 		err := srv.ListenAndServeTLS(s.CertFile, s.KeyFile)
 		ch <- err
@@ -243,6 +276,14 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.ZstdHandler == nil {
+		es.ZstdHandler = zstdHandler
+	}
+
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -261,6 +302,10 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.PermissionChecker = checkVolumePermissions
 	}
 
+	if es.DestinationCredentialsPath == "" {
+		es.DestinationCredentialsPath = export.DestinationCredentialsPath
+	}
+
 	return es
 }
 
@@ -438,6 +483,31 @@ func pipeToGzip(reader io.ReadCloser) io.ReadCloser {
 	return pr
 }
 
+func pipeToZstd(reader io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+
+	go func() {
+		zw, err := zstd.NewWriter(pw)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		n, err := io.Copy(zw, reader)
+		if err != nil {
+			log.Log.Reason(err).Error("error piping to zstd")
+		}
+		if err = zw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing zstd writer")
+		}
+		if err = pw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing pipe writer")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	}()
+
+	return pr
+}
+
 func getTokenQueryParam(r *http.Request) (token string) {
 	q := r.URL.Query()
 	if keys, ok := q[authHeader]; ok {
@@ -570,6 +640,68 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+func zstdHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		zstdReader := pipeToZstd(f)
+		defer zstdReader.Close()
+		n, err := io.Copy(w, zstdReader)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+// qcow2Handler serves the raw image as a sparse qcow2 image. The layout is computed from
+// the allocation of the raw image on the first request and reused by later ones, which
+// allows cheap range requests.
+func qcow2Handler(filePath string) http.Handler {
+	var layout atomic.Pointer[qcow2Image]
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+
+		// concurrent first requests compute the same layout, none of them waits for another
+		cached := layout.Load()
+		if cached == nil {
+			// Stat does not return the size of block devices
+			size, err := f.Seek(0, io.SeekEnd)
+			if err == nil {
+				cached, err = newQcow2ImageFromFile(f, size)
+			}
+			if err != nil {
+				log.Log.Reason(err).Errorf("error creating qcow2 layout of %s", filePath)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			layout.Store(cached)
+		}
+		img := *cached
+		img.raw = f
+		http.ServeContent(w, r, "disk.qcow2", time.Time{}, io.NewSectionReader(&img, 0, img.Size()))
+	})
+}
+
 func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -20,13 +20,17 @@
 package virtexportserver
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
@@ -63,6 +67,12 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		ZstdHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +135,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +200,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +262,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +323,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -677,6 +727,35 @@ var _ = Describe("exportserver", func() {
 		})
 	})
 
+	Context("Zstd handler", func() {
+		It("Should return the zstd compressed file", func() {
+			raw := bytes.Repeat([]byte("kubevirt"), 1024)
+			filePath := filepath.Join(GinkgoT().TempDir(), "disk.img")
+			Expect(os.WriteFile(filePath, raw, 0644)).To(Succeed())
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/disk.img.zst", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			zstdHandler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.Len()).To(BeNumerically("<", len(raw)))
+
+			decoder, err := zstd.NewReader(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			defer decoder.Close()
+			out, err := io.ReadAll(decoder)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal(raw))
+		})
+
+		It("Should return error on non GET", func() {
+			req, err := http.NewRequest("POST", "https://test.blah.invalid/disk.img.zst", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			zstdHandler("/tmp/disk.img").ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Context("Secret handler", func() {
 		verifySecret := func(yamlString string) {
 			resSecret := &v1.Secret{}
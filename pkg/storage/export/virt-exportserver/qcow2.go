@@ -0,0 +1,320 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	qcow2Magic         = 0x514649fb
+	qcow2Version       = 3
+	qcow2HeaderLength  = 104
+	qcow2ClusterBits   = 16
+	qcow2ClusterSize   = 1 << qcow2ClusterBits
+	qcow2RefcountOrder = 4
+	qcow2OflagCopied   = uint64(1) << 63
+
+	// number of 8 byte entries in a L1 or L2 table cluster
+	qcow2TableEntries = qcow2ClusterSize / 8
+	// number of 16 bit refcounts in a refcount block
+	qcow2RefcountsPerBlock = qcow2ClusterSize * 8 / (1 << qcow2RefcountOrder)
+)
+
+var zeroCluster = make([]byte, qcow2ClusterSize)
+
+// qcow2Extent is a range of allocated guest clusters
+type qcow2Extent struct {
+	start    int64
+	clusters int64
+}
+
+func (e qcow2Extent) end() int64 {
+	return e.start + e.clusters
+}
+
+// qcow2Image presents a raw disk image as a qcow2 image without writing it anywhere.
+// Clusters outside of the allocated extents of the raw image are left unallocated, and
+// all metadata is laid out up front, so the size of the image is known and any range
+// of it can be read.
+//
+// Host layout, in clusters: header, L1 table, refcount table, refcount blocks,
+// L2 tables and the allocated data clusters in guest order.
+type qcow2Image struct {
+	raw         io.ReaderAt
+	virtualSize int64
+
+	l1Clusters            int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+
+	// L1 index of every L2 table, in host order
+	l2Tables []int64
+	// allocated guest clusters, sorted and not adjacent to each other
+	extents []qcow2Extent
+	// index of the first data cluster of every extent
+	extentDataIndex []int64
+	dataClusters    int64
+
+	size int64
+}
+
+// newQcow2Image lays out the qcow2 image of the raw image, the extents are the allocated
+// guest clusters of the raw image, sorted and merged.
+func newQcow2Image(raw io.ReaderAt, virtualSize int64, extents []qcow2Extent) *qcow2Image {
+	img := &qcow2Image{
+		raw:         raw,
+		virtualSize: virtualSize,
+		extents:     extents,
+	}
+
+	for _, extent := range extents {
+		img.extentDataIndex = append(img.extentDataIndex, img.dataClusters)
+		img.dataClusters += extent.clusters
+		for l1Index := extent.start / qcow2TableEntries; l1Index <= (extent.end()-1)/qcow2TableEntries; l1Index++ {
+			if len(img.l2Tables) == 0 || img.l2Tables[len(img.l2Tables)-1] != l1Index {
+				img.l2Tables = append(img.l2Tables, l1Index)
+			}
+		}
+	}
+
+	guestClusters := divRoundUp(virtualSize, qcow2ClusterSize)
+	img.l1Clusters = divRoundUp(max(divRoundUp(guestClusters, qcow2TableEntries), 1)*8, qcow2ClusterSize)
+	// The refcount blocks have to cover themselves, grow them until they do
+	img.refcountBlocks = 1
+	for {
+		img.refcountTableClusters = divRoundUp(img.refcountBlocks*8, qcow2ClusterSize)
+		needed := divRoundUp(img.hostClusters(), qcow2RefcountsPerBlock)
+		if needed <= img.refcountBlocks {
+			break
+		}
+		img.refcountBlocks = needed
+	}
+	img.size = img.hostClusters() * qcow2ClusterSize
+
+	return img
+}
+
+// newQcow2ImageFromFile lays out the qcow2 image of the raw image in the given file
+func newQcow2ImageFromFile(f *os.File, size int64) (*qcow2Image, error) {
+	extents, err := allocatedExtents(f, size)
+	if err != nil {
+		return nil, err
+	}
+	return newQcow2Image(f, size, extents), nil
+}
+
+// allocatedExtents returns the guest clusters of the raw image holding data. The allocation
+// is taken from the file system with SEEK_DATA and SEEK_HOLE, so the image is not read.
+// Files which do not report their holes, like block devices, are fully allocated.
+func allocatedExtents(f *os.File, size int64) ([]qcow2Extent, error) {
+	var extents []qcow2Extent
+	addRange := func(start, end int64) {
+		first := start / qcow2ClusterSize
+		last := divRoundUp(end, qcow2ClusterSize)
+		if n := len(extents); n > 0 && extents[n-1].end() >= first {
+			extents[n-1].clusters = max(extents[n-1].clusters, last-extents[n-1].start)
+			return
+		}
+		extents = append(extents, qcow2Extent{start: first, clusters: last - first})
+	}
+
+	for offset := int64(0); offset < size; {
+		data, err := f.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			// no data after offset
+			break
+		}
+		if errors.Is(err, unix.EINVAL) || errors.Is(err, unix.EOPNOTSUPP) {
+			return []qcow2Extent{{start: 0, clusters: divRoundUp(size, qcow2ClusterSize)}}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if data >= size {
+			break
+		}
+		hole, err := f.Seek(data, unix.SEEK_HOLE)
+		if err != nil {
+			return nil, err
+		}
+		hole = min(hole, size)
+		addRange(data, hole)
+		offset = hole
+	}
+	return extents, nil
+}
+
+func divRoundUp(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+func (img *qcow2Image) hostClusters() int64 {
+	return img.dataStart() + img.dataClusters
+}
+
+func (img *qcow2Image) l1Start() int64 {
+	return 1
+}
+
+func (img *qcow2Image) refcountTableStart() int64 {
+	return img.l1Start() + img.l1Clusters
+}
+
+func (img *qcow2Image) refcountBlocksStart() int64 {
+	return img.refcountTableStart() + img.refcountTableClusters
+}
+
+func (img *qcow2Image) l2Start() int64 {
+	return img.refcountBlocksStart() + img.refcountBlocks
+}
+
+func (img *qcow2Image) dataStart() int64 {
+	return img.l2Start() + int64(len(img.l2Tables))
+}
+
+// Size returns the size of the qcow2 image in bytes
+func (img *qcow2Image) Size() int64 {
+	return img.size
+}
+
+// ReadAt implements io.ReaderAt
+func (img *qcow2Image) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+
+	n := 0
+	for n < len(p) && off < img.size {
+		cluster := off / qcow2ClusterSize
+		within := off % qcow2ClusterSize
+		chunk := min(int64(len(p)-n), qcow2ClusterSize-within)
+		if err := img.readCluster(cluster, within, p[n:n+int(chunk)]); err != nil {
+			return n, err
+		}
+		n += int(chunk)
+		off += chunk
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (img *qcow2Image) readCluster(cluster, within int64, dst []byte) error {
+	if cluster >= img.dataStart() {
+		return img.readDataCluster(img.guestCluster(cluster-img.dataStart()), within, dst)
+	}
+
+	buf := make([]byte, qcow2ClusterSize)
+	switch {
+	case cluster == 0:
+		img.writeHeader(buf)
+	case cluster < img.refcountTableStart():
+		img.writeL1Table(cluster-img.l1Start(), buf)
+	case cluster < img.refcountBlocksStart():
+		img.writeRefcountTable(cluster-img.refcountTableStart(), buf)
+	case cluster < img.l2Start():
+		img.writeRefcountBlock(cluster-img.refcountBlocksStart(), buf)
+	default:
+		img.writeL2Table(img.l2Tables[cluster-img.l2Start()], buf)
+	}
+	copy(dst, buf[within:])
+	return nil
+}
+
+// guestCluster returns the guest cluster of the given data cluster
+func (img *qcow2Image) guestCluster(dataIndex int64) int64 {
+	i := sort.Search(len(img.extents), func(i int) bool {
+		return img.extentDataIndex[i]+img.extents[i].clusters > dataIndex
+	})
+	return img.extents[i].start + dataIndex - img.extentDataIndex[i]
+}
+
+func (img *qcow2Image) readDataCluster(guestCluster, within int64, dst []byte) error {
+	n, err := img.raw.ReadAt(dst, guestCluster*qcow2ClusterSize+within)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	// the last cluster of the raw image may be incomplete
+	copy(dst[n:], zeroCluster)
+	return nil
+}
+
+func (img *qcow2Image) writeHeader(buf []byte) {
+	be := binary.BigEndian
+	be.PutUint32(buf[0:], qcow2Magic)
+	be.PutUint32(buf[4:], qcow2Version)
+	// backing_file_offset and backing_file_size stay zero
+	be.PutUint32(buf[20:], qcow2ClusterBits)
+	be.PutUint64(buf[24:], uint64(img.virtualSize))
+	// crypt_method stays zero
+	be.PutUint32(buf[36:], uint32(max(divRoundUp(divRoundUp(img.virtualSize, qcow2ClusterSize), qcow2TableEntries), 1)))
+	be.PutUint64(buf[40:], uint64(img.l1Start()*qcow2ClusterSize))
+	be.PutUint64(buf[48:], uint64(img.refcountTableStart()*qcow2ClusterSize))
+	be.PutUint32(buf[56:], uint32(img.refcountTableClusters))
+	// nb_snapshots, snapshots_offset and the feature bits stay zero
+	be.PutUint32(buf[96:], qcow2RefcountOrder)
+	be.PutUint32(buf[100:], qcow2HeaderLength)
+	// the header extension area following the header is terminated by a zero entry
+}
+
+func (img *qcow2Image) writeL1Table(index int64, buf []byte) {
+	first := index * qcow2TableEntries
+	i := sort.Search(len(img.l2Tables), func(i int) bool { return img.l2Tables[i] >= first })
+	for ; i < len(img.l2Tables) && img.l2Tables[i] < first+qcow2TableEntries; i++ {
+		offset := (img.l2Start() + int64(i)) * qcow2ClusterSize
+		binary.BigEndian.PutUint64(buf[(img.l2Tables[i]-first)*8:], uint64(offset)|qcow2OflagCopied)
+	}
+}
+
+func (img *qcow2Image) writeRefcountTable(index int64, buf []byte) {
+	first := index * qcow2TableEntries
+	for i := first; i < img.refcountBlocks && i < first+qcow2TableEntries; i++ {
+		offset := (img.refcountBlocksStart() + i) * qcow2ClusterSize
+		binary.BigEndian.PutUint64(buf[(i-first)*8:], uint64(offset))
+	}
+}
+
+func (img *qcow2Image) writeRefcountBlock(index int64, buf []byte) {
+	first := index * qcow2RefcountsPerBlock
+	for i := first; i < img.hostClusters() && i < first+qcow2RefcountsPerBlock; i++ {
+		binary.BigEndian.PutUint16(buf[(i-first)*2:], 1)
+	}
+}
+
+func (img *qcow2Image) writeL2Table(l1Index int64, buf []byte) {
+	first := l1Index * qcow2TableEntries
+	i := sort.Search(len(img.extents), func(i int) bool { return img.extents[i].end() > first })
+	for ; i < len(img.extents) && img.extents[i].start < first+qcow2TableEntries; i++ {
+		extent := img.extents[i]
+		for c := max(extent.start, first); c < min(extent.end(), first+qcow2TableEntries); c++ {
+			offset := (img.dataStart() + img.extentDataIndex[i] + c - extent.start) * qcow2ClusterSize
+			binary.BigEndian.PutUint64(buf[(c-first)*8:], uint64(offset)|qcow2OflagCopied)
+		}
+	}
+}
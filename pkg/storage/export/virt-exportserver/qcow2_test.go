@@ -0,0 +1,317 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 returns the virtual size and allocated guest clusters of a qcow2 image and checks
+// that every host cluster is referenced and refcounted exactly once
+func readQcow2(img []byte) (uint64, map[uint64][]byte) {
+	be := binary.BigEndian
+	Expect(be.Uint32(img[0:])).To(BeEquivalentTo(qcow2Magic))
+	Expect(be.Uint32(img[4:])).To(BeEquivalentTo(3))
+	clusterSize := uint64(1) << be.Uint32(img[20:])
+	size := be.Uint64(img[24:])
+	l1Size := be.Uint32(img[36:])
+	l1Offset := be.Uint64(img[40:])
+	refcountTableOffset := be.Uint64(img[48:])
+	refcountTableClusters := be.Uint32(img[56:])
+	Expect(be.Uint32(img[96:])).To(BeEquivalentTo(4))
+	Expect(be.Uint32(img[100:])).To(BeEquivalentTo(104))
+	Expect(uint64(len(img)) % clusterSize).To(BeZero())
+	hostClusters := uint64(len(img)) / clusterSize
+
+	offsetMask := uint64(1)<<62 - 1
+	referenced := map[uint64]int{0: 1}
+	markClusters := func(offset, length uint64) {
+		for c := offset / clusterSize; c < (offset+length+clusterSize-1)/clusterSize; c++ {
+			referenced[c]++
+		}
+	}
+	markClusters(l1Offset, uint64(l1Size)*8)
+	markClusters(refcountTableOffset, uint64(refcountTableClusters)*clusterSize)
+
+	clusters := map[uint64][]byte{}
+	entries := clusterSize / 8
+	for i := uint64(0); i < uint64(l1Size); i++ {
+		l2Offset := be.Uint64(img[l1Offset+i*8:]) & offsetMask
+		if l2Offset == 0 {
+			continue
+		}
+		markClusters(l2Offset, clusterSize)
+		for j := uint64(0); j < entries; j++ {
+			dataOffset := be.Uint64(img[l2Offset+j*8:]) & offsetMask
+			if dataOffset == 0 {
+				continue
+			}
+			markClusters(dataOffset, clusterSize)
+			clusters[i*entries+j] = img[dataOffset : dataOffset+clusterSize]
+		}
+	}
+
+	refcounts := clusterSize * 8 / 16
+	for i := uint64(0); i < uint64(refcountTableClusters)*entries; i++ {
+		blockOffset := be.Uint64(img[refcountTableOffset+i*8:])
+		if blockOffset == 0 {
+			continue
+		}
+		markClusters(blockOffset, clusterSize)
+		for j := uint64(0); j < refcounts; j++ {
+			refcount := be.Uint16(img[blockOffset+j*2:])
+			if c := i*refcounts + j; c < hostClusters {
+				Expect(refcount).To(BeEquivalentTo(1), "cluster %d", c)
+			} else {
+				Expect(refcount).To(BeZero())
+			}
+		}
+	}
+	Expect(referenced).To(HaveLen(int(hostClusters)))
+	for c := uint64(0); c < hostClusters; c++ {
+		Expect(referenced[c]).To(Equal(1), "cluster %d", c)
+	}
+
+	return size, clusters
+}
+
+// sparseImage is a raw image of the given size with 4k of data at the given sorted offsets
+type sparseImage struct {
+	size   int64
+	dataAt []int64
+}
+
+func (s *sparseImage) ReadAt(p []byte, off int64) (int, error) {
+	n := int(max(min(int64(len(p)), s.size-off), 0))
+	clear(p[:n])
+	// dataAt is sorted, skip the data before off
+	first := sort.Search(len(s.dataAt), func(i int) bool { return s.dataAt[i]+4096 > off })
+	for i := first; i < len(s.dataAt) && s.dataAt[i] < off+int64(n); i++ {
+		start := max(s.dataAt[i], off)
+		end := min(s.dataAt[i]+4096, off+int64(n))
+		for ; start < end; start++ {
+			p[start-off] = byte(i%255 + 1)
+		}
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (s *sparseImage) bytes() []byte {
+	raw := make([]byte, s.size)
+	_, err := s.ReadAt(raw, 0)
+	Expect(err).ToNot(HaveOccurred())
+	return raw
+}
+
+// extents returns the guest clusters holding data
+func (s *sparseImage) extents() []qcow2Extent {
+	var extents []qcow2Extent
+	for _, offset := range s.dataAt {
+		first := offset / qcow2ClusterSize
+		last := (offset + 4096 - 1) / qcow2ClusterSize
+		if n := len(extents); n > 0 && extents[n-1].end() >= first {
+			extents[n-1].clusters = max(extents[n-1].clusters, last+1-extents[n-1].start)
+			continue
+		}
+		extents = append(extents, qcow2Extent{start: first, clusters: last + 1 - first})
+	}
+	return extents
+}
+
+// writeSparse writes the image to a file, leaving holes where it has no data
+func (s *sparseImage) writeSparse(filePath string) {
+	f, err := os.Create(filePath)
+	Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+	Expect(f.Truncate(s.size)).To(Succeed())
+	buf := make([]byte, 4096)
+	for _, offset := range s.dataAt {
+		n, _ := s.ReadAt(buf, offset)
+		_, err := f.WriteAt(buf[:n], offset)
+		Expect(err).ToNot(HaveOccurred())
+	}
+}
+
+func expectQcow2Content(qcow2 []byte, raw *sparseImage) {
+	size, clusters := readQcow2(qcow2)
+	Expect(size).To(BeEquivalentTo(raw.size))
+	buf := make([]byte, qcow2ClusterSize)
+	for c := uint64(0); c*qcow2ClusterSize < size; c++ {
+		n, _ := raw.ReadAt(buf, int64(c*qcow2ClusterSize))
+		copy(buf[n:], zeroCluster)
+		if data, ok := clusters[c]; ok {
+			Expect(data).To(Equal(buf), "cluster %d", c)
+		} else {
+			Expect(buf).To(Equal(zeroCluster), "cluster %d", c)
+		}
+	}
+}
+
+func readAll(img *qcow2Image) []byte {
+	out, err := io.ReadAll(io.NewSectionReader(img, 0, img.Size()))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out).To(HaveLen(int(img.Size())))
+	return out
+}
+
+var _ = Describe("qcow2", func() {
+	DescribeTable("should convert raw image", func(raw *sparseImage, expectedDataClusters int) {
+		img := newQcow2Image(raw, raw.size, raw.extents())
+		Expect(img.dataClusters).To(BeEquivalentTo(expectedDataClusters))
+		expectQcow2Content(readAll(img), raw)
+	},
+		Entry("that is empty", &sparseImage{size: 10 * qcow2ClusterSize}, 0),
+		Entry("that is sparse", &sparseImage{size: 100 * qcow2ClusterSize, dataAt: []int64{0, 50*qcow2ClusterSize + 100}}, 2),
+		Entry("that has data across a cluster boundary", &sparseImage{size: 100 * qcow2ClusterSize, dataAt: []int64{2*qcow2ClusterSize - 10}}, 2),
+		Entry("that does not end on a cluster boundary", &sparseImage{size: 3*qcow2ClusterSize + 512, dataAt: []int64{3 * qcow2ClusterSize}}, 1),
+		Entry("that needs multiple L2 tables", &sparseImage{size: 2 * qcow2TableEntries * qcow2ClusterSize, dataAt: []int64{0, qcow2TableEntries * qcow2ClusterSize, 2*qcow2TableEntries*qcow2ClusterSize - 4096}}, 3),
+	)
+
+	It("should grow the refcount blocks to cover all clusters", func() {
+		dataAt := make([]int64, 0, qcow2RefcountsPerBlock)
+		for c := int64(0); c < qcow2RefcountsPerBlock; c++ {
+			dataAt = append(dataAt, c*qcow2ClusterSize*2)
+		}
+		raw := &sparseImage{size: qcow2RefcountsPerBlock * qcow2ClusterSize * 2, dataAt: dataAt}
+		img := newQcow2Image(raw, raw.size, raw.extents())
+		Expect(img.dataClusters).To(BeEquivalentTo(qcow2RefcountsPerBlock))
+		Expect(img.refcountBlocks).To(BeEquivalentTo(2))
+		Expect(img.hostClusters()).To(BeNumerically(">", qcow2RefcountsPerBlock))
+		readQcow2(readAll(img))
+	})
+
+	It("should read ranges that span clusters", func() {
+		raw := &sparseImage{size: 20 * qcow2ClusterSize, dataAt: []int64{0, 5 * qcow2ClusterSize, 6 * qcow2ClusterSize}}
+		img := newQcow2Image(raw, raw.size, raw.extents())
+		full := readAll(img)
+
+		buf := make([]byte, 3*qcow2ClusterSize)
+		n, err := img.ReadAt(buf, qcow2ClusterSize/2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(len(buf)))
+		Expect(buf).To(Equal(full[qcow2ClusterSize/2 : qcow2ClusterSize/2+len(buf)]))
+
+		n, err = img.ReadAt(buf, img.Size()-100)
+		Expect(err).To(MatchError(io.EOF))
+		Expect(n).To(Equal(100))
+	})
+
+	It("should lay out a fully allocated raw image", func() {
+		raw := &sparseImage{size: 2*qcow2TableEntries*qcow2ClusterSize + 512, dataAt: []int64{qcow2ClusterSize}}
+		img := newQcow2Image(raw, raw.size, []qcow2Extent{{start: 0, clusters: 2*qcow2TableEntries + 1}})
+		Expect(img.l2Tables).To(Equal([]int64{0, 1, 2}))
+		size, clusters := readQcow2(readAll(img))
+		Expect(size).To(BeEquivalentTo(raw.size))
+		Expect(clusters).To(HaveLen(2*qcow2TableEntries + 1))
+		expectQcow2Content(readAll(img), raw)
+	})
+
+	DescribeTable("should find the allocated clusters of a sparse file", func(raw *sparseImage, expectedExtents []qcow2Extent) {
+		filePath := filepath.Join(GinkgoT().TempDir(), "disk.img")
+		raw.writeSparse(filePath)
+		f, err := os.Open(filePath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(allocatedExtents(f, raw.size)).To(Equal(expectedExtents))
+	},
+		Entry("that is empty", &sparseImage{size: 10 * qcow2ClusterSize}, []qcow2Extent(nil)),
+		Entry("that is sparse",
+			&sparseImage{size: 100 * qcow2ClusterSize, dataAt: []int64{0, 50 * qcow2ClusterSize, 51 * qcow2ClusterSize, 70 * qcow2ClusterSize}},
+			[]qcow2Extent{{start: 0, clusters: 1}, {start: 50, clusters: 2}, {start: 70, clusters: 1}},
+		),
+		Entry("that has data across a cluster boundary",
+			&sparseImage{size: 100 * qcow2ClusterSize, dataAt: []int64{2*qcow2ClusterSize - 2048}},
+			[]qcow2Extent{{start: 1, clusters: 2}},
+		),
+		Entry("that does not end on a cluster boundary",
+			&sparseImage{size: 3*qcow2ClusterSize + 4096, dataAt: []int64{3 * qcow2ClusterSize}},
+			[]qcow2Extent{{start: 3, clusters: 1}},
+		),
+	)
+
+	Context("handler", func() {
+		var (
+			raw      *sparseImage
+			filePath string
+		)
+
+		BeforeEach(func() {
+			raw = &sparseImage{size: 40 * qcow2ClusterSize, dataAt: []int64{0, 30 * qcow2ClusterSize}}
+			filePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			raw.writeSparse(filePath)
+		})
+
+		It("should serve the qcow2 image", func() {
+			req, err := http.NewRequest(http.MethodGet, "https://test.blah.invalid/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			expectQcow2Content(resp.Body.Bytes(), raw)
+			_, clusters := readQcow2(resp.Body.Bytes())
+			Expect(clusters).To(HaveLen(2))
+		})
+
+		It("should serve ranges of the qcow2 image", func() {
+			handler := qcow2Handler(filePath)
+			req, err := http.NewRequest(http.MethodGet, "https://test.blah.invalid/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			full := resp.Body.Bytes()
+
+			req.Header.Set("Range", "bytes=100-70000")
+			resp = httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusPartialContent))
+			Expect(resp.Header().Get("Accept-Ranges")).To(Equal("bytes"))
+			Expect(resp.Body.Bytes()).To(Equal(full[100:70001]))
+		})
+
+		It("should fail on non GET or HEAD", func() {
+			req, err := http.NewRequest(http.MethodPost, "https://test.blah.invalid/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 500 if the file cannot be opened", func() {
+			req, err := http.NewRequest(http.MethodGet, "https://test.blah.invalid/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler(filepath.Join(filepath.Dir(filePath), "missing")).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
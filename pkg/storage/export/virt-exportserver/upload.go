@@ -0,0 +1,367 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/s3"
+)
+
+const destinationManifestName = "virtualmachine.yaml"
+
+// uploader uploads the exported volumes and the VM manifest to the destination
+// and keeps track of the progress
+type uploader struct {
+	destination *export.Destination
+	volumes     []export.VolumeInfo
+	client      *s3.Client
+
+	lock   sync.Mutex
+	status exportv1.VirtualMachineExportDestinationStatus
+}
+
+func newUploader(destination *export.Destination, volumes []export.VolumeInfo, credentialsPath string) (*uploader, error) {
+	accessKeyID, err := os.ReadFile(filepath.Join(credentialsPath, export.DestinationAccessKeyIDKey))
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := os.ReadFile(filepath.Join(credentialsPath, export.DestinationSecretAccessKeyKey))
+	if err != nil {
+		return nil, err
+	}
+	client, err := s3.NewClient(destination.Endpoint, destination.Region, strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretAccessKey)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &uploader{
+		destination: destination,
+		volumes:     volumes,
+		client:      client,
+		status: exportv1.VirtualMachineExportDestinationStatus{
+			Phase: exportv1.UploadPending,
+		},
+	}
+	for _, vi := range volumes {
+		key, format := u.volumeObject(vi)
+		u.status.Volumes = append(u.status.Volumes, exportv1.VirtualMachineExportVolumeUploadStatus{
+			Name:   volumeName(vi),
+			Key:    key,
+			Format: format,
+		})
+	}
+	return u, nil
+}
+
+func volumeName(vi export.VolumeInfo) string {
+	if vi.Name != "" {
+		return vi.Name
+	}
+	return filepath.Base(vi.Path)
+}
+
+func (u *uploader) key(name string) string {
+	return strings.TrimPrefix(path.Join(u.destination.KeyPrefix, name), "/")
+}
+
+// volumeObject returns the key and format of the uploaded volume, volumes
+// without a disk image are uploaded as archive
+func (u *uploader) volumeObject(vi export.VolumeInfo) (string, exportv1.ExportVolumeFormat) {
+	name := volumeName(vi)
+	if vi.RawURI == "" {
+		return u.key(path.Join(name, "disk.tar.gz")), exportv1.ArchiveGz
+	}
+	switch format := exportv1.ExportVolumeFormat(u.destination.Format); format {
+	case exportv1.KubeVirtRaw:
+		return u.key(path.Join(name, "disk.img")), format
+	case exportv1.KubeVirtZstd:
+		return u.key(path.Join(name, "disk.img.zst")), format
+	case exportv1.KubeVirtQcow2:
+		return u.key(path.Join(name, "disk.qcow2")), format
+	default:
+		return u.key(path.Join(name, "disk.img.gz")), exportv1.KubeVirtGz
+	}
+}
+
+// Status returns a copy of the current status
+func (u *uploader) Status() *exportv1.VirtualMachineExportDestinationStatus {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.status.DeepCopy()
+}
+
+func (u *uploader) updateStatus(update func(status *exportv1.VirtualMachineExportDestinationStatus)) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	update(&u.status)
+}
+
+func (u *uploader) Run(ctx context.Context) {
+	u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+		status.Phase = exportv1.UploadInProgress
+	})
+	for i := range u.volumes {
+		if err := u.uploadVolume(ctx, i); err != nil {
+			log.Log.Reason(err).Errorf("error uploading volume %s", volumeName(u.volumes[i]))
+			u.fail(fmt.Sprintf("failed to upload volume %s: %v", volumeName(u.volumes[i]), err))
+			return
+		}
+	}
+	if err := u.uploadManifest(ctx); err != nil {
+		log.Log.Reason(err).Error("error uploading VM manifest")
+		u.fail(fmt.Sprintf("failed to upload VM manifest: %v", err))
+		return
+	}
+	u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+		status.Phase = exportv1.UploadSucceeded
+	})
+	log.Log.Infof("Uploaded export to bucket %s", u.destination.Bucket)
+}
+
+func (u *uploader) fail(message string) {
+	u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+		status.Phase = exportv1.UploadFailed
+		status.Message = message
+	})
+}
+
+func (u *uploader) uploadVolume(ctx context.Context, index int) error {
+	vi := u.volumes[index]
+	volumeStatus := u.Status().Volumes[index]
+
+	source, size, err := openVolumeSource(vi, volumeStatus.Format)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	read := &countingReader{reader: source, onRead: func(n int64) {
+		if size <= 0 {
+			return
+		}
+		u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+			status.Volumes[index].Progress = formatProgress(n, size)
+		})
+	}}
+	body := compress(read, volumeStatus.Format)
+	defer body.Close()
+	// the size of a compressed object is only known once it is uploaded
+	objectSize := size
+	if isCompressed(volumeStatus.Format) {
+		objectSize = 0
+	}
+
+	hash := sha256.New()
+	uploaded := &countingReader{reader: io.TeeReader(body, hash), onRead: func(n int64) {
+		u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+			status.Volumes[index].UploadedBytes = n
+		})
+	}}
+	log.Log.Infof("Uploading volume %s to %s", volumeStatus.Name, volumeStatus.Key)
+	if err := u.client.Upload(ctx, u.destination.Bucket, volumeStatus.Key, uploaded, objectSize); err != nil {
+		return err
+	}
+
+	u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+		status.Volumes[index].Progress = formatProgress(1, 1)
+		status.Volumes[index].Checksum = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	})
+	return nil
+}
+
+// openVolumeSource returns the uncompressed content of the volume and its size,
+// the size of archives is not known in advance and is returned as 0
+func openVolumeSource(vi export.VolumeInfo, format exportv1.ExportVolumeFormat) (io.ReadCloser, int64, error) {
+	if format == exportv1.ArchiveGz {
+		if hasPermissions := checkDirectoryPermissions(vi.Path); !hasPermissions {
+			return nil, 0, fmt.Errorf("unable to read the contents of %s", vi.Path)
+		}
+		tarReader, err := newTarReader(vi.Path)
+		return tarReader, 0, err
+	}
+
+	p := vi.Path
+	if fi, err := os.Stat(p); err != nil {
+		return nil, 0, err
+	} else if fi.IsDir() {
+		p = path.Join(p, "disk.img")
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Stat does not return the size of block devices
+	size, err := f.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	if format != exportv1.KubeVirtQcow2 {
+		return f, size, nil
+	}
+
+	img, err := newQcow2ImageFromFile(f, size)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.NewSectionReader(img, 0, img.Size()), f}, img.Size(), nil
+}
+
+func isCompressed(format exportv1.ExportVolumeFormat) bool {
+	return format == exportv1.KubeVirtGz || format == exportv1.ArchiveGz || format == exportv1.KubeVirtZstd
+}
+
+// compress compresses the reader in the given format. Unlike pipeToGzip, errors
+// reading the source are passed on, so a failed read does not upload a truncated object.
+func compress(reader io.Reader, format exportv1.ExportVolumeFormat) io.ReadCloser {
+	var newWriter func(io.Writer) (io.WriteCloser, error)
+	switch format {
+	case exportv1.KubeVirtGz, exportv1.ArchiveGz:
+		newWriter = func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		}
+	case exportv1.KubeVirtZstd:
+		newWriter = func(w io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(w)
+		}
+	default:
+		return io.NopCloser(reader)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		zw, err := newWriter(pw)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err = io.Copy(zw, reader); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(zw.Close())
+	}()
+	return pr
+}
+
+func formatProgress(n, size int64) string {
+	return fmt.Sprintf("%.2f%%", float64(n)*100/float64(size))
+}
+
+// countingReader calls onRead with the total number of bytes read after every read
+type countingReader struct {
+	reader io.Reader
+	total  int64
+	onRead func(int64)
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.total += int64(n)
+		r.onRead(r.total)
+	}
+	return n, err
+}
+
+// uploadManifest uploads the VM and its DataVolumes, if the export has a VM
+func (u *uploader) uploadManifest(ctx context.Context) error {
+	expandedVm := getExpandedVM()
+	if expandedVm == nil {
+		return nil
+	}
+	expandedVm.TypeMeta = metav1.TypeMeta{
+		Kind:       virtv1.VirtualMachineGroupVersionKind.Kind,
+		APIVersion: virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+	}
+	resources := []runtime.Object{expandedVm}
+	datavolumes, err := getDataVolumes(expandedVm)
+	if err != nil {
+		return err
+	}
+	for _, dv := range datavolumes {
+		dv.TypeMeta = metav1.TypeMeta{
+			Kind:       "DataVolume",
+			APIVersion: "cdi.kubevirt.io/v1beta1",
+		}
+		resources = append(resources, dv)
+	}
+	data, err := resourceToBytesYaml(resources)
+	if err != nil {
+		return err
+	}
+
+	key := u.key(destinationManifestName)
+	if err := u.client.PutObject(ctx, u.destination.Bucket, key, data); err != nil {
+		return err
+	}
+	u.updateStatus(func(status *exportv1.VirtualMachineExportDestinationStatus) {
+		status.ManifestKey = key
+	})
+	return nil
+}
+
+func uploadStatusHandler(u *uploader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := json.Marshal(u.Status())
+		if err != nil {
+			log.Log.Reason(err).Error("error marshalling upload status")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			log.Log.Reason(err).Error("error writing upload status")
+		}
+	})
+}
@@ -0,0 +1,262 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/s3/fake"
+)
+
+const (
+	testAccessKeyID     = "minio"
+	testSecretAccessKey = "minio123"
+	testBucket          = "exports"
+)
+
+var _ = Describe("uploader", func() {
+	var (
+		server          *fake.Server
+		credentialsPath string
+		volumeDir       string
+		raw             []byte
+
+		orgGetExpandedVM  = getExpandedVM
+		orgGetDataVolumes = getDataVolumes
+	)
+
+	BeforeEach(func() {
+		server = fake.NewServer("", testAccessKeyID, testSecretAccessKey, testBucket)
+		DeferCleanup(server.Close)
+
+		credentialsPath = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(credentialsPath, export.DestinationAccessKeyIDKey), []byte(testAccessKeyID), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(credentialsPath, export.DestinationSecretAccessKeyKey), []byte(testSecretAccessKey+"\n"), 0600)).To(Succeed())
+
+		volumeDir = GinkgoT().TempDir()
+		raw = (&sparseImage{size: 3 * qcow2ClusterSize, dataAt: []int64{0, 2 * qcow2ClusterSize}}).bytes()
+		Expect(os.WriteFile(filepath.Join(volumeDir, "disk.img"), raw, 0644)).To(Succeed())
+
+		getExpandedVM = func() *virtv1.VirtualMachine {
+			return nil
+		}
+		DeferCleanup(func() {
+			getExpandedVM = orgGetExpandedVM
+			getDataVolumes = orgGetDataVolumes
+		})
+	})
+
+	newTestUploader := func(format exportv1.ExportVolumeFormat, volumes ...export.VolumeInfo) *uploader {
+		destination := &export.Destination{
+			Endpoint:  server.URL,
+			Bucket:    testBucket,
+			KeyPrefix: "backups/export",
+			Format:    string(format),
+		}
+		u, err := newUploader(destination, volumes, credentialsPath)
+		Expect(err).ToNot(HaveOccurred())
+		return u
+	}
+
+	expectUploaded := func(status exportv1.VirtualMachineExportVolumeUploadStatus) []byte {
+		data := server.Object(testBucket, status.Key)
+		Expect(data).ToNot(BeNil())
+		checksum := sha256.Sum256(data)
+		Expect(status.Checksum).To(Equal("sha256:" + hex.EncodeToString(checksum[:])))
+		Expect(status.UploadedBytes).To(BeEquivalentTo(len(data)))
+		Expect(status.Progress).To(Equal("100.00%"))
+		return data
+	}
+
+	DescribeTable("should upload a disk image", func(format exportv1.ExportVolumeFormat, expectedKey string, decode func([]byte) []byte) {
+		u := newTestUploader(format, export.VolumeInfo{Name: "volume1", Path: volumeDir, RawURI: "/volumes/volume1/disk.img"})
+		Expect(u.Status().Phase).To(Equal(exportv1.UploadPending))
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadSucceeded), status.Message)
+		Expect(status.ManifestKey).To(BeEmpty())
+		Expect(status.Volumes).To(HaveLen(1))
+		Expect(status.Volumes[0].Name).To(Equal("volume1"))
+		Expect(status.Volumes[0].Key).To(Equal(expectedKey))
+		Expect(status.Volumes[0].Format).To(Equal(format))
+		Expect(decode(expectUploaded(status.Volumes[0]))).To(Equal(raw))
+		Expect(server.Keys(testBucket)).To(ConsistOf(expectedKey))
+	},
+		Entry("as raw", exportv1.KubeVirtRaw, "backups/export/volume1/disk.img", func(data []byte) []byte {
+			return data
+		}),
+		Entry("as gzip", exportv1.KubeVirtGz, "backups/export/volume1/disk.img.gz", func(data []byte) []byte {
+			r, err := gzip.NewReader(bytes.NewReader(data))
+			Expect(err).ToNot(HaveOccurred())
+			out, err := io.ReadAll(r)
+			Expect(err).ToNot(HaveOccurred())
+			return out
+		}),
+		Entry("as zstd", exportv1.KubeVirtZstd, "backups/export/volume1/disk.img.zst", func(data []byte) []byte {
+			r, err := zstd.NewReader(bytes.NewReader(data))
+			Expect(err).ToNot(HaveOccurred())
+			defer r.Close()
+			out, err := io.ReadAll(r)
+			Expect(err).ToNot(HaveOccurred())
+			return out
+		}),
+	)
+
+	It("should upload a qcow2 image", func() {
+		u := newTestUploader(exportv1.KubeVirtQcow2, export.VolumeInfo{Name: "volume1", Path: filepath.Join(volumeDir, "disk.img"), RawURI: "/volumes/volume1/disk.img"})
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadSucceeded), status.Message)
+		Expect(status.Volumes[0].Key).To(Equal("backups/export/volume1/disk.qcow2"))
+		expectQcow2Content(expectUploaded(status.Volumes[0]), &sparseImage{size: 3 * qcow2ClusterSize, dataAt: []int64{0, 2 * qcow2ClusterSize}})
+	})
+
+	It("should upload volumes without a disk image as archive", func() {
+		Expect(os.WriteFile(filepath.Join(volumeDir, "file.txt"), []byte("hello"), 0644)).To(Succeed())
+		u := newTestUploader(exportv1.KubeVirtRaw, export.VolumeInfo{Path: volumeDir, ArchiveURI: "/volumes/volume1/disk.tar.gz"})
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadSucceeded), status.Message)
+		Expect(status.Volumes[0].Name).To(Equal(filepath.Base(volumeDir)))
+		Expect(status.Volumes[0].Format).To(Equal(exportv1.ArchiveGz))
+		gz, err := gzip.NewReader(bytes.NewReader(expectUploaded(status.Volumes[0])))
+		Expect(err).ToNot(HaveOccurred())
+		var names []string
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			names = append(names, hdr.Name)
+		}
+		Expect(names).To(ContainElements("./disk.img", "./file.txt"))
+	})
+
+	It("should upload the VM manifest", func() {
+		getExpandedVM = func() *virtv1.VirtualMachine {
+			return &virtv1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "test-vm"}}
+		}
+		getDataVolumes = func(vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
+			return []*cdiv1.DataVolume{{ObjectMeta: metav1.ObjectMeta{Name: "volume1"}}}, nil
+		}
+		u := newTestUploader(exportv1.KubeVirtGz, export.VolumeInfo{Name: "volume1", Path: volumeDir, RawURI: "/volumes/volume1/disk.img"})
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadSucceeded), status.Message)
+		Expect(status.ManifestKey).To(Equal("backups/export/virtualmachine.yaml"))
+		manifest := string(server.Object(testBucket, status.ManifestKey))
+		Expect(manifest).To(ContainSubstring("kind: VirtualMachine\n"))
+		Expect(manifest).To(ContainSubstring("name: test-vm"))
+		Expect(manifest).To(ContainSubstring("kind: DataVolume\n"))
+	})
+
+	It("should fail if the upload fails", func() {
+		server.FailPartsAfter(0)
+		u := newTestUploader(exportv1.KubeVirtRaw, export.VolumeInfo{Name: "volume1", Path: volumeDir, RawURI: "/volumes/volume1/disk.img"})
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadFailed))
+		Expect(status.Message).To(ContainSubstring("failed to upload volume volume1"))
+		Expect(status.Volumes[0].Checksum).To(BeEmpty())
+		Expect(server.PendingUploads()).To(BeZero())
+	})
+
+	It("should fail if the volume cannot be read", func() {
+		u := newTestUploader(exportv1.KubeVirtGz, export.VolumeInfo{Name: "volume1", Path: filepath.Join(volumeDir, "missing"), RawURI: "/volumes/volume1/disk.img"})
+		u.Run(context.Background())
+
+		status := u.Status()
+		Expect(status.Phase).To(Equal(exportv1.UploadFailed))
+		Expect(server.Keys(testBucket)).To(BeEmpty())
+	})
+
+	It("should fail to create without credentials", func() {
+		_, err := newUploader(&export.Destination{Endpoint: server.URL, Bucket: testBucket}, nil, filepath.Join(credentialsPath, "missing"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should serve the status at the internal upload status path", func() {
+		token := "foo"
+		es := newTestServer(token)
+		es.DestinationCredentialsPath = credentialsPath
+		es.Paths = &export.ServerPaths{
+			Volumes:     []export.VolumeInfo{{Name: "volume1", Path: volumeDir, RawURI: "/volumes/volume1/disk.img"}},
+			Destination: &export.Destination{Endpoint: server.URL, Bucket: testBucket, StatusToken: "bar"},
+		}
+		es.initHandler()
+		Expect(es.uploader).ToNot(BeNil())
+		es.uploader.Run(context.Background())
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		req, err := http.NewRequest(http.MethodGet, httpServer.URL+"/internal"+export.UploadStatusPath, nil)
+		Expect(err).ToNot(HaveOccurred())
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err = http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		req.Header.Set("x-kubevirt-export-token", "bar")
+		res, err = http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		status := &exportv1.VirtualMachineExportDestinationStatus{}
+		Expect(json.NewDecoder(res.Body).Decode(status)).To(Succeed())
+		Expect(status.Phase).To(Equal(exportv1.UploadSucceeded))
+		Expect(status.Volumes).To(HaveLen(1))
+		Expect(status.Volumes[0].Key).To(Equal("volume1/disk.img.gz"))
+	})
+})
@@ -24,11 +24,13 @@ import (
 	"fmt"
 
 	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -41,6 +43,22 @@ const (
 	failed      = "Memory dump failed"
 )
 
+// IsSupportedFormat returns whether the memory dump can be written in the given format,
+// an empty format stands for the default elf format
+func IsSupportedFormat(format v1.MemoryDumpFormat) bool {
+	switch format {
+	case "", v1.MemoryDumpFormatELF, v1.MemoryDumpFormatKdumpZlib, v1.MemoryDumpFormatKdumpLzo, v1.MemoryDumpFormatKdumpSnappy:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExportName returns the name of the VirtualMachineExport exposing the memory dump pvc of the vm
+func ExportName(vmName, claimName string) string {
+	return fmt.Sprintf("memorydump-%s-%s", vmName, claimName)
+}
+
 func HasCompleted(vm *v1.VirtualMachine) bool {
 	return vm.Status.MemoryDumpRequest != nil && vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpAssociating && vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpInProgress
 }
@@ -72,10 +90,15 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
 			return nil
 		}
+		// The export of a previous memory dump keeps the pvc in use,
+		// remove it before dumping again to the same pvc
+		if err := deleteMemoryDumpExport(client, vm); err != nil {
+			return err
+		}
 		// When in state associating we want to add the memory dump pvc
 		// as a volume in the vm and in the vmi to trigger the mount
 		// to virt launcher and the memory dump
-		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest.ClaimName)
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest)
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
 			return nil
 		}
@@ -90,6 +113,11 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		// Check if the memory dump is in the vmi list of volumes,
 		// if it still there remove it to make it unmount from virt launcher
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; !exists {
+			// The memory dump completes once the pvc is unmounted, the export is
+			// only created then so that an export deleted later is not recreated
+			if vm.Status.MemoryDumpRequest.Phase == v1.MemoryDumpUnmounting && isUnmounted(vmi, vm.Status.MemoryDumpRequest.ClaimName) {
+				return createMemoryDumpExport(client, vm)
+			}
 			return nil
 		}
 
@@ -98,6 +126,9 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 			return err
 		}
 	case v1.MemoryDumpDissociating:
+		if err := deleteMemoryDumpExport(client, vm); err != nil {
+			return err
+		}
 		// Check if the memory dump is in the vmi list of volumes,
 		// if it still there remove it to make it unmount from virt launcher
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
@@ -136,6 +167,9 @@ func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 		for _, volume := range vm.Spec.Template.Spec.Volumes {
 			if vm.Status.MemoryDumpRequest.ClaimName == volume.Name {
 				updatedMemoryDumpReq.Phase = v1.MemoryDumpInProgress
+				// The export of the previous memory dump was removed
+				// before the volume was added
+				updatedMemoryDumpReq.ExportName = nil
 				break
 			}
 		}
@@ -153,6 +187,7 @@ func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 						updatedMemoryDumpReq.Phase = v1.MemoryDumpUnmounting
 						updatedMemoryDumpReq.EndTimestamp = volumeStatus.MemoryDumpVolume.EndTimestamp
 						updatedMemoryDumpReq.FileName = &volumeStatus.MemoryDumpVolume.TargetFileName
+						updatedMemoryDumpReq.Checksum = volumeStatus.MemoryDumpVolume.Checksum
 					} else if volumeStatus.Phase == v1.MemoryDumpVolumeFailed {
 						updatedMemoryDumpReq.Phase = v1.MemoryDumpFailed
 						updatedMemoryDumpReq.Message = volumeStatus.Message
@@ -164,16 +199,14 @@ func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 	case v1.MemoryDumpUnmounting:
 		// Update memory dump as completed once the memory dump has been
 		// unmounted - not a part of the vmi volume status
-		if vmi != nil {
-			for _, volumeStatus := range vmi.Status.VolumeStatus {
-				// If we found the claim name in the vmi volume status
-				// then the pvc is still mounted
-				if volumeStatus.Name == vm.Status.MemoryDumpRequest.ClaimName {
-					return
-				}
-			}
+		if !isUnmounted(vmi, vm.Status.MemoryDumpRequest.ClaimName) {
+			return
 		}
 		updatedMemoryDumpReq.Phase = v1.MemoryDumpCompleted
+		if updatedMemoryDumpReq.Export {
+			exportName := ExportName(vm.Name, vm.Status.MemoryDumpRequest.ClaimName)
+			updatedMemoryDumpReq.ExportName = &exportName
+		}
 	case v1.MemoryDumpDissociating:
 		// Make sure the memory dump is not in the vmi list of volumes
 		if vmi != nil {
@@ -214,7 +247,7 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 
 	vmiCopy := vmi.DeepCopy()
 	if addVolume {
-		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request.ClaimName)
+		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request)
 	} else {
 		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
 	}
@@ -235,9 +268,14 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 	return err
 }
 
-func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
-	for _, volume := range vmiSpec.Volumes {
-		if volume.Name == claimName {
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
+	for i, volume := range vmiSpec.Volumes {
+		if volume.Name == request.ClaimName {
+			// The volume is kept in the vm spec between dumps,
+			// make sure it reflects the format of the last request
+			if volume.MemoryDump != nil {
+				vmiSpec.Volumes[i].MemoryDump.Format = request.Format
+			}
 			return vmiSpec
 		}
 	}
@@ -245,14 +283,15 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 	memoryDumpVol := &v1.MemoryDumpVolumeSource{
 		PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
 			PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
-				ClaimName: claimName,
+				ClaimName: request.ClaimName,
 			},
 			Hotpluggable: true,
 		},
+		Format: request.Format,
 	}
 
 	newVolume := v1.Volume{
-		Name: claimName,
+		Name: request.ClaimName,
 	}
 	newVolume.VolumeSource.MemoryDump = memoryDumpVol
 
@@ -310,3 +349,61 @@ func patchMemoryDumpPVCAnnotation(client kubecli.KubevirtClient, vm *v1.VirtualM
 
 	return nil
 }
+
+// isUnmounted returns true once the memory dump pvc is not a part of the vmi volume status
+func isUnmounted(vmi *v1.VirtualMachineInstance, claimName string) bool {
+	if vmi == nil {
+		return true
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == claimName {
+			return false
+		}
+	}
+	return true
+}
+
+func createMemoryDumpExport(client kubecli.KubevirtClient, vm *v1.VirtualMachine) error {
+	request := vm.Status.MemoryDumpRequest
+	if !request.Export {
+		return nil
+	}
+
+	exportName := ExportName(vm.Name, request.ClaimName)
+	vmExport := &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exportName,
+			Namespace: vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: exportv1.VirtualMachineExportSpec{
+			Source: k8score.TypedLocalObjectReference{
+				APIGroup: &k8score.SchemeGroupVersion.Group,
+				Kind:     "PersistentVolumeClaim",
+				Name:     request.ClaimName,
+			},
+		},
+	}
+	_, err := client.VirtualMachineExport(vm.Namespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		log.Log.Object(vm).Errorf("failed to create memory dump export %s/%s, error: %s", vm.Namespace, exportName, err)
+		return err
+	}
+	return nil
+}
+
+func deleteMemoryDumpExport(client kubecli.KubevirtClient, vm *v1.VirtualMachine) error {
+	request := vm.Status.MemoryDumpRequest
+	if request.ExportName == nil {
+		return nil
+	}
+
+	err := client.VirtualMachineExport(vm.Namespace).Delete(context.Background(), *request.ExportName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Log.Object(vm).Errorf("failed to delete memory dump export %s/%s, error: %s", vm.Namespace, *request.ExportName, err)
+		return err
+	}
+	return nil
+}
@@ -26,11 +26,13 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/kubevirt/fake"
@@ -43,6 +45,7 @@ const (
 	testPVCName    = "testPVC"
 	targetFileName = "memory.dump"
 	vmName         = "testVM"
+	testChecksum   = "sha256:8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4"
 )
 
 var now = metav1.Now()
@@ -64,6 +67,9 @@ var _ = Describe("MemoryDump", func() {
 		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
 			virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault),
 		).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(metav1.NamespaceDefault).Return(
+			virtFakeClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault),
+		).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
@@ -112,6 +118,27 @@ var _ = Describe("MemoryDump", func() {
 			Expect(vm.Status.MemoryDumpRequest).To(Equal(updatedMemoryDump))
 		})
 
+		It("should record the memory dump checksum when the memory dump completed", func() {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpInProgress)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:  testPVCName,
+					Phase: v1.MemoryDumpVolumeCompleted,
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+						EndTimestamp:   pointer.P(now),
+						ClaimName:      testPVCName,
+						TargetFileName: targetFileName,
+						Checksum:       testChecksum,
+					},
+				},
+			}
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpUnmounting))
+			Expect(vm.Status.MemoryDumpRequest.Checksum).To(Equal(testChecksum))
+		})
+
 		It("should update status to failed when memory dump failed", func() {
 			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpInProgress)
 			vmi.Status.VolumeStatus = []v1.VolumeStatus{
@@ -155,6 +182,26 @@ var _ = Describe("MemoryDump", func() {
 			Expect(vm.Status.MemoryDumpRequest).To(Equal(updatedMemoryDump))
 		})
 
+		It("should set the export name once memory dump completed when export requested", func() {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpUnmounting)
+			vm.Status.MemoryDumpRequest.Export = true
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpCompleted))
+			Expect(vm.Status.MemoryDumpRequest.ExportName).To(HaveValue(Equal(ExportName(vmName, testPVCName))))
+		})
+
+		It("should clear the export name of the previous memory dump once the memory dump in vm volumes", func() {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+			vm.Status.MemoryDumpRequest.ExportName = pointer.P(ExportName(vmName, testPVCName))
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpInProgress))
+			Expect(vm.Status.MemoryDumpRequest.ExportName).To(BeNil())
+		})
+
 		It("should dissociate memory dump request when status is Dissociating and not in vm volumes", func() {
 			// No need to add vmi - can do this action even if vm not running
 			vm, _ := createVirtualMachineWithMemoryDump(v1.MemoryDumpDissociating)
@@ -203,6 +250,114 @@ var _ = Describe("MemoryDump", func() {
 		Entry("when phase is Unmounting", v1.MemoryDumpUnmounting, targetFileName),
 		Entry("when phase is Failed", v1.MemoryDumpFailed, "Memory dump failed"),
 	)
+
+	It("should add memory dump volume with the requested format to the vm and vmi", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Spec.Template.Spec.Volumes = nil
+		vm.Status.MemoryDumpRequest.Format = v1.MemoryDumpFormatKdumpZlib
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(HaveLen(1))
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+	})
+
+	addMemoryDumpPVC := func(namespace string) {
+		pvc := &k8score.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPVCName,
+				Namespace: namespace,
+			},
+		}
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pvcStore.Add(pvc)).To(Succeed())
+	}
+
+	It("should export the memory dump pvc once the memory dump pvc is unmounted", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpUnmounting)
+		addMemoryDumpPVC(vm.Namespace)
+		vm.Status.MemoryDumpRequest.Export = true
+		vmi.Spec.Volumes = nil
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+		// Handling the request again should tolerate the existing export
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		vmExport, err := virtFakeClient.ExportV1beta1().VirtualMachineExports(vm.Namespace).Get(context.Background(), ExportName(vmName, testPVCName), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmExport.Spec.Source.Kind).To(Equal("PersistentVolumeClaim"))
+		Expect(vmExport.Spec.Source.Name).To(Equal(testPVCName))
+		Expect(vmExport.OwnerReferences).To(HaveLen(1))
+		Expect(vmExport.OwnerReferences[0].UID).To(Equal(vm.UID))
+	})
+
+	It("should not export the memory dump pvc while it is mounted", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpUnmounting)
+		addMemoryDumpPVC(vm.Namespace)
+		vm.Status.MemoryDumpRequest.Export = true
+		vmi.Spec.Volumes = nil
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: testPVCName}}
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		_, err := virtFakeClient.ExportV1beta1().VirtualMachineExports(vm.Namespace).Get(context.Background(), ExportName(vmName, testPVCName), metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not recreate a deleted export of a completed memory dump", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+		vm.Status.MemoryDumpRequest.Export = true
+		vm.Status.MemoryDumpRequest.ExportName = pointer.P(ExportName(vmName, testPVCName))
+		vmi.Spec.Volumes = nil
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		_, err := virtFakeClient.ExportV1beta1().VirtualMachineExports(vm.Namespace).Get(context.Background(), ExportName(vmName, testPVCName), metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	DescribeTable("should remove the memory dump export", func(phase v1.MemoryDumpPhase) {
+		vm, vmi := createVirtualMachineWithMemoryDump(phase)
+		vm.Status.MemoryDumpRequest.ExportName = pointer.P(ExportName(vmName, testPVCName))
+
+		vmExport := &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ExportName(vmName, testPVCName),
+				Namespace: vm.Namespace,
+			},
+		}
+		_, err := virtFakeClient.ExportV1beta1().VirtualMachineExports(vm.Namespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		_, err = virtFakeClient.ExportV1beta1().VirtualMachineExports(vm.Namespace).Get(context.Background(), ExportName(vmName, testPVCName), metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	},
+		Entry("when dumping again to the same pvc", v1.MemoryDumpAssociating),
+		Entry("when dissociating the memory dump pvc", v1.MemoryDumpDissociating),
+	)
+
+	DescribeTable("should validate the memory dump format", func(format v1.MemoryDumpFormat, supported bool) {
+		Expect(IsSupportedFormat(format)).To(Equal(supported))
+	},
+		Entry("default format", v1.MemoryDumpFormat(""), true),
+		Entry("elf format", v1.MemoryDumpFormatELF, true),
+		Entry("kdump zlib format", v1.MemoryDumpFormatKdumpZlib, true),
+		Entry("kdump lzo format", v1.MemoryDumpFormatKdumpLzo, true),
+		Entry("kdump snappy format", v1.MemoryDumpFormatKdumpSnappy, true),
+		Entry("unknown format", v1.MemoryDumpFormat("kdump-lz4"), false),
+	)
 })
 
 func ApplyVMIMemoryDumpVol(spec *v1.VirtualMachineInstanceSpec) {
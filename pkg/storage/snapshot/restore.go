@@ -56,6 +56,9 @@ import (
 const (
 	RestoreNameAnnotation = "restore.kubevirt.io/name"
 
+	// RestoreNamespaceAnnotation is set on objects restored to a namespace other than the one of their restore
+	RestoreNamespaceAnnotation = "restore.kubevirt.io/namespace"
+
 	vmRestoreFinalizer = "snapshot.kubevirt.io/vmrestore-protection"
 
 	populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
@@ -82,6 +85,7 @@ var (
 	restoreTargetNotReady           = "Restore target not ready"
 	restoredFailed                  = "Operation failed"
 	errorRestoreToExistingTarget    = "restore source and restore target are different but restore target already exists"
+	errorPartialRestoreNoTarget     = "restoring a subset of the volumes requires an existing restore target"
 )
 
 type restoreTarget interface {
@@ -114,6 +118,11 @@ var restoreAnnotationsToDelete = []string{
 }
 
 func restorePVCName(vmRestore *snapshotv1.VirtualMachineRestore, name string) string {
+	for _, override := range vmRestore.Spec.VolumeRestoreOverrides {
+		if override.VolumeName == name && override.RestoreName != "" {
+			return override.RestoreName
+		}
+	}
 	return fmt.Sprintf("restore-%s-%s", vmRestore.UID, name)
 }
 
@@ -121,6 +130,29 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+func restoreTargetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace != nil && *vmRestore.Spec.TargetNamespace != "" {
+		return *vmRestore.Spec.TargetNamespace
+	}
+	return vmRestore.Namespace
+}
+
+func isCrossNamespaceRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return restoreTargetNamespace(vmRestore) != vmRestore.Namespace
+}
+
+func isVolumeRestored(vmRestore *snapshotv1.VirtualMachineRestore, name string) bool {
+	if len(vmRestore.Spec.Volumes) == 0 {
+		return true
+	}
+	for _, v := range vmRestore.Spec.Volumes {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
 func vmRestoreFailed(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status != nil &&
 		hasConditionType(vmRestore.Status.Conditions, snapshotv1.ConditionFailure)
@@ -171,7 +203,8 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, nil
 	}
 
-	if len(vmRestoreOut.OwnerReferences) == 0 {
+	// Owner references can't cross namespaces
+	if len(vmRestoreOut.OwnerReferences) == 0 && !isCrossNamespaceRestore(vmRestoreOut) {
 		target.Own(vmRestoreOut)
 	}
 	controller.AddFinalizer(vmRestoreOut, vmRestoreFinalizer)
@@ -207,6 +240,11 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorRestoreToExistingTarget))
 	}
 
+	if len(vmRestoreOut.Spec.Volumes) > 0 && !target.Exists() {
+		logger.Error(errorPartialRestoreNoTarget)
+		return 0, ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorPartialRestoreNoTarget))
+	}
+
 	err = target.UpdateRestoreInProgress()
 	if err != nil {
 		return 0, err
@@ -372,7 +410,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
-	noRestore, err := ctrl.volumesNotForRestore(content)
+	noRestore, err := ctrl.volumesNotForRestore(vmRestore, content)
 	if err != nil {
 		return false, err
 	}
@@ -418,7 +456,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 	createdPVC := false
 	waitingPVC := false
 	for _, restore := range restores {
-		pvc, err := ctrl.getPVC(vmRestore.Namespace, restore.PersistentVolumeClaimName)
+		pvc, err := ctrl.getPVC(restoreTargetNamespace(vmRestore), restore.PersistentVolumeClaimName)
 		if err != nil {
 			return false, err
 		}
@@ -568,7 +606,8 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 }
 
 func (t *vmRestoreTarget) reconcileBackendVolume(snapshotVM *snapshotv1.VirtualMachine) (bool, error) {
-	if !backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
+	if !backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) ||
+		!isVolumeRestored(t.vmRestore, storageutils.BackendPVCVolumeName(snapshotVM.Name)) {
 		return true, nil
 	}
 
@@ -643,7 +682,7 @@ func (t *vmRestoreTarget) removeBackendLabelFromPVC(pvc *corev1.PersistentVolume
 func (t *vmRestoreTarget) updateRestorePVCWithBackendLabel(originalPVC *corev1.PersistentVolumeClaim) (bool, error) {
 	for _, vr := range t.vmRestore.Status.Restores {
 		if vr.VolumeName == storageutils.BackendPVCVolumeName(t.vmRestore.Spec.Target.Name) {
-			restorePVC, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+			restorePVC, err := t.controller.getPVC(restoreTargetNamespace(t.vmRestore), vr.PersistentVolumeClaimName)
 			if err != nil {
 				return false, err
 			}
@@ -728,13 +767,13 @@ func (t *vmRestoreTarget) updateVMRestoreRestores(snapshotVM *snapshotv1.Virtual
 				templateIndex := findDVTemplateIndex(volume.DataVolume.Name, snapshotVM)
 				if templateIndex >= 0 {
 					dvName := restoreDVName(t.vmRestore, restore.VolumeName)
-					pvc, err := t.controller.getPVC(t.vmRestore.Namespace, restore.PersistentVolumeClaimName)
+					pvc, err := t.controller.getPVC(restoreTargetNamespace(t.vmRestore), restore.PersistentVolumeClaimName)
 					if err != nil {
 						return false, err
 					}
 
 					if pvc == nil {
-						return false, fmt.Errorf("pvc %s/%s does not exist and should", t.vmRestore.Namespace, restore.PersistentVolumeClaimName)
+						return false, fmt.Errorf("pvc %s/%s does not exist and should", restoreTargetNamespace(t.vmRestore), restore.PersistentVolumeClaimName)
 					}
 
 					if err = t.updatePVCPopulatedForAnnotation(pvc, dvName); err != nil {
@@ -761,6 +800,7 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	log.Log.Object(t.vmRestore).V(3).Info("generating restored VM spec")
 	var newTemplates = make([]kubevirtv1.DataVolumeTemplateSpec, len(snapshotVM.Spec.DataVolumeTemplates))
 	var newVolumes []kubevirtv1.Volume
+	droppedTemplates := sets.NewInt()
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
 		t.DeepCopyInto(&newTemplates[i])
@@ -776,6 +816,28 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	for _, v := range volumes {
 		nv := v.DeepCopy()
 		if nv.DataVolume != nil || nv.PersistentVolumeClaim != nil {
+			if !isVolumeRestored(t.vmRestore, nv.Name) {
+				// keep the volume of the target as it is, together with its DataVolumeTemplate
+				templateIndex := -1
+				if nv.DataVolume != nil {
+					templateIndex = findDVTemplateIndex(nv.DataVolume.Name, snapshotVM)
+				}
+				current, currentTemplate := t.currentVolume(nv.Name)
+				if current != nil {
+					nv = current
+				}
+				switch {
+				case templateIndex >= 0 && currentTemplate != nil:
+					newTemplates[templateIndex] = *currentTemplate
+				case templateIndex >= 0 && current != nil:
+					droppedTemplates.Insert(templateIndex)
+				case currentTemplate != nil:
+					newTemplates = append(newTemplates, *currentTemplate)
+				}
+				newVolumes = append(newVolumes, *nv)
+				continue
+			}
+
 			for _, vr := range t.vmRestore.Status.Restores {
 				if vr.VolumeName != nv.Name {
 					continue
@@ -818,12 +880,22 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 		newVolumes = append(newVolumes, *nv)
 	}
 
+	if droppedTemplates.Len() > 0 {
+		var templates []kubevirtv1.DataVolumeTemplateSpec
+		for i, template := range newTemplates {
+			if !droppedTemplates.Has(i) {
+				templates = append(templates, template)
+			}
+		}
+		newTemplates = templates
+	}
+
 	var newVM *kubevirtv1.VirtualMachine
 	if !t.Exists() {
 		newVM = &kubevirtv1.VirtualMachine{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        t.vmRestore.Spec.Target.Name,
-				Namespace:   t.vmRestore.Namespace,
+				Namespace:   restoreTargetNamespace(t.vmRestore),
 				Labels:      snapshotVM.Labels,
 				Annotations: snapshotVM.Annotations,
 			},
@@ -851,6 +923,30 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	return newVM, nil
 }
 
+// currentVolume returns the volume of the existing target with the given name,
+// and its DataVolumeTemplate if it has one
+func (t *vmRestoreTarget) currentVolume(name string) (*kubevirtv1.Volume, *kubevirtv1.DataVolumeTemplateSpec) {
+	if !t.Exists() || t.vm.Spec.Template == nil {
+		return nil, nil
+	}
+
+	for _, v := range t.vm.Spec.Template.Spec.Volumes {
+		if v.Name != name {
+			continue
+		}
+		if v.DataVolume != nil {
+			for _, dvt := range t.vm.Spec.DataVolumeTemplates {
+				if dvt.Name == v.DataVolume.Name {
+					return v.DeepCopy(), dvt.DeepCopy()
+				}
+			}
+		}
+		return v.DeepCopy(), nil
+	}
+
+	return nil, nil
+}
+
 func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconcile new VM spec")
 
@@ -864,7 +960,7 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
 		}
-		restoredVM, err = t.controller.Client.VirtualMachine(t.vmRestore.Namespace).Create(context.Background(), restoredVM, metav1.CreateOptions{})
+		restoredVM, err = t.controller.Client.VirtualMachine(restoredVM.Namespace).Create(context.Background(), restoredVM, metav1.CreateOptions{})
 	} else {
 		restoredVM, err = t.controller.Client.VirtualMachine(restoredVM.Namespace).Update(context.Background(), restoredVM, metav1.UpdateOptions{})
 	}
@@ -975,7 +1071,8 @@ func (t *vmRestoreTarget) getControllerRevision(namespace, name string) (*appsv1
 }
 
 func (t *vmRestoreTarget) restoreInstancetypeControllerRevision(vmSnapshotRevisionName, vmSnapshotName string, vm *kubevirtv1.VirtualMachine) (*appsv1.ControllerRevision, error) {
-	snapshotCR, err := t.getControllerRevision(vm.Namespace, vmSnapshotRevisionName)
+	// The snapshot ControllerRevision lives next to the VirtualMachineSnapshot
+	snapshotCR, err := t.getControllerRevision(t.vmRestore.Namespace, vmSnapshotRevisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1082,7 +1179,7 @@ func (t *vmRestoreTarget) createDataVolume(restoredVM *kubevirtv1.VirtualMachine
 	}
 	if pvc == nil {
 		return false, fmt.Errorf("when creating restore dv pvc %s/%s does not exist and should",
-			t.vmRestore.Namespace, dvt.Name)
+			restoredVM.Namespace, dvt.Name)
 	}
 	if pvc.Annotations[populatedForPVCAnnotation] != dvt.Name || len(pvc.OwnerReferences) > 0 {
 		return false, nil
@@ -1097,6 +1194,9 @@ func (t *vmRestoreTarget) createDataVolume(restoredVM *kubevirtv1.VirtualMachine
 		newDataVolume.Annotations = make(map[string]string)
 	}
 	newDataVolume.Annotations[RestoreNameAnnotation] = t.vmRestore.Name
+	if isCrossNamespaceRestore(t.vmRestore) {
+		newDataVolume.Annotations[RestoreNamespaceAnnotation] = t.vmRestore.Namespace
+	}
 	newDataVolume.Annotations[cdiv1.AnnPrePopulated] = "true"
 
 	if _, err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(restoredVM.Namespace).Create(context.Background(), newDataVolume, metav1.CreateOptions{}); err != nil {
@@ -1128,14 +1228,14 @@ func (t *vmRestoreTarget) Own(obj metav1.Object) {
 
 func (ctrl *VMRestoreController) deleteObsoleteVolumes(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget) error {
 	for _, dvName := range vmRestore.Status.DeletedDataVolumes {
-		objKey := cacheKeyFunc(vmRestore.Namespace, dvName)
+		objKey := cacheKeyFunc(restoreTargetNamespace(vmRestore), dvName)
 		_, exists, err := ctrl.DataVolumeInformer.GetStore().GetByKey(objKey)
 		if err != nil {
 			return err
 		}
 
 		if exists {
-			err = ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(vmRestore.Namespace).
+			err = ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(restoreTargetNamespace(vmRestore)).
 				Delete(context.Background(), dvName, metav1.DeleteOptions{})
 			if err != nil {
 				return err
@@ -1155,7 +1255,7 @@ func (ctrl *VMRestoreController) deleteObsoleteVolumes(vmRestore *snapshotv1.Vir
 func (ctrl *VMRestoreController) deleteObsoleteBackendPVC(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget) error {
 	// Target should always exist at this point, just nil check for safety.
 	if target.Exists() && backendstorage.IsBackendStorageNeededForVM(target.VirtualMachine()) {
-		pvcs, err := ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).List(context.Background(), metav1.ListOptions{
+		pvcs, err := ctrl.Client.CoreV1().PersistentVolumeClaims(restoreTargetNamespace(vmRestore)).List(context.Background(), metav1.ListOptions{
 			LabelSelector: fmt.Sprintf("%s=%s", restoreCleanupBackendPVCLabel, getCleanupLabelValue(vmRestore)),
 		})
 		if err != nil {
@@ -1311,7 +1411,7 @@ func (ctrl *VMRestoreController) getTarget(vmRestore *snapshotv1.VirtualMachineR
 	vmRestore.Spec.Target.DeepCopy()
 	switch vmRestore.Spec.Target.Kind {
 	case "VirtualMachine":
-		vm, err := ctrl.getVM(vmRestore.Namespace, vmRestore.Spec.Target.Name)
+		vm, err := ctrl.getVM(restoreTargetNamespace(vmRestore), vmRestore.Spec.Target.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -1358,7 +1458,14 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	}
 	target.Own(pvc)
 
-	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if isCrossNamespaceRestore(vmRestore) {
+		// The VolumeSnapshot is in the namespace of the restore, only the DataSourceRef can point there
+		pvc.Spec.DataSource = nil
+		pvc.Spec.DataSourceRef.Namespace = pointer.P(vmRestore.Namespace)
+		pvc.Annotations[RestoreNamespaceAnnotation] = vmRestore.Namespace
+	}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(restoreTargetNamespace(vmRestore)).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -1471,8 +1578,8 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
-func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
+// Memory dump volumes and volumes not selected by the restore should not be restored
+func (ctrl *VMRestoreController) volumesNotForRestore(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
 	volumes, err := storageutils.GetVolumes(content.Spec.Source.VirtualMachine, ctrl.Client)
@@ -1486,6 +1593,12 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 		}
 	}
 
+	for _, vb := range content.Spec.VolumeBackups {
+		if !isVolumeRestored(vmRestore, vb.VolumeName) {
+			noRestore.Insert(vb.VolumeName)
+		}
+	}
+
 	return noRestore, nil
 }
 
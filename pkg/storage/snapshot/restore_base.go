@@ -24,6 +24,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -193,13 +194,11 @@ func (ctrl *VMRestoreController) handleDataVolume(obj interface{}) {
 	}
 
 	if dv, ok := obj.(*cdiv1.DataVolume); ok {
-		restoreName, ok := dv.Annotations[RestoreNameAnnotation]
+		objName, ok := restoreKeyFromAnnotations(dv)
 		if !ok {
 			return
 		}
 
-		objName := cacheKeyFunc(dv.Namespace, restoreName)
-
 		log.Log.V(3).Infof("Handling DV %s/%s, Restore %s", dv.Namespace, dv.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
 	}
@@ -211,18 +210,31 @@ func (ctrl *VMRestoreController) handlePVC(obj interface{}) {
 	}
 
 	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
-		restoreName, ok := pvc.Annotations[RestoreNameAnnotation]
+		objName, ok := restoreKeyFromAnnotations(pvc)
 		if !ok {
 			return
 		}
 
-		objName := cacheKeyFunc(pvc.Namespace, restoreName)
-
 		log.Log.V(3).Infof("Handling PVC %s/%s, Restore %s", pvc.Namespace, pvc.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
 	}
 }
 
+// restoreKeyFromAnnotations returns the key of the VirtualMachineRestore an object was restored by
+func restoreKeyFromAnnotations(obj metav1.Object) (string, bool) {
+	restoreName, ok := obj.GetAnnotations()[RestoreNameAnnotation]
+	if !ok {
+		return "", false
+	}
+
+	namespace := obj.GetNamespace()
+	if restoreNamespace, ok := obj.GetAnnotations()[RestoreNamespaceAnnotation]; ok {
+		namespace = restoreNamespace
+	}
+
+	return cacheKeyFunc(namespace, restoreName), true
+}
+
 func (ctrl *VMRestoreController) handleVM(obj interface{}) {
 	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
 		obj = unknown.Obj
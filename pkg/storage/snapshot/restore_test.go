@@ -729,6 +729,146 @@ var _ = Describe("Restore controller", func() {
 				Expect(*calls).To(Equal(2))
 			})
 
+			Context("with a PVC volume next to the DataVolume", func() {
+				BeforeEach(func() {
+					vm = createModifiedVM()
+					vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, kubevirtv1.Volume{
+						Name: "disk2",
+						VolumeSource: kubevirtv1.VolumeSource{
+							PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "extra-pvc"}},
+						},
+					})
+					pvcs := append(createPVCsForVM(vm), corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: vm.Namespace,
+							Name:      "extra-pvc",
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							StorageClassName: &storageClass.Name,
+						},
+					})
+					vmSnapshotContentSource.Delete(sc)
+					sc = createVirtualMachineSnapshotContent(s, vm, pvcs)
+					sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: timeFunc(),
+						ReadyToUse:   pointer.P(true),
+					}
+					vmSnapshotContentSource.Add(sc)
+				})
+
+				It("should only add the selected volumes to VolumeRestores", func() {
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{"disk2"}
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+						Restores: []snapshotv1.VolumeRestore{
+							{
+								VolumeName:                "disk2",
+								PersistentVolumeClaimName: "restore-uid-disk2",
+								VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk2",
+							},
+						},
+					}
+					vmSource.Add(vm)
+					expectUpdateVMRestoreInProgress(vm)
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+			})
+
+			It("should name VolumeRestores after the volume restore overrides", func() {
+				r := createRestoreWithOwner()
+				r.Spec.VolumeRestoreOverrides = []snapshotv1.VolumeRestoreOverride{
+					{VolumeName: diskName, RestoreName: "restored-disk1"},
+				}
+				vm := createModifiedVM()
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					},
+					Restores: []snapshotv1.VolumeRestore{
+						{
+							VolumeName:                diskName,
+							PersistentVolumeClaimName: "restored-disk1",
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk1",
+						},
+					},
+				}
+				vmSource.Add(vm)
+				expectUpdateVMRestoreInProgress(vm)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
+			It("should error if a subset of the volumes is restored to a target that does not exist", func() {
+				r := createRestoreWithOwner()
+				r.Spec.Target.Name = newVMName
+				r.Spec.Volumes = []string{diskName}
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, errorPartialRestoreNoTarget),
+						newReadyCondition(corev1.ConditionFalse, errorPartialRestoreNoTarget),
+					},
+				}
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
+			It("should create restore PVCs in the target namespace from the VolumeSnapshots of the restore namespace", func() {
+				const targetNamespace = "target-ns"
+				r := createRestoreWithOwner()
+				r.OwnerReferences = nil
+				r.Spec.TargetNamespace = pointer.P(targetNamespace)
+				r.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+				addVolumeRestores(r)
+				pvcSize := resource.MustParse("2Gi")
+				fakeVolumeSnapshotProvider.Add(createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize))
+
+				calls := 0
+				k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					create, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+					Expect(create.GetNamespace()).To(Equal(targetNamespace))
+
+					createObj := create.GetObject().(*corev1.PersistentVolumeClaim)
+					Expect(createObj.Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+					Expect(createObj.Spec.DataSource).To(BeNil())
+					Expect(createObj.Spec.DataSourceRef).ToNot(BeNil())
+					Expect(createObj.Spec.DataSourceRef.Namespace).To(HaveValue(Equal(testNamespace)))
+					Expect(createObj.Annotations).To(HaveKeyWithValue(RestoreNamespaceAnnotation, testNamespace))
+
+					calls++
+					return true, createObj, nil
+				})
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				Expect(calls).To(Equal(1))
+			})
+
 			It("should create restore PVC with volume snapshot size if bigger then PVC size", func() {
 				r := createRestoreWithOwner()
 				vm := createModifiedVM()
@@ -1225,6 +1365,44 @@ var _ = Describe("Restore controller", func() {
 					Entry("wait for dvs when dv phase pending", true, cdiv1.Pending, true),
 					Entry("create dvs when dv doesnt exists", false, cdiv1.PhaseUnset, true),
 				)
+
+				It("should keep the volumes of the target that are not restored", func() {
+					pvcVolume := func(claimName string) kubevirtv1.Volume {
+						return kubevirtv1.Volume{
+							Name: "disk2",
+							VolumeSource: kubevirtv1.VolumeSource{
+								PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName}},
+							},
+						}
+					}
+					r.Spec.Volumes = []string{"disk2"}
+					r.Status.Restores = []snapshotv1.VolumeRestore{
+						{
+							VolumeName:                "disk2",
+							PersistentVolumeClaimName: "restore-uid-disk2",
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk2",
+						},
+					}
+					vm.Spec.DataVolumeTemplates[0].Name = "current-dv"
+					vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "current-dv"
+					vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, pvcVolume("current-pvc"))
+					targetVM.UpdateTarget(vm)
+
+					snapshotVM := createSnapshotVM()
+					snapshotVM.Spec.Template.Spec.Volumes = append(snapshotVM.Spec.Template.Spec.Volumes, pvcVolume("extra-pvc"))
+
+					restoredVM, err := targetVM.(*vmRestoreTarget).generateRestoredVMSpec(&snapshotv1.VirtualMachine{
+						ObjectMeta: snapshotVM.ObjectMeta,
+						Spec:       snapshotVM.Spec,
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(restoredVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+					Expect(restoredVM.Spec.DataVolumeTemplates[0].Name).To(Equal("current-dv"))
+					Expect(restoredVM.Spec.Template.Spec.Volumes).To(HaveLen(2))
+					Expect(restoredVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("current-dv"))
+					Expect(restoredVM.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("restore-uid-disk2"))
+				})
 			})
 
 			Context("target VM is different than source VM", func() {
@@ -0,0 +1,500 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	snapshotScheduleTimeAnnotation = "snapshot.kubevirt.io/schedule-time"
+
+	scheduledSnapshotsCreateEvent = "SuccessfulScheduledVirtualMachineSnapshotsCreate"
+
+	scheduledSnapshotCreateErrorEvent = "FailedScheduledVirtualMachineSnapshotCreate"
+
+	scheduledSnapshotDeleteEvent = "SuccessfulScheduledVirtualMachineSnapshotDelete"
+
+	scheduledSnapshotTimeFormat = "20060102-1504"
+)
+
+// VMSnapshotScheduleController periodically snapshots VMs according to VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotScheduleQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotschedule"},
+	)
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotScheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		scheduleName, ok := vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel]
+		if !ok {
+			return
+		}
+
+		k := cacheKeyFunc(vmSnapshot.Namespace, scheduleName)
+		log.Log.V(3).Infof("enqueued %q for sync", k)
+		ctrl.vmSnapshotScheduleQueue.Add(k)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	status := &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	if schedule.Status != nil {
+		status = schedule.Status.DeepCopy()
+	}
+
+	var retry time.Duration
+	syncErr := ctrl.syncSchedule(schedule, status)
+	if syncErr == nil {
+		syncErr = ctrl.pruneSnapshots(schedule)
+	}
+
+	if syncErr != nil {
+		status.Error = &snapshotv1.Error{
+			Time:    currentTime(),
+			Message: pointer.P(syncErr.Error()),
+		}
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, syncErr.Error()))
+	} else {
+		status.Error = nil
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+		if status.NextScheduleTime != nil {
+			retry = status.NextScheduleTime.Sub(currentTime().Time)
+		}
+	}
+
+	if err := ctrl.updateScheduleStatus(schedule, status); err != nil {
+		return 0, err
+	}
+
+	if syncErr != nil && !errors.Is(syncErr, errInvalidSchedule) {
+		return 0, syncErr
+	}
+
+	return retry, nil
+}
+
+var errInvalidSchedule = errors.New("invalid schedule")
+
+// syncSchedule takes the snapshots of the most recent activation which did not happen
+// yet. Activations missed while the controller was down are not caught up on.
+func (ctrl *VMSnapshotScheduleController) syncSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule, status *snapshotv1.VirtualMachineSnapshotScheduleStatus) error {
+	cronSchedule, err := cron.Parse(schedule.Spec.Schedule)
+	if err != nil {
+		status.NextScheduleTime = nil
+		return fmt.Errorf("%w: %v", errInvalidSchedule, err)
+	}
+
+	if schedule.Spec.Suspend {
+		status.NextScheduleTime = nil
+		return nil
+	}
+
+	now := currentTime().Time
+	if activation := lastActivation(cronSchedule, schedule, now); !activation.IsZero() {
+		if err := ctrl.takeSnapshots(schedule, activation); err != nil {
+			return err
+		}
+		status.LastScheduleTime = &metav1.Time{Time: activation}
+	}
+
+	status.NextScheduleTime = nil
+	if next := cronSchedule.Next(now); !next.IsZero() {
+		status.NextScheduleTime = &metav1.Time{Time: next}
+	}
+
+	return nil
+}
+
+// lastActivation returns the most recent activation since the last scheduled snapshots,
+// or the zero time if there is none.
+func lastActivation(cronSchedule *cron.Schedule, schedule *snapshotv1.VirtualMachineSnapshotSchedule, now time.Time) time.Time {
+	since := schedule.CreationTimestamp.Time
+	if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+		since = schedule.Status.LastScheduleTime.Time
+	}
+
+	var last time.Time
+	for next := cronSchedule.Next(since); !next.IsZero() && !next.After(now); next = cronSchedule.Next(next) {
+		last = next
+	}
+	return last
+}
+
+func (ctrl *VMSnapshotScheduleController) selectedVMs(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*kubevirtv1.VirtualMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, schedule.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+func scheduledSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, activation time.Time) string {
+	timestamp := activation.UTC().Format(scheduledSnapshotTimeFormat)
+	name := fmt.Sprintf("%s-%s-%s", schedule.Name, vm.Name, timestamp)
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		// the hash of the schedule name keeps the snapshots of schedules which select the same VM apart
+		scheduleHash := sha256.Sum256([]byte(schedule.Name))
+		name = fmt.Sprintf("%s-%x-%s", vm.UID, scheduleHash[:4], timestamp)
+	}
+	return name
+}
+
+func newScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, activation time.Time) *snapshotv1.VirtualMachineSnapshot {
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledSnapshotName(schedule, vm, activation),
+			Namespace: schedule.Namespace,
+			Labels:    map[string]string{},
+			Annotations: map[string]string{
+				snapshotScheduleTimeAnnotation: activation.UTC().Format(time.RFC3339),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+		},
+	}
+
+	if template := schedule.Spec.SnapshotTemplate; template != nil {
+		for k, v := range template.Labels {
+			vmSnapshot.Labels[k] = v
+		}
+		vmSnapshot.Spec.DeletionPolicy = template.DeletionPolicy
+		vmSnapshot.Spec.FailureDeadline = template.FailureDeadline
+	}
+	vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel] = schedule.Name
+
+	return vmSnapshot
+}
+
+func (ctrl *VMSnapshotScheduleController) takeSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, activation time.Time) error {
+	vms, err := ctrl.selectedVMs(schedule)
+	if err != nil {
+		return err
+	}
+
+	created := 0
+	var errs []error
+	for _, vm := range vms {
+		vmSnapshot := newScheduledSnapshot(schedule, vm, activation)
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeWarning,
+				scheduledSnapshotCreateErrorEvent,
+				"Error creating VirtualMachineSnapshot %s: %v",
+				vmSnapshot.Name,
+				err,
+			)
+			errs = append(errs, err)
+			continue
+		}
+		created++
+	}
+
+	if created > 0 {
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			scheduledSnapshotsCreateEvent,
+			"Successfully created %d VirtualMachineSnapshots",
+			created,
+		)
+	}
+
+	return errors.Join(errs...)
+}
+
+// scheduledTime returns the activation the snapshot was taken for
+func scheduledTime(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Time {
+	if t, err := time.Parse(time.RFC3339, vmSnapshot.Annotations[snapshotScheduleTimeAnnotation]); err == nil {
+		return t
+	}
+	return vmSnapshot.CreationTimestamp.Time
+}
+
+// keepsSnapshots reports whether the retention has a rule which keeps snapshots. The admitter
+// rejects retentions without one, a retention which keeps nothing is treated like no retention.
+func keepsSnapshots(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention) bool {
+	return retention != nil &&
+		(ptr.Deref(retention.KeepLast, 0) > 0 ||
+			ptr.Deref(retention.KeepDaily, 0) > 0 ||
+			ptr.Deref(retention.KeepWeekly, 0) > 0)
+}
+
+// snapshotsToPrune returns the snapshots of a single VM which are not kept by the
+// retention rules. Snapshots in progress are always kept, failed ones never.
+func snapshotsToPrune(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) []*snapshotv1.VirtualMachineSnapshot {
+	var ready, prune []*snapshotv1.VirtualMachineSnapshot
+	for _, vmSnapshot := range vmSnapshots {
+		switch {
+		case vmSnapshotFailed(vmSnapshot):
+			prune = append(prune, vmSnapshot)
+		case VmSnapshotReady(vmSnapshot):
+			ready = append(ready, vmSnapshot)
+		}
+	}
+
+	sort.SliceStable(ready, func(i, j int) bool {
+		return scheduledTime(ready[i]).After(scheduledTime(ready[j]))
+	})
+
+	keepLast := int(ptr.Deref(retention.KeepLast, 0))
+	keepDaily := int(ptr.Deref(retention.KeepDaily, 0))
+	keepWeekly := int(ptr.Deref(retention.KeepWeekly, 0))
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+
+	for i, vmSnapshot := range ready {
+		t := scheduledTime(vmSnapshot).UTC()
+		keep := i < keepLast
+
+		day := t.Format(time.DateOnly)
+		if !days[day] && len(days) < keepDaily {
+			days[day] = true
+			keep = true
+		}
+
+		year, week := t.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if !weeks[weekKey] && len(weeks) < keepWeekly {
+			weeks[weekKey] = true
+			keep = true
+		}
+
+		if !keep {
+			prune = append(prune, vmSnapshot)
+		}
+	}
+
+	return prune
+}
+
+func (ctrl *VMSnapshotScheduleController) pruneSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	// without retention rules all snapshots are kept
+	if !keepsSnapshots(schedule.Spec.Retention) {
+		return nil
+	}
+
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return err
+	}
+
+	snapshotsByVM := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, obj := range objs {
+		vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if vmSnapshot.DeletionTimestamp != nil {
+			continue
+		}
+		snapshotsByVM[vmSnapshot.Spec.Source.Name] = append(snapshotsByVM[vmSnapshot.Spec.Source.Name], vmSnapshot)
+	}
+
+	var errs []error
+	for _, vmSnapshots := range snapshotsByVM {
+		for _, vmSnapshot := range snapshotsToPrune(schedule.Spec.Retention, vmSnapshots) {
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				errs = append(errs, err)
+				continue
+			}
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				scheduledSnapshotDeleteEvent,
+				"Successfully deleted VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (ctrl *VMSnapshotScheduleController) updateScheduleStatus(schedule *snapshotv1.VirtualMachineSnapshotSchedule, status *snapshotv1.VirtualMachineSnapshotScheduleStatus) error {
+	if schedule.Status != nil && equality.Semantic.DeepEqual(schedule.Status, status) {
+		return nil
+	}
+
+	scheduleCpy := schedule.DeepCopy()
+	scheduleCpy.Status = status
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleCpy.Namespace).UpdateStatus(context.Background(), scheduleCpy, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,361 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const scheduleName = "nightly"
+
+	var (
+		controller      *VMSnapshotScheduleController
+		vmSnapshotStore cache.Store
+		vmStore         cache.Store
+		recorder        *record.FakeRecorder
+		kubevirtClient  *kubevirtfake.Clientset
+		now             time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		scheduleInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshotSchedule{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		vmSnapshotInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmSnapshotStore = vmSnapshotInformer.GetStore()
+		vmStore = vmInformer.GetStore()
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+		kubevirtClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+
+		now = time.Date(2024, time.January, 10, 2, 0, 30, 0, time.UTC)
+		currentTime = func() *metav1.Time {
+			return &metav1.Time{Time: now}
+		}
+	})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-24 * time.Hour)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				Schedule: "0 2 * * *",
+			},
+		}
+	}
+
+	newVM := func(name string, labels map[string]string) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				UID:       types.UID(name + "-uid"),
+				Labels:    labels,
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	}
+
+	newScheduledVMSnapshot := func(vmName string, scheduled time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := newScheduledSnapshot(newSchedule(), newVM(vmName, nil), scheduled)
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:      phase,
+			ReadyToUse: pointer.P(phase == snapshotv1.Succeeded),
+		}
+		return vmSnapshot
+	}
+
+	expectSnapshotCreates := func() *[]*snapshotv1.VirtualMachineSnapshot {
+		created := []*snapshotv1.VirtualMachineSnapshot{}
+		kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create := action.(testing.CreateAction)
+			created = append(created, create.GetObject().(*snapshotv1.VirtualMachineSnapshot))
+			return true, create.GetObject(), nil
+		})
+		return &created
+	}
+
+	expectSnapshotDeletes := func() *[]string {
+		deleted := []string{}
+		kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			deleted = append(deleted, action.(testing.DeleteAction).GetName())
+			return true, nil, nil
+		})
+		return &deleted
+	}
+
+	expectStatusUpdate := func() **snapshotv1.VirtualMachineSnapshotScheduleStatus {
+		var status *snapshotv1.VirtualMachineSnapshotScheduleStatus
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinesnapshotschedules", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update := action.(testing.UpdateAction)
+			Expect(update.GetSubresource()).To(Equal("status"))
+			status = update.GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule).Status
+			return true, update.GetObject(), nil
+		})
+		return &status
+	}
+
+	It("should snapshot the selected VMs at the activation", func() {
+		Expect(vmStore.Add(newVM("selected", map[string]string{"backup": "true"}))).To(Succeed())
+		Expect(vmStore.Add(newVM("other", nil))).To(Succeed())
+		schedule := newSchedule()
+		schedule.Spec.SnapshotTemplate = &snapshotv1.VirtualMachineSnapshotScheduleTemplate{
+			Labels:          map[string]string{"team": "a"},
+			FailureDeadline: &metav1.Duration{Duration: time.Minute},
+		}
+
+		created := expectSnapshotCreates()
+		status := expectStatusUpdate()
+
+		retry, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		activation := time.Date(2024, time.January, 10, 2, 0, 0, 0, time.UTC)
+		next := activation.Add(24 * time.Hour)
+		Expect(retry).To(Equal(next.Sub(now)))
+
+		Expect(*created).To(HaveLen(1))
+		vmSnapshot := (*created)[0]
+		Expect(vmSnapshot.Name).To(Equal("nightly-selected-20240110-0200"))
+		Expect(vmSnapshot.Spec.Source.Name).To(Equal("selected"))
+		Expect(vmSnapshot.Spec.FailureDeadline.Duration).To(Equal(time.Minute))
+		Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+		Expect(vmSnapshot.Labels).To(HaveKeyWithValue("team", "a"))
+
+		Expect(*status).ToNot(BeNil())
+		Expect((*status).LastScheduleTime.Time).To(Equal(activation))
+		Expect((*status).NextScheduleTime.Time).To(Equal(next))
+		Expect((*status).Error).To(BeNil())
+		testutils.ExpectEvent(recorder, scheduledSnapshotsCreateEvent)
+	})
+
+	It("should not snapshot again before the next activation", func() {
+		Expect(vmStore.Add(newVM("selected", map[string]string{"backup": "true"}))).To(Succeed())
+		schedule := newSchedule()
+		activation := time.Date(2024, time.January, 10, 2, 0, 0, 0, time.UTC)
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: &metav1.Time{Time: activation},
+			NextScheduleTime: &metav1.Time{Time: activation.Add(24 * time.Hour)},
+			Conditions:       []snapshotv1.Condition{newReadyCondition(corev1.ConditionTrue, "Operation complete")},
+		}
+
+		retry, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(activation.Add(24 * time.Hour).Sub(now)))
+	})
+
+	It("should not snapshot when suspended", func() {
+		Expect(vmStore.Add(newVM("selected", map[string]string{"backup": "true"}))).To(Succeed())
+		schedule := newSchedule()
+		schedule.Spec.Suspend = true
+		status := expectStatusUpdate()
+
+		retry, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeZero())
+		Expect((*status).LastScheduleTime).To(BeNil())
+		Expect((*status).NextScheduleTime).To(BeNil())
+	})
+
+	It("should report an invalid schedule", func() {
+		schedule := newSchedule()
+		schedule.Spec.Schedule = "every night"
+		status := expectStatusUpdate()
+
+		retry, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeZero())
+		Expect((*status).Error).ToNot(BeNil())
+		Expect(*(*status).Error.Message).To(ContainSubstring("invalid schedule"))
+	})
+
+	It("should keep the shortened snapshot names of different schedules apart", func() {
+		vm := newVM(strings.Repeat("v", 250), nil)
+		vm.UID = "6a7d5f2e-9c1b-4e8a-b3d4-0f1e2d3c4b5a"
+		schedule := newSchedule()
+		other := newSchedule()
+		other.Name = "weekly"
+
+		name := scheduledSnapshotName(schedule, vm, now)
+		Expect(len(name)).To(BeNumerically("<=", validation.DNS1123SubdomainMaxLength))
+		Expect(name).To(HavePrefix(string(vm.UID) + "-"))
+		Expect(name).ToNot(Equal(scheduledSnapshotName(other, vm, now)))
+		Expect(name).To(Equal(scheduledSnapshotName(newSchedule(), vm, now)))
+	})
+
+	It("should enqueue the schedule of its snapshots", func() {
+		controller.handleVMSnapshot(newScheduledVMSnapshot("vm", now, snapshotv1.Succeeded))
+		Expect(controller.vmSnapshotScheduleQueue.Len()).To(Equal(1))
+		key, _ := controller.vmSnapshotScheduleQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + scheduleName))
+	})
+
+	Context("with retention rules", func() {
+		day := func(d int) time.Time {
+			return time.Date(2024, time.January, d, 2, 0, 0, 0, time.UTC)
+		}
+
+		names := func(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) []string {
+			result := []string{}
+			for _, vmSnapshot := range vmSnapshots {
+				result = append(result, vmSnapshot.Name)
+			}
+			return result
+		}
+
+		It("should keep the last snapshots", func() {
+			snapshots := []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledVMSnapshot("vm", day(1), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(3), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(2), snapshotv1.Succeeded),
+			}
+			prune := snapshotsToPrune(&snapshotv1.VirtualMachineSnapshotScheduleRetention{KeepLast: pointer.P(int32(2))}, snapshots)
+			Expect(names(prune)).To(ConsistOf("nightly-vm-20240101-0200"))
+		})
+
+		It("should keep the last snapshot of each day and week", func() {
+			snapshots := []*snapshotv1.VirtualMachineSnapshot{
+				// monday to wednesday of the first two ISO weeks of 2024
+				newScheduledVMSnapshot("vm", day(1), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(2), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(3), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(8), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(9), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(10), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm", day(10).Add(time.Hour), snapshotv1.Succeeded),
+			}
+			prune := snapshotsToPrune(&snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				KeepDaily:  pointer.P(int32(2)),
+				KeepWeekly: pointer.P(int32(2)),
+			}, snapshots)
+			Expect(names(prune)).To(ConsistOf(
+				"nightly-vm-20240101-0200",
+				"nightly-vm-20240102-0200",
+				"nightly-vm-20240108-0200",
+				"nightly-vm-20240110-0200",
+			))
+		})
+
+		It("should delete failed snapshots and keep snapshots in progress", func() {
+			snapshots := []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledVMSnapshot("vm", day(1), snapshotv1.Failed),
+				newScheduledVMSnapshot("vm", day(2), snapshotv1.InProgress),
+				newScheduledVMSnapshot("vm", day(3), snapshotv1.Succeeded),
+			}
+			prune := snapshotsToPrune(&snapshotv1.VirtualMachineSnapshotScheduleRetention{KeepLast: pointer.P(int32(1))}, snapshots)
+			Expect(names(prune)).To(ConsistOf("nightly-vm-20240101-0200"))
+		})
+
+		It("should prune the snapshots of each VM", func() {
+			schedule := newSchedule()
+			schedule.Spec.Suspend = true
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{KeepLast: pointer.P(int32(1))}
+			for _, vmSnapshot := range []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledVMSnapshot("vm1", day(1), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm1", day(2), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm2", day(2), snapshotv1.Succeeded),
+			} {
+				Expect(vmSnapshotStore.Add(vmSnapshot)).To(Succeed())
+			}
+			unrelated := createVirtualMachineSnapshot(testNamespace, "manual", "vm1")
+			Expect(vmSnapshotStore.Add(unrelated)).To(Succeed())
+
+			deleted := expectSnapshotDeletes()
+			expectStatusUpdate()
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*deleted).To(ConsistOf("nightly-vm1-20240101-0200"))
+			testutils.ExpectEvent(recorder, scheduledSnapshotDeleteEvent)
+		})
+
+		It("should keep all snapshots with a retention which keeps no snapshots", func() {
+			schedule := newSchedule()
+			schedule.Spec.Suspend = true
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{KeepLast: pointer.P(int32(0))}
+			for _, vmSnapshot := range []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledVMSnapshot("vm1", day(1), snapshotv1.Succeeded),
+				newScheduledVMSnapshot("vm1", day(2), snapshotv1.Failed),
+			} {
+				Expect(vmSnapshotStore.Add(vmSnapshot)).To(Succeed())
+			}
+
+			deleted := expectSnapshotDeletes()
+			expectStatusUpdate()
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*deleted).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package types
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// ApplyDiskIOTuneLimits gives the disks without a bytes or an IOPS limit the limits of this kind of the cluster.
+// CD-ROMs are not limited. It returns true if a disk was changed.
+func ApplyDiskIOTuneLimits(disks []virtv1.Disk, limits *virtv1.DiskIOTune) bool {
+	if limits == nil {
+		return false
+	}
+
+	changed := false
+	for i := range disks {
+		disk := &disks[i]
+		if disk.CDRom != nil {
+			continue
+		}
+		limitBytes := !hasBytesLimit(disk.IOTune) && hasBytesLimit(limits)
+		limitIOPS := !hasIOPSLimit(disk.IOTune) && hasIOPSLimit(limits)
+		if !limitBytes && !limitIOPS {
+			continue
+		}
+
+		ioTune := &virtv1.DiskIOTune{}
+		if disk.IOTune != nil {
+			ioTune = disk.IOTune.DeepCopy()
+		}
+		if ioTune.Burst == nil && limits.Burst != nil {
+			ioTune.Burst = &virtv1.DiskIOTuneBurst{}
+		}
+		if limitBytes {
+			copyBytesLimits(ioTune, limits)
+		}
+		if limitIOPS {
+			copyIOPSLimits(ioTune, limits)
+		}
+		if ioTune.Burst != nil {
+			burstLimits := *ioTune.Burst
+			burstLimits.LengthSeconds = nil
+			switch {
+			case burstLimits == (virtv1.DiskIOTuneBurst{}):
+				ioTune.Burst = nil
+			case ioTune.Burst.LengthSeconds == nil && limits.Burst != nil && limits.Burst.LengthSeconds != nil:
+				lengthSeconds := *limits.Burst.LengthSeconds
+				ioTune.Burst.LengthSeconds = &lengthSeconds
+			}
+		}
+		disk.IOTune = ioTune
+		changed = true
+	}
+	return changed
+}
+
+func hasBytesLimit(ioTune *virtv1.DiskIOTune) bool {
+	return ioTune != nil && (ioTune.TotalBytesPerSecond != nil || ioTune.ReadBytesPerSecond != nil || ioTune.WriteBytesPerSecond != nil)
+}
+
+func hasIOPSLimit(ioTune *virtv1.DiskIOTune) bool {
+	return ioTune != nil && (ioTune.TotalIOPS != nil || ioTune.ReadIOPS != nil || ioTune.WriteIOPS != nil)
+}
+
+func copyBytesLimits(ioTune, limits *virtv1.DiskIOTune) {
+	limits = limits.DeepCopy()
+	ioTune.TotalBytesPerSecond = limits.TotalBytesPerSecond
+	ioTune.ReadBytesPerSecond = limits.ReadBytesPerSecond
+	ioTune.WriteBytesPerSecond = limits.WriteBytesPerSecond
+	if limits.Burst != nil {
+		ioTune.Burst.TotalBytesPerSecond = limits.Burst.TotalBytesPerSecond
+		ioTune.Burst.ReadBytesPerSecond = limits.Burst.ReadBytesPerSecond
+		ioTune.Burst.WriteBytesPerSecond = limits.Burst.WriteBytesPerSecond
+	}
+}
+
+func copyIOPSLimits(ioTune, limits *virtv1.DiskIOTune) {
+	limits = limits.DeepCopy()
+	ioTune.TotalIOPS = limits.TotalIOPS
+	ioTune.ReadIOPS = limits.ReadIOPS
+	ioTune.WriteIOPS = limits.WriteIOPS
+	if limits.Burst != nil {
+		ioTune.Burst.TotalIOPS = limits.Burst.TotalIOPS
+		ioTune.Burst.ReadIOPS = limits.Burst.ReadIOPS
+		ioTune.Burst.WriteIOPS = limits.Burst.WriteIOPS
+	}
+}
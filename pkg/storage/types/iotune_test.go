@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package types
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Disk I/O limits", func() {
+	clusterIOTune := &virtv1.DiskIOTune{
+		TotalBytesPerSecond: pointer.P(resource.MustParse("100Mi")),
+		ReadIOPS:            pointer.P(int64(1000)),
+		WriteIOPS:           pointer.P(int64(500)),
+		Burst: &virtv1.DiskIOTuneBurst{
+			TotalBytesPerSecond: pointer.P(resource.MustParse("200Mi")),
+			LengthSeconds:       pointer.P(int64(10)),
+		},
+	}
+
+	DescribeTable("should apply the limits of the cluster", func(disk virtv1.Disk, expectedChanged bool, expectedIOTune *virtv1.DiskIOTune) {
+		disks := []virtv1.Disk{disk}
+		Expect(ApplyDiskIOTuneLimits(disks, clusterIOTune)).To(Equal(expectedChanged))
+		Expect(disks[0].IOTune).To(Equal(expectedIOTune))
+	},
+		Entry("to a disk without limits",
+			virtv1.Disk{Name: "disk"}, true, clusterIOTune,
+		),
+		Entry("to a disk without a bytes limit",
+			virtv1.Disk{Name: "disk", IOTune: &virtv1.DiskIOTune{TotalIOPS: pointer.P(int64(100))}}, true,
+			&virtv1.DiskIOTune{
+				TotalBytesPerSecond: pointer.P(resource.MustParse("100Mi")),
+				TotalIOPS:           pointer.P(int64(100)),
+				Burst: &virtv1.DiskIOTuneBurst{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("200Mi")),
+					LengthSeconds:       pointer.P(int64(10)),
+				},
+			},
+		),
+		Entry("to a disk without an IOPS limit",
+			virtv1.Disk{Name: "disk", IOTune: &virtv1.DiskIOTune{ReadBytesPerSecond: pointer.P(resource.MustParse("10Mi"))}}, true,
+			&virtv1.DiskIOTune{
+				ReadBytesPerSecond: pointer.P(resource.MustParse("10Mi")),
+				ReadIOPS:           pointer.P(int64(1000)),
+				WriteIOPS:          pointer.P(int64(500)),
+			},
+		),
+		Entry("not to a disk with both limits",
+			virtv1.Disk{Name: "disk", IOTune: &virtv1.DiskIOTune{
+				ReadBytesPerSecond: pointer.P(resource.MustParse("10Mi")),
+				TotalIOPS:          pointer.P(int64(100)),
+			}}, false,
+			&virtv1.DiskIOTune{
+				ReadBytesPerSecond: pointer.P(resource.MustParse("10Mi")),
+				TotalIOPS:          pointer.P(int64(100)),
+			},
+		),
+		Entry("not to a CD-ROM",
+			virtv1.Disk{Name: "cdrom", DiskDevice: virtv1.DiskDevice{CDRom: &virtv1.CDRomTarget{}}}, false, nil,
+		),
+	)
+
+	It("should not change the disks without limits of the cluster", func() {
+		disks := []virtv1.Disk{{Name: "disk"}}
+		Expect(ApplyDiskIOTuneLimits(disks, nil)).To(BeFalse())
+		Expect(disks[0].IOTune).To(BeNil())
+	})
+})
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
@@ -115,6 +116,8 @@ func PVCNameFromVirtVolume(volume *virtv1.Volume) string {
 		return volume.PersistentVolumeClaim.ClaimName
 	} else if volume.MemoryDump != nil {
 		return volume.MemoryDump.ClaimName
+	} else if volume.Backup != nil {
+		return volume.Backup.ClaimName
 	}
 
 	return ""
@@ -274,6 +277,9 @@ func IsHotplugVolume(vol *virtv1.Volume) bool {
 	if volSrc.MemoryDump != nil && volSrc.MemoryDump.PersistentVolumeClaimVolumeSource.Hotpluggable {
 		return true
 	}
+	if volSrc.Backup != nil && volSrc.Backup.PersistentVolumeClaimVolumeSource.Hotpluggable {
+		return true
+	}
 
 	return false
 }
@@ -294,6 +300,12 @@ func GetDisksByName(vmiSpec *virtv1.VirtualMachineInstanceSpec) map[string]*virt
 	return disks
 }
 
+// EqualDisksIgnoringIOTune returns true if the disks only differ in their I/O limits, which can be changed on a running VMI
+func EqualDisksIgnoringIOTune(a, b virtv1.Disk) bool {
+	a.IOTune, b.IOTune = nil, nil
+	return equality.Semantic.DeepEqual(a, b)
+}
+
 // Get expected disk capacity - a minimum between the request and the PVC capacity.
 // Returns nil when we have insufficient data to calculate this minimum.
 func GetDiskCapacity(pvcInfo *virtv1.PersistentVolumeClaimInfo) *int64 {
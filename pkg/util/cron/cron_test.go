@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cron
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	// a wednesday
+	start := time.Date(2024, time.January, 10, 10, 17, 42, 0, time.UTC)
+
+	DescribeTable("should calculate the next activation", func(spec string, from, expected time.Time) {
+		schedule, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(from)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", start, time.Date(2024, time.January, 10, 10, 18, 0, 0, time.UTC)),
+		Entry("@hourly", "@hourly", start, time.Date(2024, time.January, 10, 11, 0, 0, 0, time.UTC)),
+		Entry("@daily", "@daily", start, time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC)),
+		Entry("@weekly", "@weekly", start, time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)),
+		Entry("@monthly", "@monthly", start, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("@yearly", "@yearly", start, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("steps", "*/15 * * * *", start, time.Date(2024, time.January, 10, 10, 30, 0, 0, time.UTC)),
+		Entry("ranges with steps", "0 8-18/4 * * *", start, time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)),
+		Entry("lists", "5,20 10 * * *", start, time.Date(2024, time.January, 10, 10, 20, 0, 0, time.UTC)),
+		Entry("exactly at an activation", "0 0 * * *", time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC)),
+		Entry("names", "30 2 * feb mon", start, time.Date(2024, time.February, 5, 2, 30, 0, 0, time.UTC)),
+		Entry("7 as sunday", "0 0 * * 7", start, time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 13 * 5", start, time.Date(2024, time.January, 12, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", start, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("in UTC", "0 12 * * *", time.Date(2024, time.January, 10, 10, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)),
+	)
+
+	DescribeTable("should calculate the next activation in a time zone", func(spec, zone string, from, expected time.Time) {
+		schedule, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		loc, err := LoadLocation(zone)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.NextIn(from, loc).Equal(expected)).To(BeTrue())
+	},
+		Entry("UTC by default", "0 12 * * *", "", start, time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)),
+		Entry("a whole hour offset", "0 12 * * *", "Europe/Berlin", start, time.Date(2024, time.January, 10, 11, 0, 0, 0, time.UTC)),
+		Entry("a half hour offset", "0 * * * *", "Asia/Kolkata", start, time.Date(2024, time.January, 10, 10, 30, 0, 0, time.UTC)),
+		Entry("the day in the time zone", "0 1 * * thu", "Asia/Tokyo", start, time.Date(2024, time.January, 10, 16, 0, 0, 0, time.UTC)),
+		Entry("a daylight saving time change", "30 2 * * *", "Europe/Berlin", time.Date(2024, time.March, 30, 12, 0, 0, 0, time.UTC), time.Date(2024, time.April, 1, 0, 30, 0, 0, time.UTC)),
+	)
+
+	It("should reject an unknown time zone", func() {
+		_, err := LoadLocation("Nowhere/Special")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not find an activation for impossible dates", func() {
+		schedule, err := Parse("0 0 30 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(start).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("should reject invalid expressions", func(spec string) {
+		_, err := Parse(spec)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("empty", ""),
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("unknown macro", "@every5m"),
+		Entry("out of range", "60 * * * *"),
+		Entry("zero day of month", "0 0 0 * *"),
+		Entry("invalid step", "*/0 * * * *"),
+		Entry("inverted range", "0 10-5 * * *"),
+		Entry("unknown name", "0 0 * * someday"),
+	)
+})
@@ -90,6 +90,24 @@ func MigrationFailed(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// IsCrossClusterMigrationReceiver returns true if the VMI waits to receive its guest from a
+// cross-cluster migration instead of being started.
+func IsCrossClusterMigrationReceiver(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.IsUnprocessed() && metav1.HasAnnotation(vmi.ObjectMeta, v1.CrossClusterMigrationReceiverAnnotation)
+}
+
+// IsMigratedToAnotherCluster returns true if the guest of the VMI was sent to another cluster by a
+// cross-cluster migration. Such a VMI must not be started again in this cluster.
+func IsMigratedToAnotherCluster(vmi *v1.VirtualMachineInstance) bool {
+	migrationState := vmi.Status.MigrationState
+	return vmi.Status.Phase == v1.Succeeded &&
+		migrationState != nil &&
+		migrationState.CrossClusterMigrationID != "" &&
+		migrationState.TargetNode == "" &&
+		migrationState.Completed &&
+		!migrationState.Failed
+}
+
 func VMIEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) *v1.EvictionStrategy {
 	if vmi != nil && vmi.Spec.EvictionStrategy != nil {
 		return vmi.Spec.EvictionStrategy
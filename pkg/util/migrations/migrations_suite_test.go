@@ -0,0 +1,11 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestMigrations(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
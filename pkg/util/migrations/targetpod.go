@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// TargetPodNodeSelector returns the node selector of the migration target pod: the node selector rendered for the VMI,
+// complemented by the added node selector of the migration. A host-model CPU restricts the target to the nodes supporting
+// the CPU of the source, the labels are taken over from the source pod once the VMI was migrated, and derived from the
+// labels of the source node before that. The source node is only needed for a host-model CPU.
+func TargetPodNodeSelector(vmi *v1.VirtualMachineInstance, renderedNodeSelector, addedNodeSelector map[string]string, sourcePod *k8sv1.Pod, sourceNode *k8sv1.Node) (map[string]string, error) {
+	nodeSelector := make(map[string]string)
+	maps.Copy(nodeSelector, addedNodeSelector)
+	maps.Copy(nodeSelector, renderedNodeSelector)
+
+	if !RequiresHostModelNodeSelector(vmi, sourcePod) {
+		return nodeSelector, nil
+	}
+
+	migratedAtLeastOnce := false
+	for key, value := range sourcePod.Spec.NodeSelector {
+		if strings.Contains(key, v1.CPUFeatureLabel) || strings.Contains(key, v1.SupportedHostModelMigrationCPU) {
+			nodeSelector[key] = value
+			migratedAtLeastOnce = true
+		}
+	}
+	if migratedAtLeastOnce {
+		return nodeSelector, nil
+	}
+
+	if sourceNode == nil {
+		return nil, fmt.Errorf("source node %q of the host-model CPU is unknown", vmi.Status.NodeName)
+	}
+	var hostCPUModel, hostModelLabelValue string
+	for key, value := range sourceNode.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			hostCPUModel = strings.TrimPrefix(key, v1.HostModelCPULabel)
+			hostModelLabelValue = value
+		}
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			nodeSelector[v1.CPUFeatureLabel+strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)] = value
+		}
+	}
+	if hostCPUModel == "" {
+		return nil, fmt.Errorf("source node %q does not report its host-model CPU with the label %q", sourceNode.Name, v1.HostModelCPULabel)
+	}
+	nodeSelector[v1.SupportedHostModelMigrationCPU+hostCPUModel] = hostModelLabelValue
+	return nodeSelector, nil
+}
+
+// RequiresHostModelNodeSelector returns true if the target pod has to run on a node supporting the host-model CPU of the source
+func RequiresHostModelNodeSelector(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod) bool {
+	cpu := vmi.Spec.Domain.CPU
+	return cpu != nil && cpu.Model == v1.CPUModeHostModel && sourcePod != nil
+}
+
+// TargetPodAffinity returns the affinity of the migration target pod: the affinity rendered for the VMI,
+// with an anti-affinity to the pods of the VMI so that the target is not scheduled on the source node.
+func TargetPodAffinity(vmi *v1.VirtualMachineInstance, renderedAffinity *k8sv1.Affinity) *k8sv1.Affinity {
+	antiAffinityTerm := k8sv1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				v1.CreatedByLabel: string(vmi.UID),
+			},
+		},
+		TopologyKey: k8sv1.LabelHostname,
+	}
+
+	affinity := &k8sv1.Affinity{}
+	if renderedAffinity != nil {
+		affinity = renderedAffinity.DeepCopy()
+	}
+	if affinity.PodAntiAffinity == nil {
+		affinity.PodAntiAffinity = &k8sv1.PodAntiAffinity{}
+	}
+	affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, antiAffinityTerm)
+	return affinity
+}
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+var _ = Describe("Migration target pod", func() {
+	var vmi *v1.VirtualMachineInstance
+	var sourcePod *k8sv1.Pod
+	var sourceNode *k8sv1.Node
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", UID: "1234"},
+			Status:     v1.VirtualMachineInstanceStatus{NodeName: "source"},
+		}
+		sourcePod = &k8sv1.Pod{}
+		sourceNode = &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source",
+				Labels: map[string]string{
+					v1.HostModelCPULabel + "Cascadelake":      "true",
+					v1.HostModelRequiredFeaturesLabel + "vmx": "true",
+				},
+			},
+		}
+	})
+
+	Context("node selector", func() {
+		It("should complement the rendered node selector with the added node selector", func() {
+			nodeSelector, err := migrations.TargetPodNodeSelector(vmi,
+				map[string]string{v1.NodeSchedulable: "true", "zone": "a"},
+				map[string]string{"zone": "b", "rack": "1"},
+				sourcePod, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeSelector).To(Equal(map[string]string{v1.NodeSchedulable: "true", "zone": "a", "rack": "1"}))
+		})
+
+		It("should require the host-model CPU and the required features of the source node", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+
+			nodeSelector, err := migrations.TargetPodNodeSelector(vmi, map[string]string{v1.NodeSchedulable: "true"}, nil, sourcePod, sourceNode)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeSelector).To(Equal(map[string]string{
+				v1.NodeSchedulable: "true",
+				v1.SupportedHostModelMigrationCPU + "Cascadelake": "true",
+				v1.CPUFeatureLabel + "vmx":                        "true",
+			}))
+		})
+
+		It("should take over the host-model CPU of the source pod once the VMI was migrated", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+			sourcePod.Spec.NodeSelector = map[string]string{
+				v1.SupportedHostModelMigrationCPU + "Skylake": "true",
+				v1.CPUFeatureLabel + "pcid":                   "true",
+			}
+
+			nodeSelector, err := migrations.TargetPodNodeSelector(vmi, nil, nil, sourcePod, sourceNode)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeSelector).To(Equal(sourcePod.Spec.NodeSelector))
+		})
+
+		It("should fail when the source node does not report its host-model CPU", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+			sourceNode.Labels = nil
+
+			_, err := migrations.TargetPodNodeSelector(vmi, nil, nil, sourcePod, sourceNode)
+			Expect(err).To(MatchError(ContainSubstring(`source node "source" does not report its host-model CPU`)))
+		})
+
+		DescribeTable("should not require a host-model CPU", func(cpu *v1.CPU, sourcePod *k8sv1.Pod) {
+			vmi.Spec.Domain.CPU = cpu
+			Expect(migrations.RequiresHostModelNodeSelector(vmi, sourcePod)).To(BeFalse())
+
+			nodeSelector, err := migrations.TargetPodNodeSelector(vmi, nil, nil, sourcePod, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeSelector).To(BeEmpty())
+		},
+			Entry("without a CPU", nil, &k8sv1.Pod{}),
+			Entry("with a named CPU model", &v1.CPU{Model: "Skylake"}, &k8sv1.Pod{}),
+			Entry("without a source pod", &v1.CPU{Model: v1.CPUModeHostModel}, nil),
+		)
+	})
+
+	Context("affinity", func() {
+		antiAffinityTerm := k8sv1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{v1.CreatedByLabel: "1234"}},
+			TopologyKey:   k8sv1.LabelHostname,
+		}
+
+		It("should keep the target pod away from the pods of the VMI", func() {
+			affinity := migrations.TargetPodAffinity(vmi, nil)
+			Expect(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(antiAffinityTerm))
+		})
+
+		It("should add the anti-affinity to the rendered affinity without changing it", func() {
+			otherTerm := k8sv1.PodAffinityTerm{TopologyKey: "zone"}
+			rendered := &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{}},
+				PodAntiAffinity: &k8sv1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{otherTerm},
+				},
+			}
+
+			affinity := migrations.TargetPodAffinity(vmi, rendered)
+			Expect(affinity.NodeAffinity).To(Equal(rendered.NodeAffinity))
+			Expect(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(otherTerm, antiAffinityTerm))
+			Expect(rendered.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(otherTerm))
+		})
+	})
+})
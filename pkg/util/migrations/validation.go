@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// ValidateCompression validates the compression of live migrations
+func ValidateCompression(field *k8sfield.Path, compression *v1.MigrationCompression) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch compression.Mode {
+	case "", v1.MigrationCompressionNone, v1.MigrationCompressionXBZRLE, v1.MigrationCompressionMultifdZstd:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("must be one of %s, %s or %s", v1.MigrationCompressionNone, v1.MigrationCompressionXBZRLE, v1.MigrationCompressionMultifdZstd),
+			Field:   field.Child("mode").String(),
+		})
+	}
+
+	if compression.XBZRLECacheSize != nil {
+		if compression.Mode != v1.MigrationCompressionXBZRLE {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("can only be set with the %s mode", v1.MigrationCompressionXBZRLE),
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		} else if compression.XBZRLECacheSize.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be greater than zero",
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		}
+	}
+
+	if compression.ZstdLevel != nil {
+		if compression.Mode != v1.MigrationCompressionMultifdZstd {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("can only be set with the %s mode", v1.MigrationCompressionMultifdZstd),
+				Field:   field.Child("zstdLevel").String(),
+			})
+		} else if *compression.ZstdLevel < 1 || *compression.ZstdLevel > 20 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be between 1 and 20",
+				Field:   field.Child("zstdLevel").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// ValidateSwitchoverPolicy validates the steps of a switchover policy. Stalled migrations are aborted
+// once they made no progress for progressTimeout seconds, so the steps waiting for a stall have to be taken before.
+func ValidateSwitchoverPolicy(field *k8sfield.Path, policy *v1.MigrationSwitchoverPolicy, progressTimeout *int64) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, step := range policy.Steps {
+		stepField := field.Child("steps").Index(i)
+
+		switch step.Action {
+		case v1.MigrationSwitchoverRaiseDowntime, v1.MigrationSwitchoverPostCopy:
+		case v1.MigrationSwitchoverAbort:
+			if i != len(policy.Steps)-1 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "must be the last step",
+					Field:   stepField.Child("action").String(),
+				})
+			}
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("must be one of %s, %s or %s", v1.MigrationSwitchoverRaiseDowntime,
+					v1.MigrationSwitchoverPostCopy, v1.MigrationSwitchoverAbort),
+				Field: stepField.Child("action").String(),
+			})
+		}
+
+		if step.After == nil && step.StalledFor == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "after or stalledFor must be set",
+				Field:   stepField.String(),
+			})
+		}
+		if step.After != nil && step.After.Duration < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be negative",
+				Field:   stepField.Child("after").String(),
+			})
+		}
+		if step.StalledFor != nil && step.StalledFor.Duration < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be negative",
+				Field:   stepField.Child("stalledFor").String(),
+			})
+		} else if step.StalledFor != nil && progressTimeout != nil && *progressTimeout > 0 &&
+			step.StalledFor.Duration >= time.Duration(*progressTimeout)*time.Second {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("must be shorter than the migration progress timeout of %d seconds", *progressTimeout),
+				Field:   stepField.Child("stalledFor").String(),
+			})
+		}
+
+		if step.Action != v1.MigrationSwitchoverRaiseDowntime {
+			if step.MaxDowntime != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("can only be set with the %s action", v1.MigrationSwitchoverRaiseDowntime),
+					Field:   stepField.Child("maxDowntime").String(),
+				})
+			}
+		} else if step.MaxDowntime == nil || step.MaxDowntime.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be greater than zero",
+				Field:   stepField.Child("maxDowntime").String(),
+			})
+		}
+	}
+
+	return causes
+}
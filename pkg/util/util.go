@@ -64,6 +64,16 @@ func IsVMIVirtiofsEnabled(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if a VMI spec requests a vhost-user-blk volume
+func IsVMIVhostUserBlkEnabled(vmi *v1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.VhostUserBlk != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Check if a VMI spec requests a HostDevice
 func IsHostDevVMI(vmi *v1.VirtualMachineInstance) bool {
 	if vmi.Spec.Domain.Devices.HostDevices != nil && len(vmi.Spec.Domain.Devices.HostDevices) != 0 {
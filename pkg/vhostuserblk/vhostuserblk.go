@@ -0,0 +1,31 @@
+package vhostuserblk
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// HostSocketDir is the directory of the node in which the storage daemon creates the vhost-user-blk sockets
+var HostSocketDir = "/var/run/kubevirt-vhost-user-blk"
+
+// This is empty dir
+var VhostUserBlkSockets = "vhost-user-blk-sockets"
+var VhostUserBlkSocketsMountBaseDir = filepath.Join(util.VirtShareDir, VhostUserBlkSockets)
+
+// HostSocketPath returns the path of the socket exported by the storage daemon on the node. The sockets are
+// scoped per namespace, so that a VMI can only use the sockets which are exported for its own namespace.
+func HostSocketPath(namespace, socket string) string {
+	return filepath.Join(HostSocketDir, namespace, socket)
+}
+
+// SocketName returns the name of the socket of the volume in the virt-launcher pod
+func SocketName(volumeName string) string {
+	return fmt.Sprintf("%s.sock", volumeName)
+}
+
+// VhostUserBlkSocketPath returns the path of the socket of the volume in the virt-launcher pod
+func VhostUserBlkSocketPath(volumeName string) string {
+	return filepath.Join(VhostUserBlkSocketsMountBaseDir, SocketName(volumeName))
+}
@@ -115,6 +115,7 @@ type virtAPIApp struct {
 	virtCli          kubecli.KubevirtClient
 	aggregatorClient *aggregatorclient.Clientset
 	authorizor       rest.VirtApiAuthorizor
+	nodeStore        cache.Store
 	certsDirectory   string
 	clusterConfig    *virtconfig.ClusterConfig
 
@@ -239,7 +240,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, app.nodeStore, app.authorizor)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -258,10 +259,12 @@ func (app *virtAPIApp) composeSubresources() {
 			To(subresourceApp.MigrateVMRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.MigrateOptions{}).
-			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.DryRunParam(subws)).
 			Operation(version.Version+"Migrate").
-			Doc("Migrate a running VirtualMachine to another node.").
-			Returns(http.StatusOK, "OK", "").
+			Doc("Migrate a running VirtualMachine to another node. A dry run reports which nodes could take the VirtualMachine instead.").
+			Writes(v1.MigrationFeasibility{}).
+			Returns(http.StatusOK, "OK", v1.MigrationFeasibility{}).
+			Returns(http.StatusAccepted, "Accepted", "").
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
@@ -484,6 +487,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestmetrics")).
+			To(subresourceApp.GuestMetrics).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Guestmetrics").
+			Doc("Get a sample of the guest resource usage").
+			Writes(v1.VirtualMachineInstanceGuestMetrics{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestMetrics{}))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -524,6 +537,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("insertcdrom")).
+			To(subresourceApp.VMInsertCDRomRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.InsertCDRomOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-insertcdrom").
+			Doc("Inserts a media into the tray of a CD-ROM disk of a Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("ejectcdrom")).
+			To(subresourceApp.VMEjectCDRomRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.EjectCDRomOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-ejectcdrom").
+			Doc("Ejects the media from the tray of a CD-ROM disk of a Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("memorydump")).
 			To(subresourceApp.MemoryDumpVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -657,6 +690,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/insertcdrom",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/ejectcdrom",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -669,6 +710,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestmetrics",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -904,7 +949,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServeVMIPreset(w, r)
 	})
 	http.HandleFunc(components.MigrationCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationCreate(w, r, app.virtCli)
+		validating_webhook.ServeMigrationCreate(w, r, app.virtCli, app.clusterConfig, app.kubeVirtServiceAccounts)
 	})
 	http.HandleFunc(components.MigrationUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationUpdate(w, r)
@@ -915,6 +960,12 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMBackupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackups(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -937,7 +988,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServePodEvictionInterceptor(w, r, app.clusterConfig, app.virtCli)
 	})
 	http.HandleFunc(components.MigrationPolicyCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationPolicies(w, r)
+		validating_webhook.ServeMigrationPolicies(w, r, app.clusterConfig)
 	})
 	http.HandleFunc(components.VMCloneCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVirtualMachineClones(w, r, app.clusterConfig, app.virtCli)
@@ -1090,6 +1141,7 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	app.nodeStore = kubeInformerFactory.KubeVirtNode().GetStore()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
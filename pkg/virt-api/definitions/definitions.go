@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	backupv1alpha1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
@@ -59,6 +60,7 @@ func ComposeAPIDefinitions() []*restful.WebService {
 	for _, f := range []func() []*restful.WebService{
 		kubevirtApiServiceDefinitions,
 		snapshotApiServiceDefinitions,
+		backupApiServiceDefinitions,
 		exportApiServiceDefinitions,
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
@@ -125,6 +127,7 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +149,11 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -153,6 +161,32 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func backupApiServiceDefinitions() []*restful.WebService {
+	vmbGVR := backupv1alpha1.SchemeGroupVersion.WithResource("virtualmachinebackups")
+	vmbtGVR := backupv1alpha1.SchemeGroupVersion.WithResource("virtualmachinebackuptrackers")
+
+	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: backupv1alpha1.SchemeGroupVersion.Group, Version: backupv1alpha1.SchemeGroupVersion.Version})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmbGVR, &backupv1alpha1.VirtualMachineBackup{}, "VirtualMachineBackup", &backupv1alpha1.VirtualMachineBackupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmbtGVR, &backupv1alpha1.VirtualMachineBackupTracker{}, "VirtualMachineBackupTracker", &backupv1alpha1.VirtualMachineBackupTrackerList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(vmbGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func exportApiServiceDefinitions() []*restful.WebService {
 	exportsGVR := exportv1.SchemeGroupVersion.WithResource("virtualmachineexports")
 
@@ -568,6 +602,7 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	DryRunParamName     = "dryRun"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +617,10 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func DryRunParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(DryRunParamName, "Evaluate the request without persisting any change").DataType("boolean").DefaultValue("false")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
@@ -79,6 +79,7 @@ var noAuthEndpoints = map[string]struct{}{
 
 type VirtApiAuthorizor interface {
 	Authorize(req *restful.Request) (bool, string, error)
+	AuthorizeResource(req *restful.Request, attributes *authv1.ResourceAttributes) (bool, error)
 	AddUserHeaders(header []string)
 	GetUserHeaders() []string
 	AddGroupHeaders(header []string)
@@ -175,23 +176,11 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 		return nil, fmt.Errorf("no URL in http request")
 	}
 
-	userName, err := a.getUserName(req.Request.Header)
+	r, err := a.newUserAccessReview(req.Request.Header)
 	if err != nil {
 		return nil, err
 	}
 
-	userGroups, err := a.getUserGroups(req.Request.Header)
-	if err != nil {
-		return nil, err
-	}
-
-	r := &authv1.SubjectAccessReview{}
-	r.Spec = authv1.SubjectAccessReviewSpec{
-		User:   userName,
-		Groups: userGroups,
-		Extra:  a.getUserExtras(req.Request.Header),
-	}
-
 	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
@@ -211,6 +200,26 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 	return r, nil
 }
 
+func (a *authorizor) newUserAccessReview(header http.Header) (*authv1.SubjectAccessReview, error) {
+	userName, err := a.getUserName(header)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups, err := a.getUserGroups(header)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &authv1.SubjectAccessReview{}
+	r.Spec = authv1.SubjectAccessReviewSpec{
+		User:   userName,
+		Groups: userGroups,
+		Extra:  a.getUserExtras(header),
+	}
+	return r, nil
+}
+
 func addNamespacedResourceAttributes(pathSplit []string, requestMethod string, r *authv1.SubjectAccessReview) error {
 	// URL example
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
@@ -351,6 +360,26 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	return false, result.Status.Reason, nil
 }
 
+// AuthorizeResource checks if the user of an already authorized request may access
+// a resource which is not addressed by the request itself
+func (a *authorizor) AuthorizeResource(req *restful.Request, attributes *authv1.ResourceAttributes) (bool, error) {
+	if req.Request == nil {
+		return false, fmt.Errorf("empty http request")
+	}
+
+	r, err := a.newUserAccessReview(req.Request.Header)
+	if err != nil {
+		return false, err
+	}
+	r.Spec.ResourceAttributes = attributes
+
+	result, err := a.client.Create(context.Background(), r, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
+
 func NewAuthorizorFromClient(client authclientv1.SubjectAccessReviewInterface) VirtApiAuthorizor {
 	return &authorizor{
 		userHeaders:             []string{userHeader},
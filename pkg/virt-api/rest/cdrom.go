@@ -0,0 +1,272 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+// VMInsertCDRomRequestHandler handles the subresource for inserting a media into the tray of a CD-ROM disk.
+func (app *SubresourceAPIApp) VMInsertCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to insert CD-ROM media because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, InsertCDRomOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.InsertCDRomOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("InsertCDRomOptions requires name to be set"), response)
+		return
+	} else if opts.VolumeSource == nil || volumeSourceName(opts.VolumeSource) == "" {
+		writeError(errors.NewBadRequest("InsertCDRomOptions requires a DataVolume or PersistentVolumeClaim VolumeSource"), response)
+		return
+	}
+
+	// The media is plugged through the hotplug attachment pod of the VMI
+	volume := &v1.Volume{Name: opts.Name}
+	if opts.VolumeSource.DataVolume != nil {
+		volume.DataVolume = opts.VolumeSource.DataVolume.DeepCopy()
+		volume.DataVolume.Hotpluggable = true
+	} else {
+		volume.PersistentVolumeClaim = opts.VolumeSource.PersistentVolumeClaim.DeepCopy()
+		volume.PersistentVolumeClaim.Hotpluggable = true
+	}
+
+	if err := app.vmCDRomMediaPatch(name, namespace, opts.Name, volume, opts.DryRun); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// VMEjectCDRomRequestHandler handles the subresource for ejecting the media from the tray of a CD-ROM disk.
+func (app *SubresourceAPIApp) VMEjectCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to eject CD-ROM media because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, EjectCDRomOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.EjectCDRomOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("EjectCDRomOptions requires name to be set"), response)
+		return
+	}
+
+	if err := app.vmCDRomMediaPatch(name, namespace, opts.Name, nil, opts.DryRun); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// vmCDRomMediaPatch persists the media of the CD-ROM in the VM template first and then changes it on the running VMI,
+// a nil volume ejects the media. The media of a running VMI is plugged like any other hotplug volume. The VMI patch
+// is validated before the VM is patched, so that a rejected change of media on the VMI leaves the VM untouched.
+func (app *SubresourceAPIApp) vmCDRomMediaPatch(name, namespace, diskName string, volume *v1.Volume, dryRun []string) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	newVolumes, err := applyCDRomMedia(&vm.Spec.Template.Spec, diskName, volume)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	var dryRunOption []string
+	if len(dryRun) > 0 && dryRun[0] == metav1.DryRunAll {
+		dryRunOption = dryRun
+	}
+
+	var vmi *v1.VirtualMachineInstance
+	var vmiPatchBytes []byte
+	if vm.Status.Created {
+		if vmi, vmiPatchBytes, statErr = app.vmiCDRomMediaPatch(vm, diskName, volume); statErr != nil {
+			return statErr
+		}
+	}
+
+	patchBytes, err := generateCDRomMediaPatch("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes, newVolumes)
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err = app.virtCli.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRunOption}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm: %v", err))
+	}
+
+	if vmi == nil {
+		return nil
+	}
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(vmiPatchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, vmiPatchBytes, metav1.PatchOptions{DryRun: dryRunOption}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err))
+	}
+	return nil
+}
+
+// vmiCDRomMediaPatch returns the running VMI of the VM and the patch changing the media of its CD-ROM
+func (app *SubresourceAPIApp) vmiCDRomMediaPatch(vm *v1.VirtualMachine, diskName string, volume *v1.Volume) (*v1.VirtualMachineInstance, []byte, *errors.StatusError) {
+	vmi, statErr := app.FetchVirtualMachineInstance(vm.Namespace, vm.Name)
+	if statErr != nil {
+		return nil, nil, statErr
+	}
+
+	if !vmi.IsRunning() {
+		return nil, nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+
+	newVolumes, err := applyCDRomMedia(&vmi.Spec, diskName, volume)
+	if err != nil {
+		return nil, nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, err)
+	}
+
+	// The status of the ejected media is kept until its volume is unplugged from the VMI
+	if volume != nil {
+		for _, volumeStatus := range vmi.Status.VolumeStatus {
+			if volumeStatus.Name == diskName {
+				return nil, nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("Unable to insert a media into CD-ROM [%s] because the previous media is still being ejected", diskName))
+			}
+		}
+	}
+
+	patchBytes, err := generateCDRomMediaPatch("/spec/volumes", vmi.Spec.Volumes, newVolumes)
+	if err != nil {
+		return nil, nil, errors.NewInternalError(err)
+	}
+	return vmi, patchBytes, nil
+}
+
+// applyCDRomMedia returns the volumes of the spec with the given volume inserted into the tray of the CD-ROM,
+// a nil volume ejects the media from the tray.
+func applyCDRomMedia(spec *v1.VirtualMachineInstanceSpec, diskName string, volume *v1.Volume) ([]v1.Volume, error) {
+	var cdrom *v1.Disk
+	for i := range spec.Domain.Devices.Disks {
+		if spec.Domain.Devices.Disks[i].Name == diskName {
+			cdrom = &spec.Domain.Devices.Disks[i]
+			break
+		}
+	}
+	if cdrom == nil {
+		return nil, fmt.Errorf("Unable to change the media of [%s] because the disk does not exist", diskName)
+	}
+	if cdrom.CDRom == nil {
+		return nil, fmt.Errorf("Unable to change the media of [%s] because the disk is not a CD-ROM", diskName)
+	}
+
+	trayEmpty := true
+	newVolumes := []v1.Volume{}
+	for _, existingVolume := range spec.Volumes {
+		if existingVolume.Name == diskName {
+			trayEmpty = false
+			continue
+		}
+		if volume != nil && volumeSourceExists(existingVolume, volumeSourceName(hotplugVolumeSource(volume))) {
+			return nil, fmt.Errorf("Unable to insert volume source [%s] because it is already used by volume [%s]", volumeSourceName(hotplugVolumeSource(volume)), existingVolume.Name)
+		}
+		newVolumes = append(newVolumes, existingVolume)
+	}
+
+	if volume == nil {
+		if trayEmpty {
+			return nil, fmt.Errorf("Unable to eject the media of CD-ROM [%s] because its tray is already empty", diskName)
+		}
+		return newVolumes, nil
+	}
+	if !trayEmpty {
+		return nil, fmt.Errorf("Unable to insert a media into CD-ROM [%s] because its tray is not empty, the media has to be ejected first", diskName)
+	}
+	return append(newVolumes, *volume), nil
+}
+
+func hotplugVolumeSource(volume *v1.Volume) *v1.HotplugVolumeSource {
+	return &v1.HotplugVolumeSource{
+		DataVolume:            volume.DataVolume,
+		PersistentVolumeClaim: volume.PersistentVolumeClaim,
+	}
+}
+
+func generateCDRomMediaPatch(path string, volumes, newVolumes []v1.Volume) ([]byte, error) {
+	patchSet := patch.New(
+		patch.WithTest(path, volumes),
+	)
+
+	if len(volumes) > 0 {
+		patchSet.AddOption(patch.WithReplace(path, newVolumes))
+	} else {
+		patchSet.AddOption(patch.WithAdd(path, newVolumes))
+	}
+	return patchSet.GeneratePayload()
+}
@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("CD-ROM Subresource api", func() {
+	const cdromName = "cdrom"
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		virtClient *kubecli.MockKubevirtClient
+		vmClient   *kubecli.MockVirtualMachineInterface
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		app        *SubresourceAPIApp
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		}
+	)
+
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
+
+	enableFeatureGate := func(featureGate string) {
+		kvConfig := kv.DeepCopy()
+		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featureGate}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+	}
+
+	newBody := func(opts interface{}) *readCloserWrapper {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	newISOVolumeSource := func(claimName string) *v1.HotplugVolumeSource {
+		return &v1.HotplugVolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		}
+	}
+
+	newVM := func(withMedia bool, running bool) *v1.VirtualMachine {
+		opts := []libvmi.Option{
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim("rootdisk", "rootdisk"),
+		}
+		if withMedia {
+			opts = append(opts, libvmi.WithCDRom(cdromName, v1.DiskBusSATA, "iso"))
+		}
+		vm := libvmi.NewVirtualMachine(libvmi.New(opts...))
+		if !withMedia {
+			vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: cdromName,
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA},
+				},
+			})
+		}
+		vm.Status.Created = running
+		return vm
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config, nil, nil)
+		enableFeatureGate(featuregate.HotplugVolumesGate)
+	})
+
+	AfterEach(func() {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+	})
+
+	DescribeTable("should reject an invalid request", func(insert bool, opts interface{}, enableGate bool) {
+		if !enableGate {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		}
+		request.Request.Body = newBody(opts)
+		if insert {
+			app.VMInsertCDRomRequestHandler(request, response)
+		} else {
+			app.VMEjectCDRomRequestHandler(request, response)
+		}
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	},
+		Entry("insert without the feature gate", true, &v1.InsertCDRomOptions{Name: cdromName, VolumeSource: newISOVolumeSource("iso")}, false),
+		Entry("insert without a name", true, &v1.InsertCDRomOptions{VolumeSource: newISOVolumeSource("iso")}, true),
+		Entry("insert without a volume source", true, &v1.InsertCDRomOptions{Name: cdromName}, true),
+		Entry("insert with an empty volume source", true, &v1.InsertCDRomOptions{Name: cdromName, VolumeSource: &v1.HotplugVolumeSource{}}, true),
+		Entry("eject without the feature gate", false, &v1.EjectCDRomOptions{Name: cdromName}, false),
+		Entry("eject without a name", false, &v1.EjectCDRomOptions{}, true),
+	)
+
+	It("should insert a hotpluggable media into the CD-ROM of a stopped VM", func() {
+		vm := newVM(false, false)
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil)
+		vmClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(string(body)).To(ContainSubstring(`"path":"/spec/template/spec/volumes"`))
+				Expect(string(body)).To(ContainSubstring(`"name":"cdrom","persistentVolumeClaim":{"claimName":"newiso","hotpluggable":true}`))
+				Expect(opts.DryRun).To(BeEmpty())
+				return vm, nil
+			})
+
+		request.Request.Body = newBody(&v1.InsertCDRomOptions{Name: cdromName, VolumeSource: newISOVolumeSource("newiso")})
+		app.VMInsertCDRomRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should eject the media from the CD-ROM of a running VM and VMI", func() {
+		vm := newVM(true, true)
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim("rootdisk", "rootdisk"),
+			libvmi.WithCDRom(cdromName, v1.DiskBusSATA, "iso"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil)
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil)
+		vmPatch := vmClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(string(body)).To(ContainSubstring(`{"op":"replace","path":"/spec/template/spec/volumes","value":[{"name":"rootdisk","persistentVolumeClaim":{"claimName":"rootdisk"}}]}`))
+				Expect(opts.DryRun).To(Equal(withDryRun()))
+				return vm, nil
+			})
+		vmiClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).To(ContainSubstring(`{"op":"replace","path":"/spec/volumes","value":[{"name":"rootdisk","persistentVolumeClaim":{"claimName":"rootdisk"}}]}`))
+				Expect(opts.DryRun).To(Equal(withDryRun()))
+				return vmi, nil
+			}).After(vmPatch)
+
+		request.Request.Body = newBody(&v1.EjectCDRomOptions{Name: cdromName, DryRun: withDryRun()})
+		app.VMEjectCDRomRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should not change the media of the VMI when the VM cannot be patched", func() {
+		vm := newVM(true, true)
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim("rootdisk", "rootdisk"),
+			libvmi.WithCDRom(cdromName, v1.DiskBusSATA, "iso"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil)
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil)
+		vmClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("conflict"))
+		vmiClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		request.Request.Body = newBody(&v1.EjectCDRomOptions{Name: cdromName})
+		app.VMEjectCDRomRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+		Expect(recorder.Body.String()).To(ContainSubstring("unable to patch vm"))
+	})
+
+	It("should not insert a media while the previous one is still being ejected", func() {
+		vm := newVM(false, true)
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: cdromName, Phase: v1.HotplugVolumeDetaching}}
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil)
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil)
+
+		request.Request.Body = newBody(&v1.InsertCDRomOptions{Name: cdromName, VolumeSource: newISOVolumeSource("newiso")})
+		app.VMInsertCDRomRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		Expect(recorder.Body.String()).To(ContainSubstring("the previous media is still being ejected"))
+	})
+
+	DescribeTable("should apply the change of media", func(withMedia bool, volume *v1.Volume, expectedVolumes []string, expectedError string) {
+		vm := newVM(withMedia, false)
+		volumes, err := applyCDRomMedia(&vm.Spec.Template.Spec, cdromName, volume)
+		if expectedError != "" {
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		var names []string
+		for _, volume := range volumes {
+			names = append(names, volume.Name)
+		}
+		Expect(names).To(Equal(expectedVolumes))
+	},
+		Entry("ejecting the media", true, nil, []string{"rootdisk"}, ""),
+		Entry("ejecting an empty tray", false, nil, nil, "its tray is already empty"),
+		Entry("inserting into an empty tray", false, &v1.Volume{Name: cdromName, VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "newiso"}},
+		}}, []string{"rootdisk", cdromName}, ""),
+		Entry("inserting into a tray which is not empty", true, &v1.Volume{Name: cdromName, VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "newiso"}},
+		}}, nil, "its tray is not empty"),
+		Entry("inserting a source used by another volume", false, &v1.Volume{Name: cdromName, VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "rootdisk"}},
+		}}, nil, "already used by volume [rootdisk]"),
+	)
+
+	It("should not change the media of a disk which is not a CD-ROM", func() {
+		vm := newVM(true, false)
+		_, err := applyCDRomMedia(&vm.Spec.Template.Spec, "rootdisk", nil)
+		Expect(err).To(MatchError(ContainSubstring("the disk is not a CD-ROM")))
+	})
+})
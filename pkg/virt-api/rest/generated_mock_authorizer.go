@@ -6,6 +6,7 @@ package rest
 import (
 	v3 "github.com/emicklei/go-restful/v3"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/authorization/v1"
 )
 
 // Mock of VirtApiAuthorizor interface
@@ -41,6 +42,17 @@ func (_mr *_MockVirtApiAuthorizorRecorder) Authorize(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Authorize", arg0)
 }
 
+func (_m *MockVirtApiAuthorizor) AuthorizeResource(req *v3.Request, attributes *v1.ResourceAttributes) (bool, error) {
+	ret := _m.ctrl.Call(_m, "AuthorizeResource", req, attributes)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtApiAuthorizorRecorder) AuthorizeResource(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AuthorizeResource", arg0, arg1)
+}
+
 func (_m *MockVirtApiAuthorizor) AddUserHeaders(header []string) {
 	_m.ctrl.Call(_m, "AddUserHeaders", header)
 }
@@ -550,6 +550,15 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 		return
 	}
 
+	dryRun, statusErr := isMigrateDryRun(request, bodyStruct)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if dryRun {
+		bodyStruct.DryRun = []string{metav1.DryRunAll}
+	}
+
 	createMigrationJob := func() *errors.StatusError {
 		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), &v1.VirtualMachineInstanceMigration{
 			ObjectMeta: metav1.ObjectMeta{
@@ -566,6 +575,23 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 		return nil
 	}
 
+	if dryRun {
+		feasibility, statusErr := app.migrationFeasibility(request, vmi, bodyStruct.AddedNodeSelector)
+		if statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
+		// the migration is only validated if it can take place, a rejection is reported as reason
+		if feasibility.Migratable {
+			if err = createMigrationJob(); err != nil {
+				feasibility.Migratable = false
+				feasibility.Reasons = append(feasibility.Reasons, err.Error())
+			}
+		}
+		response.WriteHeaderAndJson(http.StatusOK, feasibility, restful.MIME_JSON)
+		return
+	}
+
 	if err = createMigrationJob(); err != nil {
 		writeError(err, response)
 		return
@@ -36,6 +36,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 )
@@ -45,6 +46,8 @@ const (
 	pvcAccessModeErr          = "pvc access mode can't be read only"
 	pvcSizeErrFmt             = "pvc size [%s] should be bigger then [%s]"
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
+	memoryDumpFormatErrFmt    = "unsupported memory dump format [%s]"
+	memoryDumpExportErr       = "Unable to export the memory dump because VMExport feature gate is not enabled."
 )
 
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
@@ -109,6 +112,13 @@ func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInst
 }
 
 func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, memoryDumpReq *v1.VirtualMachineMemoryDumpRequest) *errors.StatusError {
+	if !memorydump.IsSupportedFormat(memoryDumpReq.Format) {
+		return errors.NewBadRequest(fmt.Sprintf(memoryDumpFormatErrFmt, memoryDumpReq.Format))
+	}
+	if memoryDumpReq.Export && !app.clusterConfig.VMExportEnabled() {
+		return errors.NewBadRequest(memoryDumpExportErr)
+	}
+
 	if memoryDumpReq.ClaimName == "" && vm.Status.MemoryDumpRequest == nil {
 		return errors.NewBadRequest("Memory dump requires claim name to be set")
 	} else if vm.Status.MemoryDumpRequest != nil && memoryDumpReq.ClaimName != "" {
@@ -222,6 +232,9 @@ func addMemoryDumpRequest(vm, vmCopy *v1.VirtualMachine, memoryDumpReq *v1.Virtu
 		if vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpCompleted && vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpFailed {
 			return fmt.Errorf("memory dump request for pvc [%s] already in progress", claimName)
 		}
+		// Keep track of the export of the previous memory dump
+		// so that it is removed before dumping again
+		memoryDumpReq.ExportName = vm.Status.MemoryDumpRequest.ExportName
 	}
 	vmCopy.Status.MemoryDumpRequest = memoryDumpReq
 	return nil
@@ -237,6 +250,7 @@ func removeMemoryDumpRequest(vm, vmCopy *v1.VirtualMachine, memoryDumpReq *v1.Vi
 		return fmt.Errorf("memory dump remove request for pvc [%s] already exists", claimName)
 	}
 	memoryDumpReq.ClaimName = claimName
+	memoryDumpReq.ExportName = vm.Status.MemoryDumpRequest.ExportName
 	vmCopy.Status.MemoryDumpRequest = memoryDumpReq
 	return nil
 }
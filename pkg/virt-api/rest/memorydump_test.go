@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -148,7 +149,7 @@ var _ = Describe("Memory dump Subresource api", func() {
 		cdiConfig := cdiConfigInit()
 		cdiClient = cdifake.NewSimpleClientset(cdiConfig)
 
-		app = NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+		app = NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -251,6 +252,18 @@ var _ = Describe("Memory dump Subresource api", func() {
 		Entry("VM with a memory dump request pvc size too small should fail", &v1.VirtualMachineMemoryDumpRequest{
 			ClaimName: testPVCName,
 		}, http.StatusConflict, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request with kdump format should succeed", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    v1.MemoryDumpFormatKdumpZlib,
+		}, http.StatusAccepted, true, true, createTestPVC("2Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request with unsupported format should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    "kdump-lz4",
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request with export but no VMExport feature gate should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Export:    true,
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
 	)
 
 	DescribeTable("With memory dump request", func(memDumpReq, prevMemDumpReq *v1.VirtualMachineMemoryDumpRequest, statusCode int) {
@@ -383,6 +396,31 @@ var _ = Describe("Memory dump Subresource api", func() {
 				}),
 			),
 			false, false),
+		Entry("add memory dump request to the same vol after completed should keep the previous export",
+			&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "vol1",
+				Phase:     v1.MemoryDumpAssociating,
+			},
+			&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName:  "vol1",
+				Phase:      v1.MemoryDumpCompleted,
+				Export:     true,
+				ExportName: pointer.P("memorydump-vm-vol1"),
+			},
+			patch.New(
+				patch.WithTest("/status/memoryDumpRequest", v1.VirtualMachineMemoryDumpRequest{
+					ClaimName:  "vol1",
+					Phase:      v1.MemoryDumpCompleted,
+					Export:     true,
+					ExportName: pointer.P("memorydump-vm-vol1"),
+				}),
+				patch.WithReplace("/status/memoryDumpRequest", v1.VirtualMachineMemoryDumpRequest{
+					ClaimName:  "vol1",
+					Phase:      v1.MemoryDumpAssociating,
+					ExportName: pointer.P("memorydump-vm-vol1"),
+				}),
+			),
+			false, false),
 		Entry("add memory dump request to the same vol while memory dump in progress should fail",
 			&v1.VirtualMachineMemoryDumpRequest{
 				ClaimName: "vol1",
@@ -467,5 +505,31 @@ var _ = Describe("Memory dump Subresource api", func() {
 				}),
 			),
 			false, true),
+		Entry("remove memory dump request to exported memory dump should keep the export",
+			&v1.VirtualMachineMemoryDumpRequest{
+				Phase:  v1.MemoryDumpDissociating,
+				Remove: true,
+			},
+			&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName:  "vol1",
+				Phase:      v1.MemoryDumpCompleted,
+				Export:     true,
+				ExportName: pointer.P("memorydump-vm-vol1"),
+			},
+			patch.New(
+				patch.WithTest("/status/memoryDumpRequest", v1.VirtualMachineMemoryDumpRequest{
+					ClaimName:  "vol1",
+					Phase:      v1.MemoryDumpCompleted,
+					Export:     true,
+					ExportName: pointer.P("memorydump-vm-vol1"),
+				}),
+				patch.WithReplace("/status/memoryDumpRequest", v1.VirtualMachineMemoryDumpRequest{
+					ClaimName:  "vol1",
+					Phase:      v1.MemoryDumpDissociating,
+					Remove:     true,
+					ExportName: pointer.P("memorydump-vm-vol1"),
+				}),
+			),
+			false, true),
 	)
 })
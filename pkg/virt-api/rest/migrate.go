@@ -0,0 +1,263 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const noFeasibleNodeReason = "no node satisfies the scheduling constraints of the migration target pod"
+
+// isMigrateDryRun returns true if a dry run is requested either with the dryRun query parameter or the migrate options
+func isMigrateDryRun(request *restful.Request, options *v1.MigrateOptions) (bool, *errors.StatusError) {
+	if value := request.QueryParameter(definitions.DryRunParamName); value != "" {
+		if value == metav1.DryRunAll {
+			return true, nil
+		}
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, errors.NewBadRequest(fmt.Sprintf("invalid dryRun value %q", value))
+		}
+		if dryRun {
+			return true, nil
+		}
+	}
+	return slices.Contains(options.DryRun, metav1.DryRunAll), nil
+}
+
+// migrationFeasibility evaluates the constraints the migration controller sets on the target pod against every node
+// except the source node, with the helpers the migration controller builds them with. Pod (anti-)affinity and resource availability are left to the scheduler.
+// The per-node detail is only reported to users who are allowed to list nodes.
+func (app *SubresourceAPIApp) migrationFeasibility(request *restful.Request, vmi *v1.VirtualMachineInstance, addedNodeSelector map[string]string) (*v1.MigrationFeasibility, *errors.StatusError) {
+	feasibility := &v1.MigrationFeasibility{}
+
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
+	if condition != nil && condition.Status == k8sv1.ConditionFalse {
+		feasibility.Reasons = append(feasibility.Reasons, fmt.Sprintf("VirtualMachineInstance is not live migratable: %s", condition.Message))
+	}
+
+	canListNodes, err := app.authorizor.AuthorizeResource(request, &authv1.ResourceAttributes{
+		Verb:     "list",
+		Version:  k8sv1.SchemeGroupVersion.Version,
+		Resource: "nodes",
+	})
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	sourcePod, err := app.findSourcePod(vmi)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	var nodes []*k8sv1.Node
+	var sourceNode *k8sv1.Node
+	for _, obj := range app.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Name == vmi.Status.NodeName {
+			sourceNode = node
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	nodeSelector, err := migrations.TargetPodNodeSelector(vmi, renderedNodeSelector(vmi, sourcePod), addedNodeSelector, sourcePod, sourceNode)
+	if err != nil {
+		feasibility.Reasons = append(feasibility.Reasons, err.Error())
+	}
+	affinity := migrations.TargetPodAffinity(vmi, vmi.Spec.Affinity)
+
+	feasibleNodes := 0
+	for _, node := range nodes {
+		reasons := rejectMigrationTargetNode(node, vmi, nodeSelector, affinity)
+		if len(reasons) == 0 {
+			feasibleNodes++
+		}
+		if !canListNodes {
+			continue
+		}
+		feasibility.Nodes = append(feasibility.Nodes, v1.NodeMigrationFeasibility{
+			Name:     node.Name,
+			Feasible: len(reasons) == 0,
+			Reasons:  reasons,
+		})
+	}
+	sort.Slice(feasibility.Nodes, func(i, j int) bool {
+		return feasibility.Nodes[i].Name < feasibility.Nodes[j].Name
+	})
+	if feasibleNodes == 0 {
+		feasibility.Reasons = append(feasibility.Reasons, noFeasibleNodeReason)
+	}
+
+	feasibility.Migratable = len(feasibility.Reasons) == 0
+	return feasibility, nil
+}
+
+// findSourcePod returns the virt-launcher pod running on the source node, or nil if there is none
+func (app *SubresourceAPIApp) findSourcePod(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	labelSelector := labels.SelectorFromSet(labels.Set{
+		v1.AppLabel:       "virt-launcher",
+		v1.CreatedByLabel: string(vmi.UID),
+	})
+	podList, err := app.virtCli.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+	for i, pod := range podList.Items {
+		if pod.Spec.NodeName == vmi.Status.NodeName && pod.Status.Phase == k8sv1.PodRunning {
+			return &podList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// renderedNodeSelector returns the node selector rendered for the launcher pod of the VMI, the source pod carries the
+// labels derived from the CPU of the VMI and the node selector of the VMI may have been updated since it was created
+func renderedNodeSelector(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod) map[string]string {
+	nodeSelector := map[string]string{}
+	if sourcePod != nil {
+		maps.Copy(nodeSelector, sourcePod.Spec.NodeSelector)
+	} else {
+		nodeSelector[v1.NodeSchedulable] = "true"
+	}
+	maps.Copy(nodeSelector, vmi.Spec.NodeSelector)
+	return nodeSelector
+}
+
+// rejectMigrationTargetNode returns the reasons why the migration target pod cannot be scheduled on the node
+func rejectMigrationTargetNode(node *k8sv1.Node, vmi *v1.VirtualMachineInstance, nodeSelector map[string]string, affinity *k8sv1.Affinity) []string {
+	var reasons []string
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, "node is cordoned")
+	}
+	if !isNodeReady(node) {
+		reasons = append(reasons, "node is not ready")
+	}
+
+	keys := slices.Sorted(maps.Keys(nodeSelector))
+	for _, key := range keys {
+		if value, ok := node.Labels[key]; ok && value == nodeSelector[key] {
+			continue
+		}
+		reasons = append(reasons, nodeSelectorMismatchReason(key, nodeSelector[key]))
+	}
+
+	if affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil && !matchesNodeSelectorTerms(node, required.NodeSelectorTerms) {
+			reasons = append(reasons, "node does not match the required node affinity")
+		}
+	}
+
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == k8sv1.TaintEffectPreferNoSchedule || isTaintTolerated(&taint, vmi.Spec.Tolerations) {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("node has the untolerated taint %s", taint.ToString()))
+	}
+	return reasons
+}
+
+func nodeSelectorMismatchReason(key, value string) string {
+	switch {
+	case key == v1.NodeSchedulable:
+		return "node is not schedulable for VirtualMachineInstances"
+	case strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU):
+		return fmt.Sprintf("node does not support the host-model CPU %s", strings.TrimPrefix(key, v1.SupportedHostModelMigrationCPU))
+	case strings.HasPrefix(key, v1.CPUModelLabel):
+		return fmt.Sprintf("node does not support the CPU model %s", strings.TrimPrefix(key, v1.CPUModelLabel))
+	case strings.HasPrefix(key, v1.CPUFeatureLabel):
+		return fmt.Sprintf("node does not support the CPU feature %s", strings.TrimPrefix(key, v1.CPUFeatureLabel))
+	default:
+		return fmt.Sprintf("node does not match the node selector %s=%s", key, value)
+	}
+}
+
+func isNodeReady(node *k8sv1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == k8sv1.NodeReady {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func isTaintTolerated(taint *k8sv1.Taint, tolerations []k8sv1.Toleration) bool {
+	for _, toleration := range tolerations {
+		if toleration.ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesNodeSelectorTerms returns true if the node matches any of the terms, the requirements of a term are ANDed
+func matchesNodeSelectorTerms(node *k8sv1.Node, terms []k8sv1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		if matchesNodeSelectorRequirements(labels.Set(node.Labels), term.MatchExpressions) &&
+			matchesNodeSelectorRequirements(labels.Set{"metadata.name": node.Name}, term.MatchFields) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorRequirements(set labels.Set, requirements []k8sv1.NodeSelectorRequirement) bool {
+	operators := map[k8sv1.NodeSelectorOperator]selection.Operator{
+		k8sv1.NodeSelectorOpIn:           selection.In,
+		k8sv1.NodeSelectorOpNotIn:        selection.NotIn,
+		k8sv1.NodeSelectorOpExists:       selection.Exists,
+		k8sv1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		k8sv1.NodeSelectorOpGt:           selection.GreaterThan,
+		k8sv1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	for _, requirement := range requirements {
+		operator, ok := operators[requirement.Operator]
+		if !ok {
+			return false
+		}
+		r, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+		if err != nil || !r.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
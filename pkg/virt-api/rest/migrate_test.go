@@ -0,0 +1,340 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Migrate dry run Subresource api", func() {
+	const (
+		sourceNodeName = "source"
+		vmiUID         = "1234"
+		cpuModel       = "Skylake"
+	)
+
+	var (
+		request       *restful.Request
+		recorder      *httptest.ResponseRecorder
+		response      *restful.Response
+		kubeClient    *fake.Clientset
+		migrateClient *kubecli.MockVirtualMachineInstanceMigrationInterface
+		nodeStore     cache.Store
+		app           *SubresourceAPIApp
+		vmi           *v1.VirtualMachineInstance
+		canListNodes  bool
+	)
+
+	newNode := func(name string, labels map[string]string) *k8sv1.Node {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					v1.NodeSchedulable:          "true",
+					v1.CPUModelLabel + cpuModel: "true",
+				},
+			},
+			Status: k8sv1.NodeStatus{
+				Conditions: []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+			},
+		}
+		for key, value := range labels {
+			node.Labels[key] = value
+		}
+		return node
+	}
+
+	addObjects := func(objects ...runtime.Object) {
+		for _, obj := range objects {
+			if node, ok := obj.(*k8sv1.Node); ok {
+				Expect(nodeStore.Add(node)).To(Succeed())
+				continue
+			}
+			Expect(kubeClient.Tracker().Add(obj)).To(Succeed())
+		}
+	}
+
+	setOptions := func(options *v1.MigrateOptions) {
+		body, err := json.Marshal(options)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	expectMigrationValidated := func() {
+		migrateClient.EXPECT().Create(context.Background(), gomock.Any(), metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}).Return(&v1.VirtualMachineInstanceMigration{}, nil)
+	}
+
+	migrateDryRun := func() *v1.MigrationFeasibility {
+		app.MigrateVMRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusOK), recorder.Body.String())
+		feasibility := &v1.MigrationFeasibility{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), feasibility)).To(Succeed())
+		return feasibility
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}, Header: http.Header{
+			userHeader:  []string{"user"},
+			groupHeader: []string{"group"},
+		}})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient := kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient := kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrateClient = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		nodeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		canListNodes = true
+		kubeClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+			review := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			Expect(review.Spec.User).To(Equal("user"))
+			Expect(review.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{Verb: "list", Version: "v1", Resource: "nodes"}))
+			review.Status.Allowed = canListNodes
+			return true, review, nil
+		})
+
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).Return(migrateClient).AnyTimes()
+		app = &SubresourceAPIApp{
+			virtCli:    virtClient,
+			nodeStore:  nodeStore,
+			authorizor: NewAuthorizorFromClient(kubeClient.AuthorizationV1().SubjectAccessReviews()),
+		}
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: testVMName, Namespace: metav1.NamespaceDefault, UID: vmiUID},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: sourceNodeName,
+			},
+		}
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(&v1.VirtualMachine{}, nil).AnyTimes()
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).DoAndReturn(func(_ context.Context, _ string, _ metav1.GetOptions) (*v1.VirtualMachineInstance, error) {
+			return vmi, nil
+		}).AnyTimes()
+
+		addObjects(newNode(sourceNodeName, nil), &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-" + testVMName,
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					v1.AppLabel:       "virt-launcher",
+					v1.CreatedByLabel: vmiUID,
+				},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: sourceNodeName,
+				NodeSelector: map[string]string{
+					v1.NodeSchedulable:          "true",
+					v1.CPUModelLabel + cpuModel: "true",
+				},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		})
+		setOptions(&v1.MigrateOptions{DryRun: withDryRun()})
+	})
+
+	It("should report which nodes could take the VirtualMachine", func() {
+		cordoned := newNode("node02", nil)
+		cordoned.Spec.Unschedulable = true
+		addObjects(newNode("node03", map[string]string{v1.CPUModelLabel + cpuModel: "false"}), cordoned, newNode("node01", nil))
+		expectMigrationValidated()
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeTrue())
+		Expect(feasibility.Reasons).To(BeEmpty())
+		Expect(feasibility.Nodes).To(Equal([]v1.NodeMigrationFeasibility{
+			{Name: "node01", Feasible: true},
+			{Name: "node02", Reasons: []string{"node is cordoned"}},
+			{Name: "node03", Reasons: []string{"node does not support the CPU model " + cpuModel}},
+		}))
+	})
+
+	It("should only report the verdict to users who cannot list nodes", func() {
+		canListNodes = false
+		cordoned := newNode("node02", nil)
+		cordoned.Spec.Unschedulable = true
+		addObjects(cordoned, newNode("node01", nil))
+		expectMigrationValidated()
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeTrue())
+		Expect(feasibility.Reasons).To(BeEmpty())
+		Expect(feasibility.Nodes).To(BeEmpty())
+	})
+
+	It("should report no feasible node to users who cannot list nodes", func() {
+		canListNodes = false
+		cordoned := newNode("node01", nil)
+		cordoned.Spec.Unschedulable = true
+		addObjects(cordoned)
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeFalse())
+		Expect(feasibility.Reasons).To(ConsistOf(noFeasibleNodeReason))
+		Expect(feasibility.Nodes).To(BeEmpty())
+	})
+
+	DescribeTable("should reject a node", func(reject func(node *k8sv1.Node, vmi *v1.VirtualMachineInstance), expectedReason string) {
+		node := newNode("node01", nil)
+		reject(node, vmi)
+		addObjects(node)
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeFalse())
+		Expect(feasibility.Reasons).To(ConsistOf(noFeasibleNodeReason))
+		Expect(feasibility.Nodes).To(ConsistOf(v1.NodeMigrationFeasibility{Name: "node01", Reasons: []string{expectedReason}}))
+	},
+		Entry("which is not ready", func(node *k8sv1.Node, _ *v1.VirtualMachineInstance) {
+			node.Status.Conditions[0].Status = k8sv1.ConditionFalse
+		}, "node is not ready"),
+		Entry("which is not schedulable for VMIs", func(node *k8sv1.Node, _ *v1.VirtualMachineInstance) {
+			node.Labels[v1.NodeSchedulable] = "false"
+		}, "node is not schedulable for VirtualMachineInstances"),
+		Entry("which does not match the node selector of the VMI", func(_ *k8sv1.Node, vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.NodeSelector = map[string]string{"zone": "a"}
+		}, "node does not match the node selector zone=a"),
+		Entry("which does not match the required node affinity of the VMI", func(_ *k8sv1.Node, vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+						MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}},
+					}},
+				},
+			}}
+		}, "node does not match the required node affinity"),
+		Entry("with a taint not tolerated by the VMI", func(node *k8sv1.Node, vmi *v1.VirtualMachineInstance) {
+			node.Spec.Taints = []k8sv1.Taint{
+				{Key: "tolerated", Effect: k8sv1.TaintEffectNoSchedule},
+				{Key: "key", Value: "value", Effect: k8sv1.TaintEffectNoSchedule},
+			}
+			vmi.Spec.Tolerations = []k8sv1.Toleration{{Key: "tolerated", Operator: k8sv1.TolerationOpExists}}
+		}, "node has the untolerated taint key=value:NoSchedule"),
+	)
+
+	It("should complement the node selector with the added node selector", func() {
+		addObjects(newNode("node01", map[string]string{"rack": "1"}), newNode("node02", nil))
+		setOptions(&v1.MigrateOptions{DryRun: withDryRun(), AddedNodeSelector: map[string]string{"rack": "1"}})
+		expectMigrationValidated()
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeTrue())
+		Expect(feasibility.Nodes).To(Equal([]v1.NodeMigrationFeasibility{
+			{Name: "node01", Feasible: true},
+			{Name: "node02", Reasons: []string{"node does not match the node selector rack=1"}},
+		}))
+	})
+
+	It("should require the host-model CPU of the source node", func() {
+		vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+		Expect(nodeStore.Update(newNode(sourceNodeName, map[string]string{
+			v1.HostModelCPULabel + "Cascadelake":      "true",
+			v1.HostModelRequiredFeaturesLabel + "vmx": "true",
+		}))).To(Succeed())
+		addObjects(newNode("node01", map[string]string{
+			v1.SupportedHostModelMigrationCPU + "Cascadelake": "true",
+			v1.CPUFeatureLabel + "vmx":                        "true",
+		}), newNode("node02", map[string]string{
+			v1.SupportedHostModelMigrationCPU + "Cascadelake": "true",
+		}), newNode("node03", nil))
+		expectMigrationValidated()
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeTrue())
+		Expect(feasibility.Nodes).To(Equal([]v1.NodeMigrationFeasibility{
+			{Name: "node01", Feasible: true},
+			{Name: "node02", Reasons: []string{"node does not support the CPU feature vmx"}},
+			{Name: "node03", Reasons: []string{
+				"node does not support the CPU feature vmx",
+				"node does not support the host-model CPU Cascadelake",
+			}},
+		}))
+	})
+
+	It("should report a VirtualMachine which is not live migratable without validating the migration", func() {
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:    v1.VirtualMachineInstanceIsMigratable,
+			Status:  k8sv1.ConditionFalse,
+			Message: "cannot migrate VMI: PVC disk is not shared",
+		}}
+		addObjects(newNode("node01", nil))
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeFalse())
+		Expect(feasibility.Reasons).To(ConsistOf("VirtualMachineInstance is not live migratable: cannot migrate VMI: PVC disk is not shared"))
+		Expect(feasibility.Nodes).To(ConsistOf(v1.NodeMigrationFeasibility{Name: "node01", Feasible: true}))
+	})
+
+	It("should report a migration which is rejected", func() {
+		addObjects(newNode("node01", nil))
+		migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("in-flight migration detected"))
+
+		feasibility := migrateDryRun()
+		Expect(feasibility.Migratable).To(BeFalse())
+		Expect(feasibility.Reasons).To(ConsistOf(ContainSubstring("in-flight migration detected")))
+	})
+
+	It("should evaluate a dry run requested with the query parameter", func() {
+		request.Request.URL.RawQuery = "dryRun=true"
+		setOptions(&v1.MigrateOptions{})
+		addObjects(newNode("node01", nil))
+		expectMigrationValidated()
+
+		Expect(migrateDryRun().Migratable).To(BeTrue())
+	})
+
+	It("should fail with an invalid dryRun query parameter", func() {
+		request.Request.URL.RawQuery = "dryRun=maybe"
+
+		app.MigrateVMRequestHandler(request, response)
+		status := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(status.Error()).To(ContainSubstring(`invalid dryRun value "maybe"`))
+	})
+})
@@ -34,6 +34,7 @@ import (
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/cache"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -74,9 +75,11 @@ type SubresourceAPIApp struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	instancetypeExpander    instancetypeVMExpander
 	handlerHttpClient       *http.Client
+	nodeStore               cache.Store
+	authorizor              VirtApiAuthorizor
 }
 
-func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig) *SubresourceAPIApp {
+func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig, nodeStore cache.Store, authorizor VirtApiAuthorizor) *SubresourceAPIApp {
 	// When this method is called from tools/openapispec.go when running 'make generate',
 	// the virtCli is nil, and accessing GeneratedKubeVirtClient() would cause nil dereference.
 	var instancetypeExpander instancetypeVMExpander
@@ -104,6 +107,8 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		clusterConfig:           clusterConfig,
 		instancetypeExpander:    instancetypeExpander,
 		handlerHttpClient:       httpClient,
+		nodeStore:               nodeStore,
+		authorizor:              authorizor,
 	}
 }
 
@@ -329,6 +334,21 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// GuestMetrics handles the subresource for providing a sample of the guest resource usage
+func (app *SubresourceAPIApp) GuestMetrics(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestMetricsURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceGuestMetrics{})
+}
+
 func decodeBody(request *restful.Request, bodyStruct interface{}) *errors.StatusError {
 	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(&bodyStruct)
 	switch err {
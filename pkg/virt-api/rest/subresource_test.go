@@ -28,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -799,6 +800,10 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 	})
 
 	Context("Subresource api - MigrateVMRequestHandler", func() {
+		BeforeEach(func() {
+			request.Request.URL = &url.URL{}
+		})
+
 		DescribeTable("should fail if VirtualMachine not exists according to options", func(migrateOptions *v1.MigrateOptions) {
 
 			request.PathParameters()["name"] = testVMName
@@ -861,7 +866,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
 		},
 			Entry("with default", &v1.MigrateOptions{}),
-			Entry("with dry-run option", &v1.MigrateOptions{DryRun: withDryRun()}),
+			Entry("with addedNodeSelector", &v1.MigrateOptions{AddedNodeSelector: map[string]string{"key": "value"}}),
 		)
 
 		DescribeTable("should migrate VirtualMachine according to options", func(migrateOptions *v1.MigrateOptions) {
@@ -893,7 +898,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
 		},
 			Entry("with default", &v1.MigrateOptions{}),
-			Entry("with dry-run option", &v1.MigrateOptions{DryRun: withDryRun()}),
+			Entry("with addedNodeSelector", &v1.MigrateOptions{AddedNodeSelector: map[string]string{"key": "value"}}),
 		)
 	})
 
@@ -915,6 +920,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for GuestMetrics", app.GuestMetrics),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -934,6 +940,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for GuestMetrics", app.GuestMetrics),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -84,6 +85,7 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 		if err = defaults.SetDefaultVirtualMachineInstance(mutator.ClusterConfig, newVMI); err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
 		}
+		storagetypes.ApplyDiskIOTuneLimits(newVMI.Spec.Domain.Devices.Disks, mutator.ClusterConfig.GetDiskIOTuneLimits())
 
 		if newVMI.Spec.Domain.CPU.IsolateEmulatorThread {
 			_, emulatorThreadCompleteToEvenParityAnnotationExists := mutator.ClusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
@@ -129,6 +131,11 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		// Disks which lost their I/O limits or were hotplugged get the limits of the cluster
+		if storagetypes.ApplyDiskIOTuneLimits(newVMI.Spec.Domain.Devices.Disks, mutator.ClusterConfig.GetDiskIOTuneLimits()) {
+			patchSet.AddOption(patch.WithReplace("/spec/domain/devices/disks", newVMI.Spec.Domain.Devices.Disks))
+		}
+
 	}
 
 	if patchSet.IsEmpty() {
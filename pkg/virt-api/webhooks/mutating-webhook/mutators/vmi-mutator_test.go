@@ -529,6 +529,66 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(vmiSpec.Domain.Devices.Disks[1].Name).To(Equal(missingVolumeName))
 	})
 
+	Context("with disk I/O limits of the cluster", func() {
+		clusterIOTune := &v1.DiskIOTune{
+			TotalIOPS: pointer.P(int64(1000)),
+			Burst:     &v1.DiskIOTuneBurst{TotalIOPS: pointer.P(int64(2000))},
+		}
+
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DiskIOTuneLimits: clusterIOTune,
+					},
+				},
+			})
+		})
+
+		It("should give the disks without limits the limits of the cluster on VMI create", func() {
+			diskIOTune := &v1.DiskIOTune{ReadIOPS: pointer.P(int64(100))}
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "unlimited"},
+				{Name: "limited", IOTune: diskIOTune},
+				{Name: "cdrom", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+			}
+
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.Devices.Disks[0].IOTune).To(Equal(clusterIOTune))
+			Expect(vmiSpec.Domain.Devices.Disks[1].IOTune).To(Equal(diskIOTune))
+			Expect(vmiSpec.Domain.Devices.Disks[2].IOTune).To(BeNil())
+		})
+
+		It("should give the disks which lost their limits the limits of the cluster on VMI update", func() {
+			oldVMI := vmi.DeepCopy()
+			oldVMI.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: &v1.DiskIOTune{TotalIOPS: pointer.P(int64(100))}}}
+			newVMI := oldVMI.DeepCopy()
+			newVMI.Spec.Domain.Devices.Disks[0].IOTune = nil
+
+			oldVMIBytes, err := json.Marshal(oldVMI)
+			Expect(err).ToNot(HaveOccurred())
+			newVMIBytes, err := json.Marshal(newVMI)
+			Expect(err).ToNot(HaveOccurred())
+			resp := mutator.Mutate(&admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					Resource:  k8smetav1.GroupVersionResource{Group: v1.VirtualMachineInstanceGroupVersionKind.Group, Version: v1.VirtualMachineInstanceGroupVersionKind.Version, Resource: "virtualmachineinstances"},
+					Object:    runtime.RawExtension{Raw: newVMIBytes},
+					OldObject: runtime.RawExtension{Raw: oldVMIBytes},
+				},
+			})
+			Expect(resp.Allowed).To(BeTrue())
+
+			var disks []v1.Disk
+			patchOps := []patch.PatchOperation{{Value: &disks}}
+			Expect(json.Unmarshal(resp.Patch, &patchOps)).To(Succeed())
+			Expect(patchOps).To(HaveLen(1))
+			Expect(patchOps[0].Path).To(Equal("/spec/domain/devices/disks"))
+			Expect(disks).To(HaveLen(1))
+			Expect(disks[0].IOTune).To(Equal(clusterIOTune))
+		})
+	})
+
 	It("should set defaults for input devices", func() {
 		vmi.Spec.Domain.Devices.Inputs = []v1.Input{{
 			Name: "default-0",
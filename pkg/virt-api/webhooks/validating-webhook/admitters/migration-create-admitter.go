@@ -23,6 +23,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
@@ -34,17 +36,26 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
+	"kubevirt.io/kubevirt/pkg/controller"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 type MigrationCreateAdmitter struct {
-	virtClient kubevirt.Interface
+	virtClient              kubevirt.Interface
+	clusterConfig           *virtconfig.ClusterConfig
+	kubeVirtServiceAccounts map[string]struct{}
 }
 
-func NewMigrationCreateAdmitter(virtClient kubevirt.Interface) *MigrationCreateAdmitter {
+func NewMigrationCreateAdmitter(virtClient kubevirt.Interface, clusterConfig *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) *MigrationCreateAdmitter {
 	return &MigrationCreateAdmitter{
-		virtClient: virtClient,
+		virtClient:              virtClient,
+		clusterConfig:           clusterConfig,
+		kubeVirtServiceAccounts: kubeVirtServiceAccounts,
 	}
 }
 
@@ -58,6 +69,20 @@ func isMigratable(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// validateMigrationPriority keeps the system priorities to the KubeVirt components which evacuate
+// nodes and update workloads. Migrations of users are capped at user-triggered, so that they can't
+// overtake evacuations.
+func validateMigrationPriority(field *k8sfield.Path, priority *v1.MigrationPriority, isKubeVirtServiceAccount bool) []metav1.StatusCause {
+	if priority == nil || *priority == v1.MigrationPriorityUserTriggered || isKubeVirtServiceAccount {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s priority %s is reserved for KubeVirt, only %s is allowed", field.String(), *priority, v1.MigrationPriorityUserTriggered),
+		Field:   field.String(),
+	}}
+}
+
 func ensureNoMigrationConflict(ctx context.Context, virtClient kubevirt.Interface, vmiName string, namespace string) error {
 	labelSelector, err := labels.Parse(fmt.Sprintf("%s in (%s)", v1.MigrationSelectorLabel, vmiName))
 	if err != nil {
@@ -92,6 +117,9 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	}
 
 	causes := ValidateVirtualMachineInstanceMigrationSpec(k8sfield.NewPath("spec"), &migration.Spec)
+	causes = append(causes, validateCrossClusterMigration(k8sfield.NewPath("spec"), &migration.Spec, admitter.clusterConfig)...)
+	_, isKubeVirtServiceAccount := admitter.kubeVirtServiceAccounts[ar.Request.UserInfo.Username]
+	causes = append(causes, validateMigrationPriority(k8sfield.NewPath("spec", "priority"), migration.Spec.Priority, isKubeVirtServiceAccount)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -115,6 +143,10 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	if err := validateCrossClusterMigrationVMI(&migration.Spec, vmi); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
 	// Don't allow new migration jobs to be introduced when previous migration jobs
 	// are already in flight.
 	err = ensureNoMigrationConflict(ctx, admitter.virtClient, migration.Spec.VMIName, migration.Namespace)
@@ -167,3 +199,89 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 
 	return causes
 }
+
+func validateCrossClusterMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceMigrationSpec, clusterConfig *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.SendTo == nil && spec.Receive == nil {
+		return nil
+	}
+	if !clusterConfig.CrossClusterLiveMigrationEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("cross-cluster live migration requires the %s feature gate", featuregate.CrossClusterLiveMigrationGate),
+			Field:   field.String(),
+		}}
+	}
+	if spec.SendTo != nil && spec.Receive != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "a migration can either send to or receive from another cluster",
+			Field:   field.Child("sendTo").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if spec.SendTo != nil {
+		if spec.SendTo.MigrationID == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "migrationID is missing",
+				Field:   field.Child("sendTo", "migrationID").String(),
+			})
+		}
+		if err := validateConnectURL(spec.SendTo.ConnectURL); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid connectURL %q: %v", spec.SendTo.ConnectURL, err),
+				Field:   field.Child("sendTo", "connectURL").String(),
+			})
+		}
+	}
+	if spec.Receive != nil && spec.Receive.MigrationID == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "migrationID is missing",
+			Field:   field.Child("receive", "migrationID").String(),
+		})
+	}
+	return causes
+}
+
+// validateCrossClusterMigrationVMI ensures that only a VMI waiting for a cross-cluster migration receives one, and
+// that the VMI does not depend on state which is only handed over between the nodes of one cluster
+func validateCrossClusterMigrationVMI(spec *v1.VirtualMachineInstanceMigrationSpec, vmi *v1.VirtualMachineInstance) error {
+	isReceiver := migrationutil.IsCrossClusterMigrationReceiver(vmi)
+	if spec.Receive == nil && isReceiver {
+		return fmt.Errorf("VMI %s/%s waits to receive a cross-cluster migration and can only be migrated by a migration with spec.receive", vmi.Namespace, vmi.Name)
+	}
+	if spec.Receive != nil && !isReceiver {
+		return fmt.Errorf("VMI %s/%s can't receive a cross-cluster migration, it has to be created with the %s annotation and must not be started", vmi.Namespace, vmi.Name, v1.CrossClusterMigrationReceiverAnnotation)
+	}
+	if spec.Receive == nil && spec.SendTo == nil {
+		return nil
+	}
+
+	switch {
+	case vmi.IsCPUDedicated():
+		return fmt.Errorf("cross-cluster migration of VMIs with dedicated CPUs is not supported")
+	case backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec):
+		return fmt.Errorf("cross-cluster migration of VMIs with persistent state is not supported")
+	case controller.VMIHasHotplugVolumes(vmi):
+		return fmt.Errorf("cross-cluster migration of VMIs with hotplugged volumes is not supported")
+	}
+	return nil
+}
+
+// validateConnectURL ensures the connect URL is a host:port pair
+func validateConnectURL(connectURL string) error {
+	host, port, err := net.SplitHostPort(connectURL)
+	if err != nil {
+		return err
+	}
+	if host == "" {
+		return fmt.Errorf("host is missing")
+	}
+	if portNumber, err := strconv.Atoi(port); err != nil || portNumber < 1 || portNumber > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,8 +35,10 @@ import (
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating MigrationCreate Admitter", func() {
@@ -60,7 +63,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			},
 		}
 		virtClient := kubevirtfake.NewSimpleClientset(vmi, inFlightMigration)
-		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+		migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 		ar, err := newAdmissionReviewForVMIMCreation(migration)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -80,7 +83,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset()
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -102,7 +105,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -128,7 +131,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -150,7 +153,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -183,7 +186,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
@@ -193,6 +196,166 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		Context("priority", func() {
+			DescribeTable("should", func(priority v1.MigrationPriority, username string, allowed bool) {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				migration := &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: vmi.Namespace,
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName:  vmi.Name,
+						Priority: &priority,
+					},
+				}
+				ar, err := newAdmissionReviewForVMIMCreation(migration)
+				Expect(err).ToNot(HaveOccurred())
+				ar.Request.UserInfo = authv1.UserInfo{Username: username}
+
+				resp := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi)).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.priority"))
+				}
+			},
+				Entry("accept user-triggered from a user", v1.MigrationPriorityUserTriggered, "tenant", true),
+				Entry("reject system-critical from a user", v1.MigrationPrioritySystemCritical, "tenant", false),
+				Entry("reject system-maintenance from a user", v1.MigrationPrioritySystemMaintenance, "tenant", false),
+				Entry("reject system-critical from a service account of another namespace",
+					v1.MigrationPrioritySystemCritical, "system:serviceaccount:default:kubevirt-controller", false),
+				Entry("accept system-critical from virt-controller",
+					v1.MigrationPrioritySystemCritical, "system:serviceaccount:kubevirt:kubevirt-controller", true),
+				Entry("accept system-maintenance from virt-controller",
+					v1.MigrationPrioritySystemMaintenance, "system:serviceaccount:kubevirt:kubevirt-controller", true),
+			)
+		})
+
+		Context("cross-cluster migration", func() {
+			newCrossClusterMigration := func(vmiName string, sendTo *v1.VirtualMachineInstanceMigrationSendTo, receive *v1.VirtualMachineInstanceMigrationReceive) *v1.VirtualMachineInstanceMigration {
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: k8sv1.NamespaceDefault,
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmiName,
+						SendTo:  sendTo,
+						Receive: receive,
+					},
+				}
+			}
+
+			It("should accept a valid sending migration", func() {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				migration := newCrossClusterMigration(vmi.Name, &v1.VirtualMachineInstanceMigrationSendTo{
+					MigrationID: "decommission",
+					ConnectURL:  "migration.example.com:49154",
+				}, nil)
+				ar, err := newAdmissionReviewForVMIMCreation(migration)
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), featuregate.CrossClusterLiveMigrationGate).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			DescribeTable("should reject", func(sendTo *v1.VirtualMachineInstanceMigrationSendTo, receive *v1.VirtualMachineInstanceMigrationReceive, featureGates []string, expectedField string) {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration(vmi.Name, sendTo, receive))
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), featureGates...).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("a cross-cluster migration without the feature gate",
+					nil, &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"}, nil, "spec"),
+				Entry("a migration sending and receiving",
+					&v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "decommission", ConnectURL: "migration.example.com:49154"},
+					&v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"},
+					[]string{featuregate.CrossClusterLiveMigrationGate}, "spec.sendTo"),
+				Entry("a sending migration without a migration ID",
+					&v1.VirtualMachineInstanceMigrationSendTo{ConnectURL: "migration.example.com:49154"}, nil,
+					[]string{featuregate.CrossClusterLiveMigrationGate}, "spec.sendTo.migrationID"),
+				Entry("a sending migration without a port",
+					&v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "decommission", ConnectURL: "migration.example.com"}, nil,
+					[]string{featuregate.CrossClusterLiveMigrationGate}, "spec.sendTo.connectURL"),
+				Entry("a sending migration with an invalid port",
+					&v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "decommission", ConnectURL: "migration.example.com:70000"}, nil,
+					[]string{featuregate.CrossClusterLiveMigrationGate}, "spec.sendTo.connectURL"),
+				Entry("a receiving migration without a migration ID",
+					nil, &v1.VirtualMachineInstanceMigrationReceive{},
+					[]string{featuregate.CrossClusterLiveMigrationGate}, "spec.receive.migrationID"),
+			)
+
+			newReceiverVMI := func() *v1.VirtualMachineInstance {
+				vmi := libvmi.New(
+					libvmi.WithNamespace(k8sv1.NamespaceDefault),
+					libvmi.WithAnnotation(v1.CrossClusterMigrationReceiverAnnotation, ""),
+				)
+				vmi.Status.Phase = v1.Pending
+				return vmi
+			}
+
+			It("should accept a receiving migration for a VMI which waits for it", func() {
+				vmi := newReceiverVMI()
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration(vmi.Name, nil, &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"}))
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), featuregate.CrossClusterLiveMigrationGate).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			DescribeTable("should reject the VMI of", func(vmi *v1.VirtualMachineInstance, sendTo *v1.VirtualMachineInstanceMigrationSendTo, receive *v1.VirtualMachineInstanceMigrationReceive, expectedMessage string) {
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration(vmi.Name, sendTo, receive))
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), featuregate.CrossClusterLiveMigrationGate).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(ContainSubstring(expectedMessage))
+			},
+				Entry("a receiving migration which does not wait for it",
+					func() *v1.VirtualMachineInstance {
+						vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+						vmi.Status.Phase = v1.Running
+						return vmi
+					}(),
+					nil, &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"},
+					"it has to be created with the kubevirt.io/crossClusterMigrationReceiver annotation"),
+				Entry("a receiving migration which is already started",
+					func() *v1.VirtualMachineInstance {
+						vmi := newReceiverVMI()
+						vmi.Status.Phase = v1.Running
+						return vmi
+					}(),
+					nil, &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"},
+					"it has to be created with the kubevirt.io/crossClusterMigrationReceiver annotation"),
+				Entry("a migration within the cluster which waits for a cross-cluster migration",
+					newReceiverVMI(), nil, nil,
+					"can only be migrated by a migration with spec.receive"),
+				Entry("a sending migration with dedicated CPUs",
+					libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault), libvmi.WithDedicatedCPUPlacement()),
+					&v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "decommission", ConnectURL: "migration.example.com:49154"}, nil,
+					"cross-cluster migration of VMIs with dedicated CPUs is not supported"),
+				Entry("a receiving migration with hotplugged volumes",
+					func() *v1.VirtualMachineInstance {
+						vmi := newReceiverVMI()
+						vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+							Name: "hotplug",
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "hotplug"},
+									Hotpluggable:                      true,
+								},
+							},
+						})
+						return vmi
+					}(),
+					nil, &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "decommission"},
+					"cross-cluster migration of VMIs with hotplugged volumes is not supported"),
+			)
+		})
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -213,18 +376,27 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
 			),
 			Entry("Migration update",
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
 			),
 		)
 	})
 })
 
+func newMigrationCreateAdmitter(virtClient *kubevirtfake.Clientset, featureGates ...string) *admitters.MigrationCreateAdmitter {
+	config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+		DeveloperConfiguration: &v1.DeveloperConfiguration{
+			FeatureGates: featureGates,
+		},
+	})
+	return admitters.NewMigrationCreateAdmitter(virtClient, config, webhooks.KubeVirtServiceAccounts("kubevirt"))
+}
+
 func newAdmissionReviewForVMIMCreation(migration *v1.VirtualMachineInstanceMigration) (*admissionv1.AdmissionReview, error) {
 	migrationBytes, err := json.Marshal(migration)
 	if err != nil {
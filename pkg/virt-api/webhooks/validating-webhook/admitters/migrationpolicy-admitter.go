@@ -33,16 +33,21 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 // MigrationPolicyAdmitter validates VirtualMachineSnapshots
 type MigrationPolicyAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
 }
 
 // NewMigrationPolicyAdmitter creates a MigrationPolicyAdmitter
-func NewMigrationPolicyAdmitter() *MigrationPolicyAdmitter {
-	return &MigrationPolicyAdmitter{}
+func NewMigrationPolicyAdmitter(clusterConfig *virtconfig.ClusterConfig) *MigrationPolicyAdmitter {
+	return &MigrationPolicyAdmitter{
+		ClusterConfig: clusterConfig,
+	}
 }
 
 // Admit validates an AdmissionReview
@@ -87,6 +92,23 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		}
 	}
 
+	if spec.Compression != nil {
+		causes = append(causes, migrationsutil.ValidateCompression(sourceField.Child("compression"), spec.Compression)...)
+	}
+
+	if spec.MaxDowntime != nil && spec.MaxDowntime.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("maxDowntime").String(),
+		})
+	}
+
+	if spec.SwitchoverPolicy != nil {
+		progressTimeout := admitter.ClusterConfig.GetMigrationConfiguration().ProgressTimeout
+		causes = append(causes, migrationsutil.ValidateSwitchoverPolicy(sourceField.Child("switchoverPolicy"), spec.SwitchoverPolicy, progressTimeout)...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -22,9 +22,11 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -38,6 +40,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
 var _ = Describe("Validating MigrationPolicy Admitter", func() {
@@ -45,7 +48,8 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 	var policyName string
 
 	BeforeEach(func() {
-		admitter = &MigrationPolicyAdmitter{}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		admitter = NewMigrationPolicyAdmitter(config)
 		policyName = "test-policy"
 	})
 
@@ -64,6 +68,73 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("unknown compression mode",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Mode: "lz4"}},
+		),
+
+		Entry("xbzrle cache size without xbzrle compression",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{
+				Mode:            v1.MigrationCompressionMultifdZstd,
+				XBZRLECacheSize: resource.NewScaledQuantity(64, resource.Mega),
+			}},
+		),
+
+		Entry("zero xbzrle cache size",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{
+				Mode:            v1.MigrationCompressionXBZRLE,
+				XBZRLECacheSize: resource.NewScaledQuantity(0, 1),
+			}},
+		),
+
+		Entry("zstd level without multifd-zstd compression",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionXBZRLE, ZstdLevel: pointer.P(int32(3))}},
+		),
+
+		Entry("zstd level out of range",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd, ZstdLevel: pointer.P(int32(21))}},
+		),
+
+		Entry("zero MaxDowntime",
+			migrationsv1.MigrationPolicySpec{MaxDowntime: &metav1.Duration{}},
+		),
+
+		Entry("unknown switchover action",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: "Pause", After: &metav1.Duration{Duration: time.Minute}},
+			}}},
+		),
+
+		Entry("switchover step without condition",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: v1.MigrationSwitchoverPostCopy},
+			}}},
+		),
+
+		Entry("raise downtime step without downtime",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: v1.MigrationSwitchoverRaiseDowntime, After: &metav1.Duration{Duration: time.Minute}},
+			}}},
+		),
+
+		Entry("switchover step stalled for the migration progress timeout",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: v1.MigrationSwitchoverPostCopy, StalledFor: &metav1.Duration{Duration: 150 * time.Second}},
+			}}},
+		),
+
+		Entry("switchover step after the abort step",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: v1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: time.Minute}},
+				{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: 2 * time.Minute}},
+			}}},
+		),
+
+		Entry("auto-converge step",
+			migrationsv1.MigrationPolicySpec{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: "AutoConverge", After: &metav1.Duration{Duration: time.Minute}},
+			}}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -93,6 +164,33 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
+
+		Entry("max downtime and switchover policy",
+			migrationsv1.MigrationPolicySpec{
+				MaxDowntime:       &metav1.Duration{Duration: 500 * time.Millisecond},
+				AllowAutoConverge: pointer.P(true),
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverRaiseDowntime, StalledFor: &metav1.Duration{Duration: 30 * time.Second}, MaxDowntime: &metav1.Duration{Duration: 2 * time.Second}},
+					{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: 3 * time.Minute}},
+					{Action: v1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: 5 * time.Minute}},
+				}},
+			},
+		),
+
+		Entry("xbzrle compression with cache size",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{
+				Mode:            v1.MigrationCompressionXBZRLE,
+				XBZRLECacheSize: resource.NewScaledQuantity(256, resource.Mega),
+			}},
+		),
+
+		Entry("multifd-zstd compression with level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd, ZstdLevel: pointer.P(int32(5))}},
+		),
+
+		Entry("disabled compression",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionNone}},
+		),
 	)
 })
 
@@ -44,6 +44,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
@@ -57,6 +58,8 @@ const requiredFieldFmt = "%s is a required field"
 
 const (
 	maxStrLen = 256
+	// The serial number field of a NVMe controller is 20 bytes long
+	maxNVMeSerialLen = 20
 
 	// Should be a power of 2
 	minCustomBlockSize = 512
@@ -201,6 +204,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	}
 
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
+	causes = append(causes, validateDiskIOTuneLimits(field.Child("domain", "devices", "disks"), spec.Domain.Devices.Disks, config.GetDiskIOTuneLimits())...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
 	causes = append(causes, validateContainerDisks(field, spec)...)
 
@@ -285,7 +289,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || volume.Backup != nil {
 			continue
 		}
 		if _, matchingDiskExists := diskAndFilesystemNames[volume.Name]; !matchingDiskExists {
@@ -598,7 +602,9 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 
 		matchingVolume, volumeExists := volumeNameMap[disk.Name]
 
-		if !volumeExists {
+		// A CD-ROM without a volume has an empty tray
+		emptyTray := disk.CDRom != nil && disk.Disk == nil && disk.LUN == nil
+		if !volumeExists && !emptyTray {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf(nameOfTypeNotFoundMessagePattern, field.Child("domain", "devices", "disks").Index(idx).Child("Name").String(), disk.Name),
@@ -632,6 +638,11 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 			}
 		}
 
+		// Verify that vhostUserBlk is mapped to a virtio disk
+		if volumeExists && matchingVolume.VhostUserBlk != nil {
+			causes = append(causes, validateVhostUserBlkDisk(field.Child("domain", "devices", "disks").Index(idx), &disk)...)
+		}
+
 		// verify that there are no duplicate boot orders
 		if disk.BootOrder != nil {
 			order := *disk.BootOrder
@@ -651,6 +662,51 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 	return causes
 }
 
+// validateVhostUserBlkDisk rejects the disk settings which are handled by the QEMU block layer, vhost-user-blk
+// disks are served by the storage daemon of the node instead
+func validateVhostUserBlkDisk(field *k8sfield.Path, disk *v1.Disk) []metav1.StatusCause {
+	if disk.Disk == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("vhostUserBlk volume must be mapped to a disk, but disk is not set on %v.", field.Child("disk").String()),
+			Field:   field.Child("disk").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if disk.Disk.Bus != v1.DiskBusVirtio && disk.Disk.Bus != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("vhostUserBlk volume must be mapped to virtio bus, but %v is set to %v", field.Child("disk", "bus").String(), disk.Disk.Bus),
+			Field:   field.Child("disk", "bus").String(),
+		})
+	}
+
+	for _, setting := range []struct {
+		field *k8sfield.Path
+		isSet bool
+	}{
+		{field.Child("cache"), disk.Cache != ""},
+		{field.Child("io"), disk.IO != ""},
+		{field.Child("errorPolicy"), disk.ErrorPolicy != nil},
+		{field.Child("blockSize"), disk.BlockSize != nil},
+		{field.Child("ioTune"), disk.IOTune != nil},
+		{field.Child("serial"), disk.Serial != ""},
+		{field.Child("shareable"), disk.Shareable != nil},
+		{field.Child("dedicatedIOThread"), disk.DedicatedIOThread != nil && *disk.DedicatedIOThread},
+		{field.Child("disk", "readonly"), disk.Disk.ReadOnly},
+	} {
+		if setting.isSet {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported on a disk with a vhostUserBlk volume", setting.field.String()),
+				Field:   setting.field.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.Features != nil {
@@ -1691,6 +1747,19 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		if volume.MemoryDump != nil {
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
+			if !memorydump.IsSupportedFormat(volume.MemoryDump.Format) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("%s is not a supported memory dump format", volume.MemoryDump.Format),
+					Field:   field.Index(idx).Child("memoryDump", "format").String(),
+				})
+			}
+		}
+		if volume.Backup != nil {
+			volumeSourceSetCount++
+		}
+		if volume.VhostUserBlk != nil {
+			volumeSourceSetCount++
 		}
 
 		if volumeSourceSetCount != 1 {
@@ -1881,6 +1950,31 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				})
 			}
 		}
+
+		if vhostUserBlk := volume.VhostUserBlk; vhostUserBlk != nil {
+			if !config.VhostUserBlkEnabled() {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "VhostUserBlk feature gate is not enabled",
+					Field:   field.Index(idx).String(),
+				})
+			}
+			socket := vhostUserBlk.Socket
+			if socket == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotFound,
+					Message: fmt.Sprintf(requiredFieldFmt, field.Index(idx).Child("vhostUserBlk", "socket").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "socket").String(),
+				})
+			} else if strings.Contains(socket, "/") || socket == "." || socket == ".." {
+				// The sockets are scoped per namespace on the node, a path could reach the sockets of another namespace
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be the name of a socket, not a path", field.Index(idx).Child("vhostUserBlk", "socket").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "socket").String(),
+				})
+			}
+		}
 	}
 
 	if serviceAccountVolumeCount > 1 {
@@ -1959,10 +2053,10 @@ func validatePciAddress(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 		return causes
 	}
 
-	if disk.Disk.Bus != v1.DiskBusVirtio {
+	if disk.Disk.Bus != v1.DiskBusVirtio && disk.Disk.Bus != v1.DiskBusNVMe {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("disk %s - setting a PCI address is only possible with bus type virtio.", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String()),
+			Message: fmt.Sprintf("disk %s - setting a PCI address is only possible with bus type virtio or nvme.", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String()),
 			Field:   field.Child("domain", "devices", "disks", "disk").Index(idx).Child("pciAddress").String(),
 		})
 	}
@@ -2047,10 +2141,27 @@ func validateBusSupport(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 				Field:   field.Index(idx).Child("disk", "bus").String(),
 			})
 		}
+	case v1.DiskBusNVMe:
+		// NVMe namespaces can only be backed by hard-disks
+		if diskType != "disk" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Bus type %s is only supported for hard-disks", bus),
+				Field:   field.Index(idx).Child(diskType, "bus").String(),
+			})
+		}
+		// the serial is reported by the NVMe controller, which only has room for 20 characters
+		if len(disk.Serial) > maxNVMeSerialLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be less than or equal to %d in length for bus type %s", field.Index(idx).Child("serial").String(), maxNVMeSerialLen, bus),
+				Field:   field.Index(idx).Child("serial").String(),
+			})
+		}
 	case v1.DiskBusSCSI, v1.DiskBusUSB:
 		break
 	default:
-		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB}
+		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB, v1.DiskBusNVMe}
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s is set with an unrecognized bus %s, must be one of: %v", field.Index(idx).String(), bus, supportedBuses),
@@ -2210,10 +2321,223 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
 		causes = append(causes, validateBlockSize(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field.Index(idx).Child("ioTune"), disk.IOTune)...)
+	}
+	return causes
+}
+
+type ioTuneLimit struct {
+	name  string
+	value *int64
+	burst *int64
+}
+
+func quantityValue(quantity *resource.Quantity) *int64 {
+	if quantity == nil {
+		return nil
+	}
+	value := quantity.Value()
+	return &value
+}
+
+func ioTuneLimits(ioTune *v1.DiskIOTune) []ioTuneLimit {
+	burst := ioTune.Burst
+	if burst == nil {
+		burst = &v1.DiskIOTuneBurst{}
+	}
+	return []ioTuneLimit{
+		{"totalBytesPerSecond", quantityValue(ioTune.TotalBytesPerSecond), quantityValue(burst.TotalBytesPerSecond)},
+		{"readBytesPerSecond", quantityValue(ioTune.ReadBytesPerSecond), quantityValue(burst.ReadBytesPerSecond)},
+		{"writeBytesPerSecond", quantityValue(ioTune.WriteBytesPerSecond), quantityValue(burst.WriteBytesPerSecond)},
+		{"totalIOPS", ioTune.TotalIOPS, burst.TotalIOPS},
+		{"readIOPS", ioTune.ReadIOPS, burst.ReadIOPS},
+		{"writeIOPS", ioTune.WriteIOPS, burst.WriteIOPS},
+	}
+}
+
+func validateIOTune(field *k8sfield.Path, ioTune *v1.DiskIOTune) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if ioTune == nil {
+		return causes
+	}
+
+	limits := ioTuneLimits(ioTune)
+	hasBurst := false
+	for _, limit := range limits {
+		if limit.value != nil && *limit.value <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", field.Child(limit.name).String()),
+				Field:   field.Child(limit.name).String(),
+			})
+		}
+		if limit.burst == nil {
+			continue
+		}
+		hasBurst = true
+		burstField := field.Child("burst", limit.name)
+		switch {
+		case limit.value == nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires %s to be set", burstField.String(), field.Child(limit.name).String()),
+				Field:   burstField.String(),
+			})
+		case *limit.burst < *limit.value:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be lower than %s", burstField.String(), field.Child(limit.name).String()),
+				Field:   burstField.String(),
+			})
+		}
+	}
+
+	// the total limits of a kind exclude the read and write limits
+	for _, kind := range [][]ioTuneLimit{limits[0:3], limits[3:6]} {
+		total, read, write := kind[0], kind[1], kind[2]
+		if (total.value != nil || total.burst != nil) && (read.value != nil || read.burst != nil || write.value != nil || write.burst != nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can't be combined with %s or %s", field.Child(total.name).String(), read.name, write.name),
+				Field:   field.Child(total.name).String(),
+			})
+		}
+	}
+
+	if ioTune.Burst != nil && ioTune.Burst.LengthSeconds != nil {
+		lengthField := field.Child("burst", "lengthSeconds")
+		if *ioTune.Burst.LengthSeconds <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		} else if !hasBurst {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires a burst limit to be set", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateDiskIOTuneLimits rejects disks which allow more I/O than the cluster. Disks without a limit of a kind
+// get the limits of the cluster from the VMI mutator and are not rejected.
+func validateDiskIOTuneLimits(field *k8sfield.Path, disks []v1.Disk, clusterIOTune *v1.DiskIOTune) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if clusterIOTune == nil {
+		return causes
+	}
+
+	clusterLimits := ioTuneLimits(clusterIOTune)
+	value := func(limit ioTuneLimit) *int64 { return limit.value }
+	burst := func(limit ioTuneLimit) *int64 {
+		if limit.burst != nil {
+			return limit.burst
+		}
+		return limit.value
+	}
+	for idx, disk := range disks {
+		if disk.CDRom != nil || disk.IOTune == nil {
+			continue
+		}
+		ioTuneField := field.Index(idx).Child("ioTune")
+		diskLimits := ioTuneLimits(disk.IOTune)
+		bursts := false
+		for _, kind := range [][2]int{{0, 3}, {3, 6}} {
+			clusterKind, diskKind := clusterLimits[kind[0]:kind[1]], diskLimits[kind[0]:kind[1]]
+			if !hasIOTuneLimit(clusterKind) || !hasIOTuneLimit(diskKind) {
+				continue
+			}
+			causes = append(causes, validateIOTuneKindLimits(ioTuneField, diskKind, clusterKind, value)...)
+			// without a burst of the disk the limits are its bursts
+			if hasIOTuneBurst(diskKind) {
+				bursts = true
+				causes = append(causes, validateIOTuneKindLimits(ioTuneField.Child("burst"), diskKind, clusterKind, burst)...)
+			}
+		}
+
+		if bursts && clusterIOTune.Burst != nil {
+			lengthField := ioTuneField.Child("burst", "lengthSeconds")
+			if burstLength(disk.IOTune.Burst) > burstLength(clusterIOTune.Burst) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must not exceed %d, the limit of the cluster", lengthField.String(), burstLength(clusterIOTune.Burst)),
+					Field:   lengthField.String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func hasIOTuneLimit(kind []ioTuneLimit) bool {
+	for _, limit := range kind {
+		if limit.value != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIOTuneBurst(kind []ioTuneLimit) bool {
+	for _, limit := range kind {
+		if limit.burst != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIOTuneKindLimits compares the total, read and write limits of a kind. A total limit also limits the
+// reads and the writes, read and write limits together also limit the total.
+func validateIOTuneKindLimits(field *k8sfield.Path, diskKind, clusterKind []ioTuneLimit, get func(ioTuneLimit) *int64) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	diskCaps := ioTuneCaps(get(diskKind[0]), get(diskKind[1]), get(diskKind[2]))
+	for i, clusterLimit := range clusterKind {
+		clusterCap := get(clusterLimit)
+		if clusterCap == nil {
+			continue
+		}
+		limitField := field.Child(diskKind[i].name)
+		switch diskCap := diskCaps[i]; {
+		case diskCap == nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be set to at most %d, the limit of the cluster", limitField.String(), *clusterCap),
+				Field:   limitField.String(),
+			})
+		case *diskCap > *clusterCap:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not exceed %d, the limit of the cluster", limitField.String(), *clusterCap),
+				Field:   limitField.String(),
+			})
+		}
 	}
 	return causes
 }
 
+func ioTuneCaps(total, read, write *int64) []*int64 {
+	switch {
+	case total != nil:
+		return []*int64{total, total, total}
+	case read != nil && write != nil:
+		sum := *read + *write
+		return []*int64{&sum, read, write}
+	}
+	return []*int64{nil, read, write}
+}
+
+func burstLength(burst *v1.DiskIOTuneBurst) int64 {
+	if burst == nil || burst.LengthSeconds == nil {
+		return 1
+	}
+	return *burst.LengthSeconds
+}
+
 // Rejects kernel boot defined with initrd/kernel path but without an image
 func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav1.StatusCause {
 	var causes []metav1.StatusCause
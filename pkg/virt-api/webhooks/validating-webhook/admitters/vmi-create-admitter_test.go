@@ -547,6 +547,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].name"))
 		})
+		It("should accept a CD-ROM with an empty tray", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testcdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{
+						Bus: v1.DiskBusSATA,
+					},
+				},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
 		It("should allow supported audio devices", func() {
 			supportedDevices := [...]string{"", "ich9", "ac97"}
 			vmi := api.NewMinimalVMI("testvmi")
@@ -1993,6 +2008,56 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].pciAddress"))
 		})
 
+		It("should accept NVMe disks with a PCI address and a serial", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				Serial: "SN12345678",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						PciAddress: "0000:04:10.0",
+						Bus:        v1.DiskBusNVMe,
+					},
+				},
+			})
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject NVMe disks with a serial longer than 20 characters", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				Serial: "SN1234567890123456789",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.DiskBusNVMe,
+					},
+				},
+			})
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].serial"))
+		})
+
+		It("should reject LUNs on a NVMe bus", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					LUN: &v1.LunTarget{
+						Bus: v1.DiskBusNVMe,
+					},
+				},
+			})
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].lun.bus"))
+		})
+
 		It("should reject disk with multiple targets ", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -2048,6 +2113,9 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("ide bus", "ide", gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
 				"Message": Equal("IDE bus is not supported"),
 			})),
+			Entry("nvme bus", "nvme", gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Message": Equal("Bus type nvme is only supported for hard-disks"),
+			})),
 		)
 
 		DescribeTable("should accept cd-roms using", func(bus string) {
@@ -2257,6 +2325,161 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should validate the disk I/O limits", func(ioTune *v1.DiskIOTune, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("should accept read and write limits",
+				&v1.DiskIOTune{
+					ReadBytesPerSecond:  pointer.P(resource.MustParse("100Mi")),
+					WriteBytesPerSecond: pointer.P(resource.MustParse("50Mi")),
+					TotalIOPS:           pointer.P(int64(1000)),
+					Burst: &v1.DiskIOTuneBurst{
+						ReadBytesPerSecond: pointer.P(resource.MustParse("200Mi")),
+						TotalIOPS:          pointer.P(int64(1000)),
+						LengthSeconds:      pointer.P(int64(10)),
+					},
+				}, "", "",
+			),
+			Entry("should reject a limit which is not positive",
+				&v1.DiskIOTune{ReadIOPS: pointer.P(int64(0))},
+				"fake[0].ioTune.readIOPS", "fake[0].ioTune.readIOPS must be greater than zero",
+			),
+			Entry("should reject a total limit combined with a read limit",
+				&v1.DiskIOTune{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("100Mi")),
+					ReadBytesPerSecond:  pointer.P(resource.MustParse("50Mi")),
+				},
+				"fake[0].ioTune.totalBytesPerSecond", "fake[0].ioTune.totalBytesPerSecond can't be combined with readBytesPerSecond or writeBytesPerSecond",
+			),
+			Entry("should reject a total limit combined with a write burst",
+				&v1.DiskIOTune{
+					TotalIOPS: pointer.P(int64(100)),
+					WriteIOPS: pointer.P(int64(100)),
+					Burst:     &v1.DiskIOTuneBurst{WriteIOPS: pointer.P(int64(200))},
+				},
+				"fake[0].ioTune.totalIOPS", "fake[0].ioTune.totalIOPS can't be combined with readIOPS or writeIOPS",
+			),
+			Entry("should reject a burst without its limit",
+				&v1.DiskIOTune{
+					Burst: &v1.DiskIOTuneBurst{WriteBytesPerSecond: pointer.P(resource.MustParse("50Mi"))},
+				},
+				"fake[0].ioTune.burst.writeBytesPerSecond", "fake[0].ioTune.burst.writeBytesPerSecond requires fake[0].ioTune.writeBytesPerSecond to be set",
+			),
+			Entry("should reject a burst lower than its limit",
+				&v1.DiskIOTune{
+					ReadIOPS: pointer.P(int64(1000)),
+					Burst:    &v1.DiskIOTuneBurst{ReadIOPS: pointer.P(int64(500))},
+				},
+				"fake[0].ioTune.burst.readIOPS", "fake[0].ioTune.burst.readIOPS must not be lower than fake[0].ioTune.readIOPS",
+			),
+			Entry("should reject a burst length without a burst limit",
+				&v1.DiskIOTune{
+					ReadIOPS: pointer.P(int64(1000)),
+					Burst:    &v1.DiskIOTuneBurst{LengthSeconds: pointer.P(int64(10))},
+				},
+				"fake[0].ioTune.burst.lengthSeconds", "fake[0].ioTune.burst.lengthSeconds requires a burst limit to be set",
+			),
+			Entry("should reject a burst length which is not positive",
+				&v1.DiskIOTune{
+					ReadIOPS: pointer.P(int64(1000)),
+					Burst: &v1.DiskIOTuneBurst{
+						ReadIOPS:      pointer.P(int64(1000)),
+						LengthSeconds: pointer.P(int64(-1)),
+					},
+				},
+				"fake[0].ioTune.burst.lengthSeconds", "fake[0].ioTune.burst.lengthSeconds must be greater than zero",
+			),
+		)
+
+		DescribeTable("should validate the disk I/O limits against the limits of the cluster", func(ioTune *v1.DiskIOTune, expectedField, expectedMessage string) {
+			clusterIOTune := &v1.DiskIOTune{
+				TotalBytesPerSecond: pointer.P(resource.MustParse("100Mi")),
+				ReadIOPS:            pointer.P(int64(1000)),
+				WriteIOPS:           pointer.P(int64(500)),
+				Burst: &v1.DiskIOTuneBurst{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("200Mi")),
+					LengthSeconds:       pointer.P(int64(10)),
+				},
+			}
+			disks := []v1.Disk{
+				{Name: "testdisk", IOTune: ioTune},
+				{Name: "cdrom", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, IOTune: &v1.DiskIOTune{TotalIOPS: pointer.P(int64(5000))}},
+			}
+
+			causes := validateDiskIOTuneLimits(k8sfield.NewPath("fake"), disks, clusterIOTune)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("should accept a disk without limits", nil, "", ""),
+			Entry("should accept limits below the limits of the cluster",
+				&v1.DiskIOTune{
+					ReadBytesPerSecond:  pointer.P(resource.MustParse("60Mi")),
+					WriteBytesPerSecond: pointer.P(resource.MustParse("40Mi")),
+					TotalIOPS:           pointer.P(int64(500)),
+					Burst: &v1.DiskIOTuneBurst{
+						ReadBytesPerSecond: pointer.P(resource.MustParse("160Mi")),
+						LengthSeconds:      pointer.P(int64(5)),
+					},
+				}, "", "",
+			),
+			Entry("should reject a limit above the limit of the cluster",
+				&v1.DiskIOTune{TotalBytesPerSecond: pointer.P(resource.MustParse("200Mi"))},
+				"fake[0].ioTune.totalBytesPerSecond", "fake[0].ioTune.totalBytesPerSecond must not exceed 104857600, the limit of the cluster",
+			),
+			Entry("should reject read and write limits above the total limit of the cluster",
+				&v1.DiskIOTune{
+					ReadBytesPerSecond:  pointer.P(resource.MustParse("60Mi")),
+					WriteBytesPerSecond: pointer.P(resource.MustParse("60Mi")),
+				},
+				"fake[0].ioTune.totalBytesPerSecond", "fake[0].ioTune.totalBytesPerSecond must not exceed 104857600, the limit of the cluster",
+			),
+			Entry("should reject a total limit above the write limit of the cluster",
+				&v1.DiskIOTune{TotalIOPS: pointer.P(int64(800))},
+				"fake[0].ioTune.writeIOPS", "fake[0].ioTune.writeIOPS must not exceed 500, the limit of the cluster",
+			),
+			Entry("should reject an unlimited kind of I/O the cluster limits",
+				&v1.DiskIOTune{ReadIOPS: pointer.P(int64(800))},
+				"fake[0].ioTune.writeIOPS", "fake[0].ioTune.writeIOPS must be set to at most 500, the limit of the cluster",
+			),
+			Entry("should reject a burst above the limit of the cluster",
+				&v1.DiskIOTune{
+					ReadIOPS:  pointer.P(int64(800)),
+					WriteIOPS: pointer.P(int64(400)),
+					Burst:     &v1.DiskIOTuneBurst{ReadIOPS: pointer.P(int64(2000))},
+				},
+				"fake[0].ioTune.burst.readIOPS", "fake[0].ioTune.burst.readIOPS must not exceed 1000, the limit of the cluster",
+			),
+			Entry("should reject a burst length above the limit of the cluster",
+				&v1.DiskIOTune{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("100Mi")),
+					Burst: &v1.DiskIOTuneBurst{
+						TotalBytesPerSecond: pointer.P(resource.MustParse("200Mi")),
+						LengthSeconds:       pointer.P(int64(60)),
+					},
+				},
+				"fake[0].ioTune.burst.lengthSeconds", "fake[0].ioTune.burst.lengthSeconds must not exceed 10, the limit of the cluster",
+			),
+		)
+
 		It("should reject invalid SN characters", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			order := uint(1)
@@ -2586,6 +2809,105 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate vhostUserBlk volumes", func(enableGate bool, socket string, matcher types.GomegaMatcher) {
+			if enableGate {
+				enableFeatureGate(featuregate.VhostUserBlkGate)
+			}
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testVhostUserBlk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: socket},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(matcher)
+		},
+			Entry("and accept a socket name", true, "vhost.0", BeEmpty()),
+			Entry("and reject them if the feature gate is not enabled", false, "vhost.0", ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VhostUserBlk feature gate is not enabled",
+				Field:   "fake[0]",
+			})),
+			Entry("and reject an empty socket", true, "", ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: "fake[0].vhostUserBlk.socket is a required field",
+				Field:   "fake[0].vhostUserBlk.socket",
+			})),
+			Entry("and reject a socket path", true, "../vhost.0", ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake[0].vhostUserBlk.socket must be the name of a socket, not a path",
+				Field:   "fake[0].vhostUserBlk.socket",
+			})),
+			Entry("and reject a socket of another namespace", true, "../other-namespace/vhost.0", ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake[0].vhostUserBlk.socket must be the name of a socket, not a path",
+				Field:   "fake[0].vhostUserBlk.socket",
+			})),
+			Entry("and reject the parent directory", true, "..", ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake[0].vhostUserBlk.socket must be the name of a socket, not a path",
+				Field:   "fake[0].vhostUserBlk.socket",
+			})),
+		)
+
+		It("should accept a vhostUserBlk volume mapped to a virtio disk", func() {
+			enableFeatureGate(featuregate.VhostUserBlkGate)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "database",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "database",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: "vhost.0"},
+				},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a disk of a vhostUserBlk volume", func(disk v1.Disk, expectedField string) {
+			causes := validateVhostUserBlkDisk(k8sfield.NewPath("fake"), &disk)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("which is not a disk", v1.Disk{
+				DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}},
+			}, "fake.disk"),
+			Entry("which is not on the virtio bus", v1.Disk{
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}},
+			}, "fake.disk.bus"),
+			Entry("with a cache mode", v1.Disk{
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				Cache:      v1.CacheNone,
+			}, "fake.cache"),
+			Entry("with an error policy", v1.Disk{
+				DiskDevice:  v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				ErrorPolicy: pointer.P(v1.DiskErrorPolicyReport),
+			}, "fake.errorPolicy"),
+			Entry("with I/O limits", v1.Disk{
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				IOTune:     &v1.DiskIOTune{},
+			}, "fake.ioTune"),
+			Entry("with a serial", v1.Disk{
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				Serial:     "serial",
+			}, "fake.serial"),
+			Entry("with a dedicated IOThread", v1.Disk{
+				DiskDevice:        v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				DedicatedIOThread: pointer.P(true),
+			}, "fake.dedicatedIOThread"),
+			Entry("which is read-only", v1.Disk{
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{ReadOnly: true}},
+			}, "fake.disk.readonly"),
+		)
+
 		It("should accept sysprep volumes", func() {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
@@ -2700,6 +3022,23 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Message).To(ContainSubstring("fake must have max one memory dump volume set"))
 		})
+		It("should reject a memoryDump volume with an unsupported format", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			memoryDump := testutils.NewFakeMemoryDumpSource("testMemoryDump")
+			memoryDump.Format = "kdump-lz4"
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testMemoryDump",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: memoryDump,
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake[0].memoryDump.format"))
+		})
 
 	})
 
@@ -31,6 +31,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -131,19 +132,19 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 }
 
 func getExpectedDisksAndFilesystems(newVolumes []v1.Volume) int {
-	numMemoryDumpVolumes := 0
+	numDirectoryVolumes := 0
 	for _, volume := range newVolumes {
-		if volume.MemoryDump != nil {
-			numMemoryDumpVolumes = numMemoryDumpVolumes + 1
+		if volume.MemoryDump != nil || volume.Backup != nil {
+			numDirectoryVolumes = numDirectoryVolumes + 1
 		}
 	}
-	return len(newVolumes) - numMemoryDumpVolumes
+	return len(newVolumes) - numDirectoryVolumes
 }
 
 // admitStorageUpdate compares the old and new volumes and disks, and ensures that they match and are valid.
 func admitStorageUpdate(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
 	expectedDisksAndFilesystems := getExpectedDisksAndFilesystems(newVolumes)
-	observedDisksAndFilesystems := len(newDisks) + len(newVMI.Spec.Domain.Devices.Filesystems)
+	observedDisksAndFilesystems := len(newDisks) + len(newVMI.Spec.Domain.Devices.Filesystems) - getEmptyCDRomTrays(newVolumes, newDisks)
 	if expectedDisksAndFilesystems != observedDisksAndFilesystems {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
@@ -152,15 +153,21 @@ func admitStorageUpdate(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks [
 			},
 		})
 	}
+	newDiskMap := getDiskMap(newDisks)
+	oldDiskMap := getDiskMap(oldDisks)
+
+	// The media changes of CD-ROMs are verified on their own as they don't add or remove any disk
+	newVolumes, oldVolumes, cdromAr := verifyCDRomMediaChanges(newVolumes, oldVolumes, newDiskMap, oldDiskMap)
+	if cdromAr != nil {
+		return cdromAr
+	}
+
 	newHotplugVolumeMap := getHotplugVolumes(newVolumes, volumeStatuses)
 	newPermanentVolumeMap := getPermanentVolumes(newVolumes, volumeStatuses)
 	oldHotplugVolumeMap := getHotplugVolumes(oldVolumes, volumeStatuses)
 	oldPermanentVolumeMap := getPermanentVolumes(oldVolumes, volumeStatuses)
 	migratedVolumeMap := getMigratedVolumeMaps(newVMI.Status.MigratedVolumes)
 
-	newDiskMap := getDiskMap(newDisks)
-	oldDiskMap := getDiskMap(oldDisks)
-
 	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
 	if permanentAr != nil {
 		return permanentAr
@@ -179,6 +186,83 @@ func admitStorageUpdate(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks [
 	return nil
 }
 
+func getEmptyCDRomTrays(volumes []v1.Volume, disks []v1.Disk) int {
+	volumeMap := make(map[string]struct{}, len(volumes))
+	for _, volume := range volumes {
+		volumeMap[volume.Name] = struct{}{}
+	}
+	emptyTrays := 0
+	for _, disk := range disks {
+		if _, ok := volumeMap[disk.Name]; !ok && disk.CDRom != nil {
+			emptyTrays++
+		}
+	}
+	return emptyTrays
+}
+
+// verifyCDRomMediaChanges ensures that a media is only ejected from or inserted into the tray of an unchanged CD-ROM,
+// and returns the volumes without the changed media.
+func verifyCDRomMediaChanges(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks map[string]v1.Disk) ([]v1.Volume, []v1.Volume, *admissionv1.AdmissionResponse) {
+	newVolumeMap := make(map[string]v1.Volume, len(newVolumes))
+	for _, volume := range newVolumes {
+		newVolumeMap[volume.Name] = volume
+	}
+	oldVolumeMap := make(map[string]v1.Volume, len(oldVolumes))
+	for _, volume := range oldVolumes {
+		oldVolumeMap[volume.Name] = volume
+	}
+
+	changedMedia := make(map[string]struct{})
+	for name, newDisk := range newDisks {
+		oldDisk, ok := oldDisks[name]
+		if !ok || newDisk.CDRom == nil || oldDisk.CDRom == nil {
+			continue
+		}
+		newVolume, newOk := newVolumeMap[name]
+		_, oldOk := oldVolumeMap[name]
+		if newOk == oldOk {
+			continue
+		}
+		if !storagetypes.EqualDisksIgnoringIOTune(newDisk, oldDisk) {
+			return nil, nil, webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("CD-ROM %s changed while changing its media", name),
+				},
+			})
+		}
+		if newOk && !isHotpluggableVolume(&newVolume) {
+			return nil, nil, webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("media inserted into CD-ROM %s is not a hotpluggable PVC or DataVolume", name),
+				},
+			})
+		}
+		changedMedia[name] = struct{}{}
+	}
+
+	if len(changedMedia) == 0 {
+		return newVolumes, oldVolumes, nil
+	}
+	return filterVolumes(newVolumes, changedMedia), filterVolumes(oldVolumes, changedMedia), nil
+}
+
+func isHotpluggableVolume(volume *v1.Volume) bool {
+	return (volume.DataVolume != nil && volume.DataVolume.Hotpluggable) ||
+		(volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable)
+}
+
+func filterVolumes(volumes []v1.Volume, excluded map[string]struct{}) []v1.Volume {
+	filtered := make([]v1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if _, ok := excluded[volume.Name]; !ok {
+			filtered = append(filtered, volume)
+		}
+	}
+	return filtered
+}
+
 func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk,
 	migratedVols map[string]bool) *admissionv1.AdmissionResponse {
 	for k, v := range newHotplugVolumeMap {
@@ -193,7 +277,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
@@ -202,7 +286,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if !storagetypes.EqualDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -212,8 +296,8 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 				}
 			}
 		} else {
-			// This is a new volume, ensure that the volume is either DV, PVC or memoryDumpVolume
-			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil {
+			// This is a new volume, ensure that the volume is either DV, PVC, memoryDumpVolume or backupVolume
+			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil && v.Backup == nil {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
@@ -221,7 +305,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				// Also ensure the matching new disk exists and is of type scsi
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -305,7 +389,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if !storagetypes.EqualDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -599,6 +599,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeDisksWithIOTune := func(ioTune *v1.DiskIOTune, indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOTune = ioTune
+		}
+		return res
+	}
+
 	makeDisksInvalidBootOrder := func(indexes ...int) []v1.Disk {
 		res := makeDisks(indexes...)
 		bootOrder := uint(0)
@@ -763,6 +771,22 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("hotplugged Disk volume-name-1 can't use dedicated IOThread: scsi bus is unsupported.", "")),
+		Entry("Should accept if we change the I/O limits of the disks",
+			makeVolumes(0, 1),
+			makeVolumes(0, 1),
+			makeDisksWithIOTune(&v1.DiskIOTune{ReadIOPS: pointer.P(int64(100))}, 0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			nil),
+		Entry("Should reject if we change the disks to invalid I/O limits",
+			makeVolumes(0),
+			makeVolumes(0),
+			makeDisksWithIOTune(&v1.DiskIOTune{ReadIOPS: pointer.P(int64(-1))}, 0),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("spec.domain.devices.disks[0].ioTune.readIOPS must be greater than zero", "spec.domain.devices.disks[0].ioTune.readIOPS")),
 		Entry("Should accept if we add LUN disk with valid SCSI bus",
 			makeVolumes(0, 1),
 			makeVolumes(0, 1),
@@ -847,6 +871,77 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		)
 	})
 
+	Context("with CD-ROM media changes", func() {
+		makeHotpluggableVolumes := func(indexes ...int) []v1.Volume {
+			res := makeVolumes(indexes...)
+			for i := range res {
+				res[i].DataVolume.Hotpluggable = true
+			}
+			return res
+		}
+
+		makeDisksWithCDRom := func(cdromIndex int, indexes ...int) []v1.Disk {
+			return append(makeDisks(indexes...), makeCDRomDisks(cdromIndex)...)
+		}
+
+		makeSATADisksWithCDRom := func(cdromIndex int, indexes ...int) []v1.Disk {
+			res := makeDisksWithCDRom(cdromIndex, indexes...)
+			res[len(res)-1].CDRom.Bus = v1.DiskBusSATA
+			return res
+		}
+
+		DescribeTable("Should return proper admission response", testHotplugResponse,
+			Entry("Should accept if the media of a permanent CD-ROM is ejected",
+				makeVolumes(0),
+				makeVolumes(0, 1),
+				makeDisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(2, 0),
+				nil),
+			Entry("Should accept if the media of a hotplugged CD-ROM is ejected",
+				makeVolumes(0),
+				append(makeVolumes(0), makeHotpluggableVolumes(1)...),
+				makeDisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(2, 1),
+				nil),
+			Entry("Should accept if a hotpluggable media is inserted into an empty CD-ROM",
+				append(makeVolumes(0), makeHotpluggableVolumes(1)...),
+				makeVolumes(0),
+				makeDisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(1, 0),
+				nil),
+			Entry("Should accept an empty CD-ROM without any change",
+				makeVolumes(0),
+				makeVolumes(0),
+				makeDisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(1, 0),
+				nil),
+			Entry("Should reject if a media which is not hotpluggable is inserted",
+				makeVolumes(0, 1),
+				makeVolumes(0),
+				makeDisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(1, 0),
+				makeExpected("media inserted into CD-ROM volume-name-1 is not a hotpluggable PVC or DataVolume", "")),
+			Entry("Should reject if the CD-ROM changes while its media is ejected",
+				makeVolumes(0),
+				makeVolumes(0, 1),
+				makeSATADisksWithCDRom(1, 0),
+				makeDisksWithCDRom(1, 0),
+				makeFilesystems(),
+				makeStatus(2, 0),
+				makeExpected("CD-ROM volume-name-1 changed while changing its media", "")),
+		)
+	})
+
 	DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.CPU = &v1.CPU{}
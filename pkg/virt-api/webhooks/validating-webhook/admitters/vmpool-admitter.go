@@ -23,12 +23,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -131,6 +134,11 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+	causes = append(causes, validateScaleInStrategy(field.Child("scaleInStrategy"), spec.ScaleInStrategy)...)
+	causes = append(causes, validateAutoscaling(field.Child("autoscaling"), spec.Autoscaling)...)
+	causes = append(causes, validateUnhealthyReplicaReplacement(field.Child("unhealthyReplicaReplacement"), spec.UnhealthyReplicaReplacement)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -150,3 +158,194 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateUpdateStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy == nil {
+		return causes
+	}
+
+	switch strategy.Type {
+	case "", poolv1.VirtualMachinePoolRollingUpdateStrategyType:
+	case poolv1.VirtualMachinePoolOnDeleteStrategyType, poolv1.VirtualMachinePoolRecreateStrategyType:
+		if strategy.RollingUpdate != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("rollingUpdate may only be set when type is %s.", poolv1.VirtualMachinePoolRollingUpdateStrategyType),
+				Field:   field.Child("rollingUpdate").String(),
+			})
+		}
+		return causes
+	default:
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported update strategy type %s.", strategy.Type),
+			Field:   field.Child("type").String(),
+		})
+	}
+
+	if strategy.RollingUpdate == nil {
+		return causes
+	}
+
+	maxUnavailable := strategy.RollingUpdate.MaxUnavailable
+	maxSurge := strategy.RollingUpdate.MaxSurge
+	maxUnavailableField := field.Child("rollingUpdate", "maxUnavailable")
+	causes = append(causes, validateIntOrPercent(maxUnavailableField, maxUnavailable)...)
+	causes = append(causes, validateIntOrPercent(field.Child("rollingUpdate", "maxSurge"), maxSurge)...)
+
+	if len(causes) == 0 && maxUnavailable != nil && isZeroIntOrPercent(maxUnavailable) && (maxSurge == nil || isZeroIntOrPercent(maxSurge)) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable may not be 0 when maxSurge is 0.",
+			Field:   maxUnavailableField.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateScaleInStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolScaleInStrategy) []metav1.StatusCause {
+	if strategy == nil {
+		return nil
+	}
+
+	switch strategy.Type {
+	case poolv1.VirtualMachinePoolNewestFirstScaleInStrategyType,
+		poolv1.VirtualMachinePoolOldestFirstScaleInStrategyType,
+		poolv1.VirtualMachinePoolNotReadyFirstScaleInStrategyType,
+		poolv1.VirtualMachinePoolHighestIndexFirstScaleInStrategyType:
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported scale-in strategy type %s.", strategy.Type),
+			Field:   field.Child("type").String(),
+		}}
+	}
+}
+
+func validateAutoscaling(field *k8sfield.Path, autoscaling *poolv1.VirtualMachinePoolAutoscaling) []metav1.StatusCause {
+	if autoscaling == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+		if minReplicas < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than or equal to 1.", field.Child("minReplicas").String()),
+				Field:   field.Child("minReplicas").String(),
+			})
+		}
+	}
+	if autoscaling.MaxReplicas < minReplicas {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than or equal to minReplicas.", field.Child("maxReplicas").String()),
+			Field:   field.Child("maxReplicas").String(),
+		})
+	}
+
+	if len(autoscaling.Metrics) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must contain at least one metric.", field.Child("metrics").String()),
+			Field:   field.Child("metrics").String(),
+		})
+	}
+	seen := map[poolv1.VirtualMachinePoolMetricType]bool{}
+	for i, metric := range autoscaling.Metrics {
+		metricField := field.Child("metrics").Index(i)
+		switch metric.Type {
+		case poolv1.VirtualMachinePoolCPUMetricType,
+			poolv1.VirtualMachinePoolMemoryMetricType,
+			poolv1.VirtualMachinePoolGuestLoadMetricType:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported metric type %s.", metric.Type),
+				Field:   metricField.Child("type").String(),
+			})
+		}
+		if seen[metric.Type] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("metric type %s is specified more than once.", metric.Type),
+				Field:   metricField.Child("type").String(),
+			})
+		}
+		seen[metric.Type] = true
+		if metric.TargetAverageUtilization <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0.", metricField.Child("targetAverageUtilization").String()),
+				Field:   metricField.Child("targetAverageUtilization").String(),
+			})
+		}
+	}
+
+	causes = append(causes, validateNonNegativeDuration(field.Child("scaleUpCooldown"), autoscaling.ScaleUpCooldown)...)
+	causes = append(causes, validateNonNegativeDuration(field.Child("scaleDownCooldown"), autoscaling.ScaleDownCooldown)...)
+
+	return causes
+}
+
+func validateUnhealthyReplicaReplacement(field *k8sfield.Path, replacement *poolv1.VirtualMachinePoolUnhealthyReplicaReplacement) []metav1.StatusCause {
+	if replacement == nil {
+		return nil
+	}
+
+	return validateNonNegativeDuration(field.Child("gracePeriod"), replacement.GracePeriod)
+}
+
+func validateNonNegativeDuration(field *k8sfield.Path, cooldown *metav1.Duration) []metav1.StatusCause {
+	if cooldown == nil || cooldown.Duration >= 0 {
+		return nil
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s must not be negative.", field.String()),
+		Field:   field.String(),
+	}}
+}
+
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) []metav1.StatusCause {
+	if value == nil {
+		return nil
+	}
+
+	invalid := []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s must be a non-negative integer or a percentage between 0%% and 100%%.", field.String()),
+		Field:   field.String(),
+	}}
+
+	switch value.Type {
+	case intstr.Int:
+		if value.IntVal < 0 {
+			return invalid
+		}
+	case intstr.String:
+		percent, found := strings.CutSuffix(value.StrVal, "%")
+		if !found {
+			return invalid
+		}
+		v, err := strconv.Atoi(percent)
+		if err != nil || v < 0 || v > 100 {
+			return invalid
+		}
+	}
+	return nil
+}
+
+func isZeroIntOrPercent(value *intstr.IntOrString) bool {
+	scaled, _ := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	return scaled == 0
+}
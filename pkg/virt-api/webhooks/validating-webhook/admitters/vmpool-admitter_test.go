@@ -22,17 +22,21 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -180,4 +184,144 @@ var _ = Describe("Validating Pool Admitter", func() {
 		resp := poolAdmitter.Admit(context.Background(), ar)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should validate the update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, causes []string) {
+		result := validateUpdateStrategy(k8sfield.NewPath("spec", "updateStrategy"), strategy)
+		Expect(result).To(HaveLen(len(causes)))
+		for i, cause := range causes {
+			Expect(result[i].Field).To(Equal(cause))
+		}
+	},
+		Entry("accept no strategy", nil, nil),
+		Entry("accept rolling update without parameters",
+			&poolv1.VirtualMachinePoolUpdateStrategy{Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType}, nil),
+		Entry("accept rolling update with absolute and percentage values",
+			&poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+					MaxSurge:       pointer.P(intstr.FromString("50%")),
+				},
+			}, nil),
+		Entry("accept OnDelete", &poolv1.VirtualMachinePoolUpdateStrategy{Type: poolv1.VirtualMachinePoolOnDeleteStrategyType}, nil),
+		Entry("accept Recreate", &poolv1.VirtualMachinePoolUpdateStrategy{Type: poolv1.VirtualMachinePoolRecreateStrategyType}, nil),
+		Entry("reject unknown type",
+			&poolv1.VirtualMachinePoolUpdateStrategy{Type: "madeup"},
+			[]string{"spec.updateStrategy.type"}),
+		Entry("reject rollingUpdate with Recreate",
+			&poolv1.VirtualMachinePoolUpdateStrategy{
+				Type:          poolv1.VirtualMachinePoolRecreateStrategyType,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+			},
+			[]string{"spec.updateStrategy.rollingUpdate"}),
+		Entry("reject negative maxSurge",
+			&poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxSurge: pointer.P(intstr.FromInt32(-1))},
+			},
+			[]string{"spec.updateStrategy.rollingUpdate.maxSurge"}),
+		Entry("reject malformed maxUnavailable",
+			&poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: pointer.P(intstr.FromString("120%"))},
+			},
+			[]string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+		Entry("reject maxUnavailable and maxSurge both being zero",
+			&poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromString("0%")),
+				},
+			},
+			[]string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+	)
+
+	DescribeTable("should validate the scale-in strategy", func(strategy *poolv1.VirtualMachinePoolScaleInStrategy, expectedCauses int) {
+		causes := validateScaleInStrategy(k8sfield.NewPath("spec", "scaleInStrategy"), strategy)
+		Expect(causes).To(HaveLen(expectedCauses))
+		for _, cause := range causes {
+			Expect(cause.Field).To(Equal("spec.scaleInStrategy.type"))
+		}
+	},
+		Entry("accept no strategy", nil, 0),
+		Entry("accept NewestFirst", &poolv1.VirtualMachinePoolScaleInStrategy{Type: poolv1.VirtualMachinePoolNewestFirstScaleInStrategyType}, 0),
+		Entry("accept OldestFirst", &poolv1.VirtualMachinePoolScaleInStrategy{Type: poolv1.VirtualMachinePoolOldestFirstScaleInStrategyType}, 0),
+		Entry("accept NotReadyFirst", &poolv1.VirtualMachinePoolScaleInStrategy{Type: poolv1.VirtualMachinePoolNotReadyFirstScaleInStrategyType}, 0),
+		Entry("accept HighestIndexFirst", &poolv1.VirtualMachinePoolScaleInStrategy{Type: poolv1.VirtualMachinePoolHighestIndexFirstScaleInStrategyType}, 0),
+		Entry("reject unknown type", &poolv1.VirtualMachinePoolScaleInStrategy{Type: "madeup"}, 1),
+		Entry("reject empty type", &poolv1.VirtualMachinePoolScaleInStrategy{}, 1),
+	)
+
+	DescribeTable("should validate the autoscaling", func(autoscaling *poolv1.VirtualMachinePoolAutoscaling, expectedFields []string) {
+		causes := validateAutoscaling(k8sfield.NewPath("spec", "autoscaling"), autoscaling)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(Equal(expectedFields))
+	},
+		Entry("accept no autoscaling", nil, []string{}),
+		Entry("accept a valid autoscaling",
+			&poolv1.VirtualMachinePoolAutoscaling{
+				MinReplicas: pointer.P(int32(2)),
+				MaxReplicas: 5,
+				Metrics: []poolv1.VirtualMachinePoolMetric{
+					{Type: poolv1.VirtualMachinePoolCPUMetricType, TargetAverageUtilization: 70},
+					{Type: poolv1.VirtualMachinePoolGuestLoadMetricType, TargetAverageUtilization: 100},
+				},
+				ScaleDownCooldown: &metav1.Duration{Duration: time.Minute},
+			},
+			[]string{}),
+		Entry("reject maxReplicas lower than the default minReplicas",
+			&poolv1.VirtualMachinePoolAutoscaling{
+				Metrics: []poolv1.VirtualMachinePoolMetric{{Type: poolv1.VirtualMachinePoolMemoryMetricType, TargetAverageUtilization: 80}},
+			},
+			[]string{"spec.autoscaling.maxReplicas"}),
+		Entry("reject minReplicas lower than 1",
+			&poolv1.VirtualMachinePoolAutoscaling{
+				MinReplicas: pointer.P(int32(0)),
+				MaxReplicas: 2,
+				Metrics:     []poolv1.VirtualMachinePoolMetric{{Type: poolv1.VirtualMachinePoolMemoryMetricType, TargetAverageUtilization: 80}},
+			},
+			[]string{"spec.autoscaling.minReplicas"}),
+		Entry("reject missing metrics",
+			&poolv1.VirtualMachinePoolAutoscaling{MaxReplicas: 2},
+			[]string{"spec.autoscaling.metrics"}),
+		Entry("reject invalid metrics",
+			&poolv1.VirtualMachinePoolAutoscaling{
+				MaxReplicas: 2,
+				Metrics: []poolv1.VirtualMachinePoolMetric{
+					{Type: "madeup", TargetAverageUtilization: 80},
+					{Type: poolv1.VirtualMachinePoolCPUMetricType},
+					{Type: poolv1.VirtualMachinePoolCPUMetricType, TargetAverageUtilization: 80},
+				},
+			},
+			[]string{
+				"spec.autoscaling.metrics[0].type",
+				"spec.autoscaling.metrics[1].targetAverageUtilization",
+				"spec.autoscaling.metrics[2].type",
+			}),
+		Entry("reject negative cooldowns",
+			&poolv1.VirtualMachinePoolAutoscaling{
+				MaxReplicas:       2,
+				Metrics:           []poolv1.VirtualMachinePoolMetric{{Type: poolv1.VirtualMachinePoolCPUMetricType, TargetAverageUtilization: 80}},
+				ScaleUpCooldown:   &metav1.Duration{Duration: -time.Minute},
+				ScaleDownCooldown: &metav1.Duration{Duration: -time.Minute},
+			},
+			[]string{"spec.autoscaling.scaleUpCooldown", "spec.autoscaling.scaleDownCooldown"}),
+	)
+
+	DescribeTable("should validate the unhealthy replica replacement", func(replacement *poolv1.VirtualMachinePoolUnhealthyReplicaReplacement, expectedFields []string) {
+		causes := validateUnhealthyReplicaReplacement(k8sfield.NewPath("spec", "unhealthyReplicaReplacement"), replacement)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(Equal(expectedFields))
+	},
+		Entry("accept no replacement", nil, []string{}),
+		Entry("accept the default grace period", &poolv1.VirtualMachinePoolUnhealthyReplicaReplacement{}, []string{}),
+		Entry("accept a grace period",
+			&poolv1.VirtualMachinePoolUnhealthyReplicaReplacement{GracePeriod: &metav1.Duration{Duration: time.Minute}},
+			[]string{}),
+		Entry("reject a negative grace period",
+			&poolv1.VirtualMachinePoolUnhealthyReplicaReplacement{GracePeriod: &metav1.Duration{Duration: -time.Minute}},
+			[]string{"spec.unhealthyReplicaReplacement.gracePeriod"}),
+	)
 })
@@ -93,6 +93,18 @@ func (config *ClusterConfig) SnapshotEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.SnapshotGate)
 }
 
+func (config *ClusterConfig) IncrementalBackupEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IncrementalBackupGate)
+}
+
+func (config *ClusterConfig) CrossClusterLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CrossClusterLiveMigrationGate)
+}
+
+func (config *ClusterConfig) VhostUserBlkEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VhostUserBlkGate)
+}
+
 func (config *ClusterConfig) VMExportEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.VMExportGate)
 }
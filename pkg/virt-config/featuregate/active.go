@@ -59,6 +59,18 @@ const (
 
 	VirtIOFSConfigVolumesGate = "EnableVirtioFsConfigVolumes"
 	VirtIOFSStorageVolumeGate = "EnableVirtioFsStorageVolumes"
+
+	// IncrementalBackupGate enables full and incremental backups of running VMs
+	// with VirtualMachineBackups, based on libvirt checkpoints.
+	IncrementalBackupGate = "IncrementalBackup"
+
+	// CrossClusterLiveMigrationGate enables live migrations of VMIs between clusters
+	// through the cross-cluster migration endpoint of virt-handler.
+	CrossClusterLiveMigrationGate = "CrossClusterLiveMigration"
+
+	// VhostUserBlkGate enables vhostUserBlk volumes, which are disks served by a storage daemon
+	// of the node over a vhost-user-blk socket.
+	VhostUserBlkGate = "VhostUserBlk"
 )
 
 func init() {
@@ -84,4 +96,7 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: NodeRestrictionGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSConfigVolumesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSStorageVolumeGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IncrementalBackupGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CrossClusterLiveMigrationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VhostUserBlkGate, State: Alpha})
 }
@@ -422,6 +422,10 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+func (c *ClusterConfig) GetDiskIOTuneLimits() *v1.DiskIOTune {
+	return c.GetConfig().DiskIOTuneLimits
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
@@ -20,6 +20,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -461,6 +462,14 @@ func withVirioFS() VolumeRendererOption {
 	}
 }
 
+func withVhostUserBlk() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuserblk.VhostUserBlkSockets, vhostuserblk.VhostUserBlkSocketsMountBaseDir))
+		renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(vhostuserblk.VhostUserBlkSockets))
+		return nil
+	}
+}
+
 func withHugepages() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		hugepagesBasePath := "/dev/hugepages"
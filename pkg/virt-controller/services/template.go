@@ -852,6 +852,10 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
 
+	if util.IsVMIVhostUserBlkEnabled(vmi) {
+		volumeOpts = append(volumeOpts, withVhostUserBlk())
+	}
+
 	volumeRenderer, err := NewVolumeRenderer(
 		namespace,
 		t.ephemeralDiskDir,
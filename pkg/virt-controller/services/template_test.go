@@ -56,6 +56,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
@@ -3016,6 +3017,42 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with a vhostUserBlk volume source", func() {
+			It("Should add an emptyDir for the vhost-user sockets", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name: "database",
+								VolumeSource: v1.VolumeSource{
+									VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: "database.sock"},
+								},
+							},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Volumes).To(ContainElement(
+					k8sv1.Volume{
+						Name: vhostuserblk.VhostUserBlkSockets,
+						VolumeSource: k8sv1.VolumeSource{
+							EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+						},
+					}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(
+					k8sv1.VolumeMount{
+						Name:      vhostuserblk.VhostUserBlkSockets,
+						MountPath: vhostuserblk.VhostUserBlkSocketsMountBaseDir,
+					}))
+			})
+		})
+
 		Context("with a configMap volume source", func() {
 			It("Should add the ConfigMap to template", func() {
 				config, kvStore, svc = configFactory(defaultArch)
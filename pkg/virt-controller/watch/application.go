@@ -84,6 +84,7 @@ import (
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/backup"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -171,6 +172,7 @@ type VirtControllerApp struct {
 
 	poolController *pool.Controller
 	poolInformer   cache.SharedIndexInformer
+	hpaInformer    cache.SharedIndexInformer
 
 	vmController *vm.Controller
 	vmInformer   cache.SharedIndexInformer
@@ -194,6 +196,8 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	backupController             *backup.VMBackupController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -201,6 +205,9 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmBackupInformer             cache.SharedIndexInformer
+	vmBackupTrackerInformer      cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -253,6 +260,8 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	snapshotScheduleControllerThreads int
+	backupControllerThreads           int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
 
@@ -366,6 +375,7 @@ func Execute() {
 
 	app.rsInformer = app.informerFactory.VMIReplicaSet()
 	app.poolInformer = app.informerFactory.VMPool()
+	app.hpaInformer = app.informerFactory.K8SInformerFactory().Autoscaling().V2().HorizontalPodAutoscalers().Informer()
 
 	app.persistentVolumeClaimInformer = app.informerFactory.PersistentVolumeClaim()
 	app.persistentVolumeClaimCache = app.persistentVolumeClaimInformer.GetStore()
@@ -382,6 +392,9 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
+	app.vmBackupTrackerInformer = app.informerFactory.VirtualMachineBackupTracker()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -465,6 +478,8 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initBackupController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -588,6 +603,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.backupController.Run(vca.backupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -726,6 +751,7 @@ func (vca *VirtControllerApp) initPool() {
 		vca.vmInformer,
 		vca.poolInformer,
 		vca.controllerRevisionInformer,
+		vca.hpaInformer,
 		recorder,
 		controller.BurstReplicas)
 	if err != nil {
@@ -862,6 +888,35 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initBackupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
+	vca.backupController = &backup.VMBackupController{
+		Client:                  vca.clientSet,
+		VMBackupInformer:        vca.vmBackupInformer,
+		VMBackupTrackerInformer: vca.vmBackupTrackerInformer,
+		VMIInformer:             vca.vmiInformer,
+		PVCInformer:             vca.persistentVolumeClaimInformer,
+		Recorder:                recorder,
+	}
+	if err := vca.backupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -996,6 +1051,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for backup controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
@@ -366,7 +366,7 @@ func getMarkedForEvictionVMIs(vmis []*virtv1.VirtualMachineInstance) []*virtv1.V
 	return evictionCandidates
 }
 
-func GenerateNewMigration(vmiName string, key string) *virtv1.VirtualMachineInstanceMigration {
+func GenerateNewMigration(vmiName string, key string, priority virtv1.MigrationPriority) *virtv1.VirtualMachineInstanceMigration {
 
 	annotations := map[string]string{
 		virtv1.EvacuationMigrationAnnotation: key,
@@ -377,11 +377,21 @@ func GenerateNewMigration(vmiName string, key string) *virtv1.VirtualMachineInst
 			GenerateName: "kubevirt-evacuation-",
 		},
 		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
-			VMIName: vmiName,
+			VMIName:  vmiName,
+			Priority: &priority,
 		},
 	}
 }
 
+// evacuationPriority returns the priority of the evacuation migrations of the node:
+// migrations off drained nodes are critical, evictions on healthy nodes, e.g. by the descheduler, are maintenance
+func evacuationPriority(node *k8sv1.Node, taint *k8sv1.Taint) virtv1.MigrationPriority {
+	if node.Spec.Unschedulable || nodeHasTaint(taint, node) {
+		return virtv1.MigrationPrioritySystemCritical
+	}
+	return virtv1.MigrationPrioritySystemMaintenance
+}
+
 func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration) error {
 	// If the node has no drain taint, we have nothing to do
 	taintKey := *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey
@@ -445,11 +455,12 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 
 	errChan := make(chan error, diff)
 
+	priority := evacuationPriority(node, taint)
 	c.migrationExpectations.ExpectCreations(node.Name, diff)
 	for _, vmi := range selectedCandidates {
 		go func(vmi *virtv1.VirtualMachineInstance) {
 			defer wg.Done()
-			createdMigration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), GenerateNewMigration(vmi.Name, node.Name), v1.CreateOptions{})
+			createdMigration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), GenerateNewMigration(vmi.Name, node.Name, priority), v1.CreateOptions{})
 			if err != nil {
 				c.migrationExpectations.CreationObserved(node.Name)
 				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateVirtualMachineInstanceMigrationReason, "Error creating a Migration: %v", err)
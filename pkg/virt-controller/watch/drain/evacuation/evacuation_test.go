@@ -70,10 +70,11 @@ var _ = Describe("Evacuation", func() {
 		mockQueue.Wait()
 	}
 
-	expectMigrationCreation := func() {
+	expectMigrationCreation := func() *v1.VirtualMachineInstanceMigration {
 		migrationList, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
 		ExpectWithOffset(1, err).ToNot(HaveOccurred())
 		ExpectWithOffset(1, migrationList.Items).To(HaveLen(1))
+		return &migrationList.Items[0]
 	}
 
 	BeforeEach(func() {
@@ -124,8 +125,9 @@ var _ = Describe("Evacuation", func() {
 
 	Context("migration object creation", func() {
 		It("should have expected values and annotations", func() {
-			migration := GenerateNewMigration("my-vmi", "somenode")
+			migration := GenerateNewMigration("my-vmi", "somenode", v1.MigrationPrioritySystemCritical)
 			Expect(migration.Spec.VMIName).To(Equal("my-vmi"))
+			Expect(migration.Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemCritical)))
 			Expect(migration.Annotations[v1.EvacuationMigrationAnnotation]).To(Equal("somenode"))
 		})
 
@@ -171,7 +173,8 @@ var _ = Describe("Evacuation", func() {
 
 			sanityExecute()
 			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
-			expectMigrationCreation()
+			migration := expectMigrationCreation()
+			Expect(migration.Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemCritical)))
 		})
 
 		It("should ignore VMIs which are not migratable", func() {
@@ -260,7 +263,22 @@ var _ = Describe("Evacuation", func() {
 			vmiFeeder.Add(vmi)
 			sanityExecute()
 			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
-			expectMigrationCreation()
+			migration := expectMigrationCreation()
+			Expect(migration.Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemMaintenance)))
+		})
+
+		It("Should evict the VMI with a critical priority if the node is cordoned", func() {
+			node := newNode("foo")
+			node.Spec.Unschedulable = true
+			addNode(node)
+			vmi := newVirtualMachine("testvm", node.Name)
+			vmi.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			vmi.Status.EvacuationNodeName = node.Name
+			vmiFeeder.Add(vmi)
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migration := expectMigrationCreation()
+			Expect(migration.Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemCritical)))
 		})
 
 		It("Should record a warning on a not migratable VMI", func() {
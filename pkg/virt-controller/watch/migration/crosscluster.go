@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// handleCrossClusterSendHandoff hands the migration off to the source virt-handler. The target is prepared
+// by the receiving migration in the other cluster, so no target pod is created and the source connects
+// straight to the cross-cluster migration endpoint of the target node.
+func (c *Controller) handleCrossClusterSendHandoff(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+		// already handed off
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		MigrationUID:            migration.UID,
+		SourceNode:              vmi.Status.NodeName,
+		SourcePod:               sourcePod.Name,
+		TargetNodeAddress:       migration.Spec.SendTo.ConnectURL,
+		CrossClusterMigrationID: migration.Spec.SendTo.MigrationID,
+	}
+
+	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs); err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+	if !c.isMigrationPolicyMatched(vmiCopy) {
+		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	c.addHandOffKey(controller.MigrationKey(migration))
+	log.Log.Object(vmi).Infof("Handed off cross-cluster migration %s/%s to source virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulHandOverPodReason, "Migration is ready to be sent to the cross-cluster migration endpoint %s.", migration.Spec.SendTo.ConnectURL)
+	return nil
+}
+
+// isCrossClusterMigrationSent reports whether the source virt-handler finalized the migration after the guest
+// was sent to the receiving cluster.
+func isCrossClusterMigrationSent(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) bool {
+	migrationState := vmi.Status.MigrationState
+	return migrationState != nil &&
+		migrationState.MigrationUID == migration.UID &&
+		migrationState.CrossClusterMigrationID != "" &&
+		migrationState.Completed &&
+		!migrationState.Failed
+}
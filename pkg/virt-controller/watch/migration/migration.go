@@ -23,7 +23,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"maps"
 	"sort"
 	"strconv"
 	"strings"
@@ -473,6 +472,11 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because vmi does not exist.")
 		log.Log.Object(migration).Error("vmi does not exist")
+	} else if vmi.IsFinal() && migration.Spec.SendTo != nil && isCrossClusterMigrationSent(migration, vmi) {
+		// the VMI shuts down in this cluster once the guest was sent to the receiving cluster
+		migrationCopy.Status.Phase = virtv1.MigrationSucceeded
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulMigrationReason, "Source node reported migration to another cluster succeeded")
+		log.Log.Object(migration).Infof("VMI reported migration to another cluster succeeded.")
 	} else if vmi.IsFinal() {
 		err := c.interruptMigration(migrationCopy, vmi)
 		if err != nil {
@@ -501,7 +505,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because target pod shutdown during migration")
 		log.Log.Object(migration).Errorf("target pod %s/%s shutdown during migration", pod.Namespace, pod.Name)
-	} else if migration.TargetIsCreated() && !podExists {
+	} else if migration.TargetIsCreated() && !podExists && migration.Spec.SendTo == nil {
 		err := c.interruptMigration(migrationCopy, vmi)
 		if err != nil {
 			return err
@@ -598,6 +602,11 @@ func (c *Controller) processMigrationPhase(
 		}
 
 		if canMigrate {
+			priority, err := c.resolveMigrationPriority(migration, vmi)
+			if err != nil {
+				return err
+			}
+			migrationCopy.Status.Priority = priority
 			migrationCopy.Status.Phase = virtv1.MigrationPending
 		} else {
 			// can not migrate because there is an active migration already
@@ -610,7 +619,12 @@ func (c *Controller) processMigrationPhase(
 			log.Log.Object(migration).Error("Migration object ont eligible for migration because another job is in progress")
 		}
 	case virtv1.MigrationPending:
-		if pod != nil {
+		if migration.Spec.SendTo != nil {
+			// the target is prepared in the receiving cluster
+			if c.isMigrationHandedOff(migration, vmi) {
+				migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+			}
+		} else if pod != nil {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil {
 					migrationCopy.Status.Phase = virtv1.MigrationScheduling
@@ -655,8 +669,12 @@ func (c *Controller) processMigrationPhase(
 			migrationCopy.Status.Phase = virtv1.MigrationRunning
 		}
 	case virtv1.MigrationRunning:
-		_, exists := pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
-		if !exists && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
+		// a cross-cluster migration has no target pod in this cluster
+		targetReadyTimestampSet := pod == nil
+		if pod != nil {
+			_, targetReadyTimestampSet = pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
+		}
+		if !targetReadyTimestampSet && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
 			if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
 				err := backendstorage.MigrationHandoff(c.clientset, c.pvcStore, migration)
 				if err != nil {
@@ -723,57 +741,38 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		// Give time to the PVC informer to update itself
 		return nil
 	}
-	templatePod, err := c.templateService.RenderMigrationManifest(vmi, migration, sourcePod)
+	var templatePod *k8sv1.Pod
+	var err error
+	if migration.Spec.Receive != nil {
+		// the target pod of a receiving migration is the first pod of the VMI
+		templatePod, err = c.templateService.RenderLaunchManifest(vmi)
+	} else {
+		templatePod, err = c.templateService.RenderMigrationManifest(vmi, migration, sourcePod)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to render launch manifest: %v", err)
 	}
 
-	antiAffinityTerm := k8sv1.PodAffinityTerm{
-		LabelSelector: &v1.LabelSelector{
-			MatchLabels: map[string]string{
-				virtv1.CreatedByLabel: string(vmi.UID),
-			},
-		},
-		TopologyKey: k8sv1.LabelHostname,
-	}
-	antiAffinityRule := &k8sv1.PodAntiAffinity{
-		RequiredDuringSchedulingIgnoredDuringExecution: []k8sv1.PodAffinityTerm{antiAffinityTerm},
-	}
-
-	if templatePod.Spec.Affinity == nil {
-		templatePod.Spec.Affinity = &k8sv1.Affinity{
-			PodAntiAffinity: antiAffinityRule,
-		}
-	} else if templatePod.Spec.Affinity.PodAntiAffinity == nil {
-		templatePod.Spec.Affinity.PodAntiAffinity = antiAffinityRule
-	} else {
-		templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, antiAffinityTerm)
-	}
-
-	nodeSelector := make(map[string]string)
-	maps.Copy(nodeSelector, migration.Spec.AddedNodeSelector)
-	maps.Copy(nodeSelector, templatePod.Spec.NodeSelector)
-	templatePod.Spec.NodeSelector = nodeSelector
-
-	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
-	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = migration.Name
+	templatePod.Spec.Affinity = migrationsutil.TargetPodAffinity(vmi, templatePod.Spec.Affinity)
 
 	// If cpu model is "host model" allow migration only to nodes that supports this cpu model
-	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == virtv1.CPUModeHostModel {
-		node, err := c.getNodeForVMI(vmi)
-
-		if err != nil {
-			return err
-		}
-
-		err = prepareNodeSelectorForHostCpuModel(node, templatePod, sourcePod)
+	var sourceNode *k8sv1.Node
+	if migrationsutil.RequiresHostModelNodeSelector(vmi, sourcePod) {
+		sourceNode, err = c.getNodeForVMI(vmi)
 		if err != nil {
 			return err
 		}
 	}
+	templatePod.Spec.NodeSelector, err = migrationsutil.TargetPodNodeSelector(vmi, templatePod.Spec.NodeSelector, migration.Spec.AddedNodeSelector, sourcePod, sourceNode)
+	if err != nil {
+		return err
+	}
+
+	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
+	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = migration.Name
 
 	matchLevelOnTarget := c.clusterConfig.GetMigrationConfiguration().MatchSELinuxLevelOnMigration
-	if matchLevelOnTarget == nil || *matchLevelOnTarget {
+	if (matchLevelOnTarget == nil || *matchLevelOnTarget) && sourcePod != nil {
 		err = setTargetPodSELinuxLevel(templatePod, vmi.Status.SelinuxContext)
 		if err != nil {
 			return err
@@ -962,6 +961,10 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		SourceNode:   vmi.Status.NodeName,
 		TargetPod:    pod.Name,
 	}
+	if migration.Spec.Receive != nil {
+		// the target virt-handler routes the connections of the migration ID to the target pod
+		vmiCopy.Status.MigrationState.CrossClusterMigrationID = migration.Spec.Receive.MigrationID
+	}
 	if migration.Status.MigrationState != nil {
 		vmiCopy.Status.MigrationState.SourcePod = migration.Status.MigrationState.SourcePod
 		vmiCopy.Status.MigrationState.SourcePersistentStatePVCName = migration.Status.MigrationState.SourcePersistentStatePVCName
@@ -970,6 +973,9 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 
 	// By setting this label, virt-handler on the target node will receive
 	// the vmi and prepare the local environment for the migration
+	if vmiCopy.ObjectMeta.Labels == nil {
+		vmiCopy.ObjectMeta.Labels = map[string]string{}
+	}
 	vmiCopy.ObjectMeta.Labels[virtv1.MigrationTargetNodeNameLabel] = pod.Spec.NodeName
 
 	if controller.VMIHasHotplugVolumes(vmiCopy) {
@@ -1122,6 +1128,28 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		return nil
 	}
 
+	// Leave the free migration slots to pending migrations with a higher priority
+	rankedBefore, err := c.pendingMigrationsRankedBefore(migration, runningMigrations)
+	if err != nil {
+		return err
+	}
+	if freeSlots := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) - len(runningMigrations); rankedBefore >= freeSlots {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] pending migrations with a higher priority take the free migration slots.", vmi.Namespace, vmi.Name, rankedBefore)
+		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: lowPriority, After: 5 * time.Second}, key)
+		return nil
+	}
+
+	if migration.Spec.SendTo != nil && vmi.IsRunning() {
+		return c.handleCrossClusterSendHandoff(migration, vmi, sourcePod)
+	}
+
+	if migration.Spec.Receive != nil {
+		if !migrationsutil.IsCrossClusterMigrationReceiver(vmi) {
+			return fmt.Errorf("vmi %s/%s does not wait to receive a cross-cluster migration", vmi.Namespace, vmi.Name)
+		}
+		return c.createTargetPod(migration, vmi, nil)
+	}
+
 	// migration was accepted into the system, now see if we
 	// should create the target pod
 	if vmi.IsRunning() {
@@ -1403,7 +1431,10 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 			return nil
 		}
 
-		if !targetPodExists {
+		if !targetPodExists && migration.Spec.Receive != nil {
+			// the VMI of a receiving migration has no source pod in this cluster
+			return c.handleTargetPodCreation(key, migration, vmi, nil)
+		} else if !targetPodExists {
 			sourcePod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to fetch pods for namespace from cache.")
@@ -1471,7 +1502,8 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 			return c.handleTargetPodHandoff(migration, vmi, pod)
 		}
 	case virtv1.MigrationPreparingTarget, virtv1.MigrationTargetReady, virtv1.MigrationFailed:
-		if (!targetPodExists || controller.PodIsDown(pod)) &&
+		if migration.Spec.SendTo == nil &&
+			(!targetPodExists || controller.PodIsDown(pod)) &&
 			vmi.Status.MigrationState != nil &&
 			len(vmi.Status.MigrationState.TargetDirectMigrationNodePorts) == 0 &&
 			vmi.Status.MigrationState.StartTimestamp == nil &&
@@ -2072,44 +2104,6 @@ func (c *Controller) alertIfHostModelIsUnschedulable(vmi *virtv1.VirtualMachineI
 	}
 }
 
-func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, sourcePod *k8sv1.Pod) error {
-	var hostCpuModel, nodeSelectorKeyForHostModel, hostModelLabelValue string
-	migratedAtLeastOnce := false
-
-	// if the vmi already migrated before it should include node selector that consider CPUModelLabel
-	for key, value := range sourcePod.Spec.NodeSelector {
-		if strings.Contains(key, virtv1.CPUFeatureLabel) || strings.Contains(key, virtv1.SupportedHostModelMigrationCPU) {
-			pod.Spec.NodeSelector[key] = value
-			migratedAtLeastOnce = true
-		}
-	}
-
-	if !migratedAtLeastOnce {
-		for key, value := range node.Labels {
-			if strings.HasPrefix(key, virtv1.HostModelCPULabel) {
-				hostCpuModel = strings.TrimPrefix(key, virtv1.HostModelCPULabel)
-				hostModelLabelValue = value
-			}
-
-			if strings.HasPrefix(key, virtv1.HostModelRequiredFeaturesLabel) {
-				requiredFeature := strings.TrimPrefix(key, virtv1.HostModelRequiredFeaturesLabel)
-				pod.Spec.NodeSelector[virtv1.CPUFeatureLabel+requiredFeature] = value
-			}
-		}
-
-		if hostCpuModel == "" {
-			return fmt.Errorf("node does not contain labal \"%s\" with information about host cpu model", virtv1.HostModelCPULabel)
-		}
-
-		nodeSelectorKeyForHostModel = virtv1.SupportedHostModelMigrationCPU + hostCpuModel
-		pod.Spec.NodeSelector[nodeSelectorKeyForHostModel] = hostModelLabelValue
-
-		log.Log.Object(pod).Infof("cpu model label selector (\"%s\") defined for migration target pod", nodeSelectorKeyForHostModel)
-	}
-
-	return nil
-}
-
 func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
 	for key, value := range requiredNodeLabels {
 		nodeValue, ok := node.Labels[key]
@@ -2012,6 +2012,29 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.Compression = &virtv1.MigrationCompression{Mode: virtv1.MigrationCompressionMultifdZstd, ZstdLevel: pointer.P(int32(3))}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Compression).To(Equal(&virtv1.MigrationCompression{Mode: virtv1.MigrationCompressionMultifdZstd, ZstdLevel: pointer.P(int32(3))}))
+				},
+				true,
+			),
+			Entry("set max downtime and switchover policy",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.MaxDowntime = &metav1.Duration{Duration: 500 * time.Millisecond}
+					p.SwitchoverPolicy = &virtv1.MigrationSwitchoverPolicy{Steps: []virtv1.MigrationSwitchoverStep{
+						{Action: virtv1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: time.Minute}},
+					}}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.MaxDowntime).To(Equal(&metav1.Duration{Duration: 500 * time.Millisecond}))
+					Expect(c.SwitchoverPolicy.Steps).To(HaveLen(1))
+					Expect(c.SwitchoverPolicy.Steps[0].Action).To(Equal(virtv1.MigrationSwitchoverAbort))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -2317,6 +2340,254 @@ var _ = Describe("Migration watcher", func() {
 		})
 	})
 
+	Context("Migration priority", func() {
+		newMigrationWithPriority := func(name, vmiName string, priority virtv1.MigrationPriority, created time.Time) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration(name, vmiName, virtv1.MigrationPending)
+			migration.CreationTimestamp = metav1.NewTime(created)
+			migration.Status.Priority = &priority
+			return migration
+		}
+
+		addRunningMigrations := func(count int) {
+			for i := 0; i < count; i++ {
+				vmi := newVirtualMachine(fmt.Sprintf("runningvmi%d", i), virtv1.Running)
+				addNodeNameToVMI(vmi, fmt.Sprintf("node%d", i))
+				addMigration(newMigration(fmt.Sprintf("runningmigration%d", i), vmi.Name, virtv1.MigrationScheduling))
+				addVirtualMachineInstance(vmi)
+			}
+		}
+
+		addPendingMigration := func(migration *virtv1.VirtualMachineInstanceMigration) {
+			vmi := newVirtualMachine(migration.Spec.VMIName, virtv1.Running)
+			addNodeNameToVMI(vmi, "othernode")
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+		}
+
+		It("should leave the last free slot to a pending migration with a higher priority", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigrationWithPriority("testmigration", vmi.Name, virtv1.MigrationPrioritySystemMaintenance, time.Now())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addRunningMigrations(4)
+			addPendingMigration(newMigrationWithPriority("criticalmigration", "criticalvmi", virtv1.MigrationPrioritySystemCritical, time.Now()))
+
+			sanityExecute()
+
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+		})
+
+		It("should start a pending migration before migrations with a lower priority", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigrationWithPriority("testmigration", vmi.Name, virtv1.MigrationPrioritySystemCritical, time.Now())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addRunningMigrations(4)
+			addPendingMigration(newMigrationWithPriority("usermigration", "uservmi", virtv1.MigrationPriorityUserTriggered, time.Now().Add(-time.Minute)))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		It("should start a low priority migration which waited long enough", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigrationWithPriority("testmigration", vmi.Name, virtv1.MigrationPrioritySystemMaintenance, time.Now().Add(-2*migrationPriorityAgingInterval))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addRunningMigrations(4)
+			addPendingMigration(newMigrationWithPriority("criticalmigration", "criticalvmi", virtv1.MigrationPrioritySystemCritical, time.Now()))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		DescribeTable("should resolve the priority when the migration becomes pending", func(requested, policyPriority *virtv1.MigrationPriority, expected virtv1.MigrationPriority) {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPhaseUnset)
+			migration.Spec.Priority = requested
+			if policyPriority != nil {
+				policy := preparePolicyAndVMIWithNSAndVMILabels(vmi, nil, 1, 0)
+				policy.Spec.Priority = policyPriority
+				addMigrationPolicies(*policy)
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.Phase).To(Equal(virtv1.MigrationPending))
+			Expect(updatedVMIM.Status.Priority).To(HaveValue(Equal(expected)))
+		},
+			Entry("user-triggered by default", nil, nil, virtv1.MigrationPriorityUserTriggered),
+			Entry("from the migration", pointer.P(virtv1.MigrationPrioritySystemMaintenance), nil, virtv1.MigrationPrioritySystemMaintenance),
+			Entry("raised by the migration policy", pointer.P(virtv1.MigrationPrioritySystemMaintenance), pointer.P(virtv1.MigrationPrioritySystemCritical), virtv1.MigrationPrioritySystemCritical),
+			Entry("not lowered by the migration policy", pointer.P(virtv1.MigrationPrioritySystemCritical), pointer.P(virtv1.MigrationPrioritySystemMaintenance), virtv1.MigrationPrioritySystemCritical),
+		)
+
+		It("should rank migrations by their aged priority and then by their creation", func() {
+			now := time.Now()
+			critical := newMigrationWithPriority("critical", "vmi", virtv1.MigrationPrioritySystemCritical, now)
+			user := newMigrationWithPriority("user", "vmi", virtv1.MigrationPriorityUserTriggered, now.Add(-time.Minute))
+			olderUser := newMigrationWithPriority("olderuser", "vmi", virtv1.MigrationPriorityUserTriggered, now.Add(-2*time.Minute))
+			agedMaintenance := newMigrationWithPriority("agedmaintenance", "vmi", virtv1.MigrationPrioritySystemMaintenance, now.Add(-2*migrationPriorityAgingInterval))
+
+			Expect(isMigrationRankedBefore(critical, user, now)).To(BeTrue())
+			Expect(isMigrationRankedBefore(olderUser, user, now)).To(BeTrue())
+			Expect(isMigrationRankedBefore(user, olderUser, now)).To(BeFalse())
+			Expect(isMigrationRankedBefore(agedMaintenance, critical, now)).To(BeTrue())
+		})
+	})
+
+	Context("Cross-cluster migration", func() {
+		It("should hand a sending migration off to the source virt-handler without a target pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+				MigrationID: "migration-1",
+				ConnectURL:  "10.0.0.1:49154",
+			}
+			sourcePod := newSourcePodForVirtualMachine(vmi)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(sourcePod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":            Equal(migration.UID),
+				"SourceNode":              Equal("node02"),
+				"SourcePod":               Equal(sourcePod.Name),
+				"TargetNode":              BeEmpty(),
+				"TargetNodeAddress":       Equal("10.0.0.1:49154"),
+				"CrossClusterMigrationID": Equal("migration-1"),
+			})))
+			expectMigrationTargetReadyState(migration.Namespace, migration.Name)
+		})
+
+		newReceiverVirtualMachine := func() *virtv1.VirtualMachineInstance {
+			vmi := newVirtualMachine("testvmi", virtv1.Pending)
+			vmi.Status.NodeName = ""
+			vmi.Status.SelinuxContext = ""
+			vmi.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation] = ""
+			return vmi
+		}
+
+		It("should create the target pod of a receiving migration without a running VMI", func() {
+			vmi := newReceiverVirtualMachine()
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{MigrationID: "migration-1"}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		It("should not create the target pod of a receiving migration for a started VMI", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{MigrationID: "migration-1"}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", virtv1.MigrationJobLabel, string(migration.UID)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+		})
+
+		It("should pass the migration ID of a receiving migration to the target virt-handler", func() {
+			vmi := newReceiverVirtualMachine()
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{MigrationID: "migration-1"}
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"TargetNode":              Equal("node01"),
+				"CrossClusterMigrationID": Equal("migration-1"),
+			})))
+		})
+
+		It("should not fail a sending migration for the missing target pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationTargetReady)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+				MigrationID: "migration-1",
+				ConnectURL:  "10.0.0.1:49154",
+			}
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:            migration.UID,
+				SourceNode:              "node02",
+				TargetNodeAddress:       "10.0.0.1:49154",
+				CrossClusterMigrationID: "migration-1",
+				StartTimestamp:          pointer.P(metav1.Now()),
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			expectMigrationRunningState(migration.Namespace, migration.Name)
+		})
+
+		It("should succeed a sending migration once the VMI was sent to the receiving cluster", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Succeeded)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+				MigrationID: "migration-1",
+				ConnectURL:  "10.0.0.1:49154",
+			}
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:            migration.UID,
+				SourceNode:              "node02",
+				TargetNodeAddress:       "10.0.0.1:49154",
+				CrossClusterMigrationID: "migration-1",
+				StartTimestamp:          pointer.P(metav1.Now()),
+				EndTimestamp:            pointer.P(metav1.Now()),
+				Completed:               true,
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulMigrationReason)
+			expectMigrationCompletedState(migration.Namespace, migration.Name)
+		})
+	})
+
 	Context("Priority queue", func() {
 		It("should properly re-enqueue pending migrations as low priority when no new migration can start", func() {
 			By("Creating 1 pending migration. It will be picked up by the call to Execute()")
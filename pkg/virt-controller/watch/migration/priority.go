@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// migrationPriorityAgingInterval is the time after which a waiting migration is ranked one priority higher,
+// so that migrations with a low priority are not starved by a steady stream of higher priority migrations
+const migrationPriorityAgingInterval = 5 * time.Minute
+
+var currentTime = time.Now
+
+func priorityRank(priority virtv1.MigrationPriority) int {
+	switch priority {
+	case virtv1.MigrationPrioritySystemCritical:
+		return 2
+	case virtv1.MigrationPrioritySystemMaintenance:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// migrationPriority returns the effective priority of the migration, which is resolved
+// when the migration becomes pending, or the requested priority before that
+func migrationPriority(migration *virtv1.VirtualMachineInstanceMigration) virtv1.MigrationPriority {
+	if migration.Status.Priority != nil {
+		return *migration.Status.Priority
+	}
+	if migration.Spec.Priority != nil {
+		return *migration.Spec.Priority
+	}
+	return virtv1.MigrationPriorityUserTriggered
+}
+
+// agedPriorityRank returns the rank of the migration priority, raised by one for every aging interval the migration waited
+func agedPriorityRank(migration *virtv1.VirtualMachineInstanceMigration, now time.Time) int {
+	rank := priorityRank(migrationPriority(migration))
+	if waiting := now.Sub(migration.CreationTimestamp.Time); waiting > 0 {
+		rank += int(waiting / migrationPriorityAgingInterval)
+	}
+	return rank
+}
+
+// isMigrationRankedBefore returns true if the migration a is started before the migration b.
+// Migrations with the same aged priority are started in the order they were created.
+func isMigrationRankedBefore(a, b *virtv1.VirtualMachineInstanceMigration, now time.Time) bool {
+	rankA, rankB := agedPriorityRank(a, now), agedPriorityRank(b, now)
+	if rankA != rankB {
+		return rankA > rankB
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// resolveMigrationPriority returns the higher one of the priority requested by the migration
+// and of the priority of the migration policy matching the VMI
+func (c *Controller) resolveMigrationPriority(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (*virtv1.MigrationPriority, error) {
+	priority := virtv1.MigrationPriorityUserTriggered
+	if migration.Spec.Priority != nil {
+		priority = *migration.Spec.Priority
+	}
+
+	var policies []v1alpha1.MigrationPolicy
+	for _, obj := range c.migrationPolicyStore.List() {
+		policy := obj.(*v1alpha1.MigrationPolicy)
+		policies = append(policies, *policy)
+	}
+	if !hasPolicyWithPriority(policies) {
+		return &priority, nil
+	}
+
+	vmiNamespace, err := c.clientset.CoreV1().Namespaces().Get(context.Background(), vmi.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	matchedPolicy := matchPolicy(&v1alpha1.MigrationPolicyList{Items: policies}, vmi, vmiNamespace)
+	if matchedPolicy != nil && matchedPolicy.Spec.Priority != nil &&
+		priorityRank(*matchedPolicy.Spec.Priority) > priorityRank(priority) {
+		priority = *matchedPolicy.Spec.Priority
+	}
+	return &priority, nil
+}
+
+func hasPolicyWithPriority(policies []v1alpha1.MigrationPolicy) bool {
+	for _, policy := range policies {
+		if policy.Spec.Priority != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingMigrationsRankedBefore counts the pending migrations which are started before the migration.
+// Only migrations which can start right away are counted: their VMI is running, they are not
+// being deleted and their source node did not hit the outbound migrations per node limit.
+func (c *Controller) pendingMigrationsRankedBefore(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, error) {
+	running := map[string]bool{}
+	for _, m := range runningMigrations {
+		running[string(m.UID)] = true
+	}
+	outboundLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+	now := currentTime()
+
+	count := 0
+	for _, other := range migrationsutil.ListUnfinishedMigrations(c.migrationIndexer) {
+		if other.UID == migration.UID || running[string(other.UID)] ||
+			other.Status.Phase != virtv1.MigrationPending || other.DeletionTimestamp != nil {
+			continue
+		}
+		if !isMigrationRankedBefore(other, migration, now) {
+			continue
+		}
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(other.Namespace, other.Spec.VMIName))
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		outboundMigrations, err := c.outboundMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
+		if err != nil {
+			return 0, err
+		}
+		if outboundMigrations >= outboundLimit {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,387 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+const (
+	FailedAutoscaleReason     = "FailedAutoscale"
+	SuccessfulAutoscaleReason = "SuccessfulAutoscale"
+
+	// guest metrics don't trigger any event, pools with autoscaling are re-evaluated periodically
+	autoscalingResyncPeriod = 30 * time.Second
+	// guest metrics are collected in the background at the same pace
+	guestMetricsCollectPeriod = autoscalingResyncPeriod
+	// ratio between the current and the target utilization within which no scaling happens
+	autoscalingTolerance = 0.1
+
+	defaultScaleUpCooldown   = 3 * time.Minute
+	defaultScaleDownCooldown = 5 * time.Minute
+
+	guestMetricsSampleTTL = 10 * time.Minute
+	// guest metrics are retrieved from a limited number of VMIs at a time,
+	// a VMI which does not answer within the timeout has no sample
+	guestMetricsConcurrency = 10
+	guestMetricsTimeout     = 5 * time.Second
+)
+
+// runGuestMetricsCollector runs the collection of the guest metrics of a pool until it is stopped
+var runGuestMetricsCollector = func(collect func(), stop <-chan struct{}) {
+	go wait.Until(collect, guestMetricsCollectPeriod, stop)
+}
+
+// guestMetricsCache keeps the guest metrics of the pools with autoscaling. The guest
+// metrics are collected by a goroutine per pool, so that slow guests don't block the
+// reconciliation of the pools, which only reads the last collected values.
+type guestMetricsCache struct {
+	lock sync.Mutex
+	// the last guest metrics sample of every VMI, the CPU utilization can only be
+	// calculated from two consecutive samples
+	samples map[types.UID]*virtv1.VirtualMachineInstanceGuestMetrics
+	pools   map[string]*poolGuestMetrics
+}
+
+// poolGuestMetrics holds the result of the last collection for a pool.
+type poolGuestMetrics struct {
+	stop         chan struct{}
+	utilizations []guestUtilization
+}
+
+func newGuestMetricsCache() *guestMetricsCache {
+	return &guestMetricsCache{
+		samples: map[types.UID]*virtv1.VirtualMachineInstanceGuestMetrics{},
+		pools:   map[string]*poolGuestMetrics{},
+	}
+}
+
+// startCollector starts the collection for a pool, unless it is already running.
+func (c *guestMetricsCache) startCollector(key string, collect func() []guestUtilization) {
+	c.lock.Lock()
+	if _, exists := c.pools[key]; exists {
+		c.lock.Unlock()
+		return
+	}
+	collector := &poolGuestMetrics{stop: make(chan struct{})}
+	c.pools[key] = collector
+	c.lock.Unlock()
+
+	runGuestMetricsCollector(func() {
+		utilizations := collect()
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		// the collector may have been replaced in the meantime
+		if c.pools[key] == collector {
+			collector.utilizations = utilizations
+		}
+	}, collector.stop)
+}
+
+// stopCollector stops the collection for a pool and drops its results.
+func (c *guestMetricsCache) stopCollector(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if collector, exists := c.pools[key]; exists {
+		close(collector.stop)
+		delete(c.pools, key)
+	}
+}
+
+func (c *guestMetricsCache) stopAllCollectors() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, collector := range c.pools {
+		close(collector.stop)
+		delete(c.pools, key)
+	}
+}
+
+// collected returns the last collected utilizations of a pool.
+func (c *guestMetricsCache) collected(key string) []guestUtilization {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	collector, exists := c.pools[key]
+	if !exists {
+		return nil
+	}
+	return collector.utilizations
+}
+
+// swap stores the new sample of a VMI and returns the previous one, if any.
+func (c *guestMetricsCache) swap(uid types.UID, sample *virtv1.VirtualMachineInstanceGuestMetrics) *virtv1.VirtualMachineInstanceGuestMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	previous := c.samples[uid]
+	c.samples[uid] = sample
+	return previous
+}
+
+// prune drops the samples of VMIs which were not sampled recently.
+func (c *guestMetricsCache) prune(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for uid, sample := range c.samples {
+		if now.Sub(sample.Timestamp.Time) > guestMetricsSampleTTL {
+			delete(c.samples, uid)
+		}
+	}
+}
+
+// guestUtilization holds the utilization of a single guest for each metric type, as a percentage.
+type guestUtilization map[poolv1.VirtualMachinePoolMetricType]float64
+
+func calcGuestUtilization(current, previous *virtv1.VirtualMachineInstanceGuestMetrics) guestUtilization {
+	utilization := guestUtilization{}
+
+	if current.VCPUCount > 0 && current.VCPUTimeNanoseconds != nil && previous != nil && previous.VCPUTimeNanoseconds != nil {
+		elapsed := current.Timestamp.Sub(previous.Timestamp.Time)
+		used := *current.VCPUTimeNanoseconds - *previous.VCPUTimeNanoseconds
+		// a negative difference means the guest was restarted in between
+		if elapsed > 0 && used >= 0 {
+			utilization[poolv1.VirtualMachinePoolCPUMetricType] = float64(used) / float64(elapsed.Nanoseconds()*int64(current.VCPUCount)) * 100
+		}
+	}
+
+	if current.MemoryAvailable != nil && current.MemoryUsable != nil && current.MemoryAvailable.Value() > 0 {
+		used := current.MemoryAvailable.Value() - current.MemoryUsable.Value()
+		utilization[poolv1.VirtualMachinePoolMemoryMetricType] = float64(used) / float64(current.MemoryAvailable.Value()) * 100
+	}
+
+	if current.Load != nil && current.VCPUCount > 0 {
+		utilization[poolv1.VirtualMachinePoolGuestLoadMetricType] = current.Load.Load1.AsApproximateFloat64() / float64(current.VCPUCount) * 100
+	}
+
+	return utilization
+}
+
+// collectGuestMetrics samples the guest metrics of the VMs of a pool. It runs in the collector of the pool.
+func (c *Controller) collectGuestMetrics(key string) []guestUtilization {
+	obj, exists, err := c.poolIndexer.GetByKey(key)
+	if err != nil || !exists {
+		return nil
+	}
+	pool := obj.(*poolv1.VirtualMachinePool)
+
+	vms, err := c.listVMsFromNamespace(pool.Namespace)
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Error("Failed to fetch vms for namespace from cache.")
+		return nil
+	}
+	vms = filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
+		controllerRef := metav1.GetControllerOf(vm)
+		return controllerRef != nil && controllerRef.UID == pool.UID
+	})
+
+	return c.collectGuestUtilization(pool, vms)
+}
+
+// findHorizontalPodAutoscaler returns the name of a HorizontalPodAutoscaler which targets the pool.
+func (c *Controller) findHorizontalPodAutoscaler(pool *poolv1.VirtualMachinePool) string {
+	objs, err := c.hpaIndexer.ByIndex(cache.NamespaceIndex, pool.Namespace)
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Warning("Failed to fetch the HorizontalPodAutoscalers of the namespace from cache")
+		return ""
+	}
+	for _, obj := range objs {
+		hpa := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+		target := hpa.Spec.ScaleTargetRef
+		gv, err := schema.ParseGroupVersion(target.APIVersion)
+		if err == nil && gv.Group == poolv1.SchemeGroupVersion.Group &&
+			target.Kind == poolv1.VirtualMachinePoolKind && target.Name == pool.Name {
+			return hpa.Name
+		}
+	}
+	return ""
+}
+
+// collectGuestUtilization samples the guest metrics of all ready VMs of the pool.
+// VMs whose metrics can't be retrieved are left out.
+func (c *Controller) collectGuestUtilization(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) []guestUtilization {
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, vm := range c.filterReadyVMs(filterDeletingVMs(vms)) {
+		if vmi := c.activeVMI(vm); vmi != nil && vmi.Status.Phase == virtv1.Running {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	var lock sync.Mutex
+	var group errgroup.Group
+	group.SetLimit(guestMetricsConcurrency)
+	utilizations := []guestUtilization{}
+
+	for _, vmi := range vmis {
+		group.Go(func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), guestMetricsTimeout)
+			defer cancel()
+			sample, err := c.clientset.VirtualMachineInstance(vmi.Namespace).GuestMetrics(ctx, vmi.Name)
+			if err != nil {
+				log.Log.Object(pool).Reason(err).V(4).Infof("Failed to retrieve guest metrics of vmi %s/%s", vmi.Namespace, vmi.Name)
+				return nil
+			}
+			previous := c.guestMetrics.swap(vmi.UID, &sample)
+
+			lock.Lock()
+			defer lock.Unlock()
+			utilizations = append(utilizations, calcGuestUtilization(&sample, previous))
+			return nil
+		})
+	}
+	// the VMIs without guest metrics are left out, so no error is returned
+	_ = group.Wait()
+
+	c.guestMetrics.prune(time.Now())
+	return utilizations
+}
+
+func autoscalingBounds(autoscaling *poolv1.VirtualMachinePoolAutoscaling) (int, int) {
+	minReplicas := 1
+	if autoscaling.MinReplicas != nil {
+		minReplicas = int(*autoscaling.MinReplicas)
+	}
+	return minReplicas, int(autoscaling.MaxReplicas)
+}
+
+// calcAutoscaledReplicas returns the number of replicas needed to bring each metric to
+// its target, bounded by the min and max replicas, along with the observed metrics.
+func calcAutoscaledReplicas(autoscaling *poolv1.VirtualMachinePoolAutoscaling, current int, utilizations []guestUtilization) (int, []poolv1.VirtualMachinePoolMetricStatus) {
+	desired := -1
+	metrics := []poolv1.VirtualMachinePoolMetricStatus{}
+
+	for _, metric := range autoscaling.Metrics {
+		sum := 0.0
+		samples := 0
+		for _, utilization := range utilizations {
+			if value, exists := utilization[metric.Type]; exists {
+				sum += value
+				samples++
+			}
+		}
+		if samples == 0 || metric.TargetAverageUtilization <= 0 {
+			continue
+		}
+
+		average := sum / float64(samples)
+		metrics = append(metrics, poolv1.VirtualMachinePoolMetricStatus{
+			Type:                      metric.Type,
+			CurrentAverageUtilization: int32(math.Round(average)),
+			Samples:                   int32(samples),
+		})
+
+		proposal := current
+		ratio := average / float64(metric.TargetAverageUtilization)
+		if math.Abs(ratio-1) > autoscalingTolerance {
+			proposal = int(math.Ceil(ratio * float64(current)))
+		}
+		desired = max(desired, proposal)
+	}
+
+	if desired < 0 {
+		// no metric reported, keep the current replicas
+		desired = current
+	}
+
+	minReplicas, maxReplicas := autoscalingBounds(autoscaling)
+	return min(max(desired, minReplicas), maxReplicas), metrics
+}
+
+func autoscalingCooldown(autoscaling *poolv1.VirtualMachinePoolAutoscaling, scaleUp bool) time.Duration {
+	if scaleUp {
+		if autoscaling.ScaleUpCooldown != nil {
+			return autoscaling.ScaleUpCooldown.Duration
+		}
+		return defaultScaleUpCooldown
+	}
+	if autoscaling.ScaleDownCooldown != nil {
+		return autoscaling.ScaleDownCooldown.Duration
+	}
+	return defaultScaleDownCooldown
+}
+
+// autoscale adjusts the replicas of the pool to the last collected guest metrics. It returns
+// the pool with the adjusted replicas and the autoscaling status to report.
+func (c *Controller) autoscale(key string, pool *poolv1.VirtualMachinePool) (*poolv1.VirtualMachinePool, *poolv1.VirtualMachinePoolAutoscalingStatus, common.SyncError) {
+	autoscaling := pool.Spec.Autoscaling
+	current := wantedReplicas(pool)
+	desired, metrics := calcAutoscaledReplicas(autoscaling, current, c.guestMetrics.collected(key))
+
+	status := &poolv1.VirtualMachinePoolAutoscalingStatus{
+		DesiredReplicas: int32(desired),
+		CurrentMetrics:  metrics,
+	}
+	if pool.Status.Autoscaling != nil {
+		status.LastScaleTime = pool.Status.Autoscaling.LastScaleTime
+	}
+
+	if desired == current {
+		return pool, status, nil
+	}
+
+	// replicas outside of the bounds are corrected regardless of the cooldown
+	minReplicas, maxReplicas := autoscalingBounds(autoscaling)
+	inBounds := current >= minReplicas && current <= maxReplicas
+	if inBounds && status.LastScaleTime != nil &&
+		time.Since(status.LastScaleTime.Time) < autoscalingCooldown(autoscaling, desired > current) {
+		log.Log.Object(pool).V(4).Infof("Not scaling pool to %d replicas during the cooldown", desired)
+		return pool, status, nil
+	}
+
+	patchBytes, err := patch.New(patch.WithAdd("/spec/replicas", desired)).GeneratePayload()
+	if err != nil {
+		return pool, status, common.NewSyncError(fmt.Errorf("Error while autoscaling: %v", err), FailedAutoscaleReason)
+	}
+	updatedPool, err := c.clientset.VirtualMachinePool(pool.Namespace).Patch(context.Background(), pool.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return pool, status, common.NewSyncError(fmt.Errorf("Error while autoscaling: %v", err), FailedAutoscaleReason)
+	}
+
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulAutoscaleReason, "Autoscaled pool from %d to %d replicas", current, desired)
+	now := metav1.Now()
+	status.LastScaleTime = &now
+
+	return updatedPool, status, nil
+}
@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	FailedReplaceUnhealthyReason   = "FailedReplaceUnhealthy"
+	ReplacedUnhealthyReplicaReason = "ReplacedUnhealthyReplica"
+
+	defaultUnhealthyGracePeriod = 5 * time.Minute
+)
+
+// unhealthyPrintableStatuses are the statuses VMs don't recover from without an intervention
+var unhealthyPrintableStatuses = map[virtv1.VirtualMachinePrintableStatus]bool{
+	virtv1.VirtualMachineStatusCrashLoopBackOff: true,
+	virtv1.VirtualMachineStatusUnschedulable:    true,
+	virtv1.VirtualMachineStatusDataVolumeError:  true,
+}
+
+func isVMUnhealthy(vm *virtv1.VirtualMachine) bool {
+	return vm.DeletionTimestamp == nil && unhealthyPrintableStatuses[vm.Status.PrintableStatus]
+}
+
+// countReplicasByStatus returns the number of VMs in each printable status, sorted by
+// status, and the number of unhealthy VMs.
+func countReplicasByStatus(vms []*virtv1.VirtualMachine) ([]poolv1.VirtualMachinePoolStatusCount, int32) {
+	counts := map[virtv1.VirtualMachinePrintableStatus]int32{}
+	unhealthy := int32(0)
+	for _, vm := range vms {
+		// VMs which were not yet reconciled have no status
+		if vm.Status.PrintableStatus != "" {
+			counts[vm.Status.PrintableStatus]++
+		}
+		if isVMUnhealthy(vm) {
+			unhealthy++
+		}
+	}
+
+	if len(counts) == 0 {
+		return nil, unhealthy
+	}
+
+	replicasByStatus := make([]poolv1.VirtualMachinePoolStatusCount, 0, len(counts))
+	for status, count := range counts {
+		replicasByStatus = append(replicasByStatus, poolv1.VirtualMachinePoolStatusCount{Status: status, Count: count})
+	}
+	sort.Slice(replicasByStatus, func(i, j int) bool {
+		return replicasByStatus[i].Status < replicasByStatus[j].Status
+	})
+	return replicasByStatus, unhealthy
+}
+
+type unhealthyObservation struct {
+	status virtv1.VirtualMachinePrintableStatus
+	since  time.Time
+}
+
+// unhealthyTracker remembers since when VMs are in an unhealthy status.
+// The VM status carries no transition time for the printable status,
+// hence the first observation of the controller is used.
+type unhealthyTracker struct {
+	lock         sync.Mutex
+	observations map[types.UID]unhealthyObservation
+}
+
+func newUnhealthyTracker() *unhealthyTracker {
+	return &unhealthyTracker{
+		observations: map[types.UID]unhealthyObservation{},
+	}
+}
+
+// observe returns since when the VM is in its current unhealthy status.
+func (t *unhealthyTracker) observe(vm *virtv1.VirtualMachine, now time.Time) time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	observation, exists := t.observations[vm.UID]
+	if !exists || observation.status != vm.Status.PrintableStatus {
+		observation = unhealthyObservation{status: vm.Status.PrintableStatus, since: now}
+		t.observations[vm.UID] = observation
+	}
+	return observation.since
+}
+
+func (t *unhealthyTracker) forget(uid types.UID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.observations, uid)
+}
+
+func unhealthyGracePeriod(pool *poolv1.VirtualMachinePool) time.Duration {
+	if gracePeriod := pool.Spec.UnhealthyReplicaReplacement.GracePeriod; gracePeriod != nil {
+		return gracePeriod.Duration
+	}
+	return defaultUnhealthyGracePeriod
+}
+
+// replaceUnhealthyReplicas deletes VMs which are unhealthy for longer than the grace
+// period, the scale out recreates them. The pool is re-enqueued for VMs whose grace
+// period did not expire yet.
+func (c *Controller) replaceUnhealthyReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	key, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
+	gracePeriod := unhealthyGracePeriod(pool)
+	now := time.Now()
+	nextCheck := time.Duration(-1)
+	deleteList := []*virtv1.VirtualMachine{}
+
+	for _, vm := range vms {
+		if !isVMUnhealthy(vm) {
+			c.unhealthyReplicas.forget(vm.UID)
+			continue
+		}
+		if isProtectedFromScaleIn(vm) {
+			continue
+		}
+
+		remaining := gracePeriod - now.Sub(c.unhealthyReplicas.observe(vm, now))
+		if remaining > 0 {
+			if nextCheck < 0 || remaining < nextCheck {
+				nextCheck = remaining
+			}
+			continue
+		}
+		if len(deleteList) < int(c.burstReplicas) {
+			deleteList = append(deleteList, vm)
+		}
+	}
+
+	if nextCheck > 0 {
+		c.queue.AddAfter(key, nextCheck)
+	}
+
+	if len(deleteList) == 0 {
+		return nil
+	}
+
+	for _, vm := range deleteList {
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, ReplacedUnhealthyReplicaReason, "Replacing VM %s/%s which is %s for more than %v", vm.Namespace, vm.Name, vm.Status.PrintableStatus, gracePeriod)
+	}
+	log.Log.Object(pool).Infof("Replacing %d unhealthy VMs in pool", len(deleteList))
+	return c.deleteVMs(pool, deleteList)
+}
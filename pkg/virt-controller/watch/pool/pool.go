@@ -7,6 +7,7 @@ import (
 	"maps"
 	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/trace"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -43,16 +45,19 @@ import (
 
 // Controller is the main Controller struct.
 type Controller struct {
-	clientset       kubecli.KubevirtClient
-	queue           workqueue.TypedRateLimitingInterface[string]
-	vmIndexer       cache.Indexer
-	vmiStore        cache.Store
-	poolIndexer     cache.Indexer
-	revisionIndexer cache.Indexer
-	recorder        record.EventRecorder
-	expectations    *controller.UIDTrackingControllerExpectations
-	burstReplicas   uint
-	hasSynced       func() bool
+	clientset         kubecli.KubevirtClient
+	queue             workqueue.TypedRateLimitingInterface[string]
+	vmIndexer         cache.Indexer
+	vmiStore          cache.Store
+	poolIndexer       cache.Indexer
+	revisionIndexer   cache.Indexer
+	hpaIndexer        cache.Indexer
+	recorder          record.EventRecorder
+	expectations      *controller.UIDTrackingControllerExpectations
+	burstReplicas     uint
+	hasSynced         func() bool
+	guestMetrics      *guestMetricsCache
+	unhealthyReplicas *unhealthyTracker
 }
 
 const (
@@ -60,6 +65,8 @@ const (
 	SuccessfulUpdateVirtualMachineReason = "SuccessfulUpdate"
 
 	defaultAddDelay = 1 * time.Second
+
+	defaultMaxUnavailable = "25%"
 )
 
 const (
@@ -80,6 +87,7 @@ func NewController(clientset kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	hpaInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*Controller, error) {
 	c := &Controller{
@@ -88,17 +96,20 @@ func NewController(clientset kubecli.KubevirtClient,
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-pool"},
 		),
-		poolIndexer:     poolInformer.GetIndexer(),
-		vmiStore:        vmiInformer.GetStore(),
-		vmIndexer:       vmInformer.GetIndexer(),
-		revisionIndexer: revisionInformer.GetIndexer(),
-		recorder:        recorder,
-		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
-		burstReplicas:   burstReplicas,
+		poolIndexer:       poolInformer.GetIndexer(),
+		vmiStore:          vmiInformer.GetStore(),
+		vmIndexer:         vmInformer.GetIndexer(),
+		revisionIndexer:   revisionInformer.GetIndexer(),
+		hpaIndexer:        hpaInformer.GetIndexer(),
+		recorder:          recorder,
+		expectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		burstReplicas:     burstReplicas,
+		guestMetrics:      newGuestMetricsCache(),
+		unhealthyReplicas: newUnhealthyTracker(),
 	}
 
 	c.hasSynced = func() bool {
-		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced()
+		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() && hpaInformer.HasSynced()
 	}
 
 	_, err := poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -345,6 +356,7 @@ func (c *Controller) deleteVMHandler(obj interface{}) {
 		return
 	}
 	c.expectations.DeletionObserved(poolKey, controller.VirtualMachineKey(vm))
+	c.unhealthyReplicas.forget(vm.UID)
 	c.enqueuePool(pool)
 }
 
@@ -451,6 +463,7 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	}
 
 	<-stopCh
+	c.guestMetrics.stopAllCollectors()
 	log.Log.Info("Stopping pool controller.")
 }
 
@@ -471,13 +484,35 @@ func (c *Controller) listVMsFromNamespace(namespace string) ([]*virtv1.VirtualMa
 	return vms, nil
 }
 
-func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+func wantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	return len(vms) - wantedReplicas(pool)
+}
+
+// calcSurge returns the number of VMs the pool may run on top of the desired
+// replicas while a rolling update replaces outdated VMs.
+func (c *Controller) calcSurge(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	if getUpdateStrategyType(pool) != poolv1.VirtualMachinePoolRollingUpdateStrategyType {
+		return 0, nil
+	}
+
+	_, maxSurge, err := resolveRollingUpdate(pool)
+	if err != nil || maxSurge == 0 {
+		return 0, err
 	}
 
-	return len(vms) - int(wantedReplicas)
+	outdated, err := c.filterOutdatedReplicas(pool, filterDeletingVMs(vms))
+	if err != nil {
+		return 0, err
+	}
+
+	return min(maxSurge, len(outdated)), nil
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -491,10 +526,19 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 	return filtered
 }
 
+func isVMReady(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+}
+
 // filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
 func (c *Controller) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	return filterVMs(vms, isVMReady)
+}
+
+// filterNotReadyVMs takes a list of VMs and returns all VMs which are not in ready state.
+func filterNotReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+		return !isVMReady(vm)
 	})
 }
 
@@ -510,32 +554,92 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 
 func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
 
-	poolKey, err := controller.KeyFunc(pool)
-	if err != nil {
-		return err
-	}
-
 	elgibleVMs := filterDeletingVMs(vms)
 
 	// make sure we count already deleting VMs here during scale in.
 	count = count - (len(vms) - len(elgibleVMs))
 
-	if len(elgibleVMs) == 0 || count == 0 {
+	unprotectedVMs := filterVMs(elgibleVMs, func(vm *virtv1.VirtualMachine) bool {
+		return !isProtectedFromScaleIn(vm)
+	})
+	if protected := len(elgibleVMs) - len(unprotectedVMs); protected > 0 && count > len(unprotectedVMs) {
+		log.Log.Object(pool).Infof("Skipping %d VMs protected from scale in", protected)
+	}
+	elgibleVMs = unprotectedVMs
+
+	if len(elgibleVMs) == 0 || count <= 0 {
 		return nil
 	} else if count > len(elgibleVMs) {
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	sortVMsForScaleIn(pool, elgibleVMs)
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
+	return c.deleteVMs(pool, elgibleVMs[0:count])
+}
+
+func isProtectedFromScaleIn(vm *virtv1.VirtualMachine) bool {
+	return vm.Annotations[virtv1.VirtualMachinePoolScaleInProtectionAnnotation] == "true"
+}
+
+// sortVMsForScaleIn orders the VMs so that the ones to remove first, according to the
+// scale-in strategy of the pool, are at the beginning.
+func sortVMsForScaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	// random order by default, and as a tie-breaker for the strategies below
+	rand.Shuffle(len(vms), func(i, j int) {
+		vms[i], vms[j] = vms[j], vms[i]
+	})
+
+	if pool.Spec.ScaleInStrategy == nil {
+		return
+	}
+
+	var less func(a, b *virtv1.VirtualMachine) bool
+	switch pool.Spec.ScaleInStrategy.Type {
+	case poolv1.VirtualMachinePoolNewestFirstScaleInStrategyType:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return b.CreationTimestamp.Before(&a.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolOldestFirstScaleInStrategyType:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolNotReadyFirstScaleInStrategyType:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return !isVMReady(a) && isVMReady(b)
+		}
+	case poolv1.VirtualMachinePoolHighestIndexFirstScaleInStrategyType:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return scaleInIndex(a) > scaleInIndex(b)
+		}
+	default:
+		return
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		return less(vms[i], vms[j])
+	})
+}
+
+// scaleInIndex returns the pool index of the VM, VMs without a valid index are sorted last.
+func scaleInIndex(vm *virtv1.VirtualMachine) int {
+	index, err := indexFromName(vm.Name)
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+func (c *Controller) deleteVMs(pool *poolv1.VirtualMachinePool, deleteList []*virtv1.VirtualMachine) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
 	var wg sync.WaitGroup
 
-	deleteList := elgibleVMs[0:count]
 	c.expectations.ExpectDeletions(poolKey, controller.VirtualMachineKeys(deleteList))
 	wg.Add(len(deleteList))
 	errChan := make(chan error, len(deleteList))
@@ -810,7 +914,12 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 }
 
 func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (common.SyncError, bool) {
-	diff := c.calcDiff(pool, vms)
+	surge, err := c.calcSurge(pool, vms)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("Error while calculating surge: %v", err), FailedScaleOutReason), false
+	}
+
+	diff := c.calcDiff(pool, vms) - surge
 	if diff == 0 {
 		// nothing to do
 		return nil, true
@@ -861,6 +970,13 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 
 			vmCopy.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vmCopy.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
+			// the scale-in protection is set on the VM itself, it must survive the update of the template
+			if protection, ok := vm.Annotations[virtv1.VirtualMachinePoolScaleInProtectionAnnotation]; ok {
+				if vmCopy.Annotations == nil {
+					vmCopy.Annotations = map[string]string{}
+				}
+				vmCopy.Annotations[virtv1.VirtualMachinePoolScaleInProtectionAnnotation] = protection
+			}
 			vmCopy.Spec = *indexVMSpec(&pool.Spec, index)
 			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
 
@@ -887,78 +1003,107 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 	return nil
 }
 
-func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+// activeVMI returns the VMI of the given VM, unless it doesn't exist or is already deleting.
+func (c *Controller) activeVMI(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+	vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+	obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
+	if !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil {
+		return nil
+	}
+	return vmi
+}
+
+// classifyVMIUpdates splits the up-to-date VMs into the ones whose VMI has to be restarted
+// and the ones whose VMI only needs the revision label patched.
+func (c *Controller) classifyVMIUpdates(vmUpdatedList []*virtv1.VirtualMachine) (restartList []*virtv1.VirtualMachine, patchList []*virtv1.VirtualMachine, err error) {
+	for _, vm := range vmUpdatedList {
+		vmi := c.activeVMI(vm)
+		if vmi == nil {
+			// no VMI to update
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(vm, vmi)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch updateType {
+		case proactiveUpdateTypeRestart:
+			restartList = append(restartList, vm)
+		case proactiveUpdateTypePatchRevisionLabel:
+			patchList = append(patchList, vm)
+		}
+	}
+	return restartList, patchList, nil
+}
+
+func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, restartList []*virtv1.VirtualMachine, patchList []*virtv1.VirtualMachine) error {
 	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
+	wg.Add(len(restartList) + len(patchList))
+	errChan := make(chan error, len(restartList)+len(patchList))
+	for i := 0; i < len(restartList); i++ {
 		go func(idx int) {
 			defer wg.Done()
-			vm := vmUpdatedList[idx]
+			vm := restartList[idx]
 
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
-			if !exists {
+			err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vm.ObjectMeta.Name, v1.DeleteOptions{})
+			if err != nil {
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error proactively updating VM %s/%s by deleting outdated VMI: %v", vm.Namespace, vm.Name, err)
+				errChan <- err
+				return
+			}
+			log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via vmi deletion", vm.Namespace, vm.Name)
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Proactive update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
+		}(i)
+	}
+	for i := 0; i < len(patchList); i++ {
+		go func(idx int) {
+			defer wg.Done()
+			vm := patchList[idx]
+
+			vmi := c.activeVMI(vm)
+			if vmi == nil {
 				// no VMI to update
 				return
 			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
+
+			patchSet := patch.New()
+			vmiCopy := vmi.DeepCopy()
+			if vmiCopy.Labels == nil {
+				vmiCopy.Labels = make(map[string]string)
+			}
+			revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+			if !exists {
+				// nothing to do
 				return
 			}
+			vmiCopy.Labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
+
+			if vmi.Labels == nil {
+				patchSet.AddOption(patch.WithAdd("/metadata/labels", vmi.Labels))
+			} else {
+				patchSet.AddOption(
+					patch.WithTest("/metadata/labels", vmiCopy.Labels),
+					patch.WithReplace("/metadata/labels", vmi.Labels),
+				)
+			}
 
-			updateType, err := c.isOutdatedVMI(vm, vmi)
+			patchBytes, err := patchSet.GeneratePayload()
 			if err != nil {
 				errChan <- err
 				return
-
 			}
-			switch updateType {
-			case proactiveUpdateTypeRestart:
-				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, v1.DeleteOptions{})
-				if err != nil {
-					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error proactively updating VM %s/%s by deleting outdated VMI: %v", vm.Namespace, vm.Name, err)
-					errChan <- err
-					return
-				}
-				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via vmi deletion", vm.Namespace, vm.Name)
-				c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Proactive update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
-			case proactiveUpdateTypePatchRevisionLabel:
-				patchSet := patch.New()
-				vmiCopy := vmi.DeepCopy()
-				if vmiCopy.Labels == nil {
-					vmiCopy.Labels = make(map[string]string)
-				}
-				revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
-				if !exists {
-					// nothing to do
-					return
-				}
-				vmiCopy.Labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
-
-				if vmi.Labels == nil {
-					patchSet.AddOption(patch.WithAdd("/metadata/labels", vmi.Labels))
-				} else {
-					patchSet.AddOption(
-						patch.WithTest("/metadata/labels", vmiCopy.Labels),
-						patch.WithReplace("/metadata/labels", vmi.Labels),
-					)
-				}
 
-				patchBytes, err := patchSet.GeneratePayload()
-				if err != nil {
-					errChan <- err
-					return
-				}
-
-				_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
-				if err != nil {
-					errChan <- fmt.Errorf("patching of vmi labels with new pool revision name: %v", err)
-					return
-				}
-				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via label patch", vm.Namespace, vm.Name)
+			_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+			if err != nil {
+				errChan <- fmt.Errorf("patching of vmi labels with new pool revision name: %v", err)
+				return
 			}
+			log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via label patch", vm.Namespace, vm.Name)
 		}(i)
 	}
 	wg.Wait()
@@ -1092,6 +1237,167 @@ func (c *Controller) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.Vi
 
 }
 
+func getUpdateStrategyType(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolUpdateStrategyType {
+	if pool.Spec.UpdateStrategy == nil {
+		return ""
+	}
+	if pool.Spec.UpdateStrategy.Type == "" {
+		return poolv1.VirtualMachinePoolRollingUpdateStrategyType
+	}
+	return pool.Spec.UpdateStrategy.Type
+}
+
+// resolveRollingUpdate converts the rolling update parameters of the pool into absolute
+// numbers of VMs, based on the desired replicas.
+func resolveRollingUpdate(pool *poolv1.VirtualMachinePool) (maxUnavailable int, maxSurge int, err error) {
+	unavailable := intstr.FromString(defaultMaxUnavailable)
+	surge := intstr.FromInt32(0)
+	if rollingUpdate := pool.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxUnavailable != nil {
+			unavailable = *rollingUpdate.MaxUnavailable
+		}
+		if rollingUpdate.MaxSurge != nil {
+			surge = *rollingUpdate.MaxSurge
+		}
+	}
+
+	replicas := wantedReplicas(pool)
+	maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(&unavailable, replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxSurge, err = intstr.GetScaledValueFromIntOrPercent(&surge, replicas, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if maxUnavailable == 0 && maxSurge == 0 {
+		// Make sure the update can make progress, even if percentages round down to zero
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge, nil
+}
+
+// isOutdatedReplica returns true if the VMI of the VM runs a template which differs from
+// the current pool template. VMs without an active VMI are never outdated replicas,
+// updating the VM itself is enough to bring them in sync.
+func (c *Controller) isOutdatedReplica(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
+	vmi := c.activeVMI(vm)
+	if vmi == nil {
+		return false, nil
+	}
+
+	vmiRevisionName, exists := vmi.Labels[virtv1.VirtualMachinePoolRevisionName]
+	if !exists {
+		return true, nil
+	}
+	if vmiRevisionName == getRevisionName(pool) {
+		return false, nil
+	}
+
+	poolSpecRevisionForVMI, exists, err := c.getControllerRevision(vm.Namespace, vmiRevisionName)
+	if err != nil {
+		return true, err
+	} else if !exists {
+		return true, nil
+	}
+
+	return !equality.Semantic.DeepEqual(poolSpecRevisionForVMI.VirtualMachineTemplate.Spec.Template, pool.Spec.VirtualMachineTemplate.Spec.Template), nil
+}
+
+func (c *Controller) filterOutdatedReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) ([]*virtv1.VirtualMachine, error) {
+	outdated := []*virtv1.VirtualMachine{}
+	for _, vm := range vms {
+		isOutdated, err := c.isOutdatedReplica(pool, vm)
+		if err != nil {
+			return nil, err
+		}
+		if isOutdated {
+			outdated = append(outdated, vm)
+		}
+	}
+	return outdated, nil
+}
+
+// countUnavailable returns how many of the desired replicas are currently not ready.
+func (c *Controller) countUnavailable(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	return max(wantedReplicas(pool)-len(c.filterReadyVMs(filterDeletingVMs(vms))), 0)
+}
+
+// selectRollingRestarts limits the VMI restarts of a rolling update, so that no more
+// than maxUnavailable replicas are unavailable at a time. Restarting a VM which is not
+// ready does not reduce availability, therefore those are always selected.
+func (c *Controller) selectRollingRestarts(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, restartList []*virtv1.VirtualMachine, maxUnavailable int) []*virtv1.VirtualMachine {
+	selected := filterNotReadyVMs(restartList)
+	readyList := c.filterReadyVMs(restartList)
+
+	budget := min(maxUnavailable-c.countUnavailable(pool, vms), len(readyList))
+	if budget > 0 {
+		selected = append(selected, readyList[:budget]...)
+	}
+
+	if len(selected) < len(restartList) {
+		log.Log.Object(pool).Infof("Rolling update restarts %d of %d outdated VMIs", len(selected), len(restartList))
+	}
+	return selected
+}
+
+// replaceOutdatedReplicas deletes outdated VMs once the pool surged above the desired
+// replicas, while keeping at least replicas-maxUnavailable VMs ready.
+func (c *Controller) replaceOutdatedReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, maxUnavailable int) error {
+	activeVMs := filterDeletingVMs(vms)
+	excess := len(activeVMs) - wantedReplicas(pool)
+	if excess <= 0 {
+		return nil
+	}
+
+	outdated, err := c.filterOutdatedReplicas(pool, activeVMs)
+	if err != nil {
+		return err
+	}
+
+	notReadyList := filterNotReadyVMs(outdated)
+	readyList := c.filterReadyVMs(outdated)
+
+	deleteList := notReadyList[:min(excess, len(notReadyList))]
+	budget := min(
+		excess-len(deleteList),
+		len(c.filterReadyVMs(activeVMs))-(wantedReplicas(pool)-maxUnavailable),
+		len(readyList),
+	)
+	if budget > 0 {
+		deleteList = append(deleteList, readyList[:budget]...)
+	}
+
+	if len(deleteList) == 0 {
+		return nil
+	}
+
+	log.Log.Object(pool).Infof("Replacing %d outdated VMs in pool", len(deleteList))
+	return c.deleteVMs(pool, deleteList)
+}
+
+// countUpdatedReplicas returns the number of VMs whose VM and VMI are in sync with the
+// current pool template.
+func (c *Controller) countUpdatedReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int32 {
+	updated := int32(0)
+	for _, vm := range filterDeletingVMs(vms) {
+		revisionName := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+		if revisionName != getRevisionName(pool) {
+			poolSpecRevisionForVM, exists, err := c.getControllerRevision(pool.Namespace, revisionName)
+			if err != nil || !exists || !equality.Semantic.DeepEqual(poolSpecRevisionForVM.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
+				continue
+			}
+		}
+
+		if outdated, err := c.isOutdatedReplica(pool, vm); err != nil || outdated {
+			continue
+		}
+		updated++
+	}
+	return updated
+}
+
 func (c *Controller) pruneUnusedRevisions(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) common.SyncError {
 
 	keys, err := c.revisionIndexer.IndexKeys("vmpool", string(pool.UID))
@@ -1166,7 +1472,33 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		return common.NewSyncError(fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason), false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	restartList, patchList, err := c.classifyVMIUpdates(vmUpdatedList)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("Error while detecting outdated VMIs: %v", err), FailedUpdateReason), false
+	}
+
+	switch getUpdateStrategyType(pool) {
+	case poolv1.VirtualMachinePoolOnDeleteStrategyType:
+		// VMIs pick up the new template once they get restarted by someone else
+		restartList = nil
+	case poolv1.VirtualMachinePoolRollingUpdateStrategyType:
+		maxUnavailable, maxSurge, err := resolveRollingUpdate(pool)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while resolving rolling update parameters: %v", err), FailedUpdateReason), false
+		}
+		if maxSurge > 0 {
+			// outdated VMs get replaced by the surge VMs instead of being restarted
+			restartList = nil
+			err = c.replaceOutdatedReplicas(pool, vms, maxUnavailable)
+			if err != nil {
+				return common.NewSyncError(fmt.Errorf("Error during replacement of outdated VMs: %v", err), FailedUpdateReason), false
+			}
+		} else {
+			restartList = c.selectRollingRestarts(pool, vms, restartList, maxUnavailable)
+		}
+	}
+
+	err = c.proactiveUpdate(pool, restartList, patchList)
 	if err != nil {
 		return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
 	}
@@ -1204,7 +1536,7 @@ func (c *Controller) Execute() bool {
 	return true
 }
 
-func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr common.SyncError) error {
+func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, autoscalingStatus *poolv1.VirtualMachinePoolAutoscalingStatus, hpaName string, syncErr common.SyncError) error {
 
 	key, err := controller.KeyFunc(origPool)
 	if err != nil {
@@ -1253,8 +1585,32 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumePoolReason, "Pool is unpaused")
 	}
 
+	if hpaName != "" && !cm.HasCondition(pool, poolv1.VirtualMachinePoolAutoscalingYielded) {
+		message := fmt.Sprintf("Not autoscaling the pool, it is targeted by the HorizontalPodAutoscaler %s", hpaName)
+		cm.UpdateCondition(pool,
+			&poolv1.VirtualMachinePoolCondition{
+				Type:               poolv1.VirtualMachinePoolAutoscalingYielded,
+				Reason:             FailedAutoscaleReason,
+				Message:            message,
+				LastTransitionTime: metav1.Now(),
+				Status:             k8score.ConditionTrue,
+			})
+		c.recorder.Event(pool, k8score.EventTypeWarning, FailedAutoscaleReason, message)
+	} else if hpaName == "" && cm.HasCondition(pool, poolv1.VirtualMachinePoolAutoscalingYielded) {
+		cm.RemoveCondition(pool, poolv1.VirtualMachinePoolAutoscalingYielded)
+	}
+
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.UpdatedReplicas = c.countUpdatedReplicas(pool, vms)
+	pool.Status.UpdateRevision = getRevisionName(pool)
+	pool.Status.ReplicasByStatus, pool.Status.UnhealthyReplicas = countReplicasByStatus(vms)
+
+	if pool.Spec.Autoscaling == nil {
+		pool.Status.Autoscaling = nil
+	} else if autoscalingStatus != nil {
+		pool.Status.Autoscaling = autoscalingStatus
+	}
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1283,6 +1639,7 @@ func (c *Controller) execute(key string) error {
 		logger = logger.Object(pool)
 	} else {
 		c.expectations.DeleteExpectations(key)
+		c.guestMetrics.stopCollector(key)
 		return nil
 	}
 
@@ -1320,14 +1677,46 @@ func (c *Controller) execute(key string) error {
 		return err
 	}
 
+	var autoscalingStatus *poolv1.VirtualMachinePoolAutoscalingStatus
+	autoscaled := pool.Spec.Autoscaling != nil && !pool.Spec.Paused && pool.DeletionTimestamp == nil
+	// both autoscalers would fight over the replicas, they are left to a HorizontalPodAutoscaler targeting the pool
+	var hpaName string
+	if autoscaled {
+		hpaName = c.findHorizontalPodAutoscaler(pool)
+	}
+	if autoscaled && hpaName == "" {
+		c.guestMetrics.startCollector(key, func() []guestUtilization {
+			return c.collectGuestMetrics(key)
+		})
+	} else {
+		c.guestMetrics.stopCollector(key)
+	}
+
 	needsSync := c.expectations.SatisfiedExpectations(key)
 	if needsSync && !pool.Spec.Paused && pool.DeletionTimestamp == nil {
 		scaleIsStable := false
 		updateIsStable := false
 
-		syncErr, scaleIsStable = c.scale(pool, vms)
-		if syncErr != nil {
-			logger.Reason(err).Error("Scaling the pool failed.")
+		if pool.Spec.Autoscaling != nil && hpaName == "" {
+			pool, autoscalingStatus, syncErr = c.autoscale(key, pool)
+			if syncErr != nil {
+				logger.Reason(syncErr).Error("Autoscaling the pool failed.")
+			}
+		}
+
+		if syncErr == nil {
+			syncErr, scaleIsStable = c.scale(pool, vms)
+			if syncErr != nil {
+				logger.Reason(err).Error("Scaling the pool failed.")
+			}
+		}
+
+		needsSync = c.expectations.SatisfiedExpectations(key)
+		if needsSync && scaleIsStable && syncErr == nil && pool.Spec.UnhealthyReplicaReplacement != nil {
+			err := c.replaceUnhealthyReplicas(pool, vms)
+			if err != nil {
+				syncErr = common.NewSyncError(fmt.Errorf("Error during replacement of unhealthy VMs: %v", err), FailedReplaceUnhealthyReason)
+			}
 		}
 
 		needsSync = c.expectations.SatisfiedExpectations(key)
@@ -1346,7 +1735,11 @@ func (c *Controller) execute(key string) error {
 		syncErr = c.pruneUnusedRevisions(pool, vms)
 	}
 
-	err = c.updateStatus(pool, vms, syncErr)
+	if autoscaled {
+		c.queue.AddAfter(key, autoscalingResyncPeriod)
+	}
+
+	err = c.updateStatus(pool, vms, autoscalingStatus, hpaName, syncErr)
 	if err != nil {
 		return err
 	}
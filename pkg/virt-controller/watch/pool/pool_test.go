@@ -19,18 +19,25 @@
 package pool
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -122,6 +129,9 @@ var _ = Describe("Pool", func() {
 			vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 			vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 			poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			hpaInformer, _ := testutils.NewFakeInformerWithIndexersFor(&autoscalingv2.HorizontalPodAutoscaler{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			})
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
@@ -142,6 +152,7 @@ var _ = Describe("Pool", func() {
 				vmInformer,
 				poolInformer,
 				crInformer,
+				hpaInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -241,6 +252,48 @@ var _ = Describe("Pool", func() {
 			})
 		}
 
+		// addReplica adds a ready VM which is updated to the current pool revision,
+		// while its VMI runs the template of the given revision.
+		addReplica := func(pool *poolv1.VirtualMachinePool, vm *v1.VirtualMachine, index int, vmiRevisionName string) {
+			vm = vm.DeepCopy()
+			vm.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+			vm.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+			vm = injectPoolRevisionLabelsIntoVM(vm, getRevisionName(pool))
+			markVmAsReady(vm)
+
+			vmi := api.NewMinimalVMI(vm.Name)
+			vmi.Namespace = vm.Namespace
+			vmi.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: vmiRevisionName}
+			vmi.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion:         v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+				Kind:               v1.VirtualMachineGroupVersionKind.Kind,
+				Name:               vm.ObjectMeta.Name,
+				UID:                vm.ObjectMeta.UID,
+				Controller:         pointer.P(true),
+				BlockOwnerDeletion: pointer.P(true),
+			}}
+			watchtesting.MarkAsReady(vmi)
+
+			addVM(vm)
+			addVMI(vmi)
+		}
+
+		// newRollingUpdatePool returns a pool whose template changed since oldRevision was created
+		newRollingUpdatePool := func(replicas int32, strategy *poolv1.VirtualMachinePoolUpdateStrategy) (*poolv1.VirtualMachinePool, *v1.VirtualMachine, *appsv1.ControllerRevision, *appsv1.ControllerRevision) {
+			pool, vm := DefaultPool(replicas)
+			pool.Spec.UpdateStrategy = strategy
+			oldPoolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			pool.Status.Replicas = replicas
+			pool.Status.ReadyReplicas = replicas
+			pool.Status.UpdateRevision = newPoolRevision.Name
+			return pool, vm, oldPoolRevision, newPoolRevision
+		}
+
 		sanityExecute := func() {
 			controllertesting.SanityExecute(controller, []cache.Store{
 				controller.vmiStore, controller.vmIndexer, controller.poolIndexer, controller.revisionIndexer,
@@ -271,6 +324,8 @@ var _ = Describe("Pool", func() {
 
 			pool.Generation = 123
 			newPoolRevision := createPoolRevision(pool)
+			pool.Status.UpdatedReplicas = 1
+			pool.Status.UpdateRevision = newPoolRevision.Name
 
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
 
@@ -332,6 +387,7 @@ var _ = Describe("Pool", func() {
 			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{}
 			pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels["newkey"] = "newval"
 			newPoolRevision := createPoolRevision(pool)
+			pool.Status.UpdateRevision = newPoolRevision.Name
 
 			vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -370,6 +426,113 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
 		})
 
+		It("should not restart outdated VMIs with the OnDelete update strategy", func() {
+			pool, vm, oldPoolRevision, newPoolRevision := newRollingUpdatePool(1, &poolv1.VirtualMachinePoolUpdateStrategy{
+				Type: poolv1.VirtualMachinePoolOnDeleteStrategyType,
+			})
+
+			addPool(pool)
+			addReplica(pool, vm, 0, oldPoolRevision.Name)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			sanityExecute()
+
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+		})
+
+		It("should restart at most maxUnavailable outdated VMIs with the RollingUpdate update strategy", func() {
+			pool, vm, oldPoolRevision, newPoolRevision := newRollingUpdatePool(3, &poolv1.VirtualMachinePoolUpdateStrategy{
+				Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(1)),
+				},
+			})
+
+			addPool(pool)
+			for x := 0; x < 3; x++ {
+				addReplica(pool, vm, x, oldPoolRevision.Name)
+			}
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+		})
+
+		It("should create a surge VM instead of restarting outdated VMIs when maxSurge is set", func() {
+			pool, vm, oldPoolRevision, newPoolRevision := newRollingUpdatePool(2, &poolv1.VirtualMachinePoolUpdateStrategy{
+				Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+					MaxSurge:       pointer.P(intstr.FromInt32(1)),
+				},
+			})
+
+			addPool(pool)
+			for x := 0; x < 2; x++ {
+				addReplica(pool, vm, x, oldPoolRevision.Name)
+			}
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			expectVMCreation(Equal(fmt.Sprintf("%s-2", pool.Name)))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(1))
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+		})
+
+		It("should delete an outdated VM once the surge VM is ready and report the updated replicas", func() {
+			pool, vm, oldPoolRevision, newPoolRevision := newRollingUpdatePool(2, &poolv1.VirtualMachinePoolUpdateStrategy{
+				Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+					MaxSurge:       pointer.P(intstr.FromInt32(1)),
+				},
+			})
+
+			addPool(pool)
+			for x := 0; x < 2; x++ {
+				addReplica(pool, vm, x, oldPoolRevision.Name)
+			}
+			surgeVM := vm.DeepCopy()
+			surgeVM.Name = fmt.Sprintf("%s-2", pool.Name)
+			surgeVM.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+			surgeVM = injectPoolRevisionLabelsIntoVM(surgeVM, newPoolRevision.Name)
+			markVmAsReady(surgeVM)
+			addVM(surgeVM)
+			addCR(oldPoolRevision)
+			addCR(newPoolRevision)
+
+			fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+			fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(k8stesting.UpdateAction)
+				Expect(ok).To(BeTrue())
+				updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+				Expect(updateObj.Status.Replicas).To(Equal(int32(3)))
+				Expect(updateObj.Status.UpdatedReplicas).To(Equal(int32(1)))
+				Expect(updateObj.Status.UpdateRevision).To(Equal(newPoolRevision.Name))
+				return true, update.GetObject(), nil
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(1))
+			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+		})
+
 		It("should do nothing", func() {
 			pool, vm := DefaultPool(1)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -380,6 +543,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -400,6 +564,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -592,6 +757,463 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(10))
 		})
 
+		DescribeTable("should select the VMs to remove according to the scale-in strategy", func(strategyType poolv1.VirtualMachinePoolScaleInStrategyType, expectedIndex int) {
+			pool, vm := DefaultPool(2)
+			pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{Type: strategyType}
+
+			addPool(pool)
+
+			now := time.Now()
+			creationOffsets := []time.Duration{time.Minute, 2 * time.Minute, 0}
+			for x, offset := range creationOffsets {
+				newVM := vm.DeepCopy()
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				newVM.CreationTimestamp = metav1.NewTime(now.Add(offset))
+				if x != 0 {
+					markVmAsReady(newVM)
+				}
+				addVM(newVM)
+			}
+
+			fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(k8stesting.UpdateAction)
+				Expect(ok).To(BeTrue())
+				return true, update.GetObject(), nil
+			})
+			fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+			deletions := testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")
+			Expect(deletions).To(HaveLen(1))
+			Expect(deletions[0].(k8stesting.DeleteAction).GetName()).To(Equal(fmt.Sprintf("%s-%d", pool.Name, expectedIndex)))
+		},
+			Entry("newest first", poolv1.VirtualMachinePoolNewestFirstScaleInStrategyType, 1),
+			Entry("oldest first", poolv1.VirtualMachinePoolOldestFirstScaleInStrategyType, 2),
+			Entry("not ready first", poolv1.VirtualMachinePoolNotReadyFirstScaleInStrategyType, 0),
+			Entry("highest index first", poolv1.VirtualMachinePoolHighestIndexFirstScaleInStrategyType, 2),
+		)
+
+		It("should not delete VMs protected from scale in", func() {
+			pool, vm := DefaultPool(0)
+
+			addPool(pool)
+
+			for x := 0; x < 3; x++ {
+				newVM := vm.DeepCopy()
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				if x != 1 {
+					newVM.Annotations[v1.VirtualMachinePoolScaleInProtectionAnnotation] = "true"
+				}
+				addVM(newVM)
+			}
+
+			fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(k8stesting.UpdateAction)
+				Expect(ok).To(BeTrue())
+				return true, update.GetObject(), nil
+			})
+			fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+			deletions := testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")
+			Expect(deletions).To(HaveLen(1))
+			Expect(deletions[0].(k8stesting.DeleteAction).GetName()).To(Equal(fmt.Sprintf("%s-1", pool.Name)))
+		})
+
+		It("should keep the scale-in protection of VMs across an update of the template", func() {
+			pool, vm := DefaultPool(3)
+			pool.Status.Replicas = 3
+			poolRevision := createPoolRevision(pool)
+
+			pool.Generation = 123
+			pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations = map[string]string{"newkey": "newval"}
+			newPoolRevision := createPoolRevision(pool)
+
+			addPool(pool)
+			addCR(poolRevision)
+			for x := 0; x < 3; x++ {
+				newVM := injectPoolRevisionLabelsIntoVM(vm.DeepCopy(), poolRevision.Name)
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				if x == 0 {
+					newVM.Annotations[v1.VirtualMachinePoolScaleInProtectionAnnotation] = "true"
+				}
+				addVM(newVM)
+			}
+
+			expectControllerRevisionCreation(newPoolRevision)
+			var updatedVMs []*v1.VirtualMachine
+			fakeVirtClient.Fake.PrependReactor("update", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(k8stesting.UpdateAction)
+				Expect(ok).To(BeTrue())
+				updatedVMs = append(updatedVMs, update.GetObject().(*v1.VirtualMachine))
+				return true, update.GetObject(), nil
+			})
+			fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(k8stesting.UpdateAction)
+				Expect(ok).To(BeTrue())
+				return true, update.GetObject(), nil
+			})
+			fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, nil
+			})
+
+			sanityExecute()
+
+			Expect(updatedVMs).To(HaveLen(3))
+			for _, updatedVM := range updatedVMs {
+				Expect(updatedVM.Annotations).To(HaveKeyWithValue("newkey", "newval"))
+				if updatedVM.Name == fmt.Sprintf("%s-0", pool.Name) {
+					Expect(updatedVM.Annotations).To(HaveKeyWithValue(v1.VirtualMachinePoolScaleInProtectionAnnotation, "true"))
+				} else {
+					Expect(updatedVM.Annotations).ToNot(HaveKey(v1.VirtualMachinePoolScaleInProtectionAnnotation))
+				}
+				Expect(controller.vmIndexer.Update(updatedVM)).To(Succeed())
+			}
+
+			for mockQueue.Len() > 0 {
+				key, _ := mockQueue.Get()
+				mockQueue.Done(key)
+			}
+			pool.Spec.Replicas = pointer.P(int32(1))
+			addPool(pool)
+
+			sanityExecute()
+
+			deletions := testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")
+			Expect(deletions).To(HaveLen(2))
+			for _, deletion := range deletions {
+				Expect(deletion.(k8stesting.DeleteAction).GetName()).ToNot(Equal(fmt.Sprintf("%s-0", pool.Name)))
+			}
+		})
+
+		Context("with autoscaling", func() {
+			newAutoscalingPool := func(lastScaleTime *metav1.Time) (*poolv1.VirtualMachinePool, *appsv1.ControllerRevision) {
+				pool, vm := DefaultPool(2)
+				pool.Spec.Autoscaling = &poolv1.VirtualMachinePoolAutoscaling{
+					MaxReplicas: 4,
+					Metrics: []poolv1.VirtualMachinePoolMetric{
+						{Type: poolv1.VirtualMachinePoolGuestLoadMetricType, TargetAverageUtilization: 50},
+					},
+				}
+				pool.Status.Replicas = 2
+				pool.Status.ReadyReplicas = 2
+				pool.Status.UpdatedReplicas = 2
+				pool.Status.Autoscaling = &poolv1.VirtualMachinePoolAutoscalingStatus{
+					DesiredReplicas: 2,
+					LastScaleTime:   lastScaleTime,
+				}
+				poolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addCR(poolRevision)
+				for x := 0; x < 2; x++ {
+					addReplica(pool, vm, x, poolRevision.Name)
+				}
+				return pool, poolRevision
+			}
+
+			BeforeEach(func() {
+				// collect the guest metrics right away instead of in the background
+				origRunGuestMetricsCollector := runGuestMetricsCollector
+				runGuestMetricsCollector = func(collect func(), _ <-chan struct{}) {
+					collect()
+				}
+				DeferCleanup(func() {
+					runGuestMetricsCollector = origRunGuestMetricsCollector
+					controller.guestMetrics.stopAllCollectors()
+				})
+			})
+
+			expectGuestMetrics := func(load1 string) {
+				fakeVirtClient.Fake.PrependReactor("get", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.GetSubresource()).To(Equal("guestmetrics"))
+					return true, &v1.VirtualMachineInstanceGuestMetrics{
+						Timestamp: metav1.NowMicro(),
+						VCPUCount: 1,
+						Load: &v1.VirtualMachineInstanceGuestLoad{
+							Load1:  resource.MustParse(load1),
+							Load5:  resource.MustParse(load1),
+							Load15: resource.MustParse(load1),
+						},
+					}, nil
+				})
+			}
+
+			It("should scale out when the guest load is above the target", func() {
+				pool, _ := newAutoscalingPool(nil)
+				expectGuestMetrics("1")
+
+				fakeVirtClient.Fake.PrependReactor("patch", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction, ok := action.(k8stesting.PatchAction)
+					Expect(ok).To(BeTrue())
+					Expect(string(patchAction.GetPatch())).To(Equal(`[{"op":"add","path":"/spec/replicas","value":4}]`))
+					patched := pool.DeepCopy()
+					patched.Spec.Replicas = pointer.P(int32(4))
+					return true, patched, nil
+				})
+				expectVMCreation(HavePrefix(fmt.Sprintf("%s-", pool.Name)))
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Autoscaling).ToNot(BeNil())
+					Expect(updateObj.Status.Autoscaling.DesiredReplicas).To(Equal(int32(4)))
+					Expect(updateObj.Status.Autoscaling.LastScaleTime).ToNot(BeNil())
+					Expect(updateObj.Status.Autoscaling.CurrentMetrics).To(ConsistOf(poolv1.VirtualMachinePoolMetricStatus{
+						Type:                      poolv1.VirtualMachinePoolGuestLoadMetricType,
+						CurrentAverageUtilization: 100,
+						Samples:                   2,
+					}))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulAutoscaleReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(2))
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should leave out the VMIs which do not report their guest metrics in time", func() {
+				pool, _ := newAutoscalingPool(nil)
+				expectGuestMetrics("0.5")
+				fakeVirtClient.Fake.PrependReactor("get", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					if action.(k8stesting.GetAction).GetName() != fmt.Sprintf("%s-0", pool.Name) {
+						return false, nil, nil
+					}
+					return true, nil, context.DeadlineExceeded
+				})
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Autoscaling.CurrentMetrics).To(ConsistOf(poolv1.VirtualMachinePoolMetricStatus{
+						Type:                      poolv1.VirtualMachinePoolGuestLoadMetricType,
+						CurrentAverageUtilization: 50,
+						Samples:                   1,
+					}))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			addHorizontalPodAutoscaler := func(pool *poolv1.VirtualMachinePool) {
+				Expect(controller.hpaIndexer.Add(&autoscalingv2.HorizontalPodAutoscaler{
+					ObjectMeta: metav1.ObjectMeta{Name: "hpa", Namespace: pool.Namespace},
+					Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+						ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+							APIVersion: poolv1.SchemeGroupVersion.String(),
+							Kind:       poolv1.VirtualMachinePoolKind,
+							Name:       pool.Name,
+						},
+					},
+				})).To(Succeed())
+			}
+
+			autoscalingYielded := poolv1.VirtualMachinePoolCondition{
+				Type:    poolv1.VirtualMachinePoolAutoscalingYielded,
+				Status:  k8sv1.ConditionTrue,
+				Reason:  FailedAutoscaleReason,
+				Message: "Not autoscaling the pool, it is targeted by the HorizontalPodAutoscaler hpa",
+			}
+
+			It("should not autoscale a pool which is targeted by a HorizontalPodAutoscaler", func() {
+				pool, _ := newAutoscalingPool(nil)
+				expectGuestMetrics("1")
+				addHorizontalPodAutoscaler(pool)
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Conditions).To(ConsistOf(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Type":    Equal(autoscalingYielded.Type),
+						"Status":  Equal(autoscalingYielded.Status),
+						"Reason":  Equal(autoscalingYielded.Reason),
+						"Message": Equal(autoscalingYielded.Message),
+					})))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, FailedAutoscaleReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(BeEmpty())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "get", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should not record an event again while the pool is yielding to a HorizontalPodAutoscaler", func() {
+				pool, _ := newAutoscalingPool(nil)
+				pool.Status.Conditions = []poolv1.VirtualMachinePoolCondition{autoscalingYielded}
+				addHorizontalPodAutoscaler(pool)
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					Expect(update.GetObject().(*poolv1.VirtualMachinePool).Status.Conditions).To(Equal(pool.Status.Conditions))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(recorder.Events).To(BeEmpty())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should resume autoscaling once no HorizontalPodAutoscaler targets the pool", func() {
+				pool, _ := newAutoscalingPool(nil)
+				pool.Status.Conditions = []poolv1.VirtualMachinePoolCondition{autoscalingYielded}
+				expectGuestMetrics("0.5")
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Conditions).To(BeEmpty())
+					Expect(updateObj.Status.Autoscaling.CurrentMetrics).ToNot(BeEmpty())
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should stop collecting the guest metrics once autoscaling is disabled", func() {
+				pool, _ := newAutoscalingPool(nil)
+				expectGuestMetrics("0.5")
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+				key, err := virtcontroller.KeyFunc(pool)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.guestMetrics.pools).To(HaveKey(key))
+
+				pool.Spec.Autoscaling = nil
+				Expect(controller.poolIndexer.Update(pool)).To(Succeed())
+				Expect(controller.execute(key)).To(Succeed())
+				Expect(controller.guestMetrics.pools).ToNot(HaveKey(key))
+			})
+
+			It("should not scale in during the cooldown", func() {
+				newAutoscalingPool(pointer.P(metav1.Now()))
+				expectGuestMetrics("0")
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Spec.Replicas).To(HaveValue(Equal(int32(2))))
+					Expect(updateObj.Status.Autoscaling.DesiredReplicas).To(Equal(int32(1)))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+			})
+		})
+
+		Context("with unhealthy replicas", func() {
+			newPoolWithStatuses := func(replacement *poolv1.VirtualMachinePoolUnhealthyReplicaReplacement, statuses ...v1.VirtualMachinePrintableStatus) (*poolv1.VirtualMachinePool, []*v1.VirtualMachine) {
+				pool, vm := DefaultPool(int32(len(statuses)))
+				pool.Spec.UnhealthyReplicaReplacement = replacement
+				pool.Status.Replicas = int32(len(statuses))
+				pool.Status.ReadyReplicas = int32(len(statuses))
+				pool.Status.UpdatedReplicas = int32(len(statuses))
+				poolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addCR(poolRevision)
+				vms := []*v1.VirtualMachine{}
+				for x, status := range statuses {
+					replica := vm.DeepCopy()
+					replica.UID = k8stypes.UID(fmt.Sprintf("uid-%d", x))
+					replica.Status.PrintableStatus = status
+					addReplica(pool, replica, x, poolRevision.Name)
+					vms = append(vms, replica)
+				}
+				return pool, vms
+			}
+
+			expectStatusUpdate := func(replicasByStatus []poolv1.VirtualMachinePoolStatusCount, unhealthyReplicas int32) {
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.ReplicasByStatus).To(Equal(replicasByStatus))
+					Expect(updateObj.Status.UnhealthyReplicas).To(Equal(unhealthyReplicas))
+					return true, update.GetObject(), nil
+				})
+			}
+
+			It("should report the replicas per status without replacing them", func() {
+				newPoolWithStatuses(nil, v1.VirtualMachineStatusRunning, v1.VirtualMachineStatusCrashLoopBackOff, v1.VirtualMachineStatusRunning)
+				expectStatusUpdate([]poolv1.VirtualMachinePoolStatusCount{
+					{Status: v1.VirtualMachineStatusCrashLoopBackOff, Count: 1},
+					{Status: v1.VirtualMachineStatusRunning, Count: 2},
+				}, 1)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+			})
+
+			It("should not replace unhealthy VMs during the grace period", func() {
+				newPoolWithStatuses(&poolv1.VirtualMachinePoolUnhealthyReplicaReplacement{},
+					v1.VirtualMachineStatusRunning, v1.VirtualMachineStatusUnschedulable)
+				expectStatusUpdate([]poolv1.VirtualMachinePoolStatusCount{
+					{Status: v1.VirtualMachineStatusUnschedulable, Count: 1},
+					{Status: v1.VirtualMachineStatusRunning, Count: 1},
+				}, 1)
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should replace VMs which are unhealthy for longer than the grace period", func() {
+				pool, vms := newPoolWithStatuses(&poolv1.VirtualMachinePoolUnhealthyReplicaReplacement{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				}, v1.VirtualMachineStatusDataVolumeError, v1.VirtualMachineStatusRunning)
+				controller.unhealthyReplicas.observe(vms[0], time.Now().Add(-2*time.Minute))
+
+				expectStatusUpdate([]poolv1.VirtualMachinePoolStatusCount{
+					{Status: v1.VirtualMachineStatusDataVolumeError, Count: 1},
+					{Status: v1.VirtualMachineStatusRunning, Count: 1},
+				}, 1)
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, ReplacedUnhealthyReplicaReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				deletions := testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")
+				Expect(deletions).To(HaveLen(1))
+				Expect(deletions[0].(k8stesting.DeleteAction).GetName()).To(Equal(fmt.Sprintf("%s-0", pool.Name)))
+			})
+		})
+
 		It("should not delete vms which are already marked deleted", func() {
 
 			pool, vm := DefaultPool(0)
@@ -796,6 +1418,7 @@ func PoolFromVM(name string, vm *v1.VirtualMachine, replicas int32) *poolv1.Virt
 		},
 		Status: poolv1.VirtualMachinePoolStatus{LabelSelector: s.String()},
 	}
+	pool.Status.UpdateRevision = getRevisionName(pool)
 	return pool
 }
 
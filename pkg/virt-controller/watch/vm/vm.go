@@ -105,6 +105,9 @@ const (
 	// SourcePVCNotAvailabe is added in an event when the source PVC of a valid
 	// clone Datavolume doesn't exist
 	SourcePVCNotAvailabe = "SourcePVCNotAvailabe"
+	// MigratedToAnotherClusterReason is added in an event when the VirtualMachine is halted
+	// because its guest was migrated to another cluster
+	MigratedToAnotherClusterReason = "MigratedToAnotherCluster"
 )
 
 const (
@@ -117,10 +120,14 @@ const (
 	hotplugMemoryErrorReason     = "HotPlugMemoryError"
 	volumesUpdateErrorReason     = "VolumesUpdateError"
 	tolerationsChangeErrorReason = "TolerationsChangeError"
+	diskIOTuneChangeErrorReason  = "DiskIOTuneChangeError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
 
+// maxMigrationHistory is the number of finished migrations kept on the VirtualMachine status
+const maxMigrationHistory = 10
+
 func NewController(vmiInformer cache.SharedIndexInformer,
 	vmInformer cache.SharedIndexInformer,
 	dataVolumeInformer cache.SharedIndexInformer,
@@ -731,6 +738,57 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) handleDiskIOTuneChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+	// the VMI mutator gives the disks without limits the limits of the cluster
+	storagetypes.ApplyDiskIOTuneLimits(vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices.Disks, c.clusterConfig.GetDiskIOTuneLimits())
+
+	vmDisks := storagetypes.GetDisksByName(&vmCopyWithInstancetype.Spec.Template.Spec)
+	patchset := patch.New()
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		vmDisk, exists := vmDisks[disk.Name]
+		if !exists || equality.Semantic.DeepEqual(disk.IOTune, vmDisk.IOTune) {
+			continue
+		}
+		diskPath := fmt.Sprintf("/spec/domain/devices/disks/%d", i)
+		patchset.AddOption(patch.WithTest(diskPath+"/name", disk.Name))
+		switch {
+		case vmDisk.IOTune == nil:
+			patchset.AddOption(patch.WithRemove(diskPath + "/ioTune"))
+		case disk.IOTune == nil:
+			patchset.AddOption(patch.WithAdd(diskPath+"/ioTune", vmDisk.IOTune))
+		default:
+			patchset.AddOption(
+				patch.WithTest(diskPath+"/ioTune", disk.IOTune),
+				patch.WithReplace(diskPath+"/ioTune", vmDisk.IOTune))
+		}
+	}
+	if patchset.IsEmpty() {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("disk I/O limits should not be changed during VMI migration")
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update disk I/O limits: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -828,7 +886,8 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	for _, volume := range vmi.Spec.Volumes {
 		hotpluggableVol := (volume.VolumeSource.PersistentVolumeClaim != nil &&
 			volume.VolumeSource.PersistentVolumeClaim.Hotpluggable) ||
-			(volume.VolumeSource.DataVolume != nil && volume.VolumeSource.DataVolume.Hotpluggable)
+			(volume.VolumeSource.DataVolume != nil && volume.VolumeSource.DataVolume.Hotpluggable) ||
+			volume.VolumeSource.Backup != nil
 		_, ok := volsVM[volume.Name]
 		if !ok && hotpluggableVol {
 			hotplugOp = true
@@ -837,7 +896,9 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	if hotplugOp {
 		return nil
 	}
-	if equality.Semantic.DeepEqual(vmi.Spec.Volumes, vmCopy.Spec.Template.Spec.Volumes) {
+	// The media of CD-ROMs is changed on the VMI and on the VM separately through their subresources
+	cdroms := getCDRomDiskNames(&vmi.Spec)
+	if equality.Semantic.DeepEqual(volumesWithoutNames(vmi.Spec.Volumes, cdroms), volumesWithoutNames(vmCopy.Spec.Template.Spec.Volumes, cdroms)) {
 		return nil
 	}
 	vmConditions := controller.NewVirtualMachineConditionManager()
@@ -924,6 +985,16 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 	}
 	log.Log.Object(vm).V(4).Infof("VirtualMachine RunStrategy: %s", runStrategy)
 
+	if vmi != nil && runStrategy != virtv1.RunStrategyHalted && migrations.IsMigratedToAnotherCluster(vmi) {
+		// The guest runs in the other cluster now. Starting it again here would run it
+		// twice on the same storage, so the VM is halted whatever its run strategy.
+		vm, err = c.haltMigratedOutVM(vm)
+		if err != nil {
+			return vm, common.NewSyncError(fmt.Errorf("failed to halt the VM migrated to another cluster: %v", err), failedUpdateErrorReason)
+		}
+		runStrategy = virtv1.RunStrategyHalted
+	}
+
 	switch runStrategy {
 	case virtv1.RunStrategyAlways:
 		// For this RunStrategy, a VMI should always be running. If a StateChangeRequest
@@ -1083,6 +1154,26 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 	}
 }
 
+// haltMigratedOutVM sets the run strategy of a VM whose guest was migrated to another cluster to Halted.
+func (c *Controller) haltMigratedOutVM(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+	vmCopy := vm.DeepCopy()
+	runStrategy := virtv1.RunStrategyHalted
+	running := false
+
+	if vmCopy.Spec.RunStrategy != nil {
+		vmCopy.Spec.RunStrategy = &runStrategy
+	} else {
+		vmCopy.Spec.Running = &running
+	}
+	updatedVM, err := c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return vm, err
+	}
+	log.Log.Object(vm).Info("Halted the VirtualMachine since its guest was migrated to another cluster")
+	c.recorder.Event(vm, k8score.EventTypeNormal, MigratedToAnotherClusterReason, "Halted the virtual machine since it was migrated to another cluster")
+	return updatedVM, nil
+}
+
 // isVMIStartExpected determines whether a VMI is expected to be started for this VM.
 func (c *Controller) isVMIStartExpected(vm *virtv1.VirtualMachine) bool {
 	vmKey, err := controller.KeyFunc(vm)
@@ -1508,6 +1599,43 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 	}
 }
 
+// syncMigrationHistory records the finished migration of the VMI on the VM, keeping the last maxMigrationHistory migrations
+func syncMigrationHistory(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.Status.MigrationState == nil || vmi.Status.MigrationState.EndTimestamp == nil {
+		return
+	}
+	state := vmi.Status.MigrationState
+	if !state.Completed && !state.Failed {
+		return
+	}
+
+	record := virtv1.VirtualMachineMigrationRecord{
+		MigrationUID:         state.MigrationUID,
+		VMIUID:               vmi.UID,
+		SourceNode:           state.SourceNode,
+		TargetNode:           state.TargetNode,
+		StartTimestamp:       state.StartTimestamp,
+		EndTimestamp:         state.EndTimestamp,
+		Mode:                 state.Mode,
+		DataTransferredBytes: state.DataTransferredBytes,
+		Downtime:             state.Downtime,
+		Failed:               state.Failed,
+		FailureReason:        state.FailureReason,
+	}
+	for i := range vm.Status.MigrationHistory {
+		if vm.Status.MigrationHistory[i].MigrationUID == record.MigrationUID {
+			// the VMI may report the final statistics after the migration ended
+			vm.Status.MigrationHistory[i] = record
+			return
+		}
+	}
+
+	vm.Status.MigrationHistory = append(vm.Status.MigrationHistory, record)
+	if excess := len(vm.Status.MigrationHistory) - maxMigrationHistory; excess > 0 {
+		vm.Status.MigrationHistory = vm.Status.MigrationHistory[excess:]
+	}
+}
+
 func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if vm.Status.VolumeUpdateState == nil || vm.Status.VolumeUpdateState.VolumeMigrationState == nil {
 		return
@@ -2402,6 +2530,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	// On a successful migration, the volume change condition is removed and we need to detect the removal before the synchronization of the VMI
 	// condition to the VM
 	syncVolumeMigration(vm, vmi)
+	syncMigrationHistory(vm, vmi)
 	syncConditions(vm, vmi, syncErr)
 	c.setPrintableStatus(vm, vmi)
 
@@ -2799,6 +2928,26 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 	vm.Status.VolumeRequests = tmpVolRequests
 }
 
+func getCDRomDiskNames(spec *virtv1.VirtualMachineInstanceSpec) map[string]struct{} {
+	cdroms := make(map[string]struct{})
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.CDRom != nil {
+			cdroms[disk.Name] = struct{}{}
+		}
+	}
+	return cdroms
+}
+
+func volumesWithoutNames(volumes []virtv1.Volume, names map[string]struct{}) []virtv1.Volume {
+	filtered := []virtv1.Volume{}
+	for _, volume := range volumes {
+		if _, ok := names[volume.Name]; !ok {
+			filtered = append(filtered, volume)
+		}
+	}
+	return filtered
+}
+
 func validLiveUpdateVolumes(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine) bool {
 	oldVols := storagetypes.GetVolumesByName(&oldVMSpec.Template.Spec)
 	// Evaluate if any volume has changed or has been added
@@ -2824,9 +2973,10 @@ func validLiveUpdateVolumes(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Vir
 			delete(oldVols, v.Name)
 		}
 	}
-	// Evaluate if any volumes were removed and they were hotplugged volumes
+	// Evaluate if any volumes were removed and they were hotplugged volumes or the ejected media of a CD-ROM
+	cdroms := getCDRomDiskNames(&vm.Spec.Template.Spec)
 	for _, v := range oldVols {
-		if !storagetypes.IsHotplugVolume(v) {
+		if _, ok := cdroms[v.Name]; !ok && !storagetypes.IsHotplugVolume(v) {
 			return false
 		}
 	}
@@ -2849,11 +2999,11 @@ func validLiveUpdateDisks(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Virtu
 		// The disk has been freshly added
 		case !okOld:
 			return false
-		// The disk has changed
-		case !equality.Semantic.DeepEqual(*oldDisk, newDisk):
+		// The disk has changed, its I/O limits can be changed on a running VMI
+		case !storagetypes.EqualDisksIgnoringIOTune(*oldDisk, newDisk):
 			return false
 		default:
-			delete(oldDisks, v.Name)
+			delete(oldDisks, newDisk.Name)
 		}
 	}
 	// Evaluate if any disks were removed and they were hotplugged volumes
@@ -3070,6 +3220,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling tolerations change request: %v", err), tolerationsChangeErrorReason), nil
 		}
 
+		if err := c.handleDiskIOTuneChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling disk I/O limits change request: %v", err), diskIOTuneChangeErrorReason), nil
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
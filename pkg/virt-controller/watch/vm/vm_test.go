@@ -1461,6 +1461,173 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("when dv priorityclass is not defined and VM priorityclass is not defined", "", "", ""),
 		)
 
+		Context("migration history", func() {
+			newMigrationState := func(uid string) *v1.VirtualMachineInstanceMigrationState {
+				start := metav1.NewTime(time.Now().Add(-time.Minute))
+				end := metav1.Now()
+				return &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:         types.UID(uid),
+					SourceNode:           "node01",
+					TargetNode:           "node02",
+					StartTimestamp:       &start,
+					EndTimestamp:         &end,
+					Mode:                 v1.MigrationPreCopy,
+					Completed:            true,
+					DataTransferredBytes: 1024,
+					Downtime:             &metav1.Duration{Duration: 50 * time.Millisecond},
+				}
+			}
+
+			It("should record the finished migration of the VMI", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.MigrationState = newMigrationState("migration-1")
+				vmi.Status.MigrationState.Failed = true
+				vmi.Status.MigrationState.Completed = false
+				vmi.Status.MigrationState.FailureReason = "some failure"
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				state := vmi.Status.MigrationState
+				Expect(vm.Status.MigrationHistory).To(ConsistOf(v1.VirtualMachineMigrationRecord{
+					MigrationUID:         state.MigrationUID,
+					VMIUID:               vmi.UID,
+					SourceNode:           "node01",
+					TargetNode:           "node02",
+					StartTimestamp:       state.StartTimestamp,
+					EndTimestamp:         state.EndTimestamp,
+					Mode:                 v1.MigrationPreCopy,
+					DataTransferredBytes: 1024,
+					Downtime:             state.Downtime,
+					Failed:               true,
+					FailureReason:        "some failure",
+				}))
+			})
+
+			It("should not record a migration which did not end", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vmi.Status.MigrationState = newMigrationState("migration-1")
+				vmi.Status.MigrationState.EndTimestamp = nil
+				vmi.Status.MigrationState.Completed = false
+
+				syncMigrationHistory(vm, vmi)
+
+				Expect(vm.Status.MigrationHistory).To(BeEmpty())
+			})
+
+			It("should update the record of a migration reported again", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vmi.Status.MigrationState = newMigrationState("migration-1")
+				syncMigrationHistory(vm, vmi)
+
+				vmi.Status.MigrationState.DataTransferredBytes = 2048
+				syncMigrationHistory(vm, vmi)
+
+				Expect(vm.Status.MigrationHistory).To(HaveLen(1))
+				Expect(vm.Status.MigrationHistory[0].DataTransferredBytes).To(Equal(int64(2048)))
+			})
+
+			It("should keep the history of previous VMIs and drop the oldest migrations", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				for i := 0; i < maxMigrationHistory; i++ {
+					vm.Status.MigrationHistory = append(vm.Status.MigrationHistory, v1.VirtualMachineMigrationRecord{
+						MigrationUID: types.UID(fmt.Sprintf("old-migration-%d", i)),
+						VMIUID:       "old-vmi",
+					})
+				}
+				vmi.Status.MigrationState = newMigrationState("migration-1")
+
+				syncMigrationHistory(vm, vmi)
+
+				Expect(vm.Status.MigrationHistory).To(HaveLen(maxMigrationHistory))
+				Expect(vm.Status.MigrationHistory[0].MigrationUID).To(Equal(types.UID("old-migration-1")))
+				Expect(vm.Status.MigrationHistory[maxMigrationHistory-1].MigrationUID).To(Equal(types.UID("migration-1")))
+			})
+		})
+
+		Context("VM migrated to another cluster", func() {
+			DescribeTable("should halt the VM instead of starting its VMI again", func(runStrategy v1.VirtualMachineRunStrategy) {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = &runStrategy
+				vmi.Status.Phase = v1.Succeeded
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:            "migration-1",
+					TargetNodeAddress:       "peer.example.com:49154",
+					CrossClusterMigrationID: "migration-id",
+					Completed:               true,
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, MigratedToAnotherClusterReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+				Expect(vm.Status.StateChangeRequests).To(BeEmpty())
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+			},
+				Entry("with runStrategy Always", v1.RunStrategyAlways),
+				Entry("with runStrategy RerunOnFailure", v1.RunStrategyRerunOnFailure),
+				Entry("with runStrategy Manual", v1.RunStrategyManual),
+				Entry("with runStrategy Once", v1.RunStrategyOnce),
+			)
+
+			It("should restart a VMI which succeeded after a migration inside the cluster", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+				vmi.Status.Phase = v1.Succeeded
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID: "migration-1",
+					TargetNode:   "node02",
+					Completed:    true,
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+			})
+		})
+
 		Context("crashloop backoff tests", func() {
 
 			It("should track start failures when VMIs fail without hitting running state", func() {
@@ -5289,6 +5456,96 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("Disk I/O limits", func() {
+				DescribeTable("should be live-updated", func(existingIOTune, updatedIOTune *v1.DiskIOTune) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}, {Name: "disk1", IOTune: updatedIOTune}}
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}, {Name: "disk1", IOTune: existingIOTune}}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new I/O limits")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(Equal(updatedIOTune))
+				},
+					Entry("when adding I/O limits",
+						nil,
+						&v1.DiskIOTune{TotalIOPS: pointer.P(int64(100))},
+					),
+					Entry("when changing I/O limits",
+						&v1.DiskIOTune{TotalIOPS: pointer.P(int64(100))},
+						&v1.DiskIOTune{
+							TotalIOPS: pointer.P(int64(200)),
+							Burst:     &v1.DiskIOTuneBurst{TotalIOPS: pointer.P(int64(400))},
+						},
+					),
+					Entry("when removing I/O limits",
+						&v1.DiskIOTune{TotalIOPS: pointer.P(int64(100))},
+						nil,
+					),
+				)
+
+				It("should replace removed I/O limits with the limits of the cluster", func() {
+					clusterIOTune := &v1.DiskIOTune{TotalIOPS: pointer.P(int64(500))}
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+								DiskIOTuneLimits:  clusterIOTune,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}, {Name: "disk1"}}
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+						{Name: "disk0", IOTune: clusterIOTune},
+						{Name: "disk1", IOTune: &v1.DiskIOTune{TotalIOPS: pointer.P(int64(100))}},
+					}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(clusterIOTune))
+					Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(Equal(clusterIOTune))
+				})
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -6199,6 +6456,14 @@ var _ = Describe("VirtualMachine", func() {
 				Name: name,
 			}
 		}
+		createCDRomDisk := func(name string) v1.Disk {
+			return v1.Disk{
+				Name: name,
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{},
+				},
+			}
+		}
 		DescribeTable("should be validated for volume updates", func(oldVols, newVols []v1.Volume, expectValid bool) {
 			oldVm, _ := watchtesting.DefaultVirtualMachine(true)
 			newVm := oldVm.DeepCopy()
@@ -6220,6 +6485,15 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("for a replaced hotpluggable pvc", []v1.Volume{createPVCVol("vol1", "test1", true)}, []v1.Volume{createPVCVol("vol1", "test2", true)}, true),
 			Entry("for a removed hotpluggable pvc", []v1.Volume{createPVCVol("vol1", "test1", true)}, []v1.Volume{}, true),
 		)
+		It("should be valid for the ejected media of a CD-ROM", func() {
+			oldVm, _ := watchtesting.DefaultVirtualMachine(true)
+			oldVm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{createCDRomDisk("vol1")}
+			oldVm.Spec.Template.Spec.Volumes = []v1.Volume{createPVCVol("vol1", "test1", false)}
+			newVm := oldVm.DeepCopy()
+			newVm.Spec.Template.Spec.Volumes = []v1.Volume{}
+
+			Expect(validLiveUpdateVolumes(&oldVm.Spec, newVm)).To(BeTrue())
+		})
 		DescribeTable("should be validated for disk updates", func(oldVols, newVols []v1.Volume, oldDisks, newDisks []v1.Disk, expectValid bool) {
 			oldVm, _ := watchtesting.DefaultVirtualMachine(true)
 			newVm := oldVm.DeepCopy()
@@ -6248,6 +6522,14 @@ var _ = Describe("VirtualMachine", func() {
 				createPVCVol("vol2", "test2", false)}, []v1.Disk{createDisk("vol2")}, []v1.Disk{createDisk("vol1"), createDisk("vol2")}, true),
 			Entry("for a removed hotpluggable pvc", []v1.Volume{createPVCVol("vol1", "test1", true)}, []v1.Volume{},
 				[]v1.Disk{createDisk("vol1")}, []v1.Disk{}, true),
+			Entry("for changed I/O limits", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{createPVCVol("vol1", "test1", false)},
+				[]v1.Disk{createDisk("vol1")}, []v1.Disk{{Name: "vol1", IOTune: &v1.DiskIOTune{ReadIOPS: pointer.P(int64(100))}}}, true),
+			Entry("for a changed cache mode", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{createPVCVol("vol1", "test1", false)},
+				[]v1.Disk{createDisk("vol1")}, []v1.Disk{{Name: "vol1", Cache: v1.CacheWriteThrough}}, false),
+			Entry("for the ejected media of a CD-ROM", []v1.Volume{createPVCVol("vol1", "test1", false)}, []v1.Volume{},
+				[]v1.Disk{createCDRomDisk("vol1")}, []v1.Disk{createCDRomDisk("vol1")}, true),
+			Entry("for a media inserted into a CD-ROM", []v1.Volume{}, []v1.Volume{createPVCVol("vol1", "test1", true)},
+				[]v1.Disk{createCDRomDisk("vol1")}, []v1.Disk{createCDRomDisk("vol1")}, true),
 		)
 	})
 
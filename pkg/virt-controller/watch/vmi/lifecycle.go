@@ -83,6 +83,13 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		return syncErr, pod
 	}
 
+	if migrations.IsCrossClusterMigrationReceiver(vmi) {
+		// The only pod of a VMI waiting for a cross-cluster migration is the
+		// migration target pod, the VMI must never be started on its own.
+		log.Log.V(3).Object(vmi).Infof("Delaying pod creation until the VMI is received by a cross-cluster migration")
+		return nil, nil
+	}
+
 	if !controller.PodExists(pod) {
 		// If we came ever that far to detect that we already created a pod, we don't create it again
 		if !vmi.IsUnprocessed() {
@@ -177,6 +177,12 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 					ClaimName: volume.Name,
 				}
 			}
+			if volume.Backup != nil && status.BackupVolume == nil {
+				status.BackupVolume = &virtv1.DomainBackupInfo{
+					ClaimName:  volume.Backup.ClaimName,
+					Checkpoint: volume.Backup.Checkpoint,
+				}
+			}
 			if attachmentPod == nil {
 				if !c.volumeReady(status.Phase) {
 					status.HotplugVolume.AttachPodUID = ""
@@ -203,7 +209,7 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 			}
 		}
 
-		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil {
+		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil || volume.VolumeSource.Backup != nil {
 			pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
 			pvcInterface, pvcExists, _ := c.pvcIndexer.GetByKey(fmt.Sprintf("%s/%s", vmi.Namespace, pvcName))
 			if pvcExists {
@@ -216,6 +222,9 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 					Requests:     pvc.Spec.Resources.Requests,
 					Preallocated: storagetypes.IsPreallocated(pvc.ObjectMeta.Annotations),
 				}
+				if pvc.Spec.StorageClassName != nil {
+					status.PersistentVolumeClaimInfo.StorageClassName = *pvc.Spec.StorageClassName
+				}
 				filesystemOverhead, err := c.getFilesystemOverhead(pvc)
 				if err != nil {
 					log.Log.Reason(err).Errorf("Failed to get filesystem overhead for PVC %s/%s", vmi.Namespace, pvcName)
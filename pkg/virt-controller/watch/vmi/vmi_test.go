@@ -865,6 +865,38 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			))
 		})
 
+		Context("waiting for a cross-cluster migration", func() {
+			newReceiverVirtualMachine := func() *virtv1.VirtualMachineInstance {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Annotations = map[string]string{virtv1.CrossClusterMigrationReceiverAnnotation: ""}
+				return vmi
+			}
+
+			It("should not create a Pod", func() {
+				vmi := newReceiverVirtualMachine()
+				addVirtualMachine(vmi)
+
+				sanityExecute()
+
+				pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).To(BeEmpty())
+				expectVirtualMachinePendingState(vmi.Namespace, vmi.Name)
+			})
+
+			It("should stay Pending while the migration target pod exists", func() {
+				vmi := newReceiverVirtualMachine()
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Labels[virtv1.MigrationJobLabel] = "testmigration"
+				addVirtualMachine(vmi)
+				addPod(pod)
+
+				sanityExecute()
+
+				expectVirtualMachinePendingState(vmi.Namespace, vmi.Name)
+			})
+		})
+
 		It("should add request-evict-only annotation to the virt-launcher pod if annotation does not exist", func() {
 			vmi := newPendingVirtualMachine("testvmi")
 			setReadyCondition(vmi, k8sv1.ConditionTrue, "")
@@ -2989,6 +3021,9 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: vmi.Namespace,
 					Name:      "existing"},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					StorageClassName: pointer.P("local-nvme"),
+				},
 			}
 			hpPVC := &k8sv1.PersistentVolumeClaim{
 				TypeMeta: metav1.TypeMeta{
@@ -3027,6 +3062,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 					PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
 						ClaimName:          "existing",
 						FilesystemOverhead: pointer.P(virtv1.Percent("0.055")),
+						StorageClassName:   "local-nvme",
 					},
 				},
 				{
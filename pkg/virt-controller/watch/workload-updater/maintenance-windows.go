@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package workloadupdater
+
+import (
+	"slices"
+	"time"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+// isWorkloadUpdateAllowed returns true if outdated VMIs of the namespace may be updated at the given time.
+// Otherwise it returns the time the next maintenance window of the namespace opens,
+// or the zero time if the namespace is never updated.
+func isWorkloadUpdateAllowed(strategy *virtv1.KubeVirtWorkloadUpdateStrategy, namespace string, now time.Time) (bool, time.Time) {
+	if slices.Contains(strategy.ExcludedNamespaces, namespace) {
+		return false, time.Time{}
+	}
+
+	windows := maintenanceWindowsForNamespace(strategy.MaintenanceWindows, namespace)
+	if len(windows) == 0 {
+		return true, time.Time{}
+	}
+
+	var nextWindow time.Time
+	for _, window := range windows {
+		open, next := isMaintenanceWindowOpen(window, now)
+		if open {
+			return true, time.Time{}
+		}
+		if !next.IsZero() && (nextWindow.IsZero() || next.Before(nextWindow)) {
+			nextWindow = next
+		}
+	}
+	return false, nextWindow
+}
+
+// maintenanceWindowsForNamespace returns the windows listing the namespace,
+// or the windows without namespaces if none lists it
+func maintenanceWindowsForNamespace(windows []virtv1.WorkloadUpdateMaintenanceWindow, namespace string) []virtv1.WorkloadUpdateMaintenanceWindow {
+	var namespaced, general []virtv1.WorkloadUpdateMaintenanceWindow
+	for _, window := range windows {
+		if len(window.Namespaces) == 0 {
+			general = append(general, window)
+		} else if slices.Contains(window.Namespaces, namespace) {
+			namespaced = append(namespaced, window)
+		}
+	}
+	if len(namespaced) > 0 {
+		return namespaced
+	}
+	return general
+}
+
+// isMaintenanceWindowOpen returns true if the window is open at the given time,
+// otherwise it returns the time it opens next. A window which can't be evaluated is never open.
+func isMaintenanceWindowOpen(window virtv1.WorkloadUpdateMaintenanceWindow, now time.Time) (bool, time.Time) {
+	schedule, err := cron.Parse(window.Schedule)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Invalid maintenance window schedule %q", window.Schedule)
+		return false, time.Time{}
+	}
+	timeZone := ""
+	if window.TimeZone != nil {
+		timeZone = *window.TimeZone
+	}
+	loc, err := cron.LoadLocation(timeZone)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Invalid maintenance window time zone %q", timeZone)
+		return false, time.Time{}
+	}
+
+	// the window is open if it opened within its duration before now
+	if opened := schedule.NextIn(now.Add(-window.Duration.Duration), loc); !opened.IsZero() && !opened.After(now) {
+		return true, time.Time{}
+	}
+	return false, schedule.NextIn(now, loc)
+}
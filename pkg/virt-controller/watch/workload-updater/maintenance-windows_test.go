@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package workloadupdater
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Maintenance windows", func() {
+	// a wednesday
+	now := time.Date(2024, time.January, 10, 10, 0, 0, 0, time.UTC)
+
+	weekend := v1.WorkloadUpdateMaintenanceWindow{Schedule: "0 22 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}}
+	wednesdayMorning := v1.WorkloadUpdateMaintenanceWindow{Schedule: "0 8 * * wed", Duration: metav1.Duration{Duration: 4 * time.Hour}, Namespaces: []string{"tenant"}}
+
+	DescribeTable("should decide if outdated VMIs may be updated", func(strategy v1.KubeVirtWorkloadUpdateStrategy, namespace string, expectedAllowed bool, expectedNextWindow time.Time) {
+		allowed, nextWindow := isWorkloadUpdateAllowed(&strategy, namespace, now)
+		Expect(allowed).To(Equal(expectedAllowed))
+		Expect(nextWindow.Equal(expectedNextWindow)).To(BeTrue(), "next window %v, expected %v", nextWindow, expectedNextWindow)
+	},
+		Entry("at any time without windows", v1.KubeVirtWorkloadUpdateStrategy{}, "default", true, time.Time{}),
+		Entry("never in an excluded namespace",
+			v1.KubeVirtWorkloadUpdateStrategy{ExcludedNamespaces: []string{"default"}}, "default", false, time.Time{},
+		),
+		Entry("in an open window",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{wednesdayMorning}}, "tenant", true, time.Time{},
+		),
+		Entry("not until a closed window opens",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{weekend}}, "default", false,
+			time.Date(2024, time.January, 13, 22, 0, 0, 0, time.UTC),
+		),
+		Entry("not in the general window when the namespace has its own window",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "@hourly", Duration: metav1.Duration{Duration: time.Hour}},
+				{Schedule: "0 22 * * sat", Duration: metav1.Duration{Duration: time.Hour}, Namespaces: []string{"tenant"}},
+			}}, "tenant", false,
+			time.Date(2024, time.January, 13, 22, 0, 0, 0, time.UTC),
+		),
+		Entry("in the general window when the namespace has no own window",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "@hourly", Duration: metav1.Duration{Duration: time.Hour}},
+				weekend,
+			}}, "default", true, time.Time{},
+		),
+		Entry("at any time when no window applies to the namespace",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{wednesdayMorning}}, "default", true, time.Time{},
+		),
+		Entry("with the schedule evaluated in the time zone of the window",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "0 8 * * wed", Duration: metav1.Duration{Duration: 2 * time.Hour}, TimeZone: pointer.P("America/New_York")},
+			}}, "default", false,
+			time.Date(2024, time.January, 10, 13, 0, 0, 0, time.UTC),
+		),
+		Entry("not in a window which can't be evaluated",
+			v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "0 8 * * wed", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: pointer.P("Nowhere/Special")},
+			}}, "default", false, time.Time{},
+		),
+	)
+
+	It("should close the window at the end of its duration", func() {
+		strategy := &v1.KubeVirtWorkloadUpdateStrategy{MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+			{Schedule: "0 8 * * wed", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+		}}
+		allowed, _ := isWorkloadUpdateAllowed(strategy, "default", now.Add(-time.Second))
+		Expect(allowed).To(BeTrue())
+		allowed, _ = isWorkloadUpdateAllowed(strategy, "default", now)
+		Expect(allowed).To(BeFalse())
+	})
+})
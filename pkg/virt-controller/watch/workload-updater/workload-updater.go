@@ -59,6 +59,8 @@ const defaultThrottleInterval = 5 * time.Second
 const defaultBatchDeletionIntervalSeconds = 60
 const defaultBatchDeletionCount = 10
 
+var currentTime = time.Now
+
 type WorkloadUpdateController struct {
 	clientset             kubecli.KubevirtClient
 	queue                 workqueue.TypedRateLimitingInterface[string]
@@ -83,6 +85,9 @@ type updateData struct {
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
 
 	numActiveMigrations int
+
+	// the time the next maintenance window opens for outdated VMIs waiting for one
+	nextMaintenanceWindow time.Time
 }
 
 func NewWorkloadUpdateController(
@@ -327,6 +332,15 @@ func isVolumesUpdateInProgress(vmi *virtv1.VirtualMachineInstance) bool {
 		virtv1.VirtualMachineInstanceVolumesChange, k8sv1.ConditionTrue)
 }
 
+// migrationPriority returns the priority of the workload update migration. Migrations applying
+// hotplugs or volume updates were requested by the user, the others are maintenance.
+func migrationPriority(vmi *virtv1.VirtualMachineInstance) virtv1.MigrationPriority {
+	if isHotplugInProgress(vmi) || isVolumesUpdateInProgress(vmi) {
+		return virtv1.MigrationPriorityUserTriggered
+	}
+	return virtv1.MigrationPrioritySystemMaintenance
+}
+
 func (c *WorkloadUpdateController) doesRequireMigration(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi.IsFinal() || migrationutils.IsMigrating(vmi) {
 		return false
@@ -361,7 +375,7 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt, now time.Time) *updateData {
 	data := &updateData{}
 
 	lookup := make(map[string]bool)
@@ -411,6 +425,15 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 		} else if exists := lookup[vmi.Namespace+"/"+vmi.Name]; exists {
 			continue
 		}
+		// migrations applying hotplug or volume updates are not deferred to maintenance windows
+		if !c.doesRequireMigration(vmi) {
+			if allowed, nextWindow := isWorkloadUpdateAllowed(&kv.Spec.WorkloadUpdateStrategy, vmi.Namespace, now); !allowed {
+				if !nextWindow.IsZero() && (data.nextMaintenanceWindow.IsZero() || nextWindow.Before(data.nextMaintenanceWindow)) {
+					data.nextMaintenanceWindow = nextWindow
+				}
+				continue
+			}
+		}
 		volMig := false
 		errValid := volumemig.ValidateVolumesUpdateMigration(vmi, nil, vmi.Status.MigratedVolumes)
 		if len(vmi.Status.MigratedVolumes) > 0 && errValid == nil {
@@ -456,8 +479,9 @@ func (c *WorkloadUpdateController) execute(key string) error {
 }
 
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
+	now := currentTime()
 
-	data := c.getUpdateData(kv)
+	data := c.getUpdateData(kv, now)
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -495,6 +519,8 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	// when we don't need to be that efficent in how quickly the updates are being processed.
 	if len(data.evictOutdatedVMIs) != 0 || len(data.migratableOutdatedVMIs) != 0 || len(data.abortChangeVMIs) != 0 {
 		c.queue.AddAfter(key, periodicReEnqueueIntervalSeconds)
+	} else if !data.nextMaintenanceWindow.IsZero() {
+		c.queue.AddAfter(key, data.nextMaintenanceWindow.Sub(now))
 	}
 
 	// Randomizes list so we don't always re-attempt the same vmis in
@@ -514,8 +540,6 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
-
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
 	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
 		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
@@ -560,6 +584,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 				labels = make(map[string]string)
 				labels[virtv1.VolumesUpdateMigration] = vmi.Name
 			}
+			priority := migrationPriority(vmi)
 			defer wg.Done()
 			createdMigration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), &virtv1.VirtualMachineInstanceMigration{
 				ObjectMeta: metav1.ObjectMeta{
@@ -570,7 +595,8 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 					GenerateName: "kubevirt-workload-update-",
 				},
 				Spec: virtv1.VirtualMachineInstanceMigrationSpec{
-					VMIName: vmi.Name,
+					VMIName:  vmi.Name,
+					Priority: &priority,
 				},
 			}, metav1.CreateOptions{})
 			if err != nil {
@@ -133,6 +133,7 @@ var _ = Describe("Workload Updater", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(migrations.Items).To(HaveLen(1))
 			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm"))
+			Expect(migrations.Items[0].Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemMaintenance)))
 		})
 
 		It("should do nothing if deployment is updating", func() {
@@ -494,6 +495,70 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("maintenance windows", func() {
+		// a wednesday
+		now := time.Date(2024, time.January, 10, 10, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			currentTime = func() time.Time { return now }
+			DeferCleanup(func() { currentTime = time.Now })
+		})
+
+		addOutdatedVMI := func() {
+			vmi := newVirtualMachineInstance("testvm", true, "madeup")
+			pod := newLauncherPodForVMI(vmi)
+			controller.vmiStore.Add(vmi)
+			controller.podIndexer.Add(pod)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+		}
+
+		DescribeTable("should migrate outdated VMIs only in a maintenance window", func(strategy v1.KubeVirtWorkloadUpdateStrategy, expectedMigrations int) {
+			addOutdatedVMI()
+			kv := newKubeVirt(1)
+			kv.Spec.WorkloadUpdateStrategy = strategy
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(expectedMigrations))
+			if expectedMigrations > 0 {
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+		},
+			Entry("when the window is open", v1.KubeVirtWorkloadUpdateStrategy{
+				MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{{Schedule: "0 8 * * wed", Duration: metav1.Duration{Duration: 4 * time.Hour}}},
+			}, 1),
+			Entry("not when the window is closed", v1.KubeVirtWorkloadUpdateStrategy{
+				MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{{Schedule: "0 22 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}}},
+			}, 0),
+			Entry("not when the namespace is excluded", v1.KubeVirtWorkloadUpdateStrategy{
+				ExcludedNamespaces: []string{k8sv1.NamespaceDefault},
+			}, 0),
+		)
+
+		It("should still count VMIs waiting for a maintenance window as outdated", func() {
+			addOutdatedVMI()
+			kv := newKubeVirt(0)
+			kv.Spec.WorkloadUpdateStrategy = v1.KubeVirtWorkloadUpdateStrategy{
+				WorkloadUpdateMethods: []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate},
+				MaintenanceWindows:    []v1.WorkloadUpdateMaintenanceWindow{{Schedule: "0 22 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}}},
+			}
+			addKubeVirt(kv)
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+			kv, err = fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), kv.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Status.OutdatedVirtualMachineInstanceWorkloads).To(HaveValue(Equal(1)))
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(BeEmpty())
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			condition := v1.VirtualMachineInstanceCondition{
@@ -510,6 +575,7 @@ var _ = Describe("Workload Updater", func() {
 			virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &condition)
 
 			Expect(controller.doesRequireMigration(vmi)).To(BeTrue())
+			Expect(migrationPriority(vmi)).To(Equal(v1.MigrationPriorityUserTriggered))
 		})
 	})
 
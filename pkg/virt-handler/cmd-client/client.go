@@ -42,6 +42,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -77,6 +78,19 @@ type MigrationOptions struct {
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
 	AllowWorkloadDisruption  bool
+	Compression              *v1.MigrationCompression
+	MaxDowntime              *metav1.Duration
+	SwitchoverPolicy         *v1.MigrationSwitchoverPolicy
+	LocalStorage             *v1.LocalStorageMigration
+}
+
+type BackupOptions struct {
+	// TargetDir is the directory the volumes are backed up to
+	TargetDir string
+	// Checkpoint is the name of the checkpoint created with the backup
+	Checkpoint string
+	// BaseCheckpoint is the checkpoint an incremental backup is based on, a full backup is taken if empty
+	BaseCheckpoint string
 }
 
 type LauncherClient interface {
@@ -106,6 +120,7 @@ type LauncherClient interface {
 	GuestPing(string, int32) error
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *BackupOptions) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -430,6 +445,31 @@ func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInst
 	return err
 }
 
+func (c *VirtLauncherClient) VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.VirtualMachineBackup(ctx, request)
+	err = handleError(err, "Backup", response)
+	return err
+}
+
 func (c *VirtLauncherClient) SoftRebootVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("SoftReboot", c.v1client.SoftRebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -300,6 +300,16 @@ func (_mr *_MockLauncherClientRecorder) VirtualMachineMemoryDump(arg0, arg1 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineMemoryDump", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) VirtualMachineBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", arg0, arg1)
+}
+
 func (_m *MockLauncherClient) GetQemuVersion() (string, error) {
 	ret := _m.ctrl.Call(_m, "GetQemuVersion")
 	ret0, _ := ret[0].(string)
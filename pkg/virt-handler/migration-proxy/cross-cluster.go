@@ -0,0 +1,257 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/ip"
+)
+
+// CrossClusterMigrationPort is the default port of the cross-cluster migration endpoint
+const CrossClusterMigrationPort = 49154
+
+const (
+	handshakeTimeout      = 10 * time.Second
+	maxHandshakeLength    = 512
+	handshakeAcknowledged = "OK"
+)
+
+// SRC POD ENV(migration unix socket) <-> HOST ENV (tcp client) <-----> CROSS-CLUSTER ENDPOINT (tcp server) <-> TARGET POD ENV (unix sockets)
+//
+// Every migration of a node shares the cross-cluster endpoint. The source opens each connection with a
+// handshake naming the migration ID and the libvirt port the connection belongs to, the endpoint
+// acknowledges it and pipes the connection to the matching unix socket of the target pod.
+
+type crossClusterRoute struct {
+	key string
+	// unix sockets of the target pod by libvirt port, 0 is the virtqemud socket
+	targetUnixFiles map[int]string
+}
+
+func crossClusterHandshake(migrationID string, port int) string {
+	return fmt.Sprintf("%s %d\n", migrationID, port)
+}
+
+func parseCrossClusterHandshake(line string) (string, int, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return "", 0, fmt.Errorf("malformed cross-cluster migration handshake")
+	}
+	port, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("malformed cross-cluster migration port: %v", err)
+	}
+	return fields[0], port, nil
+}
+
+func (m *migrationProxyManager) crossClusterPort() int {
+	if port := m.config.GetMigrationConfiguration().CrossClusterMigrationPort; port != nil {
+		return int(*port)
+	}
+	return CrossClusterMigrationPort
+}
+
+// StartCrossClusterTargetListener routes the connections of the cross-cluster migration to the unix sockets of the target pod
+func (m *migrationProxyManager) StartCrossClusterTargetListener(key string, migrationID string, targetUnixFiles map[int]string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+	if route, exists := m.crossClusterRoutes[migrationID]; exists && route.key != key {
+		return fmt.Errorf("cross-cluster migration %s is already received by %s", migrationID, route.key)
+	}
+
+	if m.crossClusterListener == nil {
+		laddr := net.JoinHostPort(ip.GetIPZeroAddress(), strconv.Itoa(m.crossClusterPort()))
+		listener, err := tls.Listen("tcp", laddr, m.crossClusterServerTLSConfig)
+		if err != nil {
+			log.Log.Reason(err).Error("failed to create the cross-cluster migration listener")
+			return err
+		}
+		m.crossClusterListener = listener
+		go m.acceptCrossClusterConnections(listener)
+		log.Log.Infof("cross-cluster migration endpoint started listening at %s", listener.Addr())
+	}
+	m.crossClusterRoutes[migrationID] = &crossClusterRoute{key: key, targetUnixFiles: targetUnixFiles}
+	return nil
+}
+
+// stopCrossClusterRoutes removes the routes of the key and stops the endpoint once no migration is received anymore.
+// The caller has to hold the manager lock.
+func (m *migrationProxyManager) stopCrossClusterRoutes(key string) {
+	for migrationID, route := range m.crossClusterRoutes {
+		if route.key == key {
+			delete(m.crossClusterRoutes, migrationID)
+		}
+	}
+	if len(m.crossClusterRoutes) == 0 && m.crossClusterListener != nil {
+		log.Log.Info("cross-cluster migration endpoint stopped listening")
+		m.crossClusterListener.Close()
+		m.crossClusterListener = nil
+	}
+}
+
+func (m *migrationProxyManager) lookupCrossClusterRoute(migrationID string, port int) (string, string, bool) {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	route, exists := m.crossClusterRoutes[migrationID]
+	if !exists {
+		return "", "", false
+	}
+	targetUnixFile, exists := route.targetUnixFiles[port]
+	return route.key, targetUnixFile, exists
+}
+
+func (m *migrationProxyManager) acceptCrossClusterConnections(listener net.Listener) {
+	for {
+		fd, err := listener.Accept()
+		if err != nil {
+			log.Log.Reason(err).V(3).Info("cross-cluster migration listener exited")
+			return
+		}
+		go m.handleCrossClusterConnection(fd)
+	}
+}
+
+func (m *migrationProxyManager) handleCrossClusterConnection(fd net.Conn) {
+	defer fd.Close()
+
+	fd.SetReadDeadline(time.Now().Add(handshakeTimeout))
+	line, err := readHandshakeLine(fd)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to read the cross-cluster migration handshake from %s", fd.RemoteAddr())
+		return
+	}
+	fd.SetReadDeadline(time.Time{})
+
+	migrationID, port, err := parseCrossClusterHandshake(line)
+	if err != nil {
+		log.Log.Reason(err).Errorf("rejecting cross-cluster migration connection from %s", fd.RemoteAddr())
+		return
+	}
+	key, targetUnixFile, exists := m.lookupCrossClusterRoute(migrationID, port)
+	if !exists {
+		log.Log.Errorf("rejecting connection from %s for unknown cross-cluster migration %s on port %d", fd.RemoteAddr(), migrationID, port)
+		return
+	}
+
+	logger := log.Log.With("uid", key).With("migrationID", migrationID).With("outbound", targetUnixFile)
+	conn, err := net.Dial("unix", targetUnixFile)
+	if err != nil {
+		logger.Reason(err).Error("unable to create outbound leg of cross-cluster proxy to the target pod")
+		return
+	}
+	defer conn.Close()
+	if _, err := fmt.Fprintln(fd, handshakeAcknowledged); err != nil {
+		logger.Reason(err).Error("failed to acknowledge the cross-cluster migration handshake")
+		return
+	}
+
+	pipeConnections(logger, fd, conn, nil)
+}
+
+// StartCrossClusterSourceListener exposes a unix socket per libvirt port which connects to the cross-cluster
+// migration endpoint of the target node
+func (m *migrationProxyManager) StartCrossClusterSourceListener(key string, migrationID string, connectURL string, ports []int, baseDir string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+	if _, exists := m.sourceProxies[key]; exists {
+		// No Op, already exists
+		return nil
+	}
+
+	proxiesList := []*migrationProxy{}
+	for _, port := range ports {
+		filePath := SourceUnixFile(baseDir, ConstructProxyKey(key, port))
+		os.RemoveAll(filePath)
+
+		proxy := NewSourceProxy(filePath, connectURL, nil, m.crossClusterClientTLSConfig, key)
+		proxy.handshake = crossClusterHandshake(migrationID, port)
+
+		err := proxy.Start()
+		if err != nil {
+			proxy.Stop()
+			// close all already created proxies for this key
+			for _, curProxy := range proxiesList {
+				curProxy.Stop()
+			}
+			return err
+		}
+		proxiesList = append(proxiesList, proxy)
+		proxy.logger.Infof("Manager created cross-cluster proxy on source node")
+	}
+	m.sourceProxies[key] = proxiesList
+	return nil
+}
+
+// sendCrossClusterHandshake names the migration and port of the connection and waits for the endpoint to acknowledge it
+func sendCrossClusterHandshake(conn net.Conn, handshake string) (net.Conn, error) {
+	if _, err := conn.Write([]byte(handshake)); err != nil {
+		return nil, err
+	}
+	conn.SetReadDeadline(time.Now().Add(handshakeTimeout))
+	reply, err := readHandshakeLine(conn)
+	if err != nil {
+		return nil, fmt.Errorf("cross-cluster migration endpoint rejected the connection: %v", err)
+	}
+	if strings.TrimSpace(reply) != handshakeAcknowledged {
+		return nil, fmt.Errorf("unexpected cross-cluster migration handshake reply %q", reply)
+	}
+	conn.SetReadDeadline(time.Time{})
+	return conn, nil
+}
+
+// readHandshakeLine reads a handshake line of at most maxHandshakeLength bytes. It reads byte by byte,
+// so that nothing the peer sent after the handshake is consumed.
+func readHandshakeLine(conn net.Conn) (string, error) {
+	reader := io.LimitReader(conn, maxHandshakeLength)
+	line := make([]byte, 0, maxHandshakeLength)
+	b := make([]byte, 1)
+	for {
+		if _, err := io.ReadFull(reader, b); err != nil {
+			if errors.Is(err, io.EOF) && len(line) == maxHandshakeLength {
+				return "", fmt.Errorf("cross-cluster migration handshake longer than %d bytes", maxHandshakeLength)
+			}
+			return "", err
+		}
+		line = append(line, b[0])
+		if b[0] == '\n' {
+			return string(line), nil
+		}
+	}
+}
@@ -54,6 +54,9 @@ type ProxyManager interface {
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
+	StartCrossClusterTargetListener(key string, migrationID string, targetUnixFiles map[int]string) error
+	StartCrossClusterSourceListener(key string, migrationID string, connectURL string, ports []int, baseDir string) error
+
 	OpenListenerCount() int
 
 	InitiateGracefulShutdown()
@@ -68,6 +71,11 @@ type migrationProxyManager struct {
 
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
+
+	crossClusterServerTLSConfig *tls.Config
+	crossClusterClientTLSConfig *tls.Config
+	crossClusterListener        net.Listener
+	crossClusterRoutes          map[string]*crossClusterRoute
 }
 
 type MigrationProxyListener interface {
@@ -88,6 +96,8 @@ type migrationProxy struct {
 	listener        net.Listener
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// handshake is sent to cross-cluster migration endpoints before any data
+	handshake string
 
 	logger *log.FilteredLogger
 }
@@ -103,7 +113,7 @@ func (m *migrationProxyManager) OpenListenerCount() int {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	return len(m.sourceProxies) + len(m.targetProxies)
+	return len(m.sourceProxies) + len(m.targetProxies) + len(m.crossClusterRoutes)
 }
 
 func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
@@ -114,13 +124,18 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 	return
 }
 
-func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
+// NewMigrationProxyManager creates the proxy manager. The cross-cluster TLS configs authenticate the
+// peers of cross-cluster migrations, they are always used regardless of the DisableTLS setting.
+func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, crossClusterServerTLSConfig *tls.Config, crossClusterClientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
 	return &migrationProxyManager{
-		sourceProxies:   make(map[string][]*migrationProxy),
-		targetProxies:   make(map[string][]*migrationProxy),
-		serverTLSConfig: serverTLSConfig,
-		clientTLSConfig: clientTLSConfig,
-		config:          config,
+		sourceProxies:               make(map[string][]*migrationProxy),
+		targetProxies:               make(map[string][]*migrationProxy),
+		serverTLSConfig:             serverTLSConfig,
+		clientTLSConfig:             clientTLSConfig,
+		config:                      config,
+		crossClusterServerTLSConfig: crossClusterServerTLSConfig,
+		crossClusterClientTLSConfig: crossClusterClientTLSConfig,
+		crossClusterRoutes:          make(map[string]*crossClusterRoute),
 	}
 }
 
@@ -255,6 +270,7 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 			delete(m.targetProxies, key)
 		}
 	}
+	m.stopCrossClusterRoutes(key)
 }
 
 func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
@@ -439,9 +455,6 @@ func (m *migrationProxy) Stop() {
 func (m *migrationProxy) handleConnection(fd net.Conn) {
 	defer fd.Close()
 
-	outBoundErr := make(chan error, 1)
-	inBoundErr := make(chan error, 1)
-
 	var conn net.Conn
 	var err error
 	if m.targetProtocol == "tcp" && m.clientTLSConfig != nil {
@@ -453,31 +466,48 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 		m.logger.Reason(err).Error("unable to create outbound leg of proxy to host")
 		return
 	}
+	defer conn.Close()
+
+	if m.handshake != "" {
+		conn, err = sendCrossClusterHandshake(conn, m.handshake)
+		if err != nil {
+			m.logger.Reason(err).Error("unable to establish cross-cluster migration connection")
+			return
+		}
+	}
+
+	pipeConnections(m.logger, fd, conn, m.stopChan)
+}
+
+// pipeConnections copies data in both directions until either side is closed or the stop channel is closed
+func pipeConnections(logger *log.FilteredLogger, fd net.Conn, conn net.Conn, stopChan chan struct{}) {
+	outBoundErr := make(chan error, 1)
+	inBoundErr := make(chan error, 1)
 
 	go func() {
 		//from outbound connection to proxy
 		n, err := io.Copy(fd, conn)
-		m.logger.Infof("%d bytes copied outbound to inbound", n)
+		logger.Infof("%d bytes copied outbound to inbound", n)
 		inBoundErr <- err
 	}()
 	go func() {
 		//from proxy to outbound connection
 		n, err := io.Copy(conn, fd)
-		m.logger.Infof("%d bytes copied from inbound to outbound", n)
+		logger.Infof("%d bytes copied from inbound to outbound", n)
 		outBoundErr <- err
 	}()
 
 	select {
-	case err = <-outBoundErr:
+	case err := <-outBoundErr:
 		if err != nil {
-			m.logger.Reason(err).Errorf("error encountered copying data to outbound connection")
+			logger.Reason(err).Errorf("error encountered copying data to outbound connection")
 		}
-	case err = <-inBoundErr:
+	case err := <-inBoundErr:
 		if err != nil {
-			m.logger.Reason(err).Errorf("error encountered copying data into inbound connection")
+			logger.Reason(err).Errorf("error encountered copying data into inbound connection")
 		}
-	case <-m.stopChan:
-		m.logger.Info("stop channel terminated proxy")
+	case <-stopChan:
+		logger.Info("stop channel terminated proxy")
 	}
 }
 
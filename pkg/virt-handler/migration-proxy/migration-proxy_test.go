@@ -162,7 +162,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
 				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
@@ -230,7 +230,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
@@ -258,5 +258,132 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
 			)
 		})
+
+		Context("cross-cluster migration", func() {
+			const (
+				directMigrationPort = 49152
+				migrationID         = "migration-1"
+			)
+
+			var (
+				manager        ProxyManager
+				virtqemudSock  string
+				directSock     string
+				virtqemudChan  chan int
+				directChan     chan int
+				closeListeners func()
+			)
+
+			msgReader := func(listener net.Listener, numBytes chan int) {
+				fd, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				var bytes [1024]byte
+				n, err := fd.Read(bytes[0:])
+				Expect(err).ShouldNot(HaveOccurred())
+				numBytes <- n
+			}
+
+			BeforeEach(func() {
+				virtqemudSock = filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				directSock = filepath.Join(tmpDir, "direct-sock")
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				closeListeners = func() {
+					virtqemudListener.Close()
+					directListener.Close()
+				}
+
+				virtqemudChan = make(chan int, 1)
+				directChan = make(chan int, 1)
+				go msgReader(virtqemudListener, virtqemudChan)
+				go msgReader(directListener, directChan)
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: &v1.MigrationConfiguration{CrossClusterMigrationPort: pointer.P(int32(12346))},
+				})
+				manager = NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
+				Expect(manager.StartCrossClusterTargetListener("target", migrationID, map[int]string{
+					0:                   virtqemudSock,
+					directMigrationPort: directSock,
+				})).To(Succeed())
+			})
+
+			AfterEach(func() {
+				manager.StopSourceListener("source")
+				manager.StopTargetListener("target")
+				closeListeners()
+			})
+
+			It("should route the connections of the migration ID to the target sockets", func() {
+				Expect(manager.StartCrossClusterSourceListener("source", migrationID, "127.0.0.1:12346", []int{0, directMigrationPort}, tmpDir)).To(Succeed())
+				Expect(manager.OpenListenerCount()).To(Equal(2))
+
+				for _, sockFile := range manager.GetSourceListenerFiles("source") {
+					conn, err := net.Dial("unix", sockFile)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+
+					message := []byte("some message")
+					sentLen, err := conn.Write(message)
+					Expect(err).ShouldNot(HaveOccurred())
+					if strings.HasSuffix(sockFile, ConstructProxyKey("source", directMigrationPort)+"-source.sock") {
+						Eventually(directChan).Should(Receive(Equal(sentLen)))
+					} else {
+						Eventually(virtqemudChan).Should(Receive(Equal(sentLen)))
+					}
+				}
+			})
+
+			It("should reject the connections of an unknown migration ID", func() {
+				Expect(manager.StartCrossClusterSourceListener("source", "unknown", "127.0.0.1:12346", []int{0}, tmpDir)).To(Succeed())
+
+				conn, err := net.Dial("unix", manager.GetSourceListenerFiles("source")[0])
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+
+				var bytes [1024]byte
+				_, err = conn.Read(bytes[0:])
+				Expect(err).Should(HaveOccurred())
+				Consistently(virtqemudChan).ShouldNot(Receive())
+			})
+
+			It("should not consume the bytes sent after the handshake", func() {
+				client, server := net.Pipe()
+				defer client.Close()
+				defer server.Close()
+				go client.Write([]byte(crossClusterHandshake(migrationID, 0) + "some message"))
+
+				line, err := readHandshakeLine(server)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(line).To(Equal(crossClusterHandshake(migrationID, 0)))
+
+				var bytes [1024]byte
+				n, err := server.Read(bytes[0:])
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(bytes[:n])).To(Equal("some message"))
+			})
+
+			It("should reject a handshake longer than the maximum length", func() {
+				client, server := net.Pipe()
+				defer client.Close()
+				defer server.Close()
+				go client.Write([]byte(strings.Repeat("a", maxHandshakeLength+1) + "\n"))
+
+				_, err := readHandshakeLine(server)
+				Expect(err).To(MatchError(ContainSubstring("longer than 512 bytes")))
+			})
+
+			It("should stop the endpoint once no migration is received anymore", func() {
+				manager.StopTargetListener("target")
+				Expect(manager.OpenListenerCount()).To(Equal(0))
+
+				_, err := net.Dial("tcp", "127.0.0.1:12346")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
 	})
 })
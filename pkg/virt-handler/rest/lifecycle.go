@@ -26,6 +26,8 @@ import (
 	"github.com/emicklei/go-restful/v3"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -34,6 +36,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 const (
@@ -237,6 +240,65 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetGuestMetrics(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Retreiving guest metrics from %s", vmi.Name)
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domainStats == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("no domain stats available for %s", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(guestMetricsFromDomainStats(domainStats))
+}
+
+func guestMetricsFromDomainStats(domainStats *stats.DomainStats) v1.VirtualMachineInstanceGuestMetrics {
+	metrics := v1.VirtualMachineInstanceGuestMetrics{
+		Timestamp: metav1.NowMicro(),
+		VCPUCount: int32(domainStats.NrVirtCpu),
+	}
+
+	if len(domainStats.Vcpu) > 0 {
+		var vcpuTime int64
+		for _, vcpu := range domainStats.Vcpu {
+			if vcpu.TimeSet {
+				vcpuTime += int64(vcpu.Time)
+			}
+		}
+		metrics.VCPUTimeNanoseconds = &vcpuTime
+	}
+
+	if mem := domainStats.Memory; mem != nil {
+		// memory stats are reported in KiB
+		if mem.AvailableSet {
+			metrics.MemoryAvailable = resource.NewQuantity(int64(mem.Available)*1024, resource.BinarySI)
+		}
+		if mem.UsableSet {
+			metrics.MemoryUsable = resource.NewQuantity(int64(mem.Usable)*1024, resource.BinarySI)
+		}
+	}
+
+	if load := domainStats.Load; load != nil && load.Load1Set && load.Load5Set && load.Load15Set {
+		metrics.Load = &v1.VirtualMachineInstanceGuestLoad{
+			Load1:  *resource.NewMilliQuantity(int64(load.Load1*1000), resource.DecimalSI),
+			Load5:  *resource.NewMilliQuantity(int64(load.Load5*1000), resource.DecimalSI),
+			Load15: *resource.NewMilliQuantity(int64(load.Load15*1000), resource.DecimalSI),
+		}
+	}
+
+	return metrics
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
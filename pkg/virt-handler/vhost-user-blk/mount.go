@@ -0,0 +1,222 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhost_user_blk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/checkpoint"
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/unsafepath"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	virt_chroot "kubevirt.io/kubevirt/pkg/virt-handler/virt-chroot"
+)
+
+var (
+	socketDirOnHost = func(podUID types.UID, kubeletPodsDir string) (*safepath.Path, error) {
+		return safepath.JoinAndResolveWithRelativeRoot("/proc/1/root", kubeletPodsDir,
+			fmt.Sprintf("/%s/volumes/kubernetes.io~empty-dir/%s", string(podUID), vhostuserblk.VhostUserBlkSockets))
+	}
+
+	daemonSocketPath = func(namespace, socket string) (*safepath.Path, error) {
+		return isolation.SafeJoin(isolation.NodeIsolationResult(), vhostuserblk.HostSocketPath(namespace, socket))
+	}
+
+	mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+		return virt_chroot.MountChroot(sourcePath, targetPath, false).CombinedOutput()
+	}
+
+	unmountCommand = func(path *safepath.Path) ([]byte, error) {
+		return virt_chroot.UmountChroot(path).CombinedOutput()
+	}
+
+	isMounted = func(path *safepath.Path) (bool, error) {
+		return isolation.IsMounted(path)
+	}
+)
+
+// Mounter bind mounts the vhost-user-blk sockets of the storage daemon of the node into the virt-launcher pod
+type Mounter interface {
+	Mount(vmi *v1.VirtualMachineInstance) error
+	Unmount(vmi *v1.VirtualMachineInstance) error
+}
+
+type mounter struct {
+	checkpointManager checkpoint.CheckpointManager
+	ownershipManager  diskutils.OwnershipManagerInterface
+	kubeletPodsDir    string
+}
+
+type vmiMountTargetEntry struct {
+	TargetFile string `json:"targetFile"`
+}
+
+type vmiMountTargetRecord struct {
+	MountTargetEntries []vmiMountTargetEntry `json:"mountTargetEntries"`
+}
+
+func NewMounter(mountStateDir string, kubeletPodsDir string) Mounter {
+	return &mounter{
+		checkpointManager: checkpoint.NewSimpleCheckpointManager(mountStateDir),
+		ownershipManager:  diskutils.DefaultOwnershipManager,
+		kubeletPodsDir:    kubeletPodsDir,
+	}
+}
+
+// Mount bind mounts the socket of every vhostUserBlk volume of the VMI into the sockets directory of the virt-launcher pod
+func (m *mounter) Mount(vmi *v1.VirtualMachineInstance) error {
+	var volumes []v1.Volume
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.VhostUserBlk != nil {
+			volumes = append(volumes, volume)
+		}
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	socketDir, err := m.findSocketDir(vmi)
+	if err != nil {
+		return err
+	}
+
+	record := &vmiMountTargetRecord{}
+	for _, volume := range volumes {
+		target, err := m.createMountTarget(socketDir, vhostuserblk.SocketName(volume.Name))
+		if err != nil {
+			return err
+		}
+		record.MountTargetEntries = append(record.MountTargetEntries, vmiMountTargetEntry{
+			TargetFile: unsafepath.UnsafeAbsolute(target.Raw()),
+		})
+	}
+	if err := m.setMountTargetRecord(vmi, record); err != nil {
+		return err
+	}
+
+	for i, volume := range volumes {
+		target, err := safepath.NewPathNoFollow(record.MountTargetEntries[i].TargetFile)
+		if err != nil {
+			return err
+		}
+		if mounted, err := isMounted(target); err != nil {
+			return fmt.Errorf("failed to check mount point for vhost-user-blk socket %v: %v", target, err)
+		} else if !mounted {
+			source, err := daemonSocketPath(vmi.Namespace, volume.VhostUserBlk.Socket)
+			if err != nil {
+				return fmt.Errorf("failed to find the vhost-user-blk socket %s of volume %s in namespace %s: %v", volume.VhostUserBlk.Socket, volume.Name, vmi.Namespace, err)
+			}
+			if out, err := mountCommand(source, target); err != nil {
+				return fmt.Errorf("failed to bindmount vhost-user-blk socket from %v to %v: %v : %v", source, target, string(out), err)
+			}
+			log.Log.Object(vmi).V(1).Infof("successfully mounted vhost-user-blk socket of volume %s", volume.Name)
+		}
+		if err := m.ownershipManager.SetFileOwnership(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unmount unmounts all the vhost-user-blk sockets which were mounted for the VMI
+func (m *mounter) Unmount(vmi *v1.VirtualMachineInstance) error {
+	if string(vmi.UID) == "" {
+		return nil
+	}
+
+	record := vmiMountTargetRecord{}
+	err := m.checkpointManager.Get(string(vmi.UID), &record)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get checkpoint %s, %w", vmi.UID, err)
+	}
+
+	for _, entry := range record.MountTargetEntries {
+		target, err := safepath.NewPathNoFollow(entry.TargetFile)
+		if errors.Is(err, os.ErrNotExist) {
+			// the pod is already gone
+			continue
+		} else if err != nil {
+			return err
+		}
+		if mounted, err := isMounted(target); err != nil {
+			return fmt.Errorf("failed to check mount point for vhost-user-blk socket %v: %v", target, err)
+		} else if mounted {
+			if out, err := unmountCommand(target); err != nil {
+				return fmt.Errorf("failed to unmount vhost-user-blk socket %v: %v : %v", target, string(out), err)
+			}
+		}
+	}
+
+	if err := m.checkpointManager.Delete(string(vmi.UID)); err != nil {
+		return fmt.Errorf("failed to delete checkpoint %s, %w", vmi.UID, err)
+	}
+	return nil
+}
+
+func (m *mounter) findSocketDir(vmi *v1.VirtualMachineInstance) (*safepath.Path, error) {
+	var found []*safepath.Path
+	for podUID := range vmi.Status.ActivePods {
+		path, err := socketDirOnHost(podUID, m.kubeletPodsDir)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		found = append(found, path)
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("unable to find the vhost-user-blk sockets directory of vmi %s/%s", vmi.Namespace, vmi.Name)
+	case 1:
+		return found[0], nil
+	default:
+		// Don't mount until outdated pod environments are removed
+		return nil, fmt.Errorf("found multiple pods active for vmi %s/%s. Waiting on outdated pod directories to be removed", vmi.Namespace, vmi.Name)
+	}
+}
+
+func (m *mounter) createMountTarget(socketDir *safepath.Path, socketName string) (*safepath.Path, error) {
+	if err := safepath.TouchAtNoFollow(socketDir, socketName, 0600); err != nil && !os.IsExist(err) {
+		return nil, err
+	}
+	return safepath.JoinNoFollow(socketDir, socketName)
+}
+
+func (m *mounter) setMountTargetRecord(vmi *v1.VirtualMachineInstance, record *vmiMountTargetRecord) error {
+	if string(vmi.UID) == "" {
+		return fmt.Errorf("unable to store the vhost-user-blk mount targets of vmi without uid")
+	}
+	if err := m.checkpointManager.Store(string(vmi.UID), record); err != nil {
+		return fmt.Errorf("failed to checkpoint %s, %w", vmi.UID, err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhost_user_blk
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/checkpoint"
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+var _ = Describe("vhost-user-blk mounter", func() {
+	var (
+		tmpDir  string
+		m       *mounter
+		vmi     *v1.VirtualMachineInstance
+		mounted map[string]string
+	)
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "pod", "sockets"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "daemon", "default"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "daemon", "other-namespace"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "state"), 0755)).To(Succeed())
+
+		vmi = api.NewMinimalVMI("fake-vmi")
+		vmi.UID = "1234"
+		vmi.Namespace = "default"
+		vmi.Status.ActivePods = map[types.UID]string{"pod": "node"}
+		vmi.Spec.Volumes = []v1.Volume{
+			{
+				Name: "database",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: "vhost.0"},
+				},
+			},
+		}
+
+		m = &mounter{
+			checkpointManager: checkpoint.NewSimpleCheckpointManager(filepath.Join(tmpDir, "state")),
+			ownershipManager:  diskutils.DefaultOwnershipManager,
+		}
+
+		mounted = map[string]string{}
+		origSocketDirOnHost, origDaemonSocketPath := socketDirOnHost, daemonSocketPath
+		origMountCommand, origUnmountCommand, origIsMounted := mountCommand, unmountCommand, isMounted
+		DeferCleanup(func() {
+			socketDirOnHost, daemonSocketPath = origSocketDirOnHost, origDaemonSocketPath
+			mountCommand, unmountCommand, isMounted = origMountCommand, origUnmountCommand, origIsMounted
+		})
+
+		socketDirOnHost = func(podUID types.UID, _ string) (*safepath.Path, error) {
+			return safepath.JoinAndResolveWithRelativeRoot("/", tmpDir, string(podUID), "sockets")
+		}
+		daemonSocketPath = func(namespace, socket string) (*safepath.Path, error) {
+			return safepath.JoinAndResolveWithRelativeRoot("/", tmpDir, "daemon", namespace, socket)
+		}
+		mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+			mounted[targetPath.String()] = sourcePath.String()
+			return nil, nil
+		}
+		unmountCommand = func(path *safepath.Path) ([]byte, error) {
+			delete(mounted, path.String())
+			return nil, nil
+		}
+		isMounted = func(path *safepath.Path) (bool, error) {
+			_, ok := mounted[path.String()]
+			return ok, nil
+		}
+	})
+
+	It("should do nothing for a VMI without vhostUserBlk volumes", func() {
+		vmi.Spec.Volumes = nil
+		Expect(m.Mount(vmi)).To(Succeed())
+		Expect(mounted).To(BeEmpty())
+		Expect(m.Unmount(vmi)).To(Succeed())
+	})
+
+	It("should fail when the storage daemon does not expose the socket", func() {
+		Expect(m.Mount(vmi)).To(MatchError(ContainSubstring("failed to find the vhost-user-blk socket vhost.0 of volume database in namespace default")))
+	})
+
+	It("should not mount the socket of another namespace", func() {
+		Expect(os.WriteFile(filepath.Join(tmpDir, "daemon", "other-namespace", "vhost.0"), nil, 0600)).To(Succeed())
+
+		Expect(m.Mount(vmi)).To(MatchError(ContainSubstring("failed to find the vhost-user-blk socket vhost.0 of volume database in namespace default")))
+		Expect(mounted).To(BeEmpty())
+	})
+
+	It("should bind mount the socket into the pod and unmount it again", func() {
+		Expect(os.WriteFile(filepath.Join(tmpDir, "daemon", "default", "vhost.0"), nil, 0600)).To(Succeed())
+
+		Expect(m.Mount(vmi)).To(Succeed())
+		Expect(mounted).To(HaveLen(1))
+		for target, source := range mounted {
+			Expect(target).To(HaveSuffix(filepath.Join("pod", "sockets", "database.sock")))
+			Expect(source).To(HaveSuffix(filepath.Join("daemon", "default", "vhost.0")))
+		}
+		Expect(filepath.Join(tmpDir, "pod", "sockets", "database.sock")).To(BeAnExistingFile())
+
+		// mounting again is a no-op
+		Expect(m.Mount(vmi)).To(Succeed())
+		Expect(mounted).To(HaveLen(1))
+
+		Expect(m.Unmount(vmi)).To(Succeed())
+		Expect(mounted).To(BeEmpty())
+		record := vmiMountTargetRecord{}
+		Expect(m.checkpointManager.Get(string(vmi.UID), &record)).To(MatchError(os.ErrNotExist))
+	})
+})
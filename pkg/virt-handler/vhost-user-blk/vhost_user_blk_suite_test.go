@@ -0,0 +1,14 @@
+package vhost_user_blk_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+)
+
+func TestVhostUserBlk(t *testing.T) {
+	ephemeraldiskutils.MockDefaultOwnershipManager()
+	testutils.KubeVirtTestSuiteSetup(t)
+}
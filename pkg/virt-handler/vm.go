@@ -91,6 +91,7 @@ import (
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	multipath_monitor "kubevirt.io/kubevirt/pkg/virt-handler/multipath-monitor"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
+	vhost_user_blk "kubevirt.io/kubevirt/pkg/virt-handler/vhost-user-blk"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
@@ -196,6 +197,11 @@ func NewController(
 		return nil, err
 	}
 
+	vhostUserBlkState := filepath.Join(virtPrivateDir, "vhost-user-blk-mount-state")
+	if err := os.MkdirAll(vhostUserBlkState, 0700); err != nil {
+		return nil, err
+	}
+
 	c := &VirtualMachineController{
 		queue:                            queue,
 		recorder:                         recorder,
@@ -211,6 +217,7 @@ func NewController(
 		podIsolationDetector:             podIsolationDetector,
 		containerDiskMounter:             container_disk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
 		hotplugVolumeMounter:             hotplug_volume.NewVolumeMounter(hotplugState, kubeletPodsDir, host),
+		vhostUserBlkMounter:              vhost_user_blk.NewMounter(vhostUserBlkState, kubeletPodsDir),
 		clusterConfig:                    clusterConfig,
 		virtLauncherFSRunDirPattern:      "/proc/%d/root/var/run",
 		capabilities:                     capabilities,
@@ -307,6 +314,7 @@ type VirtualMachineController struct {
 	podIsolationDetector     isolation.PodIsolationDetector
 	containerDiskMounter     container_disk.Mounter
 	hotplugVolumeMounter     hotplug_volume.VolumeMounter
+	vhostUserBlkMounter      vhost_user_blk.Mounter
 	clusterConfig            *virtconfig.ClusterConfig
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
 	downwardMetricsManager   downwardMetricsManager
@@ -603,6 +611,36 @@ func (c *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 	vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	if convergence := migrationMetadata.Convergence; convergence != nil {
+		vmi.Status.MigrationState.Convergence = &v1.MigrationConvergence{
+			DirtyRateBytesPerSecond:      convergence.DirtyRate,
+			MeasurementTimestamp:         convergence.MeasurementTimestamp,
+			TransferRateBytesPerSecond:   convergence.TransferRate,
+			Converging:                   convergence.Converging,
+			PredictedCompletionTimestamp: convergence.PredictedCompletionTimestamp,
+		}
+	}
+	vmi.Status.MigrationState.DataTransferredBytes = int64(migrationMetadata.DataProcessed)
+	if migrationMetadata.Downtime > 0 {
+		vmi.Status.MigrationState.Downtime = &metav1.Duration{Duration: time.Duration(migrationMetadata.Downtime) * time.Millisecond}
+	}
+	if migrationMetadata.SetupTime > 0 {
+		vmi.Status.MigrationState.SetupTime = &metav1.Duration{Duration: time.Duration(migrationMetadata.SetupTime) * time.Millisecond}
+	}
+	if localVolumes := migrationMetadata.LocalVolumes; localVolumes != nil {
+		vmi.Status.MigrationState.LocalVolumes = &v1.MigrationLocalVolumesState{
+			TransferredBytes:        int64(localVolumes.Transferred),
+			TotalBytes:              int64(localVolumes.Total),
+			BandwidthBytesPerSecond: int64(localVolumes.Bandwidth),
+		}
+		for _, volume := range localVolumes.Volumes {
+			vmi.Status.MigrationState.LocalVolumes.Volumes = append(vmi.Status.MigrationState.LocalVolumes.Volumes, v1.MigrationLocalVolumeState{
+				VolumeName:       volume.Name,
+				TransferredBytes: int64(volume.Transferred),
+				TotalBytes:       int64(volume.Total),
+			})
+		}
+	}
 }
 
 func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -644,7 +682,14 @@ func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.Vi
 	// way of transferring ownership. The only option here is to move the
 	// vmi to failed.  The cluster vmi controller will then tear down the
 	// resulting pods.
-	if migrationHost == "" {
+	if migrationHost == "" && crossClusterMigrationID(vmi) != "" {
+		// the guest was sent to another cluster, the VMI in this cluster is done.
+		vmi.Status.Phase = v1.Succeeded
+		vmi.Status.MigrationState.Completed = true
+
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), "The VirtualMachineInstance migrated to another cluster.")
+		log.Log.Object(vmi).Info("migration completed to another cluster")
+	} else if migrationHost == "" {
 		// migrated to unknown host.
 		vmi.Status.Phase = v1.Failed
 		vmi.Status.MigrationState.Completed = true
@@ -733,6 +778,11 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 			c.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "Failed to update target node qemu memory limits during live migration")
 		}
 
+		// there is no source node in this cluster to report the start of a received migration
+		if isReceivingCrossClusterMigration(vmi) && vmiCopy.Status.MigrationState.StartTimestamp == nil {
+			now := metav1.Now()
+			vmiCopy.Status.MigrationState.StartTimestamp = &now
+		}
 	}
 
 	if domainExists &&
@@ -747,6 +797,10 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		now := metav1.Now()
 		vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp = &now
 		c.finalizeMigration(vmiCopy)
+
+		if isReceivingCrossClusterMigration(vmi) {
+			c.finalizeCrossClusterMigrationReceive(vmiCopy)
+		}
 	}
 
 	if !migrations.IsMigrating(vmi) {
@@ -987,6 +1041,10 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 				volumeStatus, tmpNeedsRefresh = c.updateMemoryDumpInfo(vmi, volumeStatus, domain)
 				needsRefresh = needsRefresh || tmpNeedsRefresh
 			}
+			if volumeStatus.BackupVolume != nil {
+				volumeStatus, tmpNeedsRefresh = c.updateBackupInfo(vmi, volumeStatus, domain)
+				needsRefresh = needsRefresh || tmpNeedsRefresh
+			}
 			newStatuses = append(newStatuses, volumeStatus)
 			newStatusMap[volumeStatus.Name] = volumeStatus
 		}
@@ -1214,6 +1272,49 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 			volumeStatus.Message = fmt.Sprintf("Memory dump to Volume %s has completed successfully", volumeStatus.Name)
 			volumeStatus.Reason = VolumeReadyReason
 			volumeStatus.MemoryDumpVolume.EndTimestamp = memoryDumpMetadata.EndTimestamp
+			volumeStatus.MemoryDumpVolume.Checksum = memoryDumpMetadata.Checksum
+		}
+	}
+
+	return volumeStatus, needsRefresh
+}
+
+func (c *VirtualMachineController) updateBackupInfo(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, domain *api.Domain) (v1.VolumeStatus, bool) {
+	needsRefresh := false
+	switch volumeStatus.Phase {
+	case v1.HotplugVolumeMounted:
+		needsRefresh = true
+		log.Log.Object(vmi).V(3).Infof("Backup volume %s attached, marking it in progress", volumeStatus.Name)
+		volumeStatus.Phase = v1.BackupVolumeInProgress
+		volumeStatus.Message = fmt.Sprintf("Backup Volume %s is attached, backing up the volumes", volumeStatus.Name)
+		volumeStatus.Reason = VolumeMountedToPodReason
+		volumeStatus.BackupVolume.TargetDirectory = volumeStatus.BackupVolume.Checkpoint
+	case v1.BackupVolumeInProgress:
+		backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+		if backupMetadata == nil || backupMetadata.Checkpoint != volumeStatus.BackupVolume.Checkpoint {
+			// backup wasnt triggered yet
+			return volumeStatus, needsRefresh
+		}
+		needsRefresh = true
+		if backupMetadata.StartTimestamp != nil {
+			volumeStatus.BackupVolume.StartTimestamp = backupMetadata.StartTimestamp
+		}
+		volumeStatus.BackupVolume.Incremental = backupMetadata.Incremental
+		volumeStatus.BackupVolume.UntrackedVolumes = nil
+		if backupMetadata.UntrackedVolumes != "" {
+			volumeStatus.BackupVolume.UntrackedVolumes = strings.Split(backupMetadata.UntrackedVolumes, ",")
+		}
+		if backupMetadata.EndTimestamp != nil && backupMetadata.Failed {
+			log.Log.Object(vmi).Errorf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Message = fmt.Sprintf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Phase = v1.BackupVolumeFailed
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
+		} else if backupMetadata.Completed {
+			log.Log.Object(vmi).V(3).Infof("Marking backup to volume %s has completed", volumeStatus.Name)
+			volumeStatus.Phase = v1.BackupVolumeCompleted
+			volumeStatus.Message = fmt.Sprintf("Backup to Volume %s has completed successfully", volumeStatus.Name)
+			volumeStatus.Reason = VolumeReadyReason
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
 		}
 	}
 
@@ -1784,7 +1885,7 @@ func (c *VirtualMachineController) migrationTargetExecute(vmi *v1.VirtualMachine
 	// set true when the current migration target has exitted and needs to be cleaned up.
 	shouldCleanUp := false
 
-	if vmiExists && vmi.IsRunning() {
+	if vmiExists && (vmi.IsRunning() || isReceivingCrossClusterMigration(vmi)) {
 		shouldUpdate = true
 	}
 
@@ -2152,6 +2253,10 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.vhostUserBlkMounter.Unmount(vmi); err != nil {
+		return err
+	}
+
 	// UnmountAll does the cleanup on the "best effort" basis: it is
 	// safe to pass a nil cgroupManager.
 	cgroupManager, _ := getCgroupManager(vmi, c.host)
@@ -2503,6 +2608,8 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			if !shared {
 				return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
 			}
+		} else if volSrc.VhostUserBlk != nil {
+			return true, fmt.Errorf("cannot migrate VMI with vhostUserBlk volume %s, the disk is local to the node", volume.Name)
 		} else {
 			if _, ok := filesystems[volume.Name]; ok {
 				log.Log.Object(vmi).Infof("Volume %s is shared with virtiofs, allow live migration", volume.Name)
@@ -2563,6 +2670,7 @@ func (c *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 	// pass in the virt-launcher's baseDir to reach the unix sockets.
 	baseDir := fmt.Sprintf(filepath.Join(c.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	migrationTargetSockets = append(migrationTargetSockets, socketFile)
+	crossClusterTargetSockets := map[int]string{0: socketFile}
 
 	migrationPortsRange := migrationproxy.GetMigrationPortsList(vmi.IsBlockMigration())
 	for _, port := range migrationPortsRange {
@@ -2570,14 +2678,54 @@ func (c *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 		// a proxy between the target direct qemu channel and the connector in the destination pod
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
+		crossClusterTargetSockets[port] = destSocketFile
 	}
 	err = c.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
 	if err != nil {
 		return err
 	}
+
+	// a cross-cluster migration reaches the target pod through the cross-cluster migration endpoint
+	if migrationID := crossClusterMigrationID(vmi); migrationID != "" {
+		return c.migrationProxy.StartCrossClusterTargetListener(string(vmi.UID), migrationID, crossClusterTargetSockets)
+	}
 	return nil
 }
 
+func crossClusterMigrationID(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Status.MigrationState == nil {
+		return ""
+	}
+	return vmi.Status.MigrationState.CrossClusterMigrationID
+}
+
+// isReceivingCrossClusterMigration reports whether the VMI was never started in this cluster
+// and waits for its guest to arrive through a cross-cluster migration.
+func isReceivingCrossClusterMigration(vmi *v1.VirtualMachineInstance) bool {
+	return migrations.IsCrossClusterMigrationReceiver(vmi) && crossClusterMigrationID(vmi) != ""
+}
+
+// finalizeCrossClusterMigrationReceive hands the received VMI over to this node. The source node is in
+// the other cluster, so the target takes the part of the source node in the migration ACK.
+func (c *VirtualMachineController) finalizeCrossClusterMigrationReceive(vmi *v1.VirtualMachineInstance) {
+	now := metav1.Now()
+	if vmi.Status.MigrationState.StartTimestamp == nil {
+		vmi.Status.MigrationState.StartTimestamp = &now
+	}
+	vmi.Status.MigrationState.EndTimestamp = &now
+	vmi.Status.MigrationState.Completed = true
+	if vmi.Labels == nil {
+		vmi.Labels = map[string]string{}
+	}
+	vmi.Labels[v1.NodeNameLabel] = c.host
+	vmi.Status.NodeName = c.host
+	vmi.Status.Phase = v1.Running
+	vmi.Status.MigrationTransport = v1.MigrationTransportUnix
+
+	c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), fmt.Sprintf("The VirtualMachineInstance was received from another cluster on node %s.", c.host))
+	log.Log.Object(vmi).Infof("cross-cluster migration received on node %s", c.host)
+}
+
 func (c *VirtualMachineController) handlePostMigrationProxyCleanup(vmi *v1.VirtualMachineInstance) {
 	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.Completed || vmi.Status.MigrationState.Failed {
 		c.migrationProxy.StopTargetListener(string(vmi.UID))
@@ -2595,6 +2743,11 @@ func (c *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 	// pass in the virt-launcher's baseDir to reach the unix sockets.
 	baseDir := fmt.Sprintf(filepath.Join(c.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	c.migrationProxy.StopTargetListener(string(vmi.UID))
+	if migrationID := crossClusterMigrationID(vmi); migrationID != "" {
+		// the target node address is the connect URL of the cross-cluster migration endpoint of the target node
+		ports := append([]int{0}, migrationproxy.GetMigrationPortsList(vmi.IsBlockMigration())...)
+		return c.migrationProxy.StartCrossClusterSourceListener(string(vmi.UID), migrationID, vmi.Status.MigrationState.TargetNodeAddress, ports, baseDir)
+	}
 	if vmi.Status.MigrationState.TargetDirectMigrationNodePorts == nil {
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
@@ -2674,6 +2827,10 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 			AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
+			Compression:             migrationConfiguration.Compression,
+			MaxDowntime:             migrationConfiguration.MaxDowntime,
+			SwitchoverPolicy:        migrationConfiguration.SwitchoverPolicy,
+			LocalStorage:            migrationConfiguration.LocalStorage,
 		}
 
 		configureParallelMigrationThreads(options, origVMI)
@@ -3015,6 +3172,10 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.backupVolumes(vmi); err != nil {
+		return err
+	}
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -3058,6 +3219,10 @@ func (c *VirtualMachineController) handleStartingVMI(
 		return false, err
 	}
 
+	if err := c.vhostUserBlkMounter.Mount(vmi); err != nil {
+		return false, err
+	}
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return false, fmt.Errorf(failedDetectIsolationFmt, err)
@@ -3311,6 +3476,41 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+func backupOptions(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus) *cmdclient.BackupOptions {
+	options := &cmdclient.BackupOptions{
+		TargetDir:  filepath.Join(hotplugdisk.GetVolumeMountDir(volumeStatus.Name), volumeStatus.BackupVolume.TargetDirectory),
+		Checkpoint: volumeStatus.BackupVolume.Checkpoint,
+	}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeStatus.Name && volume.Backup != nil {
+			options.BaseCheckpoint = volume.Backup.BaseCheckpoint
+		}
+	}
+	return options
+}
+
+func (c *VirtualMachineController) backupVolumes(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to back up volumes"
+
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.BackupVolume == nil || volumeStatus.Phase != v1.BackupVolumeInProgress {
+			continue
+		}
+		client, err := c.getVerifiedLauncherClient(vmi)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+
+		log.Log.V(3).Object(vmi).Info("sending backup command")
+		err = client.VirtualMachineBackup(vmi, backupOptions(vmi, volumeStatus))
+		if err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+	}
+
+	return nil
+}
+
 func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	isUnresponsive, isInitialized, err := c.isLauncherClientUnresponsive(vmi)
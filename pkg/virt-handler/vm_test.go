@@ -55,6 +55,7 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
@@ -179,7 +180,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		mockHotplugVolumeMounter = hotplugvolume.NewMockVolumeMounter(ctrl)
 		mockCgroupManager = cgroup.NewMockManager(ctrl)
 
-		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 		fakeDownwardMetricsManager := newFakeManager()
 
 		networkBindingPluginMemoryCalculator = &stubNetBindingPluginMemoryCalculator{}
@@ -1548,6 +1549,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 					StartTimestamp: &now,
 					EndTimestamp:   &now,
 					Completed:      true,
+					Checksum:       "sha256:8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4",
 				}
 				domain.Status.Status = api.Running
 				addVMI(vmi)
@@ -1560,6 +1562,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.MemoryDumpVolumeCompleted))
 				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.StartTimestamp).ToNot(BeNil())
 				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.EndTimestamp).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.Checksum).To(Equal("sha256:8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4"))
 				testutils.ExpectEvent(recorder, "Memory dump to Volume test has completed successfully")
 				By("Calling it again with updated status, no new events are generated as long as memory dump not completed")
 				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
@@ -1617,6 +1620,164 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		})
 
+		Context("backup status events", func() {
+			newBackupVMI := func(phase v1.VolumePhase, targetDirectory string) *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "test",
+				})
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:    "test",
+					Phase:   phase,
+					Reason:  "reason",
+					Message: "message",
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+					BackupVolume: &v1.DomainBackupInfo{
+						ClaimName:       "test",
+						Checkpoint:      "backup-1",
+						TargetDirectory: targetDirectory,
+					},
+				})
+				return vmi
+			}
+
+			It("Should trigger backup and generate InProgress event once mounted", func() {
+				vmi := newBackupVMI(v1.HotplugVolumeMounted, "")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.TargetDirectory).To(Equal("backup-1"))
+				testutils.ExpectEvent(recorder, "Backup Volume test is attached, backing up the volumes")
+				By("Calling it again with updated status, no new events are generated as long as backup not completed")
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+			})
+
+			It("Should ignore the backup metadata of another checkpoint", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress, "backup-1")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Checkpoint:     "backup-0",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+				}
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).To(BeNil())
+			})
+
+			It("Should generate backup completed event once backup completed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress, "backup-1")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Checkpoint:     "backup-1",
+					Incremental:    true,
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+				}
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeCompleted))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.Incremental).To(BeTrue())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.StartTimestamp).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).ToNot(BeNil())
+				testutils.ExpectEvent(recorder, "Backup to Volume test has completed successfully")
+			})
+
+			It("Should report the volumes which cannot track their changed blocks", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress, "backup-1")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Checkpoint:       "backup-1",
+					StartTimestamp:   &now,
+					EndTimestamp:     &now,
+					Completed:        true,
+					UntrackedVolumes: "rootdisk,datadisk",
+				}
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeCompleted))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.UntrackedVolumes).To(Equal([]string{"rootdisk", "datadisk"}))
+				testutils.ExpectEvent(recorder, "Backup to Volume test has completed successfully")
+			})
+
+			It("Should generate backup failed event if backup failed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress, "backup-1")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				now := metav1.Now()
+				failureReason := "backup failed"
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					Checkpoint:     "backup-1",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+					Failed:         true,
+					FailureReason:  failureReason,
+				}
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeFailed))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).ToNot(BeNil())
+				testutils.ExpectEvent(recorder, fmt.Sprintf("Backup to pvc %s failed: %s", "test", failureReason))
+			})
+
+			It("Should send the backup command to the launcher", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress, "backup-1")
+				vmi.Spec.Volumes[0].Backup = &v1.BackupVolumeSource{
+					Checkpoint:     "backup-1",
+					BaseCheckpoint: "backup-0",
+				}
+
+				client.EXPECT().Ping()
+				client.EXPECT().VirtualMachineBackup(vmi, &cmdclient.BackupOptions{
+					TargetDir:      filepath.Join(hotplugdisk.GetVolumeMountDir("test"), "backup-1"),
+					Checkpoint:     "backup-1",
+					BaseCheckpoint: "backup-0",
+				}).Return(nil)
+				Expect(controller.backupVolumes(vmi)).To(Succeed())
+			})
+		})
+
 		DescribeTable("should leave the VirtualMachineInstance alone if it is in the final phase", func(phase v1.VirtualMachineInstancePhase) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Status.Phase = phase
@@ -1847,6 +2008,52 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, VMIMigrating)
 		})
 
+		It("should pass the compression, max downtime and switchover policy of the migration configuration to the migration", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: "othernode"}
+			vmi.Status.NodeName = host
+			migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration()
+			migrationConfiguration.Compression = &v1.MigrationCompression{
+				Mode:            v1.MigrationCompressionXBZRLE,
+				XBZRLECacheSize: pointer.P(resource.MustParse("256Mi")),
+			}
+			migrationConfiguration.MaxDowntime = &metav1.Duration{Duration: 500 * time.Millisecond}
+			migrationConfiguration.SwitchoverPolicy = &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+				{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: time.Minute}},
+			}}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				MigrationConfiguration:         migrationConfiguration,
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			addDomain(domain)
+			addVMI(vmi)
+			client.EXPECT().MigrateVirtualMachine(vmi, gomock.Any()).DoAndReturn(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) error {
+				Expect(options.Compression).To(Equal(migrationConfiguration.Compression))
+				Expect(options.MaxDowntime).To(Equal(migrationConfiguration.MaxDowntime))
+				Expect(options.SwitchoverPolicy).To(Equal(migrationConfiguration.SwitchoverPolicy))
+				return nil
+			})
+			sanityExecute()
+			testutils.ExpectEvent(recorder, VMIMigrating)
+		})
+
 		It("should not try to migrate a vmi twice", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1884,6 +2091,81 @@ var _ = Describe("VirtualMachineInstance", func() {
 			sanityExecute()
 		})
 
+		It("should report the migration convergence and statistics measured by the source", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = host
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = "othernode"
+			vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
+			now := metav1.Time{Time: time.Unix(time.Now().UTC().Unix(), 0)}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				StartTimestamp:                 &now,
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				StartTimestamp: &now,
+				UID:            "123",
+				Convergence: &api.MigrationConvergenceMetadata{
+					DirtyRate:                    100,
+					MeasurementTimestamp:         &now,
+					TransferRate:                 200,
+					Converging:                   pointer.P(true),
+					PredictedCompletionTimestamp: &now,
+				},
+				DataProcessed: 4096,
+				Downtime:      120,
+				SetupTime:     15,
+				LocalVolumes: &api.MigrationLocalVolumesMetadata{
+					Transferred: 1024, Total: 2048, Bandwidth: 512,
+					Volumes: []api.MigrationLocalVolumeMetadata{
+						{Name: "disk0", Transferred: 1024, Total: 1024},
+						{Name: "disk1", Transferred: 0, Total: 1024},
+					},
+				},
+			}
+			addDomain(domain)
+			addVMI(vmi)
+			createVMI(vmi)
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.Convergence).To(Equal(&v1.MigrationConvergence{
+				DirtyRateBytesPerSecond:      100,
+				MeasurementTimestamp:         &now,
+				TransferRateBytesPerSecond:   200,
+				Converging:                   pointer.P(true),
+				PredictedCompletionTimestamp: &now,
+			}))
+			Expect(updatedVMI.Status.MigrationState.DataTransferredBytes).To(Equal(int64(4096)))
+			Expect(updatedVMI.Status.MigrationState.Downtime).To(Equal(&metav1.Duration{Duration: 120 * time.Millisecond}))
+			Expect(updatedVMI.Status.MigrationState.SetupTime).To(Equal(&metav1.Duration{Duration: 15 * time.Millisecond}))
+			Expect(updatedVMI.Status.MigrationState.LocalVolumes).To(Equal(&v1.MigrationLocalVolumesState{
+				TransferredBytes: 1024, TotalBytes: 2048, BandwidthBytesPerSecond: 512,
+				Volumes: []v1.MigrationLocalVolumeState{
+					{VolumeName: "disk0", TransferredBytes: 1024, TotalBytes: 1024},
+					{VolumeName: "disk1", TransferredBytes: 0, TotalBytes: 1024},
+				},
+			}))
+		})
+
 		It("should abort vmi migration vmi when migration object indicates deletion", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1972,6 +2254,127 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Labels).To(HaveKeyWithValue(v1.NodeNameLabel, "othernode"))
 		})
 
+		It("should finalize a VMI sent to another cluster after completed migration", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = map[string]string{v1.NodeNameLabel: host}
+			vmi.Status.NodeName = host
+			now := metav1.Time{Time: time.Unix(time.Now().UTC().Unix(), 0)}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNodeAddress:       "10.0.0.1:49154",
+				SourceNode:              host,
+				MigrationUID:            "123",
+				CrossClusterMigrationID: "migration-1",
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutoff
+			domain.Status.Reason = api.ReasonMigrated
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				UID:            "123",
+				StartTimestamp: &now,
+				EndTimestamp:   &now,
+				Completed:      true,
+			}
+
+			addDomain(domain)
+			addVMI(vmi)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, "The VirtualMachineInstance migrated to another cluster")
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Succeeded))
+			Expect(updatedVMI.Status.MigrationState.Completed).To(BeTrue())
+			Expect(updatedVMI.Status.MigrationState.Failed).To(BeFalse())
+			Expect(updatedVMI.Status.NodeName).To(Equal(host))
+		})
+
+		It("should take over a VMI received from another cluster after completed migration", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Pending
+			vmi.Annotations = map[string]string{v1.CrossClusterMigrationReceiverAnnotation: ""}
+			vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: host}
+			pastTime := metav1.NewTime(metav1.Now().Add(time.Duration(-10) * time.Second))
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:               host,
+				TargetNodeAddress:        "127.0.0.1:12345",
+				MigrationUID:             "123",
+				CrossClusterMigrationID:  "migration-1",
+				TargetNodeDomainDetected: true,
+				StartTimestamp:           &pastTime,
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				UID:            "123",
+				StartTimestamp: &pastTime,
+			}
+
+			addDomain(domain)
+			addVMI(vmi)
+			createVMI(vmi)
+
+			client.EXPECT().Ping().AnyTimes()
+			client.EXPECT().FinalizeVirtualMachineMigration(gomock.Any(), gomock.Any())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, "The VirtualMachineInstance was received from another cluster")
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+			Expect(updatedVMI.Status.NodeName).To(Equal(host))
+			Expect(updatedVMI.Labels).To(HaveKeyWithValue(v1.NodeNameLabel, host))
+			Expect(updatedVMI.Status.MigrationTransport).To(Equal(v1.MigrationTransportUnix))
+			Expect(updatedVMI.Status.MigrationState.TargetNodeDomainReadyTimestamp).ToNot(BeNil())
+			Expect(updatedVMI.Status.MigrationState.EndTimestamp).ToNot(BeNil())
+			Expect(updatedVMI.Status.MigrationState.Completed).To(BeTrue())
+		})
+
+		It("should report the start of a migration received from another cluster", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Pending
+			vmi.Annotations = map[string]string{v1.CrossClusterMigrationReceiverAnnotation: ""}
+			vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: host}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:              host,
+				MigrationUID:            "123",
+				CrossClusterMigrationID: "migration-1",
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Paused
+			domain.Status.Reason = api.ReasonPausedMigration
+
+			addDomain(domain)
+			addVMI(vmi)
+			createVMI(vmi)
+
+			client.EXPECT().Ping().AnyTimes()
+			client.EXPECT().SyncMigrationTarget(gomock.Any(), gomock.Any())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, "Migration Target Prepared")
+			testutils.ExpectEvent(recorder, "Migration Target is listening at")
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Pending))
+			Expect(updatedVMI.Status.MigrationState.TargetNodeAddress).ToNot(BeEmpty())
+			Expect(updatedVMI.Status.MigrationState.TargetNodeDomainDetected).To(BeTrue())
+			Expect(updatedVMI.Status.MigrationState.StartTimestamp).ToNot(BeNil())
+		})
+
 		It("should apply post-migration operations on guest VM after migration completed", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2604,6 +3007,31 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		It("should not be allowed to live-migrate a VMI with a vhostUserBlk volume", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{
+					Name: "mydisk",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{
+							Bus: v1.DiskBusVirtio,
+						},
+					},
+				},
+			}
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "mydisk",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: "vhost.0"},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError("cannot migrate VMI with vhostUserBlk volume mydisk, the disk is local to the node"))
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
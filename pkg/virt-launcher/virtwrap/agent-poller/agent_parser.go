@@ -95,6 +95,13 @@ type Filesystem struct {
 	Disk       []FSDisk `json:"disk,omitempty"`
 }
 
+// Load averages of the guest
+type Load struct {
+	Load1  float64 `json:"load1"`
+	Load5  float64 `json:"load5"`
+	Load15 float64 `json:"load15"`
+}
+
 // AgentInfo from the guest VM serves the purpose
 // of checking the GA presence and version compatibility
 type AgentInfo struct {
@@ -245,6 +252,19 @@ func parseUsers(agentReply string) ([]api.User, error) {
 	return convertedResult, nil
 }
 
+// parseLoad from the agent response
+func parseLoad(agentReply string) (Load, error) {
+	result := Load{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return Load{}, err
+	}
+
+	return result, nil
+}
+
 // parseAgent gets the agent version from response
 func parseAgent(agentReply string) (AgentInfo, error) {
 	gaInfo := AgentInfo{}
@@ -259,6 +259,24 @@ var _ = Describe("Qemu agent poller", func() {
 			Expect(parseTimezone(jsonInput)).To(Equal(expectedTimezone))
 		})
 
+		It("should parse Load", func() {
+
+			jsonInput := `{
+                "return":{
+                    "load1":0.5,
+                    "load5":1.25,
+                    "load15":2
+                }
+            }`
+
+			expectedLoad := Load{
+				Load1:  0.5,
+				Load5:  1.25,
+				Load15: 2,
+			}
+			Expect(parseLoad(jsonInput)).To(Equal(expectedLoad))
+		})
+
 		It("should parse Filesystem", func() {
 
 			jsonInput := `{
@@ -47,6 +47,7 @@ const (
 	GET_FILESYSTEM      AgentCommand = "guest-get-fsinfo"
 	GET_AGENT           AgentCommand = "guest-info"
 	GET_FSFREEZE_STATUS AgentCommand = "guest-fsfreeze-status"
+	GET_LOAD            AgentCommand = "guest-get-load"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -81,7 +82,9 @@ func (s *AsyncAgentStore) Store(key AgentCommand, value interface{}) {
 
 	s.store.Store(key, value)
 
-	if updated {
+	// the guest load changes on every poll and is only read on demand,
+	// there is no need to propagate it to the domain
+	if updated && key != GET_LOAD {
 		domainInfo := api.DomainGuestInfo{}
 		switch key {
 		case GET_OSINFO, GET_INTERFACES, GET_FSFREEZE_STATUS:
@@ -171,6 +174,17 @@ func (s *AsyncAgentStore) GetFSFreezeStatus() *api.FSFreeze {
 	return &fsfreezeStatus
 }
 
+// GetLoad returns the Guest load averages
+func (s *AsyncAgentStore) GetLoad() *Load {
+	data, ok := s.store.Load(GET_LOAD)
+	if !ok {
+		return nil
+	}
+
+	load := data.(Load)
+	return &load
+}
+
 // GetFS returns the filesystem list limited to the limit set
 // set limit to -1 to return the whole list
 func (s *AsyncAgentStore) GetFS(limit int) []api.Filesystem {
@@ -277,6 +291,7 @@ func CreatePoller(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentLoadInterval time.Duration,
 ) *AgentPoller {
 	p := &AgentPoller{
 		Connection: connecton,
@@ -311,6 +326,11 @@ func CreatePoller(
 		CallTick:      qemuAgentFSFreezeStatusInterval,
 		AgentCommands: []AgentCommand{GET_FSFREEZE_STATUS},
 	})
+	// load command group
+	p.workers = append(p.workers, PollerWorker{
+		CallTick:      qemuAgentLoadInterval,
+		AgentCommands: []AgentCommand{GET_LOAD},
+	})
 
 	return p
 }
@@ -407,6 +427,13 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 				continue
 			}
 			agentStore.Store(GET_AGENT, agent)
+		case GET_LOAD:
+			load, err := parseLoad(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent load %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_LOAD, load)
 		}
 	}
 }
@@ -180,6 +180,111 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDisk) DeepCopyInto(out *BackupDisk) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(BackupDiskTarget)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(BackupDiskDriver)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDisk.
+func (in *BackupDisk) DeepCopy() *BackupDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDiskDriver) DeepCopyInto(out *BackupDiskDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDiskDriver.
+func (in *BackupDiskDriver) DeepCopy() *BackupDiskDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDiskDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDiskTarget) DeepCopyInto(out *BackupDiskTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDiskTarget.
+func (in *BackupDiskTarget) DeepCopy() *BackupDiskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDiskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDisks) DeepCopyInto(out *BackupDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]BackupDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDisks.
+func (in *BackupDisks) DeepCopy() *BackupDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupMetadata) DeepCopyInto(out *BackupMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupMetadata.
+func (in *BackupMetadata) DeepCopy() *BackupMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
@@ -481,6 +586,43 @@ func (in *ChannelTarget) DeepCopy() *ChannelTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckpointDisk) DeepCopyInto(out *CheckpointDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckpointDisk.
+func (in *CheckpointDisk) DeepCopy() *CheckpointDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckpointDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckpointDisks) DeepCopyInto(out *CheckpointDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]CheckpointDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckpointDisks.
+func (in *CheckpointDisks) DeepCopy() *CheckpointDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckpointDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Clock) DeepCopyInto(out *Clock) {
 	*out = *in
@@ -871,6 +1013,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -973,6 +1120,22 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1007,6 +1170,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = make([]Slice, len(*in))
 		copy(*out, *in)
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(DiskSourceReconnect)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1036,6 +1204,27 @@ func (in *DiskSourceHost) DeepCopy() *DiskSourceHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSourceReconnect) DeepCopyInto(out *DiskSourceReconnect) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSourceReconnect.
+func (in *DiskSourceReconnect) DeepCopy() *DiskSourceReconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSourceReconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1080,6 +1269,50 @@ func (in *Domain) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackup) DeepCopyInto(out *DomainBackup) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = new(BackupDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackup.
+func (in *DomainBackup) DeepCopy() *DomainBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpoint) DeepCopyInto(out *DomainCheckpoint) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = new(CheckpointDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpoint.
+func (in *DomainCheckpoint) DeepCopy() *DomainCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 	*out = *in
@@ -2238,6 +2471,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2629,6 +2867,72 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationConvergenceMetadata) DeepCopyInto(out *MigrationConvergenceMetadata) {
+	*out = *in
+	if in.MeasurementTimestamp != nil {
+		in, out := &in.MeasurementTimestamp, &out.MeasurementTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Converging != nil {
+		in, out := &in.Converging, &out.Converging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PredictedCompletionTimestamp != nil {
+		in, out := &in.PredictedCompletionTimestamp, &out.PredictedCompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationConvergenceMetadata.
+func (in *MigrationConvergenceMetadata) DeepCopy() *MigrationConvergenceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationConvergenceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationLocalVolumeMetadata) DeepCopyInto(out *MigrationLocalVolumeMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationLocalVolumeMetadata.
+func (in *MigrationLocalVolumeMetadata) DeepCopy() *MigrationLocalVolumeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationLocalVolumeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationLocalVolumesMetadata) DeepCopyInto(out *MigrationLocalVolumesMetadata) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]MigrationLocalVolumeMetadata, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationLocalVolumesMetadata.
+func (in *MigrationLocalVolumesMetadata) DeepCopy() *MigrationLocalVolumesMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationLocalVolumesMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationMetadata) DeepCopyInto(out *MigrationMetadata) {
 	*out = *in
@@ -2640,6 +2944,20 @@ func (in *MigrationMetadata) DeepCopyInto(out *MigrationMetadata) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(MigrationConvergenceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DirtyRateCalcStartTimestamp != nil {
+		in, out := &in.DirtyRateCalcStartTimestamp, &out.DirtyRateCalcStartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LocalVolumes != nil {
+		in, out := &in.LocalVolumes, &out.LocalVolumes
+		*out = new(MigrationLocalVolumesMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -390,6 +390,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -404,17 +405,60 @@ type MemoryDumpMetadata struct {
 	Completed      bool         `xml:"completed,omitempty"`
 	Failed         bool         `xml:"failed,omitempty"`
 	FailureReason  string       `xml:"failureReason,omitempty"`
+	Checksum       string       `xml:"checksum,omitempty"`
+}
+
+type BackupMetadata struct {
+	Checkpoint     string       `xml:"checkpoint,omitempty"`
+	Incremental    bool         `xml:"incremental,omitempty"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	FailureReason  string       `xml:"failureReason,omitempty"`
+	// UntrackedVolumes is comma separated, the metadata has to stay comparable
+	UntrackedVolumes string `xml:"untrackedVolumes,omitempty"`
 }
 
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Completed      bool             `xml:"completed,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID                     `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time                  `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time                  `xml:"endTimestamp,omitempty"`
+	Completed      bool                          `xml:"completed,omitempty"`
+	Failed         bool                          `xml:"failed,omitempty"`
+	FailureReason  string                        `xml:"failureReason,omitempty"`
+	AbortStatus    string                        `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode              `xml:"mode,omitempty"`
+	Convergence    *MigrationConvergenceMetadata `xml:"convergence,omitempty"`
+	DataProcessed  uint64                        `xml:"dataProcessed,omitempty"`
+	// Start of the dirty rate measurement taken before the migration, not set if libvirt could not start it
+	DirtyRateCalcStartTimestamp *metav1.Time `xml:"dirtyRateCalcStartTimestamp,omitempty"`
+	// Downtime and setup time in milliseconds measured once the migration completed
+	Downtime  uint64 `xml:"downtime,omitempty"`
+	SetupTime uint64 `xml:"setupTime,omitempty"`
+	// Copy progress of the local volumes migrated with the domain
+	LocalVolumes *MigrationLocalVolumesMetadata `xml:"localVolumes,omitempty"`
+}
+
+type MigrationLocalVolumesMetadata struct {
+	Transferred uint64                         `xml:"transferred"`
+	Total       uint64                         `xml:"total"`
+	Bandwidth   uint64                         `xml:"bandwidth,omitempty"`
+	Volumes     []MigrationLocalVolumeMetadata `xml:"volume,omitempty"`
+}
+
+type MigrationLocalVolumeMetadata struct {
+	Name        string `xml:"name,attr"`
+	Transferred uint64 `xml:"transferred"`
+	Total       uint64 `xml:"total"`
+}
+
+type MigrationConvergenceMetadata struct {
+	DirtyRate                    int64        `xml:"dirtyRate"`
+	MeasurementTimestamp         *metav1.Time `xml:"measurementTimestamp,omitempty"`
+	TransferRate                 int64        `xml:"transferRate,omitempty"`
+	Converging                   *bool        `xml:"converging,omitempty"`
+	PredictedCompletionTimestamp *metav1.Time `xml:"predictedCompletionTimestamp,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -639,6 +683,7 @@ type Controller struct {
 	Index   string            `xml:"index,attr"`
 	Model   string            `xml:"model,attr,omitempty"`
 	Driver  *ControllerDriver `xml:"driver,omitempty"`
+	Serial  string            `xml:"serial,omitempty"`
 	Alias   *Alias            `xml:"alias,omitempty"`
 	Address *Address          `xml:"address,omitempty"`
 }
@@ -676,6 +721,28 @@ type Disk struct {
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
+}
+
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
 }
 
 type DiskAuth struct {
@@ -692,14 +759,17 @@ type DiskSecret struct {
 type ReadOnly struct{}
 
 type DiskSource struct {
-	Dev           string          `xml:"dev,attr,omitempty"`
-	File          string          `xml:"file,attr,omitempty"`
-	StartupPolicy string          `xml:"startupPolicy,attr,omitempty"`
-	Protocol      string          `xml:"protocol,attr,omitempty"`
-	Name          string          `xml:"name,attr,omitempty"`
-	Host          *DiskSourceHost `xml:"host,omitempty"`
-	Reservations  *Reservations   `xml:"reservations,omitempty"`
-	Slices        []Slice         `xml:"slices,omitempty"`
+	Dev           string               `xml:"dev,attr,omitempty"`
+	File          string               `xml:"file,attr,omitempty"`
+	StartupPolicy string               `xml:"startupPolicy,attr,omitempty"`
+	Protocol      string               `xml:"protocol,attr,omitempty"`
+	Name          string               `xml:"name,attr,omitempty"`
+	Type          string               `xml:"type,attr,omitempty"`
+	Path          string               `xml:"path,attr,omitempty"`
+	Host          *DiskSourceHost      `xml:"host,omitempty"`
+	Reservations  *Reservations        `xml:"reservations,omitempty"`
+	Slices        []Slice              `xml:"slices,omitempty"`
+	Reconnect     *DiskSourceReconnect `xml:"reconnect,omitempty"`
 }
 
 type DiskTarget struct {
@@ -734,6 +804,11 @@ type DiskSourceHost struct {
 	Port string `xml:"port,attr,omitempty"`
 }
 
+type DiskSourceReconnect struct {
+	Enabled string `xml:"enabled,attr"`
+	Timeout *uint  `xml:"timeout,attr,omitempty"`
+}
+
 type BackingStore struct {
 	Type   string              `xml:"type,attr,omitempty"`
 	Format *BackingStoreFormat `xml:"format,omitempty"`
@@ -1239,6 +1314,50 @@ type SecretSpec struct {
 	Usage       SecretUsage `xml:"usage,omitempty"`
 }
 
+// DomainBackup describes a push mode backup job of the domain disks
+type DomainBackup struct {
+	XMLName     xml.Name     `xml:"domainbackup"`
+	Mode        string       `xml:"mode,attr,omitempty"`
+	Incremental string       `xml:"incremental,omitempty"`
+	Disks       *BackupDisks `xml:"disks,omitempty"`
+}
+
+type BackupDisks struct {
+	Disks []BackupDisk `xml:"disk"`
+}
+
+type BackupDisk struct {
+	Name   string            `xml:"name,attr"`
+	Backup string            `xml:"backup,attr,omitempty"`
+	Type   string            `xml:"type,attr,omitempty"`
+	Target *BackupDiskTarget `xml:"target,omitempty"`
+	Driver *BackupDiskDriver `xml:"driver,omitempty"`
+}
+
+type BackupDiskTarget struct {
+	File string `xml:"file,attr"`
+}
+
+type BackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+// DomainCheckpoint describes the dirty bitmaps tracking the changes of the domain disks after a backup
+type DomainCheckpoint struct {
+	XMLName xml.Name         `xml:"domaincheckpoint"`
+	Name    string           `xml:"name"`
+	Disks   *CheckpointDisks `xml:"disks,omitempty"`
+}
+
+type CheckpointDisks struct {
+	Disks []CheckpointDisk `xml:"disk"`
+}
+
+type CheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr"`
+}
+
 func NewMinimalDomainSpec(vmiName string) *DomainSpec {
 	precond.MustNotBeEmpty(vmiName)
 	domain := &DomainSpec{}
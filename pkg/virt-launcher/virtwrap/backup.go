@@ -0,0 +1,350 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	failedDomainBackup    = "Domain backup failed"
+	maxConcurrentBackups  = 1
+	backupJobPollInterval = time.Second
+	backupImageExtension  = ".qcow2"
+)
+
+var (
+	// rebaseBackupImage sets the backing file of an incremental backup image without touching its data
+	rebaseBackupImage = func(image, backingFile string) error {
+		cmd := exec.Command("/usr/bin/qemu-img", "rebase", "-u", "-f", "qcow2", "-F", "qcow2", "-b", backingFile, image)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("setting the backing file of %s failed with error: %v, output: %s", image, err, out)
+		}
+		return nil
+	}
+)
+
+// BackupVMI starts a push mode backup of the persistent volumes of the VMI to the target directory.
+// The backup runs in the background, its progress is reported through the domain metadata.
+func (l *LibvirtDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	select {
+	case l.backupInProgress <- struct{}{}:
+	default:
+		log.Log.Object(vmi).Infof("backup is in progress")
+		return nil
+	}
+
+	go func() {
+		defer func() { <-l.backupInProgress }()
+		if err := l.backup(vmi, options); err != nil {
+			log.Log.Object(vmi).Reason(err).Error(failedDomainBackup)
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) backup(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	logger := log.Log.Object(vmi)
+
+	if l.shouldSkipBackup(options.Checkpoint) {
+		return nil
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if dom == nil || err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	checkpoints, err := listCheckpointNames(dom)
+	if err != nil {
+		return err
+	}
+	// checkpoints are lost when the domain is restarted or migrated,
+	// fall back to a full backup if the base checkpoint is gone
+	incremental := options.BaseCheckpoint != "" && checkpoints[options.BaseCheckpoint]
+	if options.BaseCheckpoint != "" && !incremental {
+		logger.Infof("Base checkpoint %s not found, taking a full backup", options.BaseCheckpoint)
+	}
+	l.initializeBackupMetadata(options.Checkpoint, incremental)
+
+	logger.Infof("Starting backup with checkpoint %s", options.Checkpoint)
+	failed := false
+	reason := ""
+	untrackedVolumes, err := l.runBackup(dom, vmi, options, incremental)
+	if err != nil {
+		failed = true
+		reason = fmt.Sprintf("%s: %s", failedDomainBackup, err)
+	} else {
+		logger.Infof("Completed backup with checkpoint %s successfully", options.Checkpoint)
+		// only the latest checkpoint is used for incremental backups, drop the
+		// older ones to stop tracking changes in their dirty bitmaps
+		removeCheckpoints(dom, options.Checkpoint)
+	}
+
+	l.setBackupResult(failed, reason, untrackedVolumes)
+	return err
+}
+
+// runBackup returns the backed up volumes which cannot track their changed blocks
+func (l *LibvirtDomainManager) runBackup(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions, incremental bool) ([]string, error) {
+	domSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	backupXML, checkpointXML, untrackedVolumes, err := backupDefinitions(domSpec, vmi, options, incremental)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(options.TargetDir, 0750); err != nil {
+		return nil, err
+	}
+
+	if err := dom.BackupBegin(backupXML, checkpointXML, 0); err != nil {
+		return nil, err
+	}
+
+	if err := waitForBackupJob(dom); err != nil {
+		return nil, err
+	}
+
+	if incremental {
+		return nil, chainIncrementalBackup(backupXML, options)
+	}
+	return untrackedVolumes, nil
+}
+
+// chainIncrementalBackup makes the incremental images of a backup reference the images of the base
+// backup as their backing file. The reference is relative, the backup directories are named after
+// their checkpoints and an incremental image restores on its own as long as the backups of its chain
+// are kept side by side.
+func chainIncrementalBackup(backupXML string, options *cmdclient.BackupOptions) error {
+	backup := api.DomainBackup{}
+	if err := xml.Unmarshal([]byte(backupXML), &backup); err != nil {
+		return err
+	}
+	for _, disk := range backup.Disks.Disks {
+		backingFile := filepath.Join("..", options.BaseCheckpoint, filepath.Base(disk.Target.File))
+		if err := rebaseBackupImage(disk.Target.File, backingFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backupDefinitions returns the backup and checkpoint XML, covering all disks backed by a PVC or a DataVolume,
+// and the volumes of the disks which cannot track their changed blocks. Only qcow2 disks can hold the dirty
+// bitmaps of a checkpoint. A checkpoint has to cover all disks to base an incremental backup on it, so the
+// checkpoint XML is empty when a disk cannot hold a bitmap, and an incremental backup of such a disk is rejected.
+func backupDefinitions(domSpec *api.DomainSpec, vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions, incremental bool) (string, string, []string, error) {
+	persistentVolumes := map[string]bool{}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil {
+			persistentVolumes[volume.Name] = true
+		}
+	}
+
+	backup := api.DomainBackup{
+		Mode:  "push",
+		Disks: &api.BackupDisks{},
+	}
+	if incremental {
+		backup.Incremental = options.BaseCheckpoint
+	}
+	checkpoint := api.DomainCheckpoint{
+		Name:  options.Checkpoint,
+		Disks: &api.CheckpointDisks{},
+	}
+	var untrackedVolumes []string
+
+	for _, disk := range domSpec.Devices.Disks {
+		if disk.Alias == nil || disk.Device != "disk" || disk.ReadOnly != nil {
+			continue
+		}
+		volumeName := disk.Alias.GetName()
+		if !persistentVolumes[volumeName] {
+			continue
+		}
+		backupDisk := api.BackupDisk{
+			Name:   disk.Target.Device,
+			Backup: "yes",
+			Type:   "file",
+			Target: &api.BackupDiskTarget{File: filepath.Join(options.TargetDir, volumeName+backupImageExtension)},
+			Driver: &api.BackupDiskDriver{Type: "qcow2"},
+		}
+		if !canHoldBitmaps(disk) {
+			untrackedVolumes = append(untrackedVolumes, volumeName)
+		}
+		backup.Disks.Disks = append(backup.Disks.Disks, backupDisk)
+		checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, api.CheckpointDisk{
+			Name:       disk.Target.Device,
+			Checkpoint: "bitmap",
+		})
+	}
+	if len(backup.Disks.Disks) == 0 {
+		return "", "", nil, fmt.Errorf("the vmi has no persistent volumes to back up")
+	}
+	if incremental && len(untrackedVolumes) > 0 {
+		return "", "", nil, fmt.Errorf("incremental backup rejected, the volumes %s are raw images which cannot track their changed blocks",
+			strings.Join(untrackedVolumes, ", "))
+	}
+
+	backupXML, err := xml.Marshal(backup)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if len(untrackedVolumes) > 0 {
+		return string(backupXML), "", untrackedVolumes, nil
+	}
+	checkpointXML, err := xml.Marshal(checkpoint)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return string(backupXML), string(checkpointXML), nil, nil
+}
+
+// canHoldBitmaps reports whether libvirt can track the changes of the disk in a persistent dirty bitmap,
+// which is only supported by qcow2 images
+func canHoldBitmaps(disk api.Disk) bool {
+	return disk.Driver != nil && disk.Driver.Type == "qcow2"
+}
+
+func waitForBackupJob(dom cli.VirDomain) error {
+	ticker := time.NewTicker(backupJobPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		jobInfo, err := dom.GetJobInfo()
+		if err != nil {
+			return err
+		}
+		if jobInfo.Type == libvirt.DOMAIN_JOB_BOUNDED || jobInfo.Type == libvirt.DOMAIN_JOB_UNBOUNDED {
+			continue
+		}
+
+		stats, err := dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+		if err != nil {
+			return err
+		}
+		if stats.OperationSet && stats.Operation != libvirt.DOMAIN_JOB_OPERATION_BACKUP {
+			return fmt.Errorf("unexpected job operation %d after the backup", stats.Operation)
+		}
+		if stats.Type != libvirt.DOMAIN_JOB_COMPLETED {
+			return fmt.Errorf("backup job ended with type %d: %s", stats.Type, stats.ErrorMessage)
+		}
+		return nil
+	}
+	return nil
+}
+
+func listCheckpointNames(dom cli.VirDomain) (map[string]bool, error) {
+	checkpoints, err := dom.ListAllCheckpoints(0)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for i := range checkpoints {
+		name, err := checkpoints[i].GetName()
+		checkpoints[i].Free()
+		if err != nil {
+			return nil, err
+		}
+		names[name] = true
+	}
+	return names, nil
+}
+
+func removeCheckpoints(dom cli.VirDomain, keep string) {
+	checkpoints, err := dom.ListAllCheckpoints(0)
+	if err != nil {
+		log.Log.Reason(err).Error("failed to list checkpoints")
+		return
+	}
+
+	for i := range checkpoints {
+		if name, err := checkpoints[i].GetName(); err == nil && name != keep {
+			if err := checkpoints[i].Delete(0); err != nil {
+				log.Log.Reason(err).Errorf("failed to remove checkpoint %s", name)
+			}
+		}
+		checkpoints[i].Free()
+	}
+}
+
+func (l *LibvirtDomainManager) shouldSkipBackup(checkpoint string) bool {
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	if backupMetadata.Checkpoint == checkpoint {
+		// backup still in progress or has just completed
+		// no need to trigger another one
+		return true
+	}
+	return false
+}
+
+func (l *LibvirtDomainManager) initializeBackupMetadata(checkpoint string, incremental bool) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		now := metav1.Now()
+		*backupMetadata = api.BackupMetadata{
+			Checkpoint:     checkpoint,
+			Incremental:    incremental,
+			StartTimestamp: &now,
+		}
+	})
+	log.Log.V(4).Infof("initialize backup metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupResult(failed bool, reason string, untrackedVolumes []string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized {
+			// nothing to report if backup metadata is empty
+			return
+		}
+
+		now := metav1.Now()
+		backupMetadata.Completed = true
+		backupMetadata.EndTimestamp = &now
+		backupMetadata.Failed = failed
+		backupMetadata.FailureReason = reason
+		backupMetadata.UntrackedVolumes = strings.Join(untrackedVolumes, ",")
+	})
+	log.Log.V(4).Infof("set backup results in metadata: %s", l.metadataCache.Backup.String())
+}
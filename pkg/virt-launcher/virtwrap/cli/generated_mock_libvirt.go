@@ -490,6 +490,47 @@ func (_mr *_MockVirDomainRecorder) MigrateStartPostCopy(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateStartPostCopy", arg0)
 }
 
+func (_m *MockVirDomain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+	ret := _m.ctrl.Call(_m, "MigrateSetMaxDowntime", downtime, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) MigrateSetMaxDowntime(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateSetMaxDowntime", arg0, arg1)
+}
+
+func (_m *MockVirDomain) MigrateSetMaxSpeed(speed uint64, flags libvirt.DomainMigrateMaxSpeedFlags) error {
+	ret := _m.ctrl.Call(_m, "MigrateSetMaxSpeed", speed, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) MigrateSetMaxSpeed(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateSetMaxSpeed", arg0, arg1)
+}
+
+func (_m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	ret := _m.ctrl.Call(_m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) GetBlockJobInfo(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetBlockJobInfo", arg0, arg1)
+}
+
+func (_m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	ret := _m.ctrl.Call(_m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetBlockIoTune(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetBlockIoTune", arg0, arg1, arg2)
+}
+
 func (_m *MockVirDomain) MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error) {
 	ret := _m.ctrl.Call(_m, "MemoryStats", nrStats, flags)
 	ret0, _ := ret[0].([]libvirt.DomainMemoryStat)
@@ -564,6 +605,16 @@ func (_mr *_MockVirDomainRecorder) AbortJob() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortJob")
 }
 
+func (_m *MockVirDomain) StartDirtyRateCalc(secs int, flags libvirt.DomainDirtyRateCalcFlags) error {
+	ret := _m.ctrl.Call(_m, "StartDirtyRateCalc", secs, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) StartDirtyRateCalc(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StartDirtyRateCalc", arg0, arg1)
+}
+
 func (_m *MockVirDomain) Free() error {
 	ret := _m.ctrl.Call(_m, "Free")
 	ret0, _ := ret[0].(error)
@@ -634,3 +685,24 @@ func (_m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecu
 func (_mr *_MockVirDomainRecorder) SetLaunchSecurityState(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetLaunchSecurityState", arg0, arg1)
 }
+
+func (_m *MockVirDomain) BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error {
+	ret := _m.ctrl.Call(_m, "BackupBegin", backupXML, checkpointXML, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) BackupBegin(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupBegin", arg0, arg1, arg2)
+}
+
+func (_m *MockVirDomain) ListAllCheckpoints(flags libvirt.DomainCheckpointListFlags) ([]libvirt.DomainCheckpoint, error) {
+	ret := _m.ctrl.Call(_m, "ListAllCheckpoints", flags)
+	ret0, _ := ret[0].([]libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) ListAllCheckpoints(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListAllCheckpoints", arg0)
+}
@@ -87,6 +87,19 @@ func getMigrationOptionsFromRequest(request *cmdv1.MigrationRequest) (*cmdclient
 	return options, nil
 }
 
+func getBackupOptionsFromRequest(request *cmdv1.BackupRequest) (*cmdclient.BackupOptions, error) {
+	if request.Options == nil {
+		return nil, fmt.Errorf("backup options object not present in command server request")
+	}
+
+	var options *cmdclient.BackupOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		return nil, fmt.Errorf("no valid backup options object present in command server request: %v", err)
+	}
+
+	return options, nil
+}
+
 func getErrorMessage(err error) string {
 	if virErr := launcherErrors.FormatLibvirtError(err); virErr != "" {
 		return virErr
@@ -259,6 +272,29 @@ func (l *Launcher) VirtualMachineMemoryDump(_ context.Context, request *cmdv1.Me
 	return response, nil
 }
 
+func (l *Launcher) VirtualMachineBackup(_ context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	options, err := getBackupOptionsFromRequest(request)
+	if err != nil {
+		response.Success = false
+		response.Message = err.Error()
+		return response, nil
+	}
+
+	if err := l.domainManager.BackupVMI(vmi, options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to back up vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func (l *Launcher) FreezeVirtualMachine(_ context.Context, request *cmdv1.FreezeRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -134,6 +134,18 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should call backup", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &cmdclient.BackupOptions{
+				TargetDir:      "path/to/backup/volBackup",
+				Checkpoint:     "backup-2",
+				BaseCheckpoint: "backup-1",
+			}
+			domainManager.EXPECT().BackupVMI(vmi, options)
+			err := client.VirtualMachineBackup(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should pause a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().PauseVMI(vmi)
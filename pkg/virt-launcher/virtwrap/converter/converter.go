@@ -38,6 +38,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -58,6 +59,7 @@ import (
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -73,6 +75,8 @@ const (
 	bootMenuTimeoutMS          = uint(10000)
 	multiQueueMaxQueues        = uint32(256)
 	QEMUSeaBiosDebugPipe       = "/var/run/kubevirt-private/QEMUSeaBiosDebugPipe"
+	// seconds
+	vhostUserBlkReconnectTimeout = uint(10)
 )
 
 type deviceNamer struct {
@@ -133,11 +137,14 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		var unit int
 		disk.Device = "disk"
 		disk.Target.Bus = diskDevice.Disk.Bus
-		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.Disk.Bus, prefixMap)
+		// NVMe disks are named and addressed once they are placed on their NVMe controller
+		if diskDevice.Disk.Bus != v1.DiskBusNVMe {
+			disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.Disk.Bus, prefixMap)
+		}
 		if diskDevice.Disk.Bus == "scsi" {
 			assignDiskToSCSIController(disk, unit)
 		}
-		if diskDevice.Disk.PciAddress != "" {
+		if diskDevice.Disk.PciAddress != "" && diskDevice.Disk.Bus != v1.DiskBusNVMe {
 			if diskDevice.Disk.Bus != v1.DiskBusVirtio {
 				return fmt.Errorf("setting a pci address is not allowed for non-virtio bus types, for disk %s", diskDevice.Name)
 			}
@@ -151,7 +158,10 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			disk.Model = InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture())
 		}
 		disk.ReadOnly = toApiReadOnly(diskDevice.Disk.ReadOnly)
-		disk.Serial = diskDevice.Serial
+		// The serial of a NVMe disk is set on its dedicated NVMe controller
+		if diskDevice.Disk.Bus != v1.DiskBusNVMe {
+			disk.Serial = diskDevice.Serial
+		}
 		if diskDevice.Shareable != nil {
 			if *diskDevice.Shareable {
 				if diskDevice.Cache == "" {
@@ -206,6 +216,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Driver.Queues = numQueues
 	}
 	disk.Alias = api.NewUserDefinedAlias(diskDevice.Name)
+	disk.IOTune = Convert_v1_DiskIOTune_To_api_DiskIOTune(diskDevice.IOTune)
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
@@ -228,6 +239,10 @@ func setReservation(disk *api.Disk) {
 }
 
 func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
+	// The storage daemon handles the I/O errors of vhost-user disks
+	if disk.Type == "vhostuser" {
+		return nil
+	}
 	if diskDevice.ErrorPolicy == nil {
 		disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 		return nil
@@ -241,6 +256,67 @@ func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	return nil
 }
 
+// Convert_v1_DiskIOTune_To_api_DiskIOTune converts the I/O limits of a disk, a nil result means the disk is not throttled
+func Convert_v1_DiskIOTune_To_api_DiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	apiIOTune := &api.DiskIOTune{
+		TotalBytesSec: quantityToUint64(ioTune.TotalBytesPerSecond),
+		ReadBytesSec:  quantityToUint64(ioTune.ReadBytesPerSecond),
+		WriteBytesSec: quantityToUint64(ioTune.WriteBytesPerSecond),
+		TotalIopsSec:  int64PtrToUint64(ioTune.TotalIOPS),
+		ReadIopsSec:   int64PtrToUint64(ioTune.ReadIOPS),
+		WriteIopsSec:  int64PtrToUint64(ioTune.WriteIOPS),
+	}
+	if burst := ioTune.Burst; burst != nil {
+		apiIOTune.TotalBytesSecMax = quantityToUint64(burst.TotalBytesPerSecond)
+		apiIOTune.ReadBytesSecMax = quantityToUint64(burst.ReadBytesPerSecond)
+		apiIOTune.WriteBytesSecMax = quantityToUint64(burst.WriteBytesPerSecond)
+		apiIOTune.TotalIopsSecMax = int64PtrToUint64(burst.TotalIOPS)
+		apiIOTune.ReadIopsSecMax = int64PtrToUint64(burst.ReadIOPS)
+		apiIOTune.WriteIopsSecMax = int64PtrToUint64(burst.WriteIOPS)
+
+		// the burst length only applies to the limits which have a burst value,
+		// it is always set so that the domain reports the same limits as requested
+		length := int64PtrToUint64(burst.LengthSeconds)
+		if length == 0 {
+			length = 1
+		}
+		apiIOTune.TotalBytesSecMaxLength = burstLength(apiIOTune.TotalBytesSecMax, length)
+		apiIOTune.ReadBytesSecMaxLength = burstLength(apiIOTune.ReadBytesSecMax, length)
+		apiIOTune.WriteBytesSecMaxLength = burstLength(apiIOTune.WriteBytesSecMax, length)
+		apiIOTune.TotalIopsSecMaxLength = burstLength(apiIOTune.TotalIopsSecMax, length)
+		apiIOTune.ReadIopsSecMaxLength = burstLength(apiIOTune.ReadIopsSecMax, length)
+		apiIOTune.WriteIopsSecMaxLength = burstLength(apiIOTune.WriteIopsSecMax, length)
+	}
+	if *apiIOTune == (api.DiskIOTune{}) {
+		return nil
+	}
+	return apiIOTune
+}
+
+func quantityToUint64(quantity *resource.Quantity) uint64 {
+	if quantity == nil || quantity.Sign() <= 0 {
+		return 0
+	}
+	return uint64(quantity.Value())
+}
+
+func int64PtrToUint64(value *int64) uint64 {
+	if value == nil || *value <= 0 {
+		return 0
+	}
+	return uint64(*value)
+}
+
+func burstLength(burst, length uint64) uint64 {
+	if burst == 0 {
+		return 0
+	}
+	return length
+}
+
 type DirectIOChecker interface {
 	CheckBlockDevice(path string) (bool, error)
 	CheckFile(path string) (bool, error)
@@ -380,6 +456,12 @@ func SetDriverCacheMode(disk *api.Disk, directIOChecker DirectIOChecker) error {
 	} else if disk.Source.Dev != "" {
 		path = disk.Source.Dev
 		isBlockDev = true
+	} else if disk.Device == "cdrom" {
+		// Nothing to check on a CD-ROM with an empty tray
+		return nil
+	} else if disk.Type == "vhostuser" {
+		// QEMU does not open the disk, it is served by the storage daemon
+		return nil
 	} else {
 		return fmt.Errorf("Unable to set a driver cache mode, disk is neither a block device nor a file")
 	}
@@ -594,6 +676,9 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 	if source.DownwardMetrics != nil {
 		return Convert_v1_DownwardMetricSource_To_api_Disk(disk, c)
 	}
+	if source.VhostUserBlk != nil {
+		return Convert_v1_VhostUserBlk_To_api_Disk(source.Name, disk)
+	}
 
 	return fmt.Errorf("disk %s references an unsupported source", disk.Alias.GetName())
 }
@@ -640,6 +725,13 @@ func GetFilesystemVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt-private", "vmi-disks", volumeName, "disk.img")
 }
 
+// Convert_v1_Empty_CDRom_To_api_Disk converts a CD-ROM without media to an api disk with an empty tray
+func Convert_v1_Empty_CDRom_To_api_Disk(disk *api.Disk) {
+	disk.Type = "file"
+	disk.Driver.Type = "raw"
+	disk.Source = api.DiskSource{}
+}
+
 // GetHotplugFilesystemVolumePath returns the path and file name of a hotplug disk image
 func GetHotplugFilesystemVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt", "hotplug-disks", fmt.Sprintf("%s.img", volumeName))
@@ -787,6 +879,36 @@ func Convert_v1_DownwardMetricSource_To_api_Disk(disk *api.Disk, c *ConverterCon
 	return nil
 }
 
+// Convert_v1_VhostUserBlk_To_api_Disk connects the disk to the vhost-user-blk socket of the volume. The I/O is
+// handled by the storage daemon, so the block layer settings of QEMU do not apply to the disk.
+func Convert_v1_VhostUserBlk_To_api_Disk(volumeName string, disk *api.Disk) error {
+	if disk.Device != "disk" {
+		return fmt.Errorf("device %s is not a disk. Not compatible with a vhostUserBlk volume", disk.Alias.GetName())
+	}
+
+	disk.Type = "vhostuser"
+	disk.Snapshot = "no"
+	disk.Driver = &api.DiskDriver{
+		Name:   "qemu",
+		Type:   "raw",
+		Queues: disk.Driver.Queues,
+	}
+	disk.ReadOnly = nil
+	disk.Shareable = nil
+	disk.Serial = ""
+	disk.IOTune = nil
+	disk.Source = api.DiskSource{
+		Type: "unix",
+		Path: vhostuserblk.VhostUserBlkSocketPath(volumeName),
+		// Keep the disk attached while the storage daemon is restarted
+		Reconnect: &api.DiskSourceReconnect{
+			Enabled: "yes",
+			Timeout: pointer.P(vhostUserBlkReconnectTimeout),
+		},
+	}
+	return nil
+}
+
 func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSource, disk *api.Disk) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
@@ -1351,16 +1473,16 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 			iothreads.IOThread = append(iothreads.IOThread, api.DiskIOThread{Id: uint32(id)})
 		}
 		for i, disk := range domain.Spec.Devices.Disks {
-			// Only disks with virtio bus support IOThreads
-			if disk.Target.Bus == v1.DiskBusVirtio {
+			// Only disks with virtio bus support IOThreads, vhost-user disks are served by their storage daemon
+			if disk.Target.Bus == v1.DiskBusVirtio && disk.Type != "vhostuser" {
 				domain.Spec.Devices.Disks[i].Driver.IOThreads = iothreads
 			}
 		}
 	} else {
 		currentDedicatedThread := uint(autoThreads + 1)
 		for i, disk := range domain.Spec.Devices.Disks {
-			// Only disks with virtio bus support IOThreads
-			if disk.Target.Bus == v1.DiskBusVirtio {
+			// Only disks with virtio bus support IOThreads, vhost-user disks are served by their storage daemon
+			if disk.Target.Bus == v1.DiskBusVirtio && disk.Type != "vhostuser" {
 				if vmi.Spec.Domain.Devices.Disks[i].DedicatedIOThread != nil && *vmi.Spec.Domain.Devices.Disks[i].DedicatedIOThread {
 					domain.Spec.Devices.Disks[i].Driver.IOThread = pointer.P(currentDedicatedThread)
 					currentDedicatedThread += 1
@@ -1539,8 +1661,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and vhost-user-blk require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || util.IsVMIVhostUserBlkEnabled(vmi) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1602,11 +1724,22 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return err
 		}
 		volume := volumes[disk.Name]
+		hpStatus, hpOk := c.HotplugVolumes[disk.Name]
+		hpReady := hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)
+		// A CD-ROM stays attached while its media is ejected or the inserted media is not plugged yet
+		if disk.CDRom != nil && (volume == nil || (hpOk && !hpReady)) {
+			Convert_v1_Empty_CDRom_To_api_Disk(&newDisk)
+			if err := setErrorPolicy(&disk, &newDisk); err != nil {
+				return err
+			}
+			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, newDisk)
+			continue
+		}
 		if volume == nil {
 			return fmt.Errorf("no matching volume with name %s found", disk.Name)
 		}
 
-		if _, ok := c.HotplugVolumes[disk.Name]; !ok {
+		if !hpOk {
 			err = Convert_v1_Volume_To_api_Disk(volume, &newDisk, c, volumeIndices[disk.Name])
 		} else {
 			err = Convert_v1_Hotplug_Volume_To_api_Disk(volume, &newDisk, c)
@@ -1619,9 +1752,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return err
 		}
 
-		hpStatus, hpOk := c.HotplugVolumes[disk.Name]
 		// if len(c.PermanentVolumes) == 0, it means the vmi is not ready yet, add all disks
-		if _, ok := c.PermanentVolumes[disk.Name]; ok || len(c.PermanentVolumes) == 0 || (hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)) {
+		if _, ok := c.PermanentVolumes[disk.Name]; ok || len(c.PermanentVolumes) == 0 || hpReady {
 			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, newDisk)
 		}
 		if err := setErrorPolicy(&disk, &newDisk); err != nil {
@@ -1688,6 +1820,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, scsiController)
 	}
 
+	nvmeControllers, err := convertNVMeControllers(vmi.Spec.Domain.Devices.Disks, domain.Spec.Devices.Disks)
+	if err != nil {
+		return err
+	}
+	domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, nvmeControllers...)
+
 	if vmi.Spec.Domain.Clock != nil {
 		clock := vmi.Spec.Domain.Clock
 		newClock := &api.Clock{}
@@ -1974,6 +2112,8 @@ func getPrefixFromBus(bus v1.DiskBus) string {
 		return "vd"
 	case v1.DiskBusSATA, v1.DiskBusSCSI, v1.DiskBusUSB:
 		return "sd"
+	case v1.DiskBusNVMe:
+		return "nvme"
 	default:
 		log.Log.Errorf("Unrecognized bus '%s'", bus)
 		return ""
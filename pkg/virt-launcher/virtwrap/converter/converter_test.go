@@ -1078,6 +1078,184 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed())
 		})
 
+		Context("with NVMe disks", func() {
+			addNVMeDisk := func(name, serial, pciAddress string) {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:   name,
+					Serial: serial,
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{
+							Bus:        v1.DiskBusNVMe,
+							PciAddress: pciAddress,
+						},
+					},
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: name,
+					VolumeSource: v1.VolumeSource{
+						EmptyDisk: &v1.EmptyDiskSource{Capacity: resource.MustParse("1Gi")},
+					},
+				})
+			}
+
+			getDisk := func(domain *api.Domain, name string) api.Disk {
+				for _, disk := range domain.Spec.Devices.Disks {
+					if disk.Alias.GetName() == name {
+						return disk
+					}
+				}
+				Fail(fmt.Sprintf("disk %s not found", name))
+				return api.Disk{}
+			}
+
+			getNVMeControllers := func(domain *api.Domain) []api.Controller {
+				var controllers []api.Controller
+				for _, controller := range domain.Spec.Devices.Controllers {
+					if controller.Type == "nvme" {
+						controllers = append(controllers, controller)
+					}
+				}
+				return controllers
+			}
+
+			It("should attach the disks without a serial as namespaces of a shared controller", func() {
+				addNVMeDisk("nvme1", "", "")
+				addNVMeDisk("nvme2", "", "")
+				domain := vmiToDomain(vmi, c)
+
+				Expect(getNVMeControllers(domain)).To(ConsistOf(api.Controller{
+					Type:   "nvme",
+					Index:  "0",
+					Serial: "kubevirt-nvme0",
+				}))
+				for i, name := range []string{"nvme1", "nvme2"} {
+					disk := getDisk(domain, name)
+					Expect(disk.Target.Bus).To(Equal(v1.DiskBusNVMe))
+					Expect(disk.Target.Device).To(Equal(fmt.Sprintf("nvme0n%d", i+1)))
+					Expect(disk.Serial).To(BeEmpty())
+					Expect(*disk.Address).To(Equal(api.Address{
+						Type:       "drive",
+						Controller: "0",
+						Bus:        "0",
+						Target:     "0",
+						Unit:       strconv.Itoa(i),
+					}))
+				}
+			})
+
+			It("should attach the disks with a serial or a pci address to a dedicated controller", func() {
+				addNVMeDisk("nvme1", "serial1", "")
+				addNVMeDisk("nvme2", "", "")
+				addNVMeDisk("nvme3", "", "0000:81:01.0")
+				domain := vmiToDomain(vmi, c)
+
+				Expect(getNVMeControllers(domain)).To(ConsistOf(
+					api.Controller{
+						Type:   "nvme",
+						Index:  "0",
+						Serial: "serial1",
+					},
+					api.Controller{
+						Type:   "nvme",
+						Index:  "1",
+						Serial: "kubevirt-nvme1",
+					},
+					api.Controller{
+						Type:   "nvme",
+						Index:  "2",
+						Serial: "kubevirt-nvme2",
+						Address: &api.Address{
+							Type:     api.AddressPCI,
+							Domain:   "0x0000",
+							Bus:      "0x81",
+							Slot:     "0x01",
+							Function: "0x0",
+						},
+					},
+				))
+				Expect(getDisk(domain, "nvme1").Target.Device).To(Equal("nvme0n1"))
+				Expect(getDisk(domain, "nvme1").Serial).To(BeEmpty())
+				Expect(getDisk(domain, "nvme2").Target.Device).To(Equal("nvme1n1"))
+				Expect(getDisk(domain, "nvme3").Target.Device).To(Equal("nvme2n1"))
+				Expect(getDisk(domain, "nvme3").Address.Type).To(Equal("drive"))
+			})
+
+			It("should fail with a malformed pci address", func() {
+				addNVMeDisk("nvme1", "", "0000:81:01")
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed())
+			})
+		})
+
+		Context("with vhostUserBlk volumes", func() {
+			BeforeEach(func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:   "database",
+					Cache:  v1.CacheNone,
+					Serial: "serial",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{
+							Bus: v1.DiskBusVirtio,
+						},
+					},
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "database",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkVolumeSource{Socket: "vhost.0"},
+					},
+				})
+			})
+
+			It("should connect the disk to the vhost-user socket", func() {
+				domain := vmiToDomain(vmi, c)
+				disk := domain.Spec.Devices.Disks[len(domain.Spec.Devices.Disks)-1]
+
+				Expect(disk.Type).To(Equal("vhostuser"))
+				Expect(disk.Device).To(Equal("disk"))
+				Expect(disk.Snapshot).To(Equal("no"))
+				Expect(disk.Target.Bus).To(Equal(v1.DiskBusVirtio))
+				Expect(disk.Serial).To(BeEmpty())
+				Expect(disk.Driver).To(Equal(&api.DiskDriver{
+					Name: "qemu",
+					Type: "raw",
+				}))
+				Expect(disk.Source).To(Equal(api.DiskSource{
+					Type: "unix",
+					Path: "/var/run/kubevirt/vhost-user-blk-sockets/database.sock",
+					Reconnect: &api.DiskSourceReconnect{
+						Enabled: "yes",
+						Timeout: pointer.P(uint(10)),
+					},
+				}))
+			})
+
+			It("should use shared memory backed by memfd", func() {
+				domain := vmiToDomain(vmi, c)
+
+				Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+				Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+				Expect(domain.Spec.CPU.NUMA).ToNot(BeNil())
+			})
+
+			It("should not assign an IOThread to the disk", func() {
+				vmi.Spec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicyShared)
+				domain := vmiToDomain(vmi, c)
+				disk := domain.Spec.Devices.Disks[len(domain.Spec.Devices.Disks)-1]
+
+				Expect(disk.Driver.IOThread).To(BeNil())
+				Expect(domain.Spec.Devices.Disks[0].Driver.IOThread).ToNot(BeNil())
+			})
+
+			It("should not set a driver cache mode", func() {
+				domain := vmiToDomain(vmi, c)
+				disk := domain.Spec.Devices.Disks[len(domain.Spec.Devices.Disks)-1]
+
+				Expect(SetDriverCacheMode(&disk, nil)).To(Succeed())
+				Expect(disk.Driver.Cache).To(BeEmpty())
+			})
+		})
+
 		It("should succeed with SCSI reservation", func() {
 			name := "scsi-reservation"
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1509,6 +1687,134 @@ var _ = Describe("Converter", func() {
 			Entry("ErrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
 			Entry("ErrorPolicy equal to enospace", pointer.P(v1.DiskErrorPolicyEnospace), "enospace"),
 		)
+		DescribeTable("Should set the I/O limits", func(ioTune *v1.DiskIOTune, expected *api.DiskIOTune) {
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				IOTune: ioTune,
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].IOTune).To(Equal(expected))
+		},
+			Entry("IOTune not specified", nil, nil),
+			Entry("IOTune without limits", &v1.DiskIOTune{}, nil),
+			Entry("IOTune with read and write limits",
+				&v1.DiskIOTune{
+					ReadBytesPerSecond:  pointer.P(resource.MustParse("100Mi")),
+					WriteBytesPerSecond: pointer.P(resource.MustParse("50Mi")),
+					ReadIOPS:            pointer.P(int64(2000)),
+					WriteIOPS:           pointer.P(int64(1000)),
+				},
+				&api.DiskIOTune{
+					ReadBytesSec:  100 * 1024 * 1024,
+					WriteBytesSec: 50 * 1024 * 1024,
+					ReadIopsSec:   2000,
+					WriteIopsSec:  1000,
+				},
+			),
+			Entry("IOTune with burst limits and the default length",
+				&v1.DiskIOTune{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("10M")),
+					TotalIOPS:           pointer.P(int64(500)),
+					Burst: &v1.DiskIOTuneBurst{
+						TotalIOPS: pointer.P(int64(1500)),
+					},
+				},
+				&api.DiskIOTune{
+					TotalBytesSec:         10000000,
+					TotalIopsSec:          500,
+					TotalIopsSecMax:       1500,
+					TotalIopsSecMaxLength: 1,
+				},
+			),
+			Entry("IOTune with burst limits and a length",
+				&v1.DiskIOTune{
+					TotalBytesPerSecond: pointer.P(resource.MustParse("10M")),
+					TotalIOPS:           pointer.P(int64(500)),
+					Burst: &v1.DiskIOTuneBurst{
+						TotalBytesPerSecond: pointer.P(resource.MustParse("20M")),
+						TotalIOPS:           pointer.P(int64(1500)),
+						LengthSeconds:       pointer.P(int64(30)),
+					},
+				},
+				&api.DiskIOTune{
+					TotalBytesSec:          10000000,
+					TotalIopsSec:           500,
+					TotalBytesSecMax:       20000000,
+					TotalIopsSecMax:        1500,
+					TotalBytesSecMaxLength: 30,
+					TotalIopsSecMaxLength:  30,
+				},
+			),
+		)
+		It("Should convert a CD-ROM without a volume to an empty tray", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "cdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{
+						Bus: v1.DiskBusSATA,
+					},
+				},
+			})
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			cdrom := domainSpec.Devices.Disks[len(domainSpec.Devices.Disks)-1]
+			Expect(cdrom.Alias.GetName()).To(Equal("cdrom"))
+			Expect(cdrom.Device).To(Equal("cdrom"))
+			Expect(cdrom.Type).To(Equal("file"))
+			Expect(cdrom.Source).To(Equal(api.DiskSource{}))
+		})
+		DescribeTable("Should convert a CD-ROM with an inserted hotplug media", func(phase v1.VolumePhase, expectedSource string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "cdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{
+						Bus: v1.DiskBusSCSI,
+					},
+				},
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "cdrom",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "iso",
+						},
+						Hotpluggable: true,
+					},
+				},
+			})
+			c.HotplugVolumes = map[string]v1.VolumeStatus{
+				"cdrom": {
+					Name:          "cdrom",
+					Phase:         phase,
+					HotplugVolume: &v1.HotplugVolumeStatus{},
+				},
+			}
+			c.PermanentVolumes = map[string]v1.VolumeStatus{
+				vmi.Spec.Volumes[0].Name: {Name: vmi.Spec.Volumes[0].Name},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			cdrom := domainSpec.Devices.Disks[len(domainSpec.Devices.Disks)-1]
+			Expect(cdrom.Alias.GetName()).To(Equal("cdrom"))
+			Expect(cdrom.Source.File).To(Equal(expectedSource))
+		},
+			Entry("with an empty tray when the media is not mounted yet", v1.HotplugVolumeAttachedToNode, ""),
+			Entry("with the media when it is mounted", v1.HotplugVolumeMounted, GetHotplugFilesystemVolumePath("cdrom")),
+		)
 		DescribeTable("Should set the vmport by arch", func(arch string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			c.Architecture = archconverter.NewConverter(arch)
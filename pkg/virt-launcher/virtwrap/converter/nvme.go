@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+// convertNVMeControllers places the NVMe disks of the domain on emulated NVMe controllers.
+// The serial and the PCI address are properties of the NVMe controller, so a disk which sets any of them
+// gets a dedicated controller. All the other disks are attached as namespaces of a single shared controller.
+// The placement only depends on the order of the disks in the VMI spec, so it is stable across restarts and
+// migrations, even if some of the disks are not part of the domain yet.
+func convertNVMeControllers(disks []v1.Disk, domainDisks []api.Disk) ([]api.Controller, error) {
+	var controllers []api.Controller
+	sharedControllerIndex := -1
+	sharedNamespaces := 0
+
+	for _, disk := range disks {
+		if disk.Disk == nil || disk.Disk.Bus != v1.DiskBusNVMe {
+			continue
+		}
+
+		index := len(controllers)
+		namespace := 1
+		if disk.Serial != "" || disk.Disk.PciAddress != "" {
+			controller := api.Controller{
+				Type:   "nvme",
+				Index:  strconv.Itoa(index),
+				Serial: disk.Serial,
+			}
+			if controller.Serial == "" {
+				controller.Serial = nvmeControllerSerial(index)
+			}
+			if disk.Disk.PciAddress != "" {
+				addr, err := device.NewPciAddressField(disk.Disk.PciAddress)
+				if err != nil {
+					return nil, fmt.Errorf("failed to configure disk %s: %v", disk.Name, err)
+				}
+				controller.Address = addr
+			}
+			controllers = append(controllers, controller)
+		} else {
+			if sharedControllerIndex < 0 {
+				sharedControllerIndex = index
+				controllers = append(controllers, api.Controller{
+					Type:   "nvme",
+					Index:  strconv.Itoa(index),
+					Serial: nvmeControllerSerial(index),
+				})
+			}
+			sharedNamespaces++
+			index = sharedControllerIndex
+			namespace = sharedNamespaces
+		}
+
+		for i := range domainDisks {
+			if domainDisks[i].Alias == nil || domainDisks[i].Alias.GetName() != disk.Name {
+				continue
+			}
+			assignDiskToNVMeController(&domainDisks[i], index, namespace)
+		}
+	}
+	return controllers, nil
+}
+
+// nvmeControllerSerial returns the serial of a NVMe controller which is not set by a disk, QEMU requires one on every controller
+func nvmeControllerSerial(index int) string {
+	return fmt.Sprintf("kubevirt-nvme%d", index)
+}
+
+func assignDiskToNVMeController(disk *api.Disk, index int, namespace int) {
+	disk.Target.Device = fmt.Sprintf("nvme%dn%d", index, namespace)
+	disk.Address = &api.Address{
+		Type:       "drive",
+		Controller: strconv.Itoa(index),
+		Bus:        "0",
+		Target:     "0",
+		Unit:       strconv.Itoa(namespace - 1),
+	}
+}
@@ -296,6 +296,16 @@ func (_mr *_MockDomainManagerRecorder) MemoryDump(arg0, arg1 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MemoryDump", arg0, arg1)
 }
 
+func (_m *MockDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *cmd_client.BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVMI", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) BackupVMI(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVMI", arg0, arg1)
+}
+
 func (_m *MockDomainManager) GetQemuVersion() (string, error) {
 	ret := _m.ctrl.Call(_m, "GetQemuVersion")
 	ret0, _ := ret[0].(string)
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"math"
 	"path/filepath"
 	"strings"
 	"time"
@@ -66,6 +67,19 @@ const (
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
 )
 
+// dirtyRateCalcPeriodSeconds is the time over which libvirt samples the guest dirty rate
+const dirtyRateCalcPeriodSeconds = 1
+
+// convergenceReportPeriod is the longest time the reported convergence of a migration is kept
+// while its verdict and its predicted completion do not change significantly
+const convergenceReportPeriod = 10 * time.Second
+
+// localVolumesProgressStepPercent is the progress of the copy of the local volumes after which it is reported again
+const localVolumesProgressStepPercent = 5
+
+// maxMigrationBandwidthMiB is the highest migration bandwidth accepted by libvirt, it does not limit the migration
+const maxMigrationBandwidthMiB = math.MaxInt64 >> 20
+
 type migrationDisks struct {
 	shared         map[string]bool
 	generated      map[string]bool
@@ -87,6 +101,36 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	// calcDirtyRate is the dirty rate measured before the migration, nil until the measurement completed
+	calcDirtyRate       *int64
+	reportedConvergence *api.MigrationConvergenceMetadata
+
+	maxDowntimeApplied bool
+	// switchoverStep is the index of the next step of the switchover policy
+	switchoverStep int
+
+	// localVolumesCopy tracks the copy of the local volumes, it is set up once the migration runs
+	localVolumesCopy *localVolumesCopy
+}
+
+type localVolumesCopy struct {
+	// bandwidth of the migration in MiB/s while the volumes are copied, 0 keeps the migration bandwidth
+	bandwidth uint64
+	// memoryBandwidth is the migration bandwidth in MiB/s once the volumes are copied,
+	// 0 if it is the bandwidth of the copy
+	memoryBandwidth    uint64
+	memoryBandwidthSet bool
+	// volumes are the copied volumes, with the progress of their copy once it started
+	volumes []*localVolumeCopy
+	// reported is the progress last reported, nil until the copy started
+	reported *api.MigrationLocalVolumesMetadata
+}
+
+type localVolumeCopy struct {
+	target   string
+	progress api.MigrationLocalVolumeMetadata
+	started  bool
 }
 
 type inflightMigrationAborted struct {
@@ -106,7 +150,7 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if options.AllowAutoConverge {
 		migrateFlags |= libvirt.MIGRATE_AUTO_CONVERGE
 	}
-	if options.AllowPostCopy {
+	if options.AllowPostCopy || hasSwitchoverAction(options, v1.MigrationSwitchoverPostCopy) {
 		migrateFlags |= libvirt.MIGRATE_POSTCOPY
 	}
 	if migratePaused {
@@ -115,6 +159,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if migrationCompressionMode(options) != v1.MigrationCompressionNone {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -287,10 +334,17 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 }
 
 func getDiskTargetsForMigration(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) []string {
-	// This method collects all VMI disks that needs to be copied during live migration
-	// and returns a list of its target device names.
-	// Shared volues are being excluded.
 	copyDisks := []string{}
+	for _, disk := range getDisksForMigration(dom, vmi) {
+		copyDisks = append(copyDisks, disk.Target.Device)
+	}
+	return copyDisks
+}
+
+func getDisksForMigration(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) []api.Disk {
+	// This method collects all VMI disks that needs to be copied during live migration.
+	// Shared volues are being excluded.
+	copyDisks := []api.Disk{}
 	migrationVols := classifyVolumesForMigration(vmi)
 	disks, err := getAllDomainDisks(dom)
 	if err != nil {
@@ -308,7 +362,7 @@ func getDiskTargetsForMigration(dom cli.VirDomain, vmi *v1.VirtualMachineInstanc
 		if (disk.Type != "file" && disk.Type != "block") || migrationVols.isSharedVolume(disk.Alias.GetName()) {
 			continue
 		}
-		copyDisks = append(copyDisks, disk.Target.Device)
+		copyDisks = append(copyDisks, disk)
 	}
 	return copyDisks
 }
@@ -524,6 +578,20 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 	}
 	m.progressWatermark = m.remainingData
 
+	// a switchover policy with an abort step replaces the completion timeout,
+	// the timeout still applies to the migrations following a plan without one
+	if m.options.SwitchoverPolicy != nil && !m.isMigrationPostCopy() {
+		if aborted := m.processSwitchoverPolicy(dom, now, elapsed); aborted != nil || m.isMigrationPostCopy() {
+			return aborted
+		}
+		if hasSwitchoverAction(m.options, v1.MigrationSwitchoverAbort) {
+			if !m.isMigrationProgressing() {
+				return m.abortStuckMigration(dom, now)
+			}
+			return nil
+		}
+	}
+
 	switch {
 	case m.isMigrationPostCopy():
 		// Currently, there is nothing for us to track when in Post Copy mode.
@@ -568,17 +636,7 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		// check if the migration is still progressing
 		// a stuck migration will get terminated when post copy
 		// isn't enabled
-		err := dom.AbortJob()
-		if err != nil {
-			logger.Reason(err).Error("failed to abort migration")
-			return nil
-		}
-
-		progressDelay := now - m.lastProgressUpdate
-		aborted := &inflightMigrationAborted{}
-		aborted.message = fmt.Sprintf("Live migration stuck for %d seconds and has been aborted", progressDelay/int64(time.Second))
-		aborted.abortStatus = v1.MigrationAbortSucceeded
-		return aborted
+		return m.abortStuckMigration(dom, now)
 	case m.shouldTriggerTimeout(elapsed):
 		// check the overall migration time
 		// if the total migration time exceeds an acceptable
@@ -600,6 +658,235 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 	return nil
 }
 
+func (m *migrationMonitor) abortStuckMigration(dom cli.VirDomain, now int64) *inflightMigrationAborted {
+	err := dom.AbortJob()
+	if err != nil {
+		log.Log.Object(m.vmi).Reason(err).Error("failed to abort migration")
+		return nil
+	}
+
+	progressDelay := now - m.lastProgressUpdate
+	aborted := &inflightMigrationAborted{}
+	aborted.message = fmt.Sprintf("Live migration stuck for %d seconds and has been aborted", progressDelay/int64(time.Second))
+	aborted.abortStatus = v1.MigrationAbortSucceeded
+	return aborted
+}
+
+func hasSwitchoverAction(options *cmdclient.MigrationOptions, action v1.MigrationSwitchoverAction) bool {
+	if options == nil || options.SwitchoverPolicy == nil {
+		return false
+	}
+	for _, step := range options.SwitchoverPolicy.Steps {
+		if step.Action == action {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMaxDowntime sets the maximum downtime of the migration once the migration job is running
+func (m *migrationMonitor) applyMaxDowntime(dom cli.VirDomain) {
+	if m.maxDowntimeApplied || m.options.MaxDowntime == nil {
+		return
+	}
+	m.maxDowntimeApplied = true
+	if err := dom.MigrateSetMaxDowntime(uint64(m.options.MaxDowntime.Milliseconds()), 0); err != nil {
+		log.Log.Object(m.vmi).Reason(err).Warning("failed to set the maximum downtime of the migration")
+	}
+}
+
+// dueSwitchoverStep returns the next step of the switchover policy if one of its conditions is met
+func (m *migrationMonitor) dueSwitchoverStep(now, elapsed int64) *v1.MigrationSwitchoverStep {
+	steps := m.options.SwitchoverPolicy.Steps
+	if m.switchoverStep >= len(steps) {
+		return nil
+	}
+	step := &steps[m.switchoverStep]
+	if step.After != nil && elapsed >= step.After.Nanoseconds() {
+		return step
+	}
+	if step.StalledFor != nil && now-m.lastProgressUpdate >= step.StalledFor.Nanoseconds() {
+		return step
+	}
+	return nil
+}
+
+// processSwitchoverPolicy takes the steps of the switchover policy one after the other.
+// A step which failed is retried on the next iteration.
+func (m *migrationMonitor) processSwitchoverPolicy(dom cli.VirDomain, now, elapsed int64) *inflightMigrationAborted {
+	logger := log.Log.Object(m.vmi)
+
+	step := m.dueSwitchoverStep(now, elapsed)
+	if step == nil {
+		return nil
+	}
+
+	switch step.Action {
+	case v1.MigrationSwitchoverRaiseDowntime:
+		if step.MaxDowntime == nil {
+			break
+		}
+		logger.Infof("Raising the maximum downtime of the migration to %s", step.MaxDowntime.Duration)
+		if err := dom.MigrateSetMaxDowntime(uint64(step.MaxDowntime.Milliseconds()), 0); err != nil {
+			logger.Reason(err).Error("failed to raise the maximum downtime of the migration")
+			return nil
+		}
+	case v1.MigrationSwitchoverPostCopy:
+		logger.Info("Starting post copy mode for migration")
+		if err := dom.MigrateStartPostCopy(0); err != nil {
+			logger.Reason(err).Error("failed to start post migration")
+			return nil
+		}
+		m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+	case v1.MigrationSwitchoverAbort:
+		if err := dom.AbortJob(); err != nil {
+			logger.Reason(err).Error("failed to abort migration")
+			return nil
+		}
+		return &inflightMigrationAborted{
+			message:     fmt.Sprintf("Live migration is not completed after %d seconds and has been aborted by the switchover policy", elapsed/int64(time.Second)),
+			abortStatus: v1.MigrationAbortSucceeded,
+		}
+	}
+	m.switchoverStep++
+	return nil
+}
+
+// startDirtyRateCalc measures the guest dirty rate before the migration starts, libvirt does not accept a new
+// measurement while the migration runs. The start of the measurement is recorded in the migration metadata,
+// the migration convergence is not reported from it if libvirt does not support it.
+func (l *LibvirtDomainManager) startDirtyRateCalc(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) {
+	if err := dom.StartDirtyRateCalc(dirtyRateCalcPeriodSeconds, 0); err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to start the dirty rate calculation")
+		return
+	}
+	now := metav1.Now()
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.DirtyRateCalcStartTimestamp = &now
+	})
+}
+
+// dirtyRate returns the guest dirty rate in bytes per second, or false if it is not known yet.
+// libvirt reports the dirty rate in the job statistics once the first pre-copy iteration completed,
+// the measurement taken before the migration is used until then.
+func (m *migrationMonitor) dirtyRate(stats *libvirt.DomainJobInfo) (int64, bool) {
+	if stats.MemDirtyRateSet && stats.MemPageSizeSet {
+		return int64(stats.MemDirtyRate * stats.MemPageSize), true
+	}
+	if m.calcDirtyRate != nil {
+		return *m.calcDirtyRate, true
+	}
+
+	migrationMetadata, _ := m.l.metadataCache.Migration.Load()
+	calcStart := migrationMetadata.DirtyRateCalcStartTimestamp
+	if calcStart == nil || time.Since(calcStart.Time) < dirtyRateCalcPeriodSeconds*time.Second {
+		return 0, false
+	}
+	dirtyRate, measured, err := m.measuredDirtyRate()
+	if err != nil {
+		log.Log.Object(m.vmi).Reason(err).Warning("failed to get the dirty rate of the domain")
+		return 0, false
+	}
+	if !measured {
+		return 0, false
+	}
+	m.calcDirtyRate = &dirtyRate
+	return dirtyRate, true
+}
+
+// updateConvergence publishes the convergence prediction for the inflight migration if it changed significantly
+func (m *migrationMonitor) updateConvergence(stats *libvirt.DomainJobInfo) {
+	dirtyRate, known := m.dirtyRate(stats)
+	if !known {
+		return
+	}
+
+	now := metav1.Now()
+	convergence := predictMigrationConvergence(now, dirtyRate, stats, m.options.Bandwidth.Value())
+	if convergenceChanged(m.reportedConvergence, convergence) {
+		m.l.updateVMIMigrationConvergence(convergence)
+		m.reportedConvergence = convergence
+	}
+}
+
+// convergenceChanged returns true if the verdict of the convergence changed, the predicted completion moved
+// by more than a quarter of the predicted remaining time or the reported convergence is outdated
+func convergenceChanged(reported, current *api.MigrationConvergenceMetadata) bool {
+	if reported == nil {
+		return true
+	}
+	if current.MeasurementTimestamp.Sub(reported.MeasurementTimestamp.Time) >= convergenceReportPeriod {
+		return true
+	}
+	if (reported.Converging == nil) != (current.Converging == nil) ||
+		(current.Converging != nil && *reported.Converging != *current.Converging) {
+		return true
+	}
+	if reported.PredictedCompletionTimestamp == nil || current.PredictedCompletionTimestamp == nil {
+		return reported.PredictedCompletionTimestamp != current.PredictedCompletionTimestamp
+	}
+	shift := current.PredictedCompletionTimestamp.Sub(reported.PredictedCompletionTimestamp.Time).Abs()
+	return shift > current.PredictedCompletionTimestamp.Sub(current.MeasurementTimestamp.Time)/4
+}
+
+// measuredDirtyRate returns the guest dirty rate in bytes per second, or false if the measurement is not completed
+func (m *migrationMonitor) measuredDirtyRate() (int64, bool, error) {
+	domStats, err := m.l.virConn.GetAllDomainStats(libvirt.DOMAIN_STATS_DIRTYRATE, 0)
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() {
+		for i := range domStats {
+			if domStats[i].Domain == nil {
+				continue
+			}
+			if err := domStats[i].Domain.Free(); err != nil {
+				log.Log.Reason(err).Warning("Error freeing a domain.")
+			}
+		}
+	}()
+
+	for _, domStat := range domStats {
+		dirtyRate := domStat.DirtyRate
+		if dirtyRate == nil || !dirtyRate.CalcStatusSet || !dirtyRate.MegabytesPerSecondSet {
+			continue
+		}
+		if libvirt.DomainDirtyRateStatus(dirtyRate.CalcStatus) != libvirt.DOMAIN_DIRTYRATE_MEASURED {
+			return 0, false, nil
+		}
+		return dirtyRate.MegabytesPerSecond * 1024 * 1024, true, nil
+	}
+	return 0, false, nil
+}
+
+// predictMigrationConvergence compares the dirty rate with the transfer rate of the migration.
+// Each pre-copy iteration transfers the memory dirtied during the previous one, so the remaining data
+// shrinks by the difference of both rates and the migration never completes if the guest dirties its
+// memory faster than it is transferred.
+func predictMigrationConvergence(now metav1.Time, dirtyRate int64, stats *libvirt.DomainJobInfo, bandwidth int64) *api.MigrationConvergenceMetadata {
+	convergence := &api.MigrationConvergenceMetadata{
+		DirtyRate:            dirtyRate,
+		MeasurementTimestamp: &now,
+		TransferRate:         bandwidth,
+	}
+	if stats != nil && stats.MemBpsSet && stats.MemBps > 0 {
+		convergence.TransferRate = int64(stats.MemBps)
+	}
+	if convergence.TransferRate <= 0 {
+		// the migration is not throttled and did not report its transfer rate yet
+		return convergence
+	}
+
+	converging := convergence.TransferRate > dirtyRate
+	convergence.Converging = &converging
+	if converging && stats != nil && stats.DataRemainingSet {
+		remaining := float64(stats.DataRemaining) / float64(convergence.TransferRate-dirtyRate)
+		predicted := metav1.NewTime(now.Add(time.Duration(remaining * float64(time.Second))))
+		convergence.PredictedCompletionTimestamp = &predicted
+	}
+	return convergence
+}
+
 func (m *migrationMonitor) startMonitor() {
 	var completedJobInfo *libvirt.DomainJobInfo
 	vmi := m.vmi
@@ -666,20 +953,26 @@ func (m *migrationMonitor) startMonitor() {
 
 		switch stats.Type {
 		case libvirt.DOMAIN_JOB_UNBOUNDED:
+			m.applyMaxDowntime(dom)
 			aborted := m.processInflightMigration(dom, stats)
 			if aborted != nil {
 				logger.Errorf("Live migration abort detected with reason: %s", aborted.message)
 				m.l.setMigrationResult(true, aborted.message, aborted.abortStatus)
 				return
 			}
+			m.updateLocalVolumesCopy(dom, stats)
+			m.updateConvergence(stats)
 			logInterval++
 			if logInterval%monitorLogInterval == 0 {
+				// every change of the metadata is sent to virt-handler, refresh the statistics as often as they are logged
+				m.l.updateVMIMigrationStatistics(stats)
 				logMigrationInfo(logger, string(vmi.Status.MigrationState.MigrationUID), stats)
 			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
 			logger.Info("Migration has been completed")
+			m.l.updateVMIMigrationStatistics(stats)
 			m.l.setMigrationResult(false, "", "")
 			return
 		case libvirt.DOMAIN_JOB_FAILED:
@@ -748,6 +1041,13 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 	if err != nil {
 		return nil, err
 	}
+	// libvirt copies each local volume within the migration bandwidth before it sends the memory,
+	// the migration monitor sets the bandwidth to BandwidthPerMigration once the volumes are copied
+	if vmi.IsBlockMigration() && options.LocalStorage != nil {
+		if copyBandwidth := localStorageCopyBandwidth(vmi, options, getDisksForMigration(dom, vmi)); copyBandwidth > 0 {
+			bandwidth = copyBandwidth
+		}
+	}
 
 	xmlstr, err := migratableDomXML(dom, vmi, domSpec)
 	if err != nil {
@@ -784,6 +1084,11 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		params.MigrateDisksDetectZeroesSet = true
 	}
 
+	if options.Compression != nil && options.Compression.Mode == v1.MigrationCompressionMultifdZstd && !parallelMigrationSet {
+		log.Log.Object(vmi).Warning("multifd-zstd migration compression requires parallel migration threads, migrating without compression")
+	}
+	configureMigrationCompression(params, options)
+
 	log.Log.Object(vmi).Infof("generated migration parameters: %+v", params)
 	return params, nil
 }
@@ -979,6 +1284,7 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		if err := prepareDomainForMigration(l.virConn, dom); err != nil {
 			return fmt.Errorf("error encountered during preparing domain for migration: %v", err)
 		}
+		l.startDirtyRateCalc(dom, vmi)
 		domSpec, err := l.getDomainSpec(dom)
 		if err != nil {
 			return fmt.Errorf("failed to get domain spec: %v", err)
@@ -1064,11 +1370,229 @@ func (l *LibvirtDomainManager) updateVMIMigrationMode(mode v1.MigrationMode) {
 	log.Log.V(4).Infof("Migration mode set in metadata: %s", l.metadataCache.Migration.String())
 }
 
+func (l *LibvirtDomainManager) updateVMIMigrationConvergence(convergence *api.MigrationConvergenceMetadata) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Convergence = convergence
+	})
+	log.Log.V(4).Infof("Migration convergence set in metadata: %s", l.metadataCache.Migration.String())
+}
+
+// updateVMIMigrationStatistics records the data transferred by the migration and, once the job completed,
+// the downtime of the guest and the setup time of the migration. The downtime of a running job is only
+// an estimate and is not recorded. It is called at the log interval while the job runs.
+func (l *LibvirtDomainManager) updateVMIMigrationStatistics(stats *libvirt.DomainJobInfo) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		if stats.DataProcessedSet {
+			migrationMetadata.DataProcessed = stats.DataProcessed
+		}
+		if stats.Type != libvirt.DOMAIN_JOB_COMPLETED {
+			return
+		}
+		if stats.DowntimeSet {
+			migrationMetadata.Downtime = stats.Downtime
+		}
+		if stats.SetupTimeSet {
+			migrationMetadata.SetupTime = stats.SetupTime
+		}
+	})
+}
+
+func (l *LibvirtDomainManager) updateVMIMigrationLocalVolumes(localVolumes *api.MigrationLocalVolumesMetadata) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.LocalVolumes = localVolumes.DeepCopy()
+	})
+}
+
+// volumeCopyBandwidth returns the bandwidth limit in bytes per second of the copy of a local volume.
+// The bandwidth of the storage class of the volume claim overrides the one of the local storage migration.
+func volumeCopyBandwidth(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, volumeName string) uint64 {
+	if options == nil || options.LocalStorage == nil {
+		return 0
+	}
+	localStorage := options.LocalStorage
+
+	var bandwidth uint64
+	if localStorage.Bandwidth != nil && localStorage.Bandwidth.Value() > 0 {
+		bandwidth = uint64(localStorage.Bandwidth.Value())
+	}
+	if storageClassName := volumeStorageClassName(vmi, volumeName); storageClassName != "" {
+		for _, class := range localStorage.StorageClasses {
+			if class.StorageClassName == storageClassName && class.Bandwidth != nil && class.Bandwidth.Value() > 0 {
+				bandwidth = uint64(class.Bandwidth.Value())
+			}
+		}
+	}
+	return bandwidth
+}
+
+func volumeStorageClassName(vmi *v1.VirtualMachineInstance, volumeName string) string {
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == volumeName && volumeStatus.PersistentVolumeClaimInfo != nil {
+			return volumeStatus.PersistentVolumeClaimInfo.StorageClassName
+		}
+	}
+	return ""
+}
+
+// localStorageMigrationBandwidth returns the bandwidth limit in bytes per second of the copy of the given local volumes.
+// libvirt copies every volume of the migration within the same bandwidth, so the lowest bandwidth of the copied
+// volumes is used.
+func localStorageMigrationBandwidth(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, disks []api.Disk) uint64 {
+	if options == nil || options.LocalStorage == nil {
+		return 0
+	}
+	var bandwidth uint64
+	for _, disk := range disks {
+		volumeBandwidth := volumeCopyBandwidth(vmi, options, disk.Alias.GetName())
+		if volumeBandwidth > 0 && (bandwidth == 0 || volumeBandwidth < bandwidth) {
+			bandwidth = volumeBandwidth
+		}
+	}
+	return bandwidth
+}
+
+// localStorageCopyBandwidth returns the migration bandwidth in MiB/s while the local volumes are copied, 0 if the copy
+// keeps the migration bandwidth. It is independent of the migration bandwidth, which only applies to the memory.
+func localStorageCopyBandwidth(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, disks []api.Disk) uint64 {
+	return bytesToMebiBytes(localStorageMigrationBandwidth(vmi, options, disks))
+}
+
+// bytesToMebiBytes rounds up to the next MiB, libvirt takes the migration bandwidth in MiB/s
+func bytesToMebiBytes(bytes uint64) uint64 {
+	return (bytes + 1024*1024 - 1) / (1024 * 1024)
+}
+
+// updateLocalVolumesCopy reports the copy progress of the local volumes and sets the migration bandwidth from the
+// bandwidth of the copy to BandwidthPerMigration once the volumes are copied and the memory is sent.
+// The progress of each volume is taken from its copy job. The progress is only reported when the copy starts,
+// advances by a step or grows with the guest writes.
+func (m *migrationMonitor) updateLocalVolumesCopy(dom cli.VirDomain, stats *libvirt.DomainJobInfo) {
+	if !m.vmi.IsBlockMigration() {
+		return
+	}
+	logger := log.Log.Object(m.vmi)
+
+	if m.localVolumesCopy == nil {
+		migrationBandwidth, err := vcpu.QuantityToMebiByte(m.options.Bandwidth)
+		if err != nil {
+			logger.Reason(err).Warning("failed to get the migration bandwidth")
+		}
+		disks := getDisksForMigration(dom, m.vmi)
+		m.localVolumesCopy = &localVolumesCopy{bandwidth: localStorageCopyBandwidth(m.vmi, m.options, disks)}
+		for _, disk := range disks {
+			m.localVolumesCopy.volumes = append(m.localVolumesCopy.volumes, &localVolumeCopy{
+				target:   disk.Target.Device,
+				progress: api.MigrationLocalVolumeMetadata{Name: disk.Alias.GetName()},
+			})
+		}
+		if migrationBandwidth == 0 {
+			migrationBandwidth = maxMigrationBandwidthMiB
+		}
+		if m.localVolumesCopy.bandwidth > 0 && migrationBandwidth != m.localVolumesCopy.bandwidth {
+			m.localVolumesCopy.memoryBandwidth = migrationBandwidth
+		}
+	}
+	volumesCopy := m.localVolumesCopy
+
+	// libvirt starts to send the memory once the volumes are copied
+	if volumesCopy.memoryBandwidth > 0 && !volumesCopy.memoryBandwidthSet && stats.MemProcessedSet && stats.MemProcessed > 0 {
+		if err := dom.MigrateSetMaxSpeed(volumesCopy.memoryBandwidth, 0); err != nil {
+			logger.Reason(err).Warning("failed to set the migration bandwidth after the copy of the local volumes, will retry")
+		} else {
+			logger.Infof("Set the migration bandwidth to %d MiB/s after the copy of the local volumes", volumesCopy.memoryBandwidth)
+			volumesCopy.memoryBandwidthSet = true
+		}
+	}
+
+	if !stats.DiskTotalSet || stats.DiskTotal == 0 {
+		// the copy did not start yet
+		return
+	}
+	current := &api.MigrationLocalVolumesMetadata{
+		Transferred: stats.DiskProcessed,
+		Total:       stats.DiskTotal,
+		Bandwidth:   volumesCopy.bandwidth * 1024 * 1024,
+	}
+	for _, volume := range volumesCopy.volumes {
+		// a volume keeps its last progress while its copy job is not found
+		if info, err := dom.GetBlockJobInfo(volume.target, 0); err != nil {
+			logger.Reason(err).V(4).Infof("failed to get the copy progress of volume %s", volume.progress.Name)
+		} else if info.End > 0 {
+			volume.progress.Transferred = info.Cur
+			volume.progress.Total = info.End
+			volume.started = true
+		}
+		if volume.started {
+			current.Volumes = append(current.Volumes, volume.progress)
+		}
+	}
+	if localVolumesProgressChanged(volumesCopy.reported, current) {
+		volumesCopy.reported = current
+		m.l.updateVMIMigrationLocalVolumes(current)
+	}
+}
+
+// localVolumesProgressChanged returns true if the copy of the local volumes or of one of them advanced by a step or grew
+func localVolumesProgressChanged(reported, current *api.MigrationLocalVolumesMetadata) bool {
+	if reported == nil || reported.Total != current.Total || len(reported.Volumes) != len(current.Volumes) ||
+		localVolumesProgressStep(reported.Transferred, reported.Total) != localVolumesProgressStep(current.Transferred, current.Total) {
+		return true
+	}
+	for i := range current.Volumes {
+		reportedVolume, currentVolume := reported.Volumes[i], current.Volumes[i]
+		if reportedVolume.Name != currentVolume.Name || reportedVolume.Total != currentVolume.Total ||
+			localVolumesProgressStep(reportedVolume.Transferred, reportedVolume.Total) != localVolumesProgressStep(currentVolume.Transferred, currentVolume.Total) {
+			return true
+		}
+	}
+	return false
+}
+
+func localVolumesProgressStep(transferred, total uint64) uint64 {
+	return transferred * 100 / total / localVolumesProgressStepPercent
+}
+
+// migrationCompressionMode returns the compression mode used for the migration.
+// multifd-zstd compresses in the parallel migration threads, so it is not used without them.
+func migrationCompressionMode(options *cmdclient.MigrationOptions) v1.MigrationCompressionMode {
+	if options == nil || options.Compression == nil {
+		return v1.MigrationCompressionNone
+	}
+	switch options.Compression.Mode {
+	case v1.MigrationCompressionXBZRLE:
+		return v1.MigrationCompressionXBZRLE
+	case v1.MigrationCompressionMultifdZstd:
+		if shouldConfigure, _ := shouldConfigureParallelMigration(options); shouldConfigure {
+			return v1.MigrationCompressionMultifdZstd
+		}
+	}
+	return v1.MigrationCompressionNone
+}
+
+func configureMigrationCompression(params *libvirt.DomainMigrateParameters, options *cmdclient.MigrationOptions) {
+	switch migrationCompressionMode(options) {
+	case v1.MigrationCompressionXBZRLE:
+		params.Compression = "xbzrle"
+		params.CompressionSet = true
+		if cacheSize := options.Compression.XBZRLECacheSize; cacheSize != nil && cacheSize.Value() > 0 {
+			params.CompressionXBZRLECache = uint64(cacheSize.Value())
+			params.CompressionXBZRLECacheSet = true
+		}
+	case v1.MigrationCompressionMultifdZstd:
+		params.Compression = "zstd"
+		params.CompressionSet = true
+		if level := options.Compression.ZstdLevel; level != nil {
+			params.CompressionZstdLevel = int(*level)
+			params.CompressionZstdLevelSet = true
+		}
+	}
+}
+
 func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shouldConfigure bool, threadsCount int) {
 	if options == nil {
 		return
 	}
-	if options.AllowPostCopy {
+	if options.AllowPostCopy || hasSwitchoverAction(options, v1.MigrationSwitchoverPostCopy) {
 		return
 	}
 	if options.ParallelMigrationThreads == nil {
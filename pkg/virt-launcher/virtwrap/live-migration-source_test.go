@@ -1,14 +1,21 @@
 package virtwrap
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 var _ = Describe("Live Migration for the source", func() {
@@ -31,4 +38,72 @@ var _ = Describe("Live Migration for the source", func() {
 				})))
 		})
 	})
+
+	Context("predictMigrationConvergence", func() {
+		const mib = 1024 * 1024
+		now := metav1.Unix(1000, 0)
+
+		predicted := func(seconds int) *metav1.Time {
+			t := metav1.NewTime(now.Add(time.Duration(seconds) * time.Second))
+			return &t
+		}
+
+		DescribeTable("should predict the migration completion", func(dirtyRate int64, stats *libvirt.DomainJobInfo, bandwidth int64, expected *api.MigrationConvergenceMetadata) {
+			expected.MeasurementTimestamp = &now
+			Expect(predictMigrationConvergence(now, dirtyRate, stats, bandwidth)).To(Equal(expected))
+		},
+			Entry("with the transfer rate of the migration",
+				int64(16*mib),
+				&libvirt.DomainJobInfo{MemBps: 64 * mib, MemBpsSet: true, DataRemaining: 480 * mib, DataRemainingSet: true},
+				int64(32*mib),
+				&api.MigrationConvergenceMetadata{DirtyRate: 16 * mib, TransferRate: 64 * mib, Converging: pointer.P(true), PredictedCompletionTimestamp: predicted(10)},
+			),
+			Entry("with the configured bandwidth before the transfer started",
+				int64(16*mib),
+				&libvirt.DomainJobInfo{DataRemaining: 480 * mib, DataRemainingSet: true},
+				int64(64*mib),
+				&api.MigrationConvergenceMetadata{DirtyRate: 16 * mib, TransferRate: 64 * mib, Converging: pointer.P(true), PredictedCompletionTimestamp: predicted(10)},
+			),
+			Entry("without a completion when the guest dirties its memory faster than it is transferred",
+				int64(128*mib),
+				&libvirt.DomainJobInfo{MemBps: 64 * mib, MemBpsSet: true, DataRemaining: 480 * mib, DataRemainingSet: true},
+				int64(64*mib),
+				&api.MigrationConvergenceMetadata{DirtyRate: 128 * mib, TransferRate: 64 * mib, Converging: pointer.P(false)},
+			),
+			Entry("without a prediction when the transfer rate is unknown",
+				int64(16*mib),
+				&libvirt.DomainJobInfo{DataRemaining: 480 * mib, DataRemainingSet: true},
+				int64(0),
+				&api.MigrationConvergenceMetadata{DirtyRate: 16 * mib},
+			),
+		)
+	})
+
+	Context("convergenceChanged", func() {
+		now := metav1.Unix(1000, 0)
+
+		convergence := func(measuredAfter time.Duration, converging *bool, predictedAfter time.Duration) *api.MigrationConvergenceMetadata {
+			measurement := metav1.NewTime(now.Add(measuredAfter))
+			metadata := &api.MigrationConvergenceMetadata{MeasurementTimestamp: &measurement, Converging: converging}
+			if predictedAfter > 0 {
+				predicted := metav1.NewTime(now.Add(predictedAfter))
+				metadata.PredictedCompletionTimestamp = &predicted
+			}
+			return metadata
+		}
+
+		reported := convergence(0, pointer.P(true), 100*time.Second)
+
+		DescribeTable("should report a convergence", func(reported, current *api.MigrationConvergenceMetadata, expected bool) {
+			Expect(convergenceChanged(reported, current)).To(Equal(expected))
+		},
+			Entry("which was not reported yet", nil, convergence(0, pointer.P(true), 0), true),
+			Entry("with a changed verdict", reported, convergence(time.Second, pointer.P(false), 0), true),
+			Entry("with a verdict which became known", convergence(0, nil, 0), convergence(time.Second, pointer.P(false), 0), true),
+			Entry("with a significantly moved completion", reported, convergence(time.Second, pointer.P(true), 150*time.Second), true),
+			Entry("after the report period", reported, convergence(convergenceReportPeriod, pointer.P(true), 100*time.Second), true),
+			Entry("but not with a slightly moved completion", reported, convergence(time.Second, pointer.P(true), 110*time.Second), false),
+			Entry("but not with the same verdict and no completion", convergence(0, pointer.P(false), 0), convergence(time.Second, pointer.P(false), 0), false),
+		)
+	})
 })
@@ -141,6 +141,7 @@ type DomainManager interface {
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	BackupVMI(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -161,6 +162,7 @@ type LibvirtDomainManager struct {
 
 	hotplugHostDevicesInProgress chan struct{}
 	memoryDumpInProgress         chan struct{}
+	backupInProgress             chan struct{}
 
 	virtShareDir             string
 	ephemeralDiskDir         string
@@ -237,6 +239,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
+	manager.backupInProgress = make(chan struct{}, maxConcurrentBackups)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
 
 	reCalcDomainStats := func() (*stats.DomainStats, error) {
@@ -249,6 +252,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 			return nil, nil
 		}
 
+		list[0].Load = manager.getGuestLoad()
 		return list[0], nil
 	}
 
@@ -1176,10 +1180,142 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := syncDiskIOTune(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
+	if err := syncCDRomMedia(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return oldSpec, nil
 }
 
+// syncDiskIOTune applies the changed I/O limits of the attached disks to the running domain
+func syncDiskIOTune(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	currentIOTunes := map[string]api.DiskIOTune{}
+	for _, disk := range spec.Devices.Disks {
+		if disk.Alias == nil {
+			continue
+		}
+		currentIOTunes[disk.Alias.GetName()] = ioTuneOrEmpty(disk.IOTune)
+	}
+
+	for _, disk := range domain.Spec.Devices.Disks {
+		if disk.Alias == nil {
+			continue
+		}
+		currentIOTune, attached := currentIOTunes[disk.Alias.GetName()]
+		desiredIOTune := ioTuneOrEmpty(disk.IOTune)
+		if !attached || currentIOTune == desiredIOTune {
+			continue
+		}
+		log.Log.Object(vmi).V(2).Infof("Updating the I/O limits of disk %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		if err := dom.SetBlockIoTune(disk.Target.Device, blockIoTuneParameters(desiredIOTune), libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to update the I/O limits of disk %s", disk.Alias.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+// syncCDRomMedia changes the media in the trays of the CD-ROMs of the running domain
+func syncCDRomMedia(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	currentCDRoms := map[string]api.Disk{}
+	for _, disk := range spec.Devices.Disks {
+		if disk.Device != "cdrom" || disk.Alias == nil {
+			continue
+		}
+		currentCDRoms[disk.Alias.GetName()] = disk
+	}
+
+	for _, disk := range domain.Spec.Devices.Disks {
+		if disk.Device != "cdrom" || disk.Alias == nil {
+			continue
+		}
+		currentCDRom, attached := currentCDRoms[disk.Alias.GetName()]
+		if !attached || getSourceFile(currentCDRom) == getSourceFile(disk) {
+			continue
+		}
+		if source := getSourceFile(disk); source != "" {
+			ready, err := checkIfDiskReadyToUse(source)
+			if err != nil {
+				return err
+			}
+			if !ready {
+				continue
+			}
+		}
+
+		// Only the media can be changed, the rest of the CD-ROM is kept as it is
+		cdrom := currentCDRom
+		cdrom.Type = disk.Type
+		cdrom.Source = disk.Source
+		cdrom.BackingStore = nil
+		cdromBytes, err := xml.Marshal(cdrom)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("marshalling CD-ROM failed")
+			return err
+		}
+		log.Log.Object(vmi).V(1).Infof("Changing the media of CD-ROM %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		if err := dom.UpdateDeviceFlags(string(cdromBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to change the media of CD-ROM %s", disk.Alias.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+func ioTuneOrEmpty(ioTune *api.DiskIOTune) api.DiskIOTune {
+	if ioTune == nil {
+		return api.DiskIOTune{}
+	}
+	return *ioTune
+}
+
+// blockIoTuneParameters sets every limit, limits which are not specified are passed as zero to remove them
+func blockIoTuneParameters(ioTune api.DiskIOTune) *libvirt.DomainBlockIoTuneParameters {
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: true,
+		TotalBytesSecMaxLength:    ioTune.TotalBytesSecMaxLength,
+		ReadBytesSecMaxLengthSet:  true,
+		ReadBytesSecMaxLength:     ioTune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: true,
+		WriteBytesSecMaxLength:    ioTune.WriteBytesSecMaxLength,
+		TotalIopsSecMaxLengthSet:  true,
+		TotalIopsSecMaxLength:     ioTune.TotalIopsSecMaxLength,
+		ReadIopsSecMaxLengthSet:   true,
+		ReadIopsSecMaxLength:      ioTune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  true,
+		WriteIopsSecMaxLength:     ioTune.WriteIopsSecMaxLength,
+	}
+}
+
 func (l *LibvirtDomainManager) syncDiskHotplug(
 	domain *api.Domain,
 	spec *api.DomainSpec,
@@ -1399,7 +1535,8 @@ func getDetachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	}
 	res := make([]api.Disk, 0)
 	for _, oldDisk := range oldDisks {
-		if !isHotplugDisk(oldDisk) {
+		// The media of CD-ROMs is changed without detaching them
+		if !isHotplugDisk(oldDisk) || oldDisk.Device == "cdrom" {
 			continue
 		}
 		if _, ok := newDiskMap[getSourceFile(oldDisk)]; !ok {
@@ -1420,7 +1557,8 @@ func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	}
 	res := make([]api.Disk, 0)
 	for _, newDisk := range newDisks {
-		if !isHotplugDisk(newDisk) {
+		// The media of CD-ROMs is changed without attaching them
+		if !isHotplugDisk(newDisk) || newDisk.Device == "cdrom" {
 			continue
 		}
 		if _, ok := oldDiskMap[getSourceFile(newDisk)]; !ok {
@@ -1560,7 +1698,11 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	checksum := ""
+	err = coreDump(dom, vmi, dumpPath)
+	if err == nil {
+		checksum, err = memoryDumpChecksum(dumpPath)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -1568,10 +1710,47 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 		logger.Infof("Completed memory dump successfully")
 	}
 
-	l.setMemoryDumpResult(failed, reason)
+	l.setMemoryDumpResult(failed, reason, checksum)
 	return err
 }
 
+func coreDump(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, dumpPath string) error {
+	format := libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump == nil {
+			continue
+		}
+		switch volume.MemoryDump.Format {
+		case "", v1.MemoryDumpFormatELF:
+			// the raw format of libvirt is an ELF core file
+			format = libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW
+		case v1.MemoryDumpFormatKdumpZlib:
+			format = libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB
+		case v1.MemoryDumpFormatKdumpLzo:
+			format = libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO
+		case v1.MemoryDumpFormatKdumpSnappy:
+			format = libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY
+		default:
+			return fmt.Errorf("unsupported memory dump format %s", volume.MemoryDump.Format)
+		}
+	}
+	return dom.CoreDumpWithFormat(dumpPath, format, libvirt.DUMP_MEMORY_ONLY)
+}
+
+func memoryDumpChecksum(dumpPath string) (string, error) {
+	f, err := os.Open(dumpPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open memory dump to compute its checksum: %v", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to compute memory dump checksum: %v", err)
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
 func (l *LibvirtDomainManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := l.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -1593,7 +1772,7 @@ func (l *LibvirtDomainManager) initializeMemoryDumpMetadata(dumpPath string) {
 	log.Log.V(4).Infof("initialize memory dump metadata: %s", l.metadataCache.MemoryDump.String())
 }
 
-func (l *LibvirtDomainManager) setMemoryDumpResult(failed bool, reason string) {
+func (l *LibvirtDomainManager) setMemoryDumpResult(failed bool, reason, checksum string) {
 	l.metadataCache.MemoryDump.WithSafeBlock(func(memoryDumpMetadata *api.MemoryDumpMetadata, initialized bool) {
 		if !initialized {
 			// nothing to report if memory dump metadata is empty
@@ -1605,6 +1784,7 @@ func (l *LibvirtDomainManager) setMemoryDumpResult(failed bool, reason string) {
 		memoryDumpMetadata.EndTimestamp = &now
 		memoryDumpMetadata.Failed = failed
 		memoryDumpMetadata.FailureReason = reason
+		memoryDumpMetadata.Checksum = checksum
 	})
 	log.Log.V(4).Infof("set memory dump results in metadata: %s", l.metadataCache.MemoryDump.String())
 	return
@@ -2209,6 +2389,27 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 	return guestInfo
 }
 
+// getGuestLoad returns the load averages the Guest Agent reported
+func (l *LibvirtDomainManager) getGuestLoad() *stats.DomainStatsLoad {
+	if l.agentData == nil {
+		return nil
+	}
+
+	load := l.agentData.GetLoad()
+	if load == nil {
+		return nil
+	}
+
+	return &stats.DomainStatsLoad{
+		Load1Set:  true,
+		Load1:     load.Load1,
+		Load5Set:  true,
+		Load5:     load.Load5,
+		Load15Set: true,
+		Load15:    load.Load15,
+	}
+}
+
 // InterfacesStatus returns the interfaces Guest Agent reported
 func (l *LibvirtDomainManager) InterfacesStatus() []api.InterfaceStatus {
 	return l.agentData.GetInterfaceStatus()
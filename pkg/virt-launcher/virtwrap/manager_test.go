@@ -647,21 +647,61 @@ var _ = Describe("Manager", func() {
 			time.Sleep(unfreezeTimeout + 2*time.Second)
 		})
 		It("should update domain with memory dump info when completed successfully", func() {
+			dumpPath := filepath.Join(GinkgoT().TempDir(), "vol1.memory.dump")
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
-			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+			mockDomain.EXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).DoAndReturn(
+				func(to string, _ libvirt.DomainCoreDumpFormat, _ libvirt.DomainCoreDumpFlags) error {
+					return os.WriteFile(to, []byte("memory"), 0644)
+				})
 
 			manager, _ := newLibvirtDomainManagerDefault()
 
 			vmi := newVMI(testNamespace, testVmName)
-			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
 			// Expect extra call to memory dump not to impact
-			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
 
 			Eventually(func() bool {
 				memoryDump, _ := metadataCache.MemoryDump.Load()
 				return memoryDump.Completed
 			}, 5*time.Second, 2).Should(BeTrue())
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			Expect(memoryDump.Failed).To(BeFalse())
+			Expect(memoryDump.Checksum).To(Equal(fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("memory")))))
 		})
+		DescribeTable("should dump the memory in the format of the memory dump volume", func(format v1.MemoryDumpFormat, expectedFormat libvirt.DomainCoreDumpFormat) {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, expectedFormat, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "vol1",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "vol1",
+							},
+							Hotpluggable: true,
+						},
+						Format: format,
+					},
+				},
+			})
+			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second, 2).Should(BeTrue())
+		},
+			Entry("elf", v1.MemoryDumpFormatELF, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW),
+			Entry("kdump zlib", v1.MemoryDumpFormatKdumpZlib, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB),
+			Entry("kdump lzo", v1.MemoryDumpFormatKdumpLzo, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO),
+			Entry("kdump snappy", v1.MemoryDumpFormatKdumpSnappy, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY),
+		)
 		It("should skip memory dump if the same dump command already completed successfully", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Times(1).Return(nil)
@@ -696,6 +736,165 @@ var _ = Describe("Manager", func() {
 				return memoryDump.Failed
 			}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 		})
+		Context("on backup", func() {
+			var vmi *v1.VirtualMachineInstance
+			var options *cmdclient.BackupOptions
+
+			newBackupDomainXML := func() string {
+				domainSpec := api.NewMinimalDomainSpec(testDomainName)
+				domainSpec.Devices.Disks = []api.Disk{
+					{Device: "disk", Target: api.DiskTarget{Device: "vda"}, Alias: api.NewUserDefinedAlias("rootdisk"), Driver: &api.DiskDriver{Type: "qcow2"}},
+					{Device: "disk", Target: api.DiskTarget{Device: "vdb"}, Alias: api.NewUserDefinedAlias("datadisk"), Driver: &api.DiskDriver{Type: "raw"}},
+					{Device: "disk", Target: api.DiskTarget{Device: "vdc"}, Alias: api.NewUserDefinedAlias("cloudinit")},
+				}
+				domainXML, err := xml.Marshal(domainSpec)
+				Expect(err).ToNot(HaveOccurred())
+				return string(domainXML)
+			}
+
+			BeforeEach(func() {
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Spec.Volumes = []v1.Volume{
+					{
+						Name: "rootdisk",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{},
+						},
+					},
+					{
+						Name: "datadisk",
+						VolumeSource: v1.VolumeSource{
+							DataVolume: &v1.DataVolumeSource{},
+						},
+					},
+					{
+						Name: "cloudinit",
+						VolumeSource: v1.VolumeSource{
+							CloudInitNoCloud: &v1.CloudInitNoCloudSource{},
+						},
+					},
+				}
+				options = &cmdclient.BackupOptions{
+					TargetDir:      filepath.Join(GinkgoT().TempDir(), "backup-2"),
+					Checkpoint:     "backup-2",
+					BaseCheckpoint: "backup-1",
+				}
+			})
+
+			expectBackupDomain := func() {
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(newBackupDomainXML(), nil)
+			}
+
+			It("should take a full backup of the persistent volumes when the base checkpoint is missing", func() {
+				var backupXML string
+				expectBackupDomain()
+				mockDomain.EXPECT().ListAllCheckpoints(libvirt.DomainCheckpointListFlags(0)).Times(2).Return([]libvirt.DomainCheckpoint{}, nil)
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).DoAndReturn(
+					func(backup, checkpoint string, _ libvirt.DomainBackupBeginFlags) error {
+						backupXML = backup
+						Expect(checkpoint).To(BeEmpty())
+						return nil
+					})
+				mockDomain.EXPECT().GetJobInfo().Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil)
+				mockDomain.EXPECT().GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED).Return(&libvirt.DomainJobInfo{
+					Type:         libvirt.DOMAIN_JOB_COMPLETED,
+					OperationSet: true,
+					Operation:    libvirt.DOMAIN_JOB_OPERATION_BACKUP,
+				}, nil)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+				Expect(manager.BackupVMI(vmi, options)).To(Succeed())
+
+				Eventually(func() bool {
+					backup, _ := metadataCache.Backup.Load()
+					return backup.Completed
+				}, 5*time.Second).Should(BeTrue())
+				backup, _ := metadataCache.Backup.Load()
+				Expect(backup.Failed).To(BeFalse())
+				Expect(backup.Incremental).To(BeFalse())
+				Expect(backup.Checkpoint).To(Equal("backup-2"))
+				Expect(backup.UntrackedVolumes).To(Equal("datadisk"))
+				Expect(backupXML).ToNot(ContainSubstring("<incremental>"))
+				Expect(backupXML).To(ContainSubstring(filepath.Join(options.TargetDir, "rootdisk.qcow2")))
+				Expect(backupXML).To(ContainSubstring(filepath.Join(options.TargetDir, "datadisk.qcow2")))
+				Expect(backupXML).ToNot(ContainSubstring("cloudinit"))
+				Expect(options.TargetDir).To(BeADirectory())
+			})
+
+			It("should checkpoint all disks in an incremental backup of qcow2 disks", func() {
+				domSpec := &api.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(newBackupDomainXML()), domSpec)).To(Succeed())
+				domSpec.Devices.Disks[1].Driver.Type = "qcow2"
+
+				backupXML, checkpointXML, untrackedVolumes, err := backupDefinitions(domSpec, vmi, options, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(untrackedVolumes).To(BeEmpty())
+				Expect(backupXML).To(ContainSubstring("<incremental>backup-1</incremental>"))
+				Expect(backupXML).To(ContainSubstring(`<disk name="vda" backup="yes" type="file">`))
+				Expect(backupXML).To(ContainSubstring(`<disk name="vdb" backup="yes" type="file">`))
+				Expect(checkpointXML).To(ContainSubstring(`<disk name="vda" checkpoint="bitmap"></disk>`))
+				Expect(checkpointXML).To(ContainSubstring(`<disk name="vdb" checkpoint="bitmap"></disk>`))
+			})
+
+			It("should not create a checkpoint and report the disks which cannot hold a dirty bitmap", func() {
+				domSpec := &api.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(newBackupDomainXML()), domSpec)).To(Succeed())
+
+				backupXML, checkpointXML, untrackedVolumes, err := backupDefinitions(domSpec, vmi, options, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(backupXML).To(ContainSubstring(filepath.Join(options.TargetDir, "rootdisk.qcow2")))
+				Expect(backupXML).To(ContainSubstring(filepath.Join(options.TargetDir, "datadisk.qcow2")))
+				Expect(checkpointXML).To(BeEmpty())
+				Expect(untrackedVolumes).To(ConsistOf("datadisk"))
+			})
+
+			It("should reject an incremental backup of disks which cannot hold a dirty bitmap", func() {
+				domSpec := &api.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(newBackupDomainXML()), domSpec)).To(Succeed())
+
+				_, _, _, err := backupDefinitions(domSpec, vmi, options, true)
+				Expect(err).To(MatchError(ContainSubstring("incremental backup rejected, the volumes datadisk are raw images")))
+			})
+
+			It("should back the incremental images with the images of the base backup", func() {
+				domSpec := &api.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(newBackupDomainXML()), domSpec)).To(Succeed())
+				domSpec.Devices.Disks[1].Driver.Type = "qcow2"
+				backupXML, _, _, err := backupDefinitions(domSpec, vmi, options, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				rebased := map[string]string{}
+				origRebaseBackupImage := rebaseBackupImage
+				DeferCleanup(func() { rebaseBackupImage = origRebaseBackupImage })
+				rebaseBackupImage = func(image, backingFile string) error {
+					rebased[image] = backingFile
+					return nil
+				}
+
+				Expect(chainIncrementalBackup(backupXML, options)).To(Succeed())
+				Expect(rebased).To(Equal(map[string]string{
+					filepath.Join(options.TargetDir, "rootdisk.qcow2"): "../backup-1/rootdisk.qcow2",
+					filepath.Join(options.TargetDir, "datadisk.qcow2"): "../backup-1/datadisk.qcow2",
+				}))
+			})
+
+			It("should update domain with backup info if the backup failed", func() {
+				expectBackupDomain()
+				mockDomain.EXPECT().ListAllCheckpoints(libvirt.DomainCheckpointListFlags(0)).Return([]libvirt.DomainCheckpoint{}, nil)
+				mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).Return(fmt.Errorf("backup failed"))
+
+				manager, _ := newLibvirtDomainManagerDefault()
+				Expect(manager.BackupVMI(vmi, options)).To(Succeed())
+
+				Eventually(func() bool {
+					backup, _ := metadataCache.Backup.Load()
+					return backup.Failed
+				}, 5*time.Second).Should(BeTrue(), "failed backup result wasn't set")
+				backup, _ := metadataCache.Backup.Load()
+				Expect(backup.FailureReason).To(ContainSubstring("backup failed"))
+			})
+		})
 		It("should pause a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1490,6 +1689,222 @@ var _ = Describe("Manager", func() {
 		})
 	})
 	Context("test migration monitor", func() {
+		var dirtyRate *libvirt.DomainStatsDirtyRate
+
+		BeforeEach(func() {
+			dirtyRate = &libvirt.DomainStatsDirtyRate{
+				CalcStatusSet:         true,
+				CalcStatus:            int(libvirt.DOMAIN_DIRTYRATE_MEASURING),
+				MegabytesPerSecondSet: true,
+			}
+			mockConn.EXPECT().GetAllDomainStats(libvirt.DOMAIN_STATS_DIRTYRATE, libvirt.ConnectGetAllDomainStatsFlags(0)).AnyTimes().DoAndReturn(
+				func(_ libvirt.DomainStatsTypes, _ libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
+					return []libvirt.DomainStats{{DirtyRate: dirtyRate}}, nil
+				})
+		})
+
+		It("migration should report its convergence", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+			dirtyRate.CalcStatus = int(libvirt.DOMAIN_DIRTYRATE_MEASURED)
+			dirtyRate.MegabytesPerSecond = 16
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+			calcStart := metav1.NewTime(time.Now().Add(-dirtyRateCalcPeriodSeconds * time.Second))
+			metadataCache.Migration.Store(api.MigrationMetadata{UID: "111222333", DirtyRateCalcStartTimestamp: &calcStart})
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+				cpuSetGetter:  fakeCpuSetGetter,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			jobStatsCalls := 0
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(_ libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+				jobStatsCalls++
+				if jobStatsCalls > 5 {
+					return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil
+				}
+				return &libvirt.DomainJobInfo{
+					Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+					DataRemaining:    48 * 1024 * 1024,
+					DataRemainingSet: true,
+					MemBps:           32 * 1024 * 1024,
+					MemBpsSet:        true,
+				}, nil
+			})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Convergence).ToNot(BeNil())
+			Expect(migration.Convergence.DirtyRate).To(BeEquivalentTo(16 * 1024 * 1024))
+			Expect(migration.Convergence.TransferRate).To(BeEquivalentTo(32 * 1024 * 1024))
+			Expect(migration.Convergence.Converging).To(HaveValue(BeTrue()))
+			Expect(migration.Convergence.PredictedCompletionTimestamp.Sub(migration.Convergence.MeasurementTimestamp.Time)).To(Equal(3 * time.Second))
+		})
+
+		It("migration should report its convergence from the dirty rate of the job statistics", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+				cpuSetGetter:  fakeCpuSetGetter,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			jobStatsCalls := 0
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(_ libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+				jobStatsCalls++
+				if jobStatsCalls > 5 {
+					return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil
+				}
+				return &libvirt.DomainJobInfo{
+					Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+					DataRemaining:    56 * 1024 * 1024,
+					DataRemainingSet: true,
+					MemBps:           32 * 1024 * 1024,
+					MemBpsSet:        true,
+					MemDirtyRate:     1024,
+					MemDirtyRateSet:  true,
+					MemPageSize:      4096,
+					MemPageSizeSet:   true,
+				}, nil
+			})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Convergence).ToNot(BeNil())
+			Expect(migration.Convergence.DirtyRate).To(BeEquivalentTo(4 * 1024 * 1024))
+			Expect(migration.Convergence.Converging).To(HaveValue(BeTrue()))
+			Expect(migration.Convergence.PredictedCompletionTimestamp.Sub(migration.Convergence.MeasurementTimestamp.Time)).To(Equal(2 * time.Second))
+		})
+
+		It("migration should report the transferred data, the measured downtime and setup time", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+				cpuSetGetter:  fakeCpuSetGetter,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			jobStatsCalls := 0
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(_ libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+				jobStatsCalls++
+				if jobStatsCalls > 2 {
+					return &libvirt.DomainJobInfo{
+						Type:             libvirt.DOMAIN_JOB_COMPLETED,
+						DataProcessed:    2 * 1024 * 1024,
+						DataProcessedSet: true,
+						Downtime:         30,
+						DowntimeSet:      true,
+						SetupTime:        5,
+						SetupTimeSet:     true,
+					}, nil
+				}
+				// the downtime of a running job is the expected downtime
+				return &libvirt.DomainJobInfo{
+					Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+					DataProcessed:    1024 * 1024,
+					DataProcessedSet: true,
+					Downtime:         500,
+					DowntimeSet:      true,
+				}, nil
+			})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Completed).To(BeTrue())
+			Expect(migration.DataProcessed).To(BeEquivalentTo(2 * 1024 * 1024))
+			Expect(migration.Downtime).To(BeEquivalentTo(30))
+			Expect(migration.SetupTime).To(BeEquivalentTo(5))
+		})
+
+		It("migration should not report the transferred data of every poll of a running job", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 300,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+				cpuSetGetter:  fakeCpuSetGetter,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			jobStatsCalls := 0
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(_ libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+				jobStatsCalls++
+				if jobStatsCalls > 2 {
+					return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil
+				}
+				return &libvirt.DomainJobInfo{
+					Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+					DataProcessed:    1024 * 1024,
+					DataProcessedSet: true,
+				}, nil
+			})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Completed).To(BeTrue())
+			Expect(migration.DataProcessed).To(BeZero())
+		})
+
 		It("migration should be canceled if it's not progressing", func() {
 			migrationErrorChan := make(chan error)
 			defer close(migrationErrorChan)
@@ -1947,6 +2362,365 @@ var _ = Describe("Manager", func() {
 
 	})
 
+	Context("migration switchover policy", func() {
+		var monitor *migrationMonitor
+		var stats *libvirt.DomainJobInfo
+
+		newSwitchoverMonitor := func(steps ...v1.MigrationSwitchoverStep) *migrationMonitor {
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:        resource.MustParse("64Mi"),
+				ProgressTimeout:  150,
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: steps},
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			manager := &LibvirtDomainManager{
+				paused: pausedVMIs{
+					paused: make(map[types.UID]bool),
+				},
+				virConn:       mockConn,
+				metadataCache: metadataCache,
+			}
+			return newMigrationMonitor(vmi, manager, options, make(chan error))
+		}
+
+		// runFor pretends the migration started the given time ago
+		runFor := func(elapsed time.Duration) {
+			monitor.start = time.Now().UTC().UnixNano() - elapsed.Nanoseconds()
+		}
+
+		BeforeEach(func() {
+			stats = &libvirt.DomainJobInfo{
+				Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+				DataRemaining:    1024,
+				DataRemainingSet: true,
+			}
+		})
+
+		It("should take the steps one after the other once they are due", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverRaiseDowntime, After: &metav1.Duration{Duration: time.Minute}, MaxDowntime: &metav1.Duration{Duration: 2 * time.Second}},
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: 3 * time.Minute}},
+			)
+
+			runFor(30 * time.Second)
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+
+			mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(2000), uint32(0)).Return(nil)
+			runFor(2 * time.Minute)
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+
+			mockDomain.EXPECT().MigrateStartPostCopy(uint32(0)).Return(nil)
+			runFor(4 * time.Minute)
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+			Expect(monitor.isMigrationPostCopy()).To(BeTrue())
+		})
+
+		It("should retry a step which failed", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: time.Minute}},
+			)
+			runFor(2 * time.Minute)
+
+			gomock.InOrder(
+				mockDomain.EXPECT().MigrateStartPostCopy(uint32(0)).Return(libvirt.Error{Code: libvirt.ERR_OPERATION_INVALID}),
+				mockDomain.EXPECT().MigrateStartPostCopy(uint32(0)).Return(nil),
+			)
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+			Expect(monitor.isMigrationPostCopy()).To(BeFalse())
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+			Expect(monitor.isMigrationPostCopy()).To(BeTrue())
+		})
+
+		It("should take a step once the migration stalled", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverAbort, StalledFor: &metav1.Duration{Duration: 30 * time.Second}},
+			)
+			runFor(2 * time.Minute)
+			monitor.remainingData = stats.DataRemaining
+			monitor.progressWatermark = stats.DataRemaining
+			monitor.lastProgressUpdate = time.Now().UTC().UnixNano() - time.Minute.Nanoseconds()
+
+			mockDomain.EXPECT().AbortJob().Return(nil)
+			aborted := monitor.processInflightMigration(mockDomain, stats)
+			Expect(aborted).ToNot(BeNil())
+			Expect(aborted.abortStatus).To(Equal(v1.MigrationAbortSucceeded))
+			Expect(aborted.message).To(ContainSubstring("aborted by the switchover policy"))
+		})
+
+		It("should leave the completion timeout to a policy with an abort step", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: 10 * time.Minute}},
+			)
+			monitor.options.AllowWorkloadDisruption = true
+			monitor.acceptableCompletionTime = 1
+			runFor(time.Minute)
+
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+		})
+
+		It("should pause the guest once a migration following a policy without an abort step timed out", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverRaiseDowntime, After: &metav1.Duration{Duration: 10 * time.Minute}, MaxDowntime: &metav1.Duration{Duration: 2 * time.Second}},
+			)
+			monitor.options.AllowWorkloadDisruption = true
+			monitor.acceptableCompletionTime = 1
+			runFor(time.Minute)
+
+			mockDomain.EXPECT().Suspend().Return(nil)
+			Expect(monitor.processInflightMigration(mockDomain, stats)).To(BeNil())
+			Expect(monitor.isPausedMigration()).To(BeTrue())
+		})
+
+		It("should abort a migration following a policy without an abort step once it timed out", func() {
+			monitor = newSwitchoverMonitor(
+				v1.MigrationSwitchoverStep{Action: v1.MigrationSwitchoverRaiseDowntime, After: &metav1.Duration{Duration: 10 * time.Minute}, MaxDowntime: &metav1.Duration{Duration: 2 * time.Second}},
+			)
+			monitor.acceptableCompletionTime = 1
+			runFor(time.Minute)
+
+			mockDomain.EXPECT().AbortJob().Return(nil)
+			aborted := monitor.processInflightMigration(mockDomain, stats)
+			Expect(aborted).ToNot(BeNil())
+			Expect(aborted.message).To(ContainSubstring("is not completed after 1 seconds"))
+		})
+
+		It("should set the maximum downtime once", func() {
+			monitor = newSwitchoverMonitor()
+			monitor.options.MaxDowntime = &metav1.Duration{Duration: 500 * time.Millisecond}
+
+			mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(500), uint32(0)).Return(nil)
+			monitor.applyMaxDowntime(mockDomain)
+			monitor.applyMaxDowntime(mockDomain)
+		})
+
+		It("should start the migration with post-copy enabled by a post-copy step", func() {
+			options := &cmdclient.MigrationOptions{
+				ParallelMigrationThreads: virtpointer.P(uint(8)),
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: time.Minute}},
+				}},
+			}
+			flags := generateMigrationFlags(false, false, options)
+			Expect(flags & libvirt.MIGRATE_POSTCOPY).To(Equal(libvirt.MIGRATE_POSTCOPY))
+		})
+
+		It("should not configure parallel migration with a post-copy step", func() {
+			options := &cmdclient.MigrationOptions{
+				ParallelMigrationThreads: virtpointer.P(uint(8)),
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: time.Minute}},
+				}},
+			}
+			shouldConfigure, _ := shouldConfigureParallelMigration(options)
+			Expect(shouldConfigure).To(BeFalse())
+		})
+	})
+
+	Context("local volumes copy", func() {
+		var vmi *v1.VirtualMachineInstance
+		var options *cmdclient.MigrationOptions
+
+		BeforeEach(func() {
+			vmi = newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testblock",
+						}},
+					},
+				},
+				{
+					Name: "myvolume1",
+					VolumeSource: v1.VolumeSource{
+						Ephemeral: &v1.EphemeralVolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "testclaim",
+							},
+						},
+					},
+				},
+			}
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{VolumeName: "myvolume"}}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+				Name:                      "myvolume",
+				PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "testblock", StorageClassName: "local-nvme"},
+			}}
+			options = &cmdclient.MigrationOptions{
+				Bandwidth: resource.MustParse("64Mi"),
+				LocalStorage: &v1.LocalStorageMigration{
+					Bandwidth: virtpointer.P(resource.MustParse("32Mi")),
+					StorageClasses: []v1.LocalStorageMigrationClass{{
+						StorageClassName: "local-nvme",
+						Bandwidth:        virtpointer.P(resource.MustParse("16Mi")),
+					}},
+				},
+			}
+		})
+
+		DescribeTable("should resolve the copy bandwidth of a volume", func(volumeName string, expectedBandwidth uint64) {
+			Expect(volumeCopyBandwidth(vmi, options, volumeName)).To(Equal(expectedBandwidth))
+		},
+			Entry("from its storage class", "myvolume", uint64(16*1024*1024)),
+			Entry("from the local storage migration without storage class", "myvolume1", uint64(32*1024*1024)),
+		)
+
+		It("should not limit the copy without local storage migration", func() {
+			options.LocalStorage = nil
+			Expect(volumeCopyBandwidth(vmi, options, "myvolume")).To(BeZero())
+			Expect(localStorageMigrationBandwidth(vmi, options, nil)).To(BeZero())
+		})
+
+		It("should use the lowest bandwidth of the copied volumes", func() {
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+			Expect(localStorageMigrationBandwidth(vmi, options, getDisksForMigration(mockDomain, vmi))).To(Equal(uint64(16 * 1024 * 1024)))
+		})
+
+		It("should report the copy progress of the volumes and raise the bandwidth once the memory is sent", func() {
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				metadataCache: metadataCache,
+			}
+			monitor := newMigrationMonitor(vmi, manager, options, make(chan error))
+
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+			gomock.InOrder(
+				mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(64), libvirt.DomainMigrateMaxSpeedFlags(0)).Return(libvirt.Error{Code: libvirt.ERR_OPERATION_INVALID}),
+				mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(64), libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil),
+			)
+			// the copy jobs of the volumes by target, a volume without job fails to report its progress
+			copyJobs := map[string]*libvirt.DomainBlockJobInfo{}
+			mockDomain.EXPECT().GetBlockJobInfo(gomock.Any(), libvirt.DomainBlockJobInfoFlags(0)).AnyTimes().DoAndReturn(
+				func(disk string, _ libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+					if job, exists := copyJobs[disk]; exists {
+						return job, nil
+					}
+					return nil, libvirt.Error{Code: libvirt.ERR_OPERATION_INVALID}
+				})
+			expectTransferred := func(transferred uint64, volumes ...api.MigrationLocalVolumeMetadata) {
+				migration, _ := metadataCache.Migration.Load()
+				Expect(migration.LocalVolumes).To(Equal(&api.MigrationLocalVolumesMetadata{
+					Transferred: transferred,
+					Total:       1024,
+					Bandwidth:   16 * 1024 * 1024,
+					Volumes:     volumes,
+				}))
+			}
+			diskStats := func(processed, memProcessed uint64) *libvirt.DomainJobInfo {
+				return &libvirt.DomainJobInfo{
+					DiskTotalSet:     true,
+					DiskTotal:        1024,
+					DiskProcessedSet: true,
+					DiskProcessed:    processed,
+					MemProcessedSet:  true,
+					MemProcessed:     memProcessed,
+				}
+			}
+
+			// the copy did not start yet
+			monitor.updateLocalVolumesCopy(mockDomain, &libvirt.DomainJobInfo{})
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.LocalVolumes).To(BeNil())
+
+			copyJobs["vda"] = &libvirt.DomainBlockJobInfo{Cur: 512, End: 512}
+			copyJobs["vdc"] = &libvirt.DomainBlockJobInfo{}
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(512, 0))
+			expectTransferred(512, api.MigrationLocalVolumeMetadata{Name: "myvolume", Transferred: 512, Total: 512})
+
+			// less than a step of progress
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(530, 0))
+			expectTransferred(512, api.MigrationLocalVolumeMetadata{Name: "myvolume", Transferred: 512, Total: 512})
+
+			// the copy of a volume starts
+			copyJobs["vdb"] = &libvirt.DomainBlockJobInfo{Cur: 18, End: 256}
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(530, 0))
+			expectTransferred(530,
+				api.MigrationLocalVolumeMetadata{Name: "myvolume", Transferred: 512, Total: 512},
+				api.MigrationLocalVolumeMetadata{Name: "myvolume1", Transferred: 18, Total: 256},
+			)
+
+			// the copy of a volume advances by a step
+			copyJobs["vdb"] = &libvirt.DomainBlockJobInfo{Cur: 64, End: 256}
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(540, 0))
+			expectTransferred(540,
+				api.MigrationLocalVolumeMetadata{Name: "myvolume", Transferred: 512, Total: 512},
+				api.MigrationLocalVolumeMetadata{Name: "myvolume1", Transferred: 64, Total: 256},
+			)
+
+			// the copy jobs are gone once the volumes are copied, the volumes keep their last progress
+			delete(copyJobs, "vda")
+			copyJobs["vdb"] = &libvirt.DomainBlockJobInfo{Cur: 256, End: 256}
+			copyJobs["vdc"] = &libvirt.DomainBlockJobInfo{Cur: 256, End: 256}
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(1024, 4096))
+			expectTransferred(1024,
+				api.MigrationLocalVolumeMetadata{Name: "myvolume", Transferred: 512, Total: 512},
+				api.MigrationLocalVolumeMetadata{Name: "myvolume1", Transferred: 256, Total: 256},
+				api.MigrationLocalVolumeMetadata{Name: "myvolumehost", Transferred: 256, Total: 256},
+			)
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(1024, 8192))
+			monitor.updateLocalVolumesCopy(mockDomain, diskStats(1024, 16384))
+		})
+
+		DescribeTable("should copy independently of the migration bandwidth", func(migrationBandwidth string) {
+			options.Bandwidth = resource.MustParse(migrationBandwidth)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+			Expect(localStorageCopyBandwidth(vmi, options, getDisksForMigration(mockDomain, vmi))).To(Equal(uint64(16)))
+		},
+			Entry("with a higher migration bandwidth", "64Mi"),
+			Entry("with a lower migration bandwidth", "8Mi"),
+			Entry("without migration bandwidth", "0"),
+		)
+
+		DescribeTable("should set the migration bandwidth once the memory is sent", func(migrationBandwidth string, expectedBandwidth uint64) {
+			options.Bandwidth = resource.MustParse(migrationBandwidth)
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				metadataCache: metadataCache,
+			}
+			monitor := newMigrationMonitor(vmi, manager, options, make(chan error))
+
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+			mockDomain.EXPECT().MigrateSetMaxSpeed(expectedBandwidth, libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil)
+			mockDomain.EXPECT().GetBlockJobInfo(gomock.Any(), libvirt.DomainBlockJobInfoFlags(0)).AnyTimes().Return(&libvirt.DomainBlockJobInfo{}, nil)
+			monitor.updateLocalVolumesCopy(mockDomain, &libvirt.DomainJobInfo{
+				DiskTotalSet:     true,
+				DiskTotal:        1024,
+				DiskProcessedSet: true,
+				DiskProcessed:    1024,
+				MemProcessedSet:  true,
+				MemProcessed:     4096,
+			})
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.LocalVolumes.Bandwidth).To(BeEquivalentTo(16 * 1024 * 1024))
+		},
+			Entry("lower than the copy bandwidth", "8Mi", uint64(8)),
+			Entry("without limit", "0", uint64(maxMigrationBandwidthMiB)),
+		)
+
+		It("should not change the bandwidth once the memory is sent if the migration bandwidth is the copy bandwidth", func() {
+			options.Bandwidth = resource.MustParse("16Mi")
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				metadataCache: metadataCache,
+			}
+			monitor := newMigrationMonitor(vmi, manager, options, make(chan error))
+
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+			mockDomain.EXPECT().MigrateSetMaxSpeed(gomock.Any(), gomock.Any()).Times(0)
+			mockDomain.EXPECT().GetBlockJobInfo(gomock.Any(), libvirt.DomainBlockJobInfoFlags(0)).AnyTimes().Return(&libvirt.DomainBlockJobInfo{}, nil)
+			monitor.updateLocalVolumesCopy(mockDomain, &libvirt.DomainJobInfo{
+				DiskTotalSet:     true,
+				DiskTotal:        1024,
+				DiskProcessedSet: true,
+				DiskProcessed:    1024,
+				MemProcessedSet:  true,
+				MemProcessed:     4096,
+			})
+		})
+	})
+
 	Context("on successful VirtualMachineInstance migrate", func() {
 		funcPreviousValue := ip.GetLoopbackAddress
 
@@ -1996,6 +2770,7 @@ var _ = Describe("Manager", func() {
 			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(fake_jobinfo, nil)
 			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXml), nil)
 
+			mockDomain.EXPECT().StartDirtyRateCalc(dirtyRateCalcPeriodSeconds, libvirt.DomainDirtyRateCalcFlags(0)).Return(nil)
 			mockDomain.EXPECT().MigrateToURI3(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("MigrationFailed"))
 			options := &cmdclient.MigrationOptions{
 				Bandwidth:               resource.MustParse("64Mi"),
@@ -2009,6 +2784,8 @@ var _ = Describe("Manager", func() {
 				migration, _ = metadataCache.Migration.Load()
 				return migration.Failed
 			}, 5*time.Second, 2).Should(BeTrue(), fmt.Sprintf("failed migration result wasn't set [%+v]", migration))
+			// the dirty rate is measured before the migration starts
+			Expect(migration.DirtyRateCalcStartTimestamp).ToNot(BeNil())
 		})
 
 		It("should detect inprogress migration job", func() {
@@ -2207,6 +2984,28 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(domStats).ToNot(BeNil())
 		})
+
+		It("should include the guest load reported by the guest agent", func() {
+			fakeDomainStats := []*stats.DomainStats{
+				{},
+			}
+			mockConn.EXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeDomainStats, nil)
+
+			agentStore := agentpoller.NewAsyncAgentStore()
+			agentStore.Store(agentpoller.GET_LOAD, agentpoller.Load{Load1: 0.5, Load5: 1, Load15: 1.5})
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, &agentStore, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes, fakeCpuSetGetter)
+			domStats, err := manager.GetDomainStats()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats.Load).To(Equal(&stats.DomainStatsLoad{
+				Load1Set:  true,
+				Load1:     0.5,
+				Load5Set:  true,
+				Load5:     1,
+				Load15Set: true,
+				Load15:    1.5,
+			}))
+		})
 	})
 
 	Context("on failed GetDomainSpecWithRuntimeInfo", func() {
@@ -2682,6 +3481,69 @@ var _ = Describe("getDetachedDisks", func() {
 			[]api.Disk{}),
 	)
 })
+
+var _ = Describe("syncDiskIOTune", func() {
+	var ctrl *gomock.Controller
+	var mockDomain *cli.MockVirDomain
+	var vmi *v1.VirtualMachineInstance
+
+	newDomainSpec := func(ioTunes ...*api.DiskIOTune) *api.DomainSpec {
+		spec := &api.DomainSpec{}
+		for i, ioTune := range ioTunes {
+			spec.Devices.Disks = append(spec.Devices.Disks, api.Disk{
+				Alias:  api.NewUserDefinedAlias(fmt.Sprintf("disk%d", i)),
+				Target: api.DiskTarget{Device: fmt.Sprintf("vd%c", 'a'+i)},
+				IOTune: ioTune,
+			})
+		}
+		return spec
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		vmi = api2.NewMinimalVMI("testvmi")
+	})
+
+	It("should not update disks with unchanged I/O limits", func() {
+		ioTune := &api.DiskIOTune{ReadIopsSec: 100}
+		domain := &api.Domain{Spec: *newDomainSpec(nil, ioTune)}
+		Expect(syncDiskIOTune(domain, newDomainSpec(nil, ioTune.DeepCopy()), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should not update disks which are not attached yet", func() {
+		domain := &api.Domain{Spec: *newDomainSpec(nil, &api.DiskIOTune{ReadIopsSec: 100})}
+		Expect(syncDiskIOTune(domain, newDomainSpec(nil), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should set the changed I/O limits on the running domain", func() {
+		domain := &api.Domain{Spec: *newDomainSpec(nil, &api.DiskIOTune{TotalIopsSec: 100, TotalIopsSecMax: 200, TotalIopsSecMaxLength: 10})}
+		mockDomain.EXPECT().SetBlockIoTune("vdb", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.TotalIopsSecSet).To(BeTrue())
+				Expect(params.TotalIopsSec).To(Equal(uint64(100)))
+				Expect(params.TotalIopsSecMax).To(Equal(uint64(200)))
+				Expect(params.TotalIopsSecMaxLength).To(Equal(uint64(10)))
+				Expect(params.ReadIopsSecSet).To(BeTrue())
+				Expect(params.ReadIopsSec).To(BeZero())
+				return nil
+			})
+		Expect(syncDiskIOTune(domain, newDomainSpec(nil, &api.DiskIOTune{ReadIopsSec: 100}), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should remove the I/O limits from the running domain", func() {
+		domain := &api.Domain{Spec: *newDomainSpec(nil)}
+		mockDomain.EXPECT().SetBlockIoTune("vda", blockIoTuneParameters(api.DiskIOTune{}), libvirt.DOMAIN_AFFECT_LIVE).Return(nil)
+		Expect(syncDiskIOTune(domain, newDomainSpec(&api.DiskIOTune{ReadBytesSec: 1024}), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should fail if the I/O limits can't be set", func() {
+		domain := &api.Domain{Spec: *newDomainSpec(&api.DiskIOTune{ReadBytesSec: 1024})}
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).Return(fmt.Errorf("failure"))
+		Expect(syncDiskIOTune(domain, newDomainSpec(nil), mockDomain, vmi)).ToNot(Succeed())
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockDomain *cli.MockVirDomain
@@ -3193,6 +4055,57 @@ var _ = Describe("Manager helper functions", func() {
 		})
 	})
 
+	Context("migration compression", func() {
+		DescribeTable("should configure", func(options *cmdclient.MigrationOptions, expectedParams libvirt.DomainMigrateParameters) {
+			params := libvirt.DomainMigrateParameters{}
+			configureMigrationCompression(&params, options)
+			Expect(params).To(Equal(expectedParams))
+
+			flags := generateMigrationFlags(false, false, options)
+			Expect(flags&libvirt.MIGRATE_COMPRESSED != 0).To(Equal(expectedParams.CompressionSet))
+		},
+			Entry("nothing without compression", &cmdclient.MigrationOptions{}, libvirt.DomainMigrateParameters{}),
+			Entry("nothing with none mode",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionNone}},
+				libvirt.DomainMigrateParameters{},
+			),
+			Entry("xbzrle",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionXBZRLE}},
+				libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "xbzrle"},
+			),
+			Entry("xbzrle with cache size",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{
+					Mode:            v1.MigrationCompressionXBZRLE,
+					XBZRLECacheSize: virtpointer.P(resource.MustParse("256Mi")),
+				}},
+				libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "xbzrle", CompressionXBZRLECacheSet: true, CompressionXBZRLECache: 256 * 1024 * 1024},
+			),
+			Entry("xbzrle with post-copy",
+				&cmdclient.MigrationOptions{AllowPostCopy: true, Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionXBZRLE}},
+				libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "xbzrle"},
+			),
+			Entry("multifd-zstd with level",
+				&cmdclient.MigrationOptions{
+					ParallelMigrationThreads: virtpointer.P(uint(8)),
+					Compression:              &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd, ZstdLevel: virtpointer.P(int32(3))},
+				},
+				libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zstd", CompressionZstdLevelSet: true, CompressionZstdLevel: 3},
+			),
+			Entry("nothing with multifd-zstd without parallel migration threads",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd}},
+				libvirt.DomainMigrateParameters{},
+			),
+			Entry("nothing with multifd-zstd and post-copy",
+				&cmdclient.MigrationOptions{
+					AllowPostCopy:            true,
+					ParallelMigrationThreads: virtpointer.P(uint(8)),
+					Compression:              &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd},
+				},
+				libvirt.DomainMigrateParameters{},
+			),
+		)
+	})
+
 })
 
 func newVMI(namespace, name string) *v1.VirtualMachineInstance {
@@ -3227,3 +4140,79 @@ func isoCreationFunc(isoOutFile, volumeID string, inDir string) error {
 	_, err := os.Create(isoOutFile)
 	return err
 }
+
+var _ = Describe("syncCDRomMedia", func() {
+	var ctrl *gomock.Controller
+	var mockDomain *cli.MockVirDomain
+	var vmi *v1.VirtualMachineInstance
+
+	newDomainSpec := func(sources ...string) *api.DomainSpec {
+		spec := &api.DomainSpec{}
+		for i, source := range sources {
+			spec.Devices.Disks = append(spec.Devices.Disks, api.Disk{
+				Device: "cdrom",
+				Type:   "file",
+				Alias:  api.NewUserDefinedAlias(fmt.Sprintf("cdrom%d", i)),
+				Target: api.DiskTarget{Bus: v1.DiskBusSATA, Device: fmt.Sprintf("sd%c", 'a'+i)},
+				Source: api.DiskSource{File: source},
+			})
+		}
+		return spec
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		vmi = api2.NewMinimalVMI("testvmi")
+		checkIfDiskReadyToUse = func(filename string) (bool, error) {
+			return true, nil
+		}
+		DeferCleanup(func() {
+			checkIfDiskReadyToUse = checkIfDiskReadyToUseFunc
+		})
+	})
+
+	expectUpdatedSource := func(source string) {
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+			func(cdromXML string, _ libvirt.DomainDeviceModifyFlags) error {
+				cdrom := api.Disk{}
+				Expect(xml.Unmarshal([]byte(cdromXML), &cdrom)).To(Succeed())
+				Expect(cdrom.Alias.GetName()).To(Equal("cdrom0"))
+				Expect(cdrom.Target.Device).To(Equal("sda"))
+				Expect(cdrom.Source.File).To(Equal(source))
+				return nil
+			})
+	}
+
+	It("should not update CD-ROMs with an unchanged media", func() {
+		domain := &api.Domain{Spec: *newDomainSpec("/var/run/kubevirt-private/vmi-disks/iso/disk.img", "")}
+		Expect(syncCDRomMedia(domain, newDomainSpec("/var/run/kubevirt-private/vmi-disks/iso/disk.img", ""), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should eject the media from the tray", func() {
+		expectUpdatedSource("")
+		domain := &api.Domain{Spec: *newDomainSpec("")}
+		Expect(syncCDRomMedia(domain, newDomainSpec("/var/run/kubevirt-private/vmi-disks/iso/disk.img"), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should insert the media into the tray", func() {
+		source := filepath.Join(v1.HotplugDiskDir, "cdrom0.img")
+		expectUpdatedSource(source)
+		domain := &api.Domain{Spec: *newDomainSpec(source)}
+		Expect(syncCDRomMedia(domain, newDomainSpec(""), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should wait for the inserted media to be ready", func() {
+		checkIfDiskReadyToUse = func(filename string) (bool, error) {
+			return false, nil
+		}
+		domain := &api.Domain{Spec: *newDomainSpec(filepath.Join(v1.HotplugDiskDir, "cdrom0.img"))}
+		Expect(syncCDRomMedia(domain, newDomainSpec(""), mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should fail if the media can't be changed", func() {
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).Return(fmt.Errorf("failure"))
+		domain := &api.Domain{Spec: *newDomainSpec("")}
+		Expect(syncCDRomMedia(domain, newDomainSpec("/var/run/kubevirt-private/vmi-disks/iso/disk.img"), mockDomain, vmi)).ToNot(Succeed())
+	})
+})
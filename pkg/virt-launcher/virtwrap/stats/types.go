@@ -71,6 +71,8 @@ type DomainStats struct {
 	CPUMapSet bool
 	CPUMap    [][]bool
 	NrVirtCpu uint
+	// not part of the bulk stats, taken from the guest agent
+	Load *DomainStatsLoad
 }
 
 type DomainStatsCPU struct {
@@ -191,3 +193,14 @@ type DomainJobInfo struct {
 	MemDirtyRateSet  bool
 	MemDirtyRate     uint64
 }
+
+// mimic existing structs, but data is taken from
+// the guest agent guest-get-load command
+type DomainStatsLoad struct {
+	Load1Set  bool
+	Load1     float64
+	Load5Set  bool
+	Load5     float64
+	Load15Set bool
+	Load15    float64
+}
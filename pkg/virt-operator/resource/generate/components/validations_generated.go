@@ -975,6 +975,100 @@ var CRDsValidation map[string]string = map[string]string{
                     in case hardware-assisted emulation is not available. Defaults to false
                   type: boolean
               type: object
+            diskIOTuneLimits:
+              description: |-
+                DiskIOTuneLimits are the highest I/O limits the disks of a VMI may have.
+                Disks without a limit of a kind get the limits of this kind, VMIs with disks
+                above the limits are rejected. CD-ROMs are not limited.
+              properties:
+                burst:
+                  description: Burst allows the disk to exceed the limits for a short
+                    time.
+                  properties:
+                    lengthSeconds:
+                      description: |-
+                        LengthSeconds is the number of seconds a burst can last.
+                        Defaults to 1.
+                      format: int64
+                      type: integer
+                    readBytesPerSecond:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: ReadBytesPerSecond is the read throughput allowed
+                        during a burst.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    readIOPS:
+                      description: ReadIOPS is the read operations per second allowed
+                        during a burst.
+                      format: int64
+                      type: integer
+                    totalBytesPerSecond:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: TotalBytesPerSecond is the combined read and write
+                        throughput allowed during a burst.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    totalIOPS:
+                      description: TotalIOPS is the combined read and write operations
+                        per second allowed during a burst.
+                      format: int64
+                      type: integer
+                    writeBytesPerSecond:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: WriteBytesPerSecond is the write throughput allowed
+                        during a burst.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    writeIOPS:
+                      description: WriteIOPS is the write operations per second allowed
+                        during a burst.
+                      format: int64
+                      type: integer
+                  type: object
+                readBytesPerSecond:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: ReadBytesPerSecond limits the read throughput in bytes
+                    per second.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                readIOPS:
+                  description: ReadIOPS limits the read operations per second.
+                  format: int64
+                  type: integer
+                totalBytesPerSecond:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: TotalBytesPerSecond limits the combined read and write
+                    throughput in bytes per second.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                totalIOPS:
+                  description: TotalIOPS limits the combined read and write operations
+                    per second.
+                  format: int64
+                  type: integer
+                writeBytesPerSecond:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: WriteBytesPerSecond limits the write throughput in
+                    bytes per second.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                writeIOPS:
+                  description: WriteIOPS limits the write operations per second.
+                  format: int64
+                  type: integer
+              type: object
             emulatedMachines:
               description: Deprecated. Use architectureConfiguration instead.
               items:
@@ -1215,11 +1309,94 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression configures the compression of the guest memory sent during live migrations.
+                    Compression trades CPU time for bandwidth and helps memory heavy VMIs to converge on slow networks.
+                    Defaults to no compression
+                  properties:
+                    mode:
+                      description: Mode is the compression method, one of none, xbzrle
+                        or multifd-zstd. Defaults to none
+                      enum:
+                      - none
+                      - xbzrle
+                      - multifd-zstd
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        XBZRLECacheSize is the size of the page cache used by the xbzrle mode.
+                        Defaults to the hypervisor default (64Mi)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    zstdLevel:
+                      description: |-
+                        ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.
+                        Higher levels compress better but use more CPU. Defaults to the hypervisor default (1)
+                      format: int32
+                      maximum: 20
+                      minimum: 1
+                      type: integer
+                  type: object
+                crossClusterMigrationPort:
+                  description: |-
+                    CrossClusterMigrationPort is the port virt-handler listens on for cross-cluster migrations.
+                    It has to be routable from the clusters sending VMIs. Defaults to 49154
+                  format: int32
+                  type: integer
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                localStorage:
+                  description: |-
+                    LocalStorage configures the copy of the local volumes of the VMI during live migrations.
+                    By default the volumes are copied within BandwidthPerMigration.
+                  properties:
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Bandwidth limits the copy of each local volume, in bytes per second.
+                        It is independent of BandwidthPerMigration: the volumes are copied before
+                        the memory, and the migration bandwidth is set to BandwidthPerMigration
+                        once they are copied. Defaults to BandwidthPerMigration
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    storageClasses:
+                      description: |-
+                        StorageClasses overrides the bandwidth for the volumes of the given storage classes.
+                        The bandwidth applies to all volumes of a migration, the lowest bandwidth of the copied volumes is used.
+                      items:
+                        description: LocalStorageMigrationClass overrides the copy
+                          settings for the local volumes of a storage class
+                        properties:
+                          bandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: |-
+                              Bandwidth limits the copy of the volumes of the storage class, in bytes per second.
+                              It is not applied per volume, the lowest bandwidth of the copied volumes limits the copy of all of them
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          storageClassName:
+                            description: StorageClassName is the name of the storage
+                              class of the volume claim
+                            type: string
+                        required:
+                        - storageClassName
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - storageClassName
+                      x-kubernetes-list-type: map
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -1227,6 +1404,12 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: |-
+                    MaxDowntime is the maximum time the VMI may be paused to switch over to the target.
+                    The migration only completes once the remaining memory can be sent within it.
+                    Defaults to the hypervisor default (300ms)
+                  type: string
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -1256,6 +1439,50 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                switchoverPolicy:
+                  description: |-
+                    SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own.
+                    A plan with an Abort step replaces the handling of CompletionTimeoutPerGiB and AllowWorkloadDisruption.
+                    Migrations which make no progress for ProgressTimeout seconds are still aborted, so StalledFor has to be shorter.
+                  properties:
+                    steps:
+                      description: |-
+                        Steps are taken one after the other. A step is only considered once the previous one was taken.
+                        Abort can only be the last step.
+                      items:
+                        description: |-
+                          MigrationSwitchoverStep is a step of the escalation plan of live migrations.
+                          The step is taken as soon as one of its conditions is met.
+                        properties:
+                          action:
+                            description: |-
+                              Action is the action taken, one of RaiseDowntime, PostCopy or Abort.
+                              Auto-converge is not a step, it is enabled for the whole migration with AllowAutoConverge
+                            enum:
+                            - RaiseDowntime
+                            - PostCopy
+                            - Abort
+                            type: string
+                          after:
+                            description: After takes the step once the migration runs
+                              for the given time
+                            type: string
+                          maxDowntime:
+                            description: MaxDowntime is the maximum downtime set by
+                              the RaiseDowntime action
+                            type: string
+                          stalledFor:
+                            description: StalledFor takes the step once the remaining
+                              data of the migration did not shrink for the given time
+                            type: string
+                        required:
+                        - action
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - steps
+                  type: object
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -2746,6 +2973,52 @@ var CRDsValidation map[string]string = map[string]string{
 
                 Defaults to 10
               type: integer
+            excludedNamespaces:
+              description: |-
+                ExcludedNamespaces lists the namespaces whose outdated VMIs are never
+                updated automatically.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            maintenanceWindows:
+              description: |-
+                MaintenanceWindows restricts automated workload updates of outdated VMIs to the
+                times one of the windows applying to their namespace is open.
+                Windows listing namespaces apply to these namespaces only, windows without
+                namespaces apply to all other namespaces. VMIs in namespaces without an applying
+                window are updated at any time.
+                Migrations required to apply hotplug or volume updates are not restricted.
+              items:
+                description: WorkloadUpdateMaintenanceWindow is a recurring window
+                  in which outdated workloads may be updated
+                properties:
+                  duration:
+                    description: Duration defines how long the window stays open
+                    type: string
+                  namespaces:
+                    description: Namespaces lists the namespaces the window applies
+                      to
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                  schedule:
+                    description: |-
+                      Schedule is the cron expression with the fields minute, hour, day of month, month
+                      and day of week at which the window opens, e.g. "0 22 * * sat"
+                    type: string
+                  timeZone:
+                    description: |-
+                      TimeZone is the name of the IANA time zone the schedule is evaluated in, e.g. "Europe/Berlin".
+                      Defaults to UTC.
+                    type: string
+                required:
+                - duration
+                - schedule
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             workloadUpdateMethods:
               description: |-
                 WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
@@ -3894,6 +4167,48 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression holds the compression options of live
+            migrations
+          properties:
+            mode:
+              description: Mode is the compression method, one of none, xbzrle or
+                multifd-zstd. Defaults to none
+              enum:
+              - none
+              - xbzrle
+              - multifd-zstd
+              type: string
+            xbzrleCacheSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                XBZRLECacheSize is the size of the page cache used by the xbzrle mode.
+                Defaults to the hypervisor default (64Mi)
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            zstdLevel:
+              description: |-
+                ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.
+                Higher levels compress better but use more CPU. Defaults to the hypervisor default (1)
+              format: int32
+              maximum: 20
+              minimum: 1
+              type: integer
+          type: object
+        maxDowntime:
+          description: MaxDowntime is the maximum time the VMI may be paused to switch
+            over to the target
+          type: string
+        priority:
+          description: Priority raises the priority of the migrations of matched VMIs
+            to at least the given priority
+          enum:
+          - system-critical
+          - user-triggered
+          - system-maintenance
+          type: string
         selectors:
           properties:
             namespaceSelector:
@@ -3905,6 +4220,48 @@ var CRDsValidation map[string]string = map[string]string{
                 type: string
               type: object
           type: object
+        switchoverPolicy:
+          description: SwitchoverPolicy is the escalation plan followed by migrations
+            which do not complete on their own
+          properties:
+            steps:
+              description: |-
+                Steps are taken one after the other. A step is only considered once the previous one was taken.
+                Abort can only be the last step.
+              items:
+                description: |-
+                  MigrationSwitchoverStep is a step of the escalation plan of live migrations.
+                  The step is taken as soon as one of its conditions is met.
+                properties:
+                  action:
+                    description: |-
+                      Action is the action taken, one of RaiseDowntime, PostCopy or Abort.
+                      Auto-converge is not a step, it is enabled for the whole migration with AllowAutoConverge
+                    enum:
+                    - RaiseDowntime
+                    - PostCopy
+                    - Abort
+                    type: string
+                  after:
+                    description: After takes the step once the migration runs for
+                      the given time
+                    type: string
+                  maxDowntime:
+                    description: MaxDowntime is the maximum downtime set by the RaiseDowntime
+                      action
+                    type: string
+                  stalledFor:
+                    description: StalledFor takes the step once the remaining data
+                      of the migration did not shrink for the given time
+                    type: string
+                required:
+                - action
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - steps
+          type: object
       required:
       - selectors
       type: object
@@ -6080,7 +6437,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -6102,6 +6459,104 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune limits the throughput and the I/O operations per second of the disk.
+                                  It can be changed on a running VMI.
+                                  The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the number of seconds a burst can last.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesPerSecond is the read
+                                          throughput allowed during a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS is the read operations
+                                          per second allowed during a burst.
+                                        format: int64
+                                        type: integer
+                                      totalBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesPerSecond is the combined
+                                          read and write throughput allowed during
+                                          a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalIOPS:
+                                        description: TotalIOPS is the combined read
+                                          and write operations per second allowed
+                                          during a burst.
+                                        format: int64
+                                        type: integer
+                                      writeBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesPerSecond is the write
+                                          throughput allowed during a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS is the write operations
+                                          per second allowed during a burst.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesPerSecond limits the read
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesPerSecond limits the combined
+                                      read and write throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPS:
+                                    description: TotalIOPS limits the combined read
+                                      and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesPerSecond limits the write
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -7546,6 +8001,37 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backup:
+                        description: Backup is attached to the virt launcher and is
+                          populated with a backup of the vmi volumes
+                        properties:
+                          baseCheckpoint:
+                            description: BaseCheckpoint is the checkpoint an incremental
+                              backup is based on, a full backup is taken if it is
+                              empty
+                            type: string
+                          checkpoint:
+                            description: Checkpoint is the name of the checkpoint
+                              created with the backup
+                            type: string
+                          claimName:
+                            description: |-
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - checkpoint
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: |-
                           CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
@@ -7884,6 +8370,10 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format is the format of the memory dump output,
+                              defaults to elf
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -7994,6 +8484,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                             x-kubernetes-map-type: atomic
                         type: object
+                      vhostUserBlk:
+                        description: VhostUserBlk attaches a disk which is served
+                          by a storage daemon of the node over a vhost-user-blk socket.
+                        properties:
+                          socket:
+                            description: |-
+                              Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+                              in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+                              directory of the namespace of the VirtualMachineInstance can be used.
+                            type: string
+                        required:
+                        - socket
+                        type: object
                     required:
                     - name
                     type: object
@@ -8088,6 +8591,9 @@ var CRDsValidation map[string]string = map[string]string{
             dump to the given pvc
           nullable: true
           properties:
+            checksum:
+              description: Checksum is the sha256 checksum of the memory dump output
+              type: string
             claimName:
               description: ClaimName is the name of the pvc that will contain the
                 memory dump
@@ -8096,9 +8602,21 @@ var CRDsValidation map[string]string = map[string]string{
               description: EndTimestamp represents the time the memory dump was completed
               format: date-time
               type: string
+            export:
+              description: Export represents request of exposing the memory dump pvc
+                through a VirtualMachineExport once the memory dump completed
+              type: boolean
+            exportName:
+              description: ExportName is the name of the VirtualMachineExport exposing
+                the memory dump
+              type: string
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format is the format of the memory dump output, defaults
+                to elf
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8118,21 +8636,70 @@ var CRDsValidation map[string]string = map[string]string{
           - claimName
           - phase
           type: object
-        observedGeneration:
-          description: ObservedGeneration is the generation observed by the vmi when
-            started.
-          format: int64
-          type: integer
-        preferenceRef:
-          description: PreferenceRef captures the state of any referenced preference
-            from the VirtualMachine
-          nullable: true
-          properties:
-            controllerRevisionRef:
-              description: |-
-                ControllerRef specifies the ControllerRevision storing a copy of the object captured
-                when it is first seen by the VirtualMachine controller
-              properties:
+        migrationHistory:
+          description: |-
+            MigrationHistory lists the last 10 finished migrations of the VirtualMachine, oldest first.
+            It outlives the VirtualMachineInstances and the migration objects.
+          items:
+            description: VirtualMachineMigrationRecord records a finished migration
+              of a VirtualMachine
+            properties:
+              dataTransferredBytes:
+                description: The amount of data the migration transferred to the target
+                format: int64
+                type: integer
+              downtime:
+                description: The time the guest was paused to switch over to the target
+                type: string
+              endTimestamp:
+                description: The time the migration ended
+                format: date-time
+                type: string
+              failed:
+                description: Indicates that the migration failed
+                type: boolean
+              failureReason:
+                description: The reason the migration failed
+                type: string
+              migrationUid:
+                description: The UID of the migration
+                type: string
+              mode:
+                description: The mode the migration ended in
+                type: string
+              sourceNode:
+                description: The node the VirtualMachineInstance was migrated from
+                type: string
+              startTimestamp:
+                description: The time the migration started
+                format: date-time
+                type: string
+              targetNode:
+                description: The node the VirtualMachineInstance was migrated to
+                type: string
+              vmiUid:
+                description: The UID of the migrated VirtualMachineInstance
+                type: string
+            required:
+            - migrationUid
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        observedGeneration:
+          description: ObservedGeneration is the generation observed by the vmi when
+            started.
+          format: int64
+          type: integer
+        preferenceRef:
+          description: PreferenceRef captures the state of any referenced preference
+            from the VirtualMachine
+          nullable: true
+          properties:
+            controllerRevisionRef:
+              description: |-
+                ControllerRef specifies the ControllerRevision storing a copy of the object captured
+                when it is first seen by the VirtualMachine controller
+              properties:
                 name:
                   description: Name of the ControllerRevision
                   type: string
@@ -8299,7 +8866,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -8321,6 +8888,101 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the I/O operations per second of the disk.
+                          It can be changed on a running VMI.
+                          The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the number of seconds a burst can last.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBytesPerSecond is the read throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  allowed during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: TotalBytesPerSecond is the combined read
+                                  and write throughput allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              totalIOPS:
+                                description: TotalIOPS is the combined read and write
+                                  operations per second allowed during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBytesPerSecond is the write throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second allowed during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSecond limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSecond limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSecond limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8530,6 +9192,10 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Requests represents the resources requested
                               by the corresponding PVC spec
                             type: object
+                          storageClassName:
+                            description: StorageClassName is the name of the storage
+                              class of the PVC
+                            type: string
                           volumeMode:
                             description: |-
                               VolumeMode defines what type of volume is required by the claim.
@@ -8580,6 +9246,10 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Requests represents the resources requested
                               by the corresponding PVC spec
                             type: object
+                          storageClassName:
+                            description: StorageClassName is the name of the storage
+                              class of the PVC
+                            type: string
                           volumeMode:
                             description: |-
                               VolumeMode defines what type of volume is required by the claim.
@@ -8601,6 +9271,223 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinebackup": `openAPIV3Schema:
+  description: |-
+    VirtualMachineBackup defines the operation of backing up the volumes of a running VM
+    into a PVC. Incremental backups only contain the blocks changed since the latest
+    checkpoint recorded in the VirtualMachineBackupTracker of the VM. Only qcow2 volumes
+    track their changed blocks. A backup of a VM with raw volumes is always full and
+    creates no checkpoint, an incremental backup of raw volumes is rejected.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup
+        resource
+      properties:
+        pvcName:
+          description: PVCName is the name of the filesystem PVC the backup is written
+            to
+          type: string
+        source:
+          description: Source is the VirtualMachine to back up, it has to be running
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        type:
+          description: |-
+            Type of the backup, a full backup is taken instead of an incremental one
+            when the VM has no checkpoint to base it on. Defaults to Incremental.
+          enum:
+          - Full
+          - Incremental
+          type: string
+      required:
+      - pvcName
+      - source
+      type: object
+    status:
+      description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup
+        resource
+      properties:
+        baseCheckpoint:
+          description: BaseCheckpoint is the checkpoint an incremental backup is based
+            on
+          type: string
+        checkpoint:
+          description: Checkpoint is the name of the checkpoint created with the backup
+          type: string
+        endTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        message:
+          description: Message describes why the backup failed, or why a full backup
+            was taken
+          type: string
+        phase:
+          description: VirtualMachineBackupPhase is the phase of a VirtualMachineBackup
+          type: string
+        startTimestamp:
+          format: date-time
+          nullable: true
+          type: string
+        type:
+          description: Type of the backup which was taken
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinebackuptracker": `openAPIV3Schema:
+  description: |-
+    VirtualMachineBackupTracker tracks the checkpoints of a VM, which incremental
+    backups are based on. It has the same name as the VM and is created when the
+    first backup of the VM succeeds.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker
+        resource
+      properties:
+        source:
+          description: Source is the VirtualMachine whose checkpoints are tracked
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineBackupTrackerStatus is the status for a VirtualMachineBackupTracker
+        resource
+      properties:
+        checkpoints:
+          description: |-
+            Checkpoints is the chain of checkpoints since the latest full backup, oldest first.
+            Restoring a backup requires the full backup and all incremental backups up to it.
+            The images of an incremental backup reference the images of the previous backup as
+            their backing file at ../<checkpoint>/<volume>.qcow2, so the backups of a chain have
+            to be kept side by side, as they are when they are written to the same PVC.
+          items:
+            description: BackupCheckpoint is a checkpoint created by a backup
+            properties:
+              backupName:
+                description: BackupName is the name of the VirtualMachineBackup which
+                  created the checkpoint
+                type: string
+              creationTimestamp:
+                format: date-time
+                nullable: true
+                type: string
+              name:
+                description: Name of the checkpoint
+                type: string
+              type:
+                description: Type of the backup which created the checkpoint
+                type: string
+            required:
+            - backupName
+            - name
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        latestCheckpoint:
+          description: LatestCheckpoint is the checkpoint the next incremental backup
+            is based on
+          properties:
+            backupName:
+              description: BackupName is the name of the VirtualMachineBackup which
+                created the checkpoint
+              type: string
+            creationTimestamp:
+              format: date-time
+              nullable: true
+              type: string
+            name:
+              description: Name of the checkpoint
+              type: string
+            type:
+              description: Type of the backup which created the checkpoint
+              type: string
+          required:
+          - backupName
+          - name
+          - type
+          type: object
+        virtualMachineInstanceUID:
+          description: |-
+            VirtualMachineInstanceUID is the VMI the checkpoints were created in. Checkpoints
+            don't survive a restart of the VM, the next backup is a full one in that case.
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineclone": `openAPIV3Schema:
   description: VirtualMachineClone is a CRD that clones one VM into another.
@@ -9715,6 +10602,47 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        destination:
+          description: |-
+            Destination, if set, makes the export server upload the exported volumes and the
+            VirtualMachine manifest, in addition to serving them
+          properties:
+            s3:
+              description: S3 is a bucket of an S3 compatible object storage
+              properties:
+                bucket:
+                  description: Bucket is the name of the bucket the objects are uploaded
+                    to
+                  type: string
+                credentialsSecretRef:
+                  description: |-
+                    CredentialsSecretRef is the name of a secret in the namespace of the export which
+                    contains the accessKeyId and secretAccessKey keys
+                  type: string
+                endpoint:
+                  description: Endpoint is the URL of the object storage, the bucket
+                    is addressed path style
+                  type: string
+                format:
+                  description: |-
+                    Format is the format of the uploaded disk images, one of raw, gzip, zstd or qcow2.
+                    Defaults to gzip. Volumes not containing a disk image are always uploaded as tar.gz
+                  type: string
+                prefix:
+                  description: |-
+                    Prefix is prepended to the key of every uploaded object. The objects of an export
+                    are stored under <prefix>/<export name>/
+                  type: string
+                region:
+                  description: Region is the region used to sign the requests, defaults
+                    to us-east-1
+                  type: string
+              required:
+              - bucket
+              - credentialsSecretRef
+              - endpoint
+              type: object
+          type: object
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -9782,6 +10710,56 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        destination:
+          description: Destination reports the progress of the upload to the destination
+          properties:
+            manifestKey:
+              description: ManifestKey is the key of the uploaded VirtualMachine manifest
+              type: string
+            message:
+              description: Message explains why the upload failed
+              type: string
+            phase:
+              description: VirtualMachineExportUploadPhase is the phase of the upload
+                to the destination
+              type: string
+            volumes:
+              items:
+                description: VirtualMachineExportVolumeUploadStatus is the status
+                  of the upload of a single volume
+                properties:
+                  checksum:
+                    description: Checksum is the SHA-256 of the uploaded object in
+                      the form sha256:<hex>, it is set once the upload completed
+                    type: string
+                  format:
+                    description: Format is the format of the uploaded object
+                    type: string
+                  key:
+                    description: Key is the key of the uploaded object
+                    type: string
+                  name:
+                    description: Name is the name of the exported volume
+                    type: string
+                  progress:
+                    description: Progress is the share of the volume read so far,
+                      e.g. 42.00%
+                    type: string
+                  uploadedBytes:
+                    description: UploadedBytes is the number of bytes uploaded so
+                      far
+                    format: int64
+                    type: integer
+                required:
+                - format
+                - key
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+          type: object
         links:
           description: VirtualMachineExportLinks contains the links that point the
             exported VM resources
@@ -11375,7 +12353,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -11397,6 +12375,101 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the I/O operations per second of the disk.
+                          It can be changed on a running VMI.
+                          The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the number of seconds a burst can last.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBytesPerSecond is the read throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  allowed during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: TotalBytesPerSecond is the combined read
+                                  and write throughput allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              totalIOPS:
+                                description: TotalIOPS is the combined read and write
+                                  operations per second allowed during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBytesPerSecond is the write throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second allowed during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSecond limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSecond limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSecond limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12827,6 +13900,36 @@ var CRDsValidation map[string]string = map[string]string{
           items:
             description: Volume represents a named volume in a vmi.
             properties:
+              backup:
+                description: Backup is attached to the virt launcher and is populated
+                  with a backup of the vmi volumes
+                properties:
+                  baseCheckpoint:
+                    description: BaseCheckpoint is the checkpoint an incremental backup
+                      is based on, a full backup is taken if it is empty
+                    type: string
+                  checkpoint:
+                    description: Checkpoint is the name of the checkpoint created
+                      with the backup
+                    type: string
+                  claimName:
+                    description: |-
+                      claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                      More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                    type: string
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
+                  readOnly:
+                    description: |-
+                      readOnly Will force the ReadOnly setting in VolumeMounts.
+                      Default false.
+                    type: boolean
+                required:
+                - checkpoint
+                - claimName
+                type: object
               cloudInitConfigDrive:
                 description: |-
                   CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
@@ -13156,6 +14259,10 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  format:
+                    description: Format is the format of the memory dump output, defaults
+                      to elf
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13265,6 +14372,19 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                     x-kubernetes-map-type: atomic
                 type: object
+              vhostUserBlk:
+                description: VhostUserBlk attaches a disk which is served by a storage
+                  daemon of the node over a vhost-user-blk socket.
+                properties:
+                  socket:
+                    description: |-
+                      Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+                      in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+                      directory of the namespace of the VirtualMachineInstance can be used.
+                    type: string
+                required:
+                - socket
+                type: object
             required:
             - name
             type: object
@@ -13532,6 +14652,10 @@ var CRDsValidation map[string]string = map[string]string{
                     description: Requests represents the resources requested by the
                       corresponding PVC spec
                     type: object
+                  storageClassName:
+                    description: StorageClassName is the name of the storage class
+                      of the PVC
+                    type: string
                   volumeMode:
                     description: |-
                       VolumeMode defines what type of volume is required by the claim.
@@ -13582,6 +14706,10 @@ var CRDsValidation map[string]string = map[string]string{
                     description: Requests represents the resources requested by the
                       corresponding PVC spec
                     type: object
+                  storageClassName:
+                    description: StorageClassName is the name of the storage class
+                      of the PVC
+                    type: string
                   volumeMode:
                     description: |-
                       VolumeMode defines what type of volume is required by the claim.
@@ -13612,6 +14740,55 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            convergence:
+              description: The guest memory dirty rate measured during the migration
+                and the completion predicted from it
+              properties:
+                converging:
+                  description: |-
+                    Converging is false if the guest dirties its memory faster than it is transferred.
+                    Such a migration only completes with post-copy or by pausing the guest.
+                  type: boolean
+                dirtyRateBytesPerSecond:
+                  description: DirtyRateBytesPerSecond is the rate at which the guest
+                    dirties its memory
+                  format: int64
+                  type: integer
+                measurementTimestamp:
+                  description: MeasurementTimestamp is the time of the last dirty
+                    rate measurement
+                  format: date-time
+                  type: string
+                predictedCompletionTimestamp:
+                  description: |-
+                    PredictedCompletionTimestamp is the time the migration is predicted to complete at,
+                    it is only set for a converging migration
+                  format: date-time
+                  type: string
+                transferRateBytesPerSecond:
+                  description: |-
+                    TransferRateBytesPerSecond is the rate at which the migration transfers the guest memory.
+                    The configured bandwidth is used until the transfer started.
+                  format: int64
+                  type: integer
+              required:
+              - dirtyRateBytesPerSecond
+              type: object
+            crossClusterMigrationID:
+              description: |-
+                The ID the sending and the receiving side of a cross-cluster migration meet with
+                at the cross-cluster migration endpoint of the target node
+              type: string
+            dataTransferredBytes:
+              description: The amount of data the migration transferred to the target
+                so far
+              format: int64
+              type: integer
+            downtime:
+              description: |-
+                The time the guest was paused to switch over to the target, as measured by the hypervisor
+                once the migration completed
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -13623,6 +14800,58 @@ var CRDsValidation map[string]string = map[string]string{
             failureReason:
               description: Contains the reason why the migration failed
               type: string
+            localVolumes:
+              description: The copy progress of the local volumes migrated with the
+                VMI
+              properties:
+                bandwidthBytesPerSecond:
+                  description: BandwidthBytesPerSecond is the bandwidth limit of the
+                    copy, it is not set if the copy is not limited
+                  format: int64
+                  type: integer
+                totalBytes:
+                  description: TotalBytes is the amount of data of the volumes to
+                    copy, it grows with the guest writes during the copy
+                  format: int64
+                  type: integer
+                transferredBytes:
+                  description: TransferredBytes is the amount of data of the volumes
+                    copied to the target so far
+                  format: int64
+                  type: integer
+                volumes:
+                  description: Volumes reports the copy progress of each local volume
+                    once its copy started
+                  items:
+                    description: MigrationLocalVolumeState reports the copy progress
+                      of a local volume migrated with the VMI
+                    properties:
+                      totalBytes:
+                        description: TotalBytes is the amount of data of the volume
+                          to copy, it grows with the guest writes during the copy
+                        format: int64
+                        type: integer
+                      transferredBytes:
+                        description: TransferredBytes is the amount of data of the
+                          volume copied to the target so far
+                        format: int64
+                        type: integer
+                      volumeName:
+                        description: VolumeName is the name of the volume
+                        type: string
+                    required:
+                    - totalBytes
+                    - transferredBytes
+                    - volumeName
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - volumeName
+                  x-kubernetes-list-type: map
+              required:
+              - totalBytes
+              - transferredBytes
+              type: object
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
@@ -13661,11 +14890,94 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression configures the compression of the guest memory sent during live migrations.
+                    Compression trades CPU time for bandwidth and helps memory heavy VMIs to converge on slow networks.
+                    Defaults to no compression
+                  properties:
+                    mode:
+                      description: Mode is the compression method, one of none, xbzrle
+                        or multifd-zstd. Defaults to none
+                      enum:
+                      - none
+                      - xbzrle
+                      - multifd-zstd
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        XBZRLECacheSize is the size of the page cache used by the xbzrle mode.
+                        Defaults to the hypervisor default (64Mi)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    zstdLevel:
+                      description: |-
+                        ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.
+                        Higher levels compress better but use more CPU. Defaults to the hypervisor default (1)
+                      format: int32
+                      maximum: 20
+                      minimum: 1
+                      type: integer
+                  type: object
+                crossClusterMigrationPort:
+                  description: |-
+                    CrossClusterMigrationPort is the port virt-handler listens on for cross-cluster migrations.
+                    It has to be routable from the clusters sending VMIs. Defaults to 49154
+                  format: int32
+                  type: integer
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                localStorage:
+                  description: |-
+                    LocalStorage configures the copy of the local volumes of the VMI during live migrations.
+                    By default the volumes are copied within BandwidthPerMigration.
+                  properties:
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Bandwidth limits the copy of each local volume, in bytes per second.
+                        It is independent of BandwidthPerMigration: the volumes are copied before
+                        the memory, and the migration bandwidth is set to BandwidthPerMigration
+                        once they are copied. Defaults to BandwidthPerMigration
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    storageClasses:
+                      description: |-
+                        StorageClasses overrides the bandwidth for the volumes of the given storage classes.
+                        The bandwidth applies to all volumes of a migration, the lowest bandwidth of the copied volumes is used.
+                      items:
+                        description: LocalStorageMigrationClass overrides the copy
+                          settings for the local volumes of a storage class
+                        properties:
+                          bandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: |-
+                              Bandwidth limits the copy of the volumes of the storage class, in bytes per second.
+                              It is not applied per volume, the lowest bandwidth of the copied volumes limits the copy of all of them
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          storageClassName:
+                            description: StorageClassName is the name of the storage
+                              class of the volume claim
+                            type: string
+                        required:
+                        - storageClassName
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - storageClassName
+                      x-kubernetes-list-type: map
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -13673,6 +14985,12 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: |-
+                    MaxDowntime is the maximum time the VMI may be paused to switch over to the target.
+                    The migration only completes once the remaining memory can be sent within it.
+                    Defaults to the hypervisor default (300ms)
+                  type: string
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -13702,6 +15020,50 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                switchoverPolicy:
+                  description: |-
+                    SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own.
+                    A plan with an Abort step replaces the handling of CompletionTimeoutPerGiB and AllowWorkloadDisruption.
+                    Migrations which make no progress for ProgressTimeout seconds are still aborted, so StalledFor has to be shorter.
+                  properties:
+                    steps:
+                      description: |-
+                        Steps are taken one after the other. A step is only considered once the previous one was taken.
+                        Abort can only be the last step.
+                      items:
+                        description: |-
+                          MigrationSwitchoverStep is a step of the escalation plan of live migrations.
+                          The step is taken as soon as one of its conditions is met.
+                        properties:
+                          action:
+                            description: |-
+                              Action is the action taken, one of RaiseDowntime, PostCopy or Abort.
+                              Auto-converge is not a step, it is enabled for the whole migration with AllowAutoConverge
+                            enum:
+                            - RaiseDowntime
+                            - PostCopy
+                            - Abort
+                            type: string
+                          after:
+                            description: After takes the step once the migration runs
+                              for the given time
+                            type: string
+                          maxDowntime:
+                            description: MaxDowntime is the maximum downtime set by
+                              the RaiseDowntime action
+                            type: string
+                          stalledFor:
+                            description: StalledFor takes the step once the remaining
+                              data of the migration did not shrink for the given time
+                            type: string
+                        required:
+                        - action
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - steps
+                  type: object
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -13720,6 +15082,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            setupTime:
+              description: |-
+                The time the hypervisor spent setting up the migration before transferring the guest state,
+                as measured once the migration completed
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -13846,6 +15213,43 @@ var CRDsValidation map[string]string = map[string]string{
             description: VolumeStatus represents information about the status of volumes
               attached to the VirtualMachineInstance.
             properties:
+              backupVolume:
+                description: If the volume is a backup volume, this will contain the
+                  backup info.
+                properties:
+                  checkpoint:
+                    description: Checkpoint is the name of the checkpoint created
+                      with the backup
+                    type: string
+                  claimName:
+                    description: ClaimName is the name of the pvc the volumes were
+                      backed up to
+                    type: string
+                  endTimestamp:
+                    description: EndTimestamp is the time when the backup completed
+                    format: date-time
+                    type: string
+                  incremental:
+                    description: Incremental is true if only the blocks changed since
+                      the base checkpoint were backed up
+                    type: boolean
+                  startTimestamp:
+                    description: StartTimestamp is the time when the backup started
+                    format: date-time
+                    type: string
+                  targetDirectory:
+                    description: TargetDirectory is the directory in the pvc the volumes
+                      were backed up to
+                    type: string
+                  untrackedVolumes:
+                    description: |-
+                      UntrackedVolumes are the backed up volumes which cannot track their changed blocks.
+                      No checkpoint is created when a volume cannot track its changed blocks.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                type: object
               containerDiskVolume:
                 description: ContainerDiskVolume shows info about the containerdisk,
                   if the volume is a containerdisk
@@ -13873,6 +15277,10 @@ var CRDsValidation map[string]string = map[string]string{
                 description: If the volume is memorydump volume, this will contain
                   the memorydump info.
                 properties:
+                  checksum:
+                    description: Checksum is the sha256 checksum of the memory dump
+                      output
+                    type: string
                   claimName:
                     description: ClaimName is the name of the pvc the memory was dumped
                       to
@@ -13940,6 +15348,10 @@ var CRDsValidation map[string]string = map[string]string{
                     description: Requests represents the resources requested by the
                       corresponding PVC spec
                     type: object
+                  storageClassName:
+                    description: StorageClassName is the name of the storage class
+                      of the PVC
+                    type: string
                   volumeMode:
                     description: |-
                       VolumeMode defines what type of volume is required by the claim.
@@ -14007,6 +15419,48 @@ var CRDsValidation map[string]string = map[string]string{
             are going to be preserved to ensure that addedNodeSelector
             can only restrict but not bypass constraints already set on the VM object.
           type: object
+        priority:
+          description: |-
+            Priority defines the order in which pending migrations are started once the
+            parallel migration limits are reached. Defaults to user-triggered.
+            Pending migrations gain priority while they wait, so that migrations with a
+            low priority are not starved. The system priorities are reserved for KubeVirt.
+          enum:
+          - system-critical
+          - user-triggered
+          - system-maintenance
+          type: string
+        receive:
+          description: |-
+            Receive makes the migration the receiving side of a cross-cluster migration.
+            The VMI has to be created with the kubevirt.io/crossClusterMigrationReceiver
+            annotation, so that it waits for the migration instead of being started.
+          properties:
+            migrationID:
+              description: MigrationID identifies the cross-cluster migration on both
+                sides
+              type: string
+          required:
+          - migrationID
+          type: object
+        sendTo:
+          description: |-
+            SendTo makes the migration the sending side of a cross-cluster migration.
+            The VMI is migrated to the cluster behind the connect URL, where a migration
+            receiving the same migration ID has to be ready.
+          properties:
+            connectURL:
+              description: ConnectURL is the routable host:port of the cross-cluster
+                migration endpoint of the target node
+              type: string
+            migrationID:
+              description: MigrationID identifies the cross-cluster migration on both
+                sides
+              type: string
+          required:
+          - connectURL
+          - migrationID
+          type: object
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -14052,6 +15506,55 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            convergence:
+              description: The guest memory dirty rate measured during the migration
+                and the completion predicted from it
+              properties:
+                converging:
+                  description: |-
+                    Converging is false if the guest dirties its memory faster than it is transferred.
+                    Such a migration only completes with post-copy or by pausing the guest.
+                  type: boolean
+                dirtyRateBytesPerSecond:
+                  description: DirtyRateBytesPerSecond is the rate at which the guest
+                    dirties its memory
+                  format: int64
+                  type: integer
+                measurementTimestamp:
+                  description: MeasurementTimestamp is the time of the last dirty
+                    rate measurement
+                  format: date-time
+                  type: string
+                predictedCompletionTimestamp:
+                  description: |-
+                    PredictedCompletionTimestamp is the time the migration is predicted to complete at,
+                    it is only set for a converging migration
+                  format: date-time
+                  type: string
+                transferRateBytesPerSecond:
+                  description: |-
+                    TransferRateBytesPerSecond is the rate at which the migration transfers the guest memory.
+                    The configured bandwidth is used until the transfer started.
+                  format: int64
+                  type: integer
+              required:
+              - dirtyRateBytesPerSecond
+              type: object
+            crossClusterMigrationID:
+              description: |-
+                The ID the sending and the receiving side of a cross-cluster migration meet with
+                at the cross-cluster migration endpoint of the target node
+              type: string
+            dataTransferredBytes:
+              description: The amount of data the migration transferred to the target
+                so far
+              format: int64
+              type: integer
+            downtime:
+              description: |-
+                The time the guest was paused to switch over to the target, as measured by the hypervisor
+                once the migration completed
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -14063,6 +15566,58 @@ var CRDsValidation map[string]string = map[string]string{
             failureReason:
               description: Contains the reason why the migration failed
               type: string
+            localVolumes:
+              description: The copy progress of the local volumes migrated with the
+                VMI
+              properties:
+                bandwidthBytesPerSecond:
+                  description: BandwidthBytesPerSecond is the bandwidth limit of the
+                    copy, it is not set if the copy is not limited
+                  format: int64
+                  type: integer
+                totalBytes:
+                  description: TotalBytes is the amount of data of the volumes to
+                    copy, it grows with the guest writes during the copy
+                  format: int64
+                  type: integer
+                transferredBytes:
+                  description: TransferredBytes is the amount of data of the volumes
+                    copied to the target so far
+                  format: int64
+                  type: integer
+                volumes:
+                  description: Volumes reports the copy progress of each local volume
+                    once its copy started
+                  items:
+                    description: MigrationLocalVolumeState reports the copy progress
+                      of a local volume migrated with the VMI
+                    properties:
+                      totalBytes:
+                        description: TotalBytes is the amount of data of the volume
+                          to copy, it grows with the guest writes during the copy
+                        format: int64
+                        type: integer
+                      transferredBytes:
+                        description: TransferredBytes is the amount of data of the
+                          volume copied to the target so far
+                        format: int64
+                        type: integer
+                      volumeName:
+                        description: VolumeName is the name of the volume
+                        type: string
+                    required:
+                    - totalBytes
+                    - transferredBytes
+                    - volumeName
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - volumeName
+                  x-kubernetes-list-type: map
+              required:
+              - totalBytes
+              - transferredBytes
+              type: object
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
@@ -14101,11 +15656,94 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression configures the compression of the guest memory sent during live migrations.
+                    Compression trades CPU time for bandwidth and helps memory heavy VMIs to converge on slow networks.
+                    Defaults to no compression
+                  properties:
+                    mode:
+                      description: Mode is the compression method, one of none, xbzrle
+                        or multifd-zstd. Defaults to none
+                      enum:
+                      - none
+                      - xbzrle
+                      - multifd-zstd
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        XBZRLECacheSize is the size of the page cache used by the xbzrle mode.
+                        Defaults to the hypervisor default (64Mi)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    zstdLevel:
+                      description: |-
+                        ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.
+                        Higher levels compress better but use more CPU. Defaults to the hypervisor default (1)
+                      format: int32
+                      maximum: 20
+                      minimum: 1
+                      type: integer
+                  type: object
+                crossClusterMigrationPort:
+                  description: |-
+                    CrossClusterMigrationPort is the port virt-handler listens on for cross-cluster migrations.
+                    It has to be routable from the clusters sending VMIs. Defaults to 49154
+                  format: int32
+                  type: integer
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                localStorage:
+                  description: |-
+                    LocalStorage configures the copy of the local volumes of the VMI during live migrations.
+                    By default the volumes are copied within BandwidthPerMigration.
+                  properties:
+                    bandwidth:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Bandwidth limits the copy of each local volume, in bytes per second.
+                        It is independent of BandwidthPerMigration: the volumes are copied before
+                        the memory, and the migration bandwidth is set to BandwidthPerMigration
+                        once they are copied. Defaults to BandwidthPerMigration
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    storageClasses:
+                      description: |-
+                        StorageClasses overrides the bandwidth for the volumes of the given storage classes.
+                        The bandwidth applies to all volumes of a migration, the lowest bandwidth of the copied volumes is used.
+                      items:
+                        description: LocalStorageMigrationClass overrides the copy
+                          settings for the local volumes of a storage class
+                        properties:
+                          bandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: |-
+                              Bandwidth limits the copy of the volumes of the storage class, in bytes per second.
+                              It is not applied per volume, the lowest bandwidth of the copied volumes limits the copy of all of them
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          storageClassName:
+                            description: StorageClassName is the name of the storage
+                              class of the volume claim
+                            type: string
+                        required:
+                        - storageClassName
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - storageClassName
+                      x-kubernetes-list-type: map
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -14113,6 +15751,12 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntime:
+                  description: |-
+                    MaxDowntime is the maximum time the VMI may be paused to switch over to the target.
+                    The migration only completes once the remaining memory can be sent within it.
+                    Defaults to the hypervisor default (300ms)
+                  type: string
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -14142,6 +15786,50 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                switchoverPolicy:
+                  description: |-
+                    SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own.
+                    A plan with an Abort step replaces the handling of CompletionTimeoutPerGiB and AllowWorkloadDisruption.
+                    Migrations which make no progress for ProgressTimeout seconds are still aborted, so StalledFor has to be shorter.
+                  properties:
+                    steps:
+                      description: |-
+                        Steps are taken one after the other. A step is only considered once the previous one was taken.
+                        Abort can only be the last step.
+                      items:
+                        description: |-
+                          MigrationSwitchoverStep is a step of the escalation plan of live migrations.
+                          The step is taken as soon as one of its conditions is met.
+                        properties:
+                          action:
+                            description: |-
+                              Action is the action taken, one of RaiseDowntime, PostCopy or Abort.
+                              Auto-converge is not a step, it is enabled for the whole migration with AllowAutoConverge
+                            enum:
+                            - RaiseDowntime
+                            - PostCopy
+                            - Abort
+                            type: string
+                          after:
+                            description: After takes the step once the migration runs
+                              for the given time
+                            type: string
+                          maxDowntime:
+                            description: MaxDowntime is the maximum downtime set by
+                              the RaiseDowntime action
+                            type: string
+                          stalledFor:
+                            description: StalledFor takes the step once the remaining
+                              data of the migration did not shrink for the given time
+                            type: string
+                        required:
+                        - action
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - steps
+                  type: object
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -14160,6 +15848,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            setupTime:
+              description: |-
+                The time the hypervisor spent setting up the migration before transferring the guest state,
+                as measured once the migration completed
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -14242,6 +15935,11 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        priority:
+          description: |-
+            Priority is the priority the pending migration is started with, the higher one
+            of the priority of the migration and of its migration policy
+          type: string
       type: object
   required:
   - spec
@@ -14595,7 +16293,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -14617,6 +16315,101 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the I/O operations per second of the disk.
+                          It can be changed on a running VMI.
+                          The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the number of seconds a burst can last.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBytesPerSecond is the read throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  allowed during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: TotalBytesPerSecond is the combined read
+                                  and write throughput allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              totalIOPS:
+                                description: TotalIOPS is the combined read and write
+                                  operations per second allowed during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesPerSecond:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBytesPerSecond is the write throughput
+                                  allowed during a burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second allowed during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSecond limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSecond limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSecond:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSecond limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -17015,7 +18808,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -17037,6 +18830,104 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune limits the throughput and the I/O operations per second of the disk.
+                                  It can be changed on a running VMI.
+                                  The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the number of seconds a burst can last.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesPerSecond is the read
+                                          throughput allowed during a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS is the read operations
+                                          per second allowed during a burst.
+                                        format: int64
+                                        type: integer
+                                      totalBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesPerSecond is the combined
+                                          read and write throughput allowed during
+                                          a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalIOPS:
+                                        description: TotalIOPS is the combined read
+                                          and write operations per second allowed
+                                          during a burst.
+                                        format: int64
+                                        type: integer
+                                      writeBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesPerSecond is the write
+                                          throughput allowed during a burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS is the write operations
+                                          per second allowed during a burst.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesPerSecond limits the read
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesPerSecond limits the combined
+                                      read and write throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPS:
+                                    description: TotalIOPS limits the combined read
+                                      and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesPerSecond:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesPerSecond limits the write
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -18481,6 +20372,37 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backup:
+                        description: Backup is attached to the virt launcher and is
+                          populated with a backup of the vmi volumes
+                        properties:
+                          baseCheckpoint:
+                            description: BaseCheckpoint is the checkpoint an incremental
+                              backup is based on, a full backup is taken if it is
+                              empty
+                            type: string
+                          checkpoint:
+                            description: Checkpoint is the name of the checkpoint
+                              created with the backup
+                            type: string
+                          claimName:
+                            description: |-
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - checkpoint
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: |-
                           CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
@@ -18819,6 +20741,10 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format is the format of the memory dump output,
+                              defaults to elf
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -18929,6 +20855,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                             x-kubernetes-map-type: atomic
                         type: object
+                      vhostUserBlk:
+                        description: VhostUserBlk attaches a disk which is served
+                          by a storage daemon of the node over a vhost-user-blk socket.
+                        properties:
+                          socket:
+                            description: |-
+                              Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+                              in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+                              directory of the namespace of the VirtualMachineInstance can be used.
+                            type: string
+                        required:
+                        - socket
+                        type: object
                     required:
                     - name
                     type: object
@@ -19258,6 +21197,57 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        autoscaling:
+          description: |-
+            Autoscaling adjusts the number of replicas based on the resource usage
+            reported by the guests. When set, the pool controller manages the replicas
+            and no other autoscaler should target the pool.
+          properties:
+            maxReplicas:
+              description: Upper limit for the number of replicas. Can not be lower
+                than minReplicas.
+              format: int32
+              type: integer
+            metrics:
+              description: |-
+                Metrics used to calculate the desired number of replicas.
+                The highest number of replicas calculated from any metric is used.
+              items:
+                properties:
+                  targetAverageUtilization:
+                    description: Target value of the metric averaged across the ready
+                      VirtualMachines, as a percentage.
+                    format: int32
+                    type: integer
+                  type:
+                    description: Type of the metric. Can be "CPU", "Memory" or "GuestLoad".
+                    enum:
+                    - CPU
+                    - Memory
+                    - GuestLoad
+                    type: string
+                required:
+                - targetAverageUtilization
+                - type
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            minReplicas:
+              description: Lower limit for the number of replicas. Defaults to 1.
+              format: int32
+              type: integer
+            scaleDownCooldown:
+              description: Minimum time between the last scaling and a scale in. Defaults
+                to 5m.
+              type: string
+            scaleUpCooldown:
+              description: Minimum time between the last scaling and a scale out.
+                Defaults to 3m.
+              type: string
+          required:
+          - maxReplicas
+          - metrics
+          type: object
         nameGeneration:
           description: Options for the name generation in a pool.
           properties:
@@ -19275,6 +21265,26 @@ var CRDsValidation map[string]string = map[string]string{
             zero and not specified. Defaults to 1.
           format: int32
           type: integer
+        scaleInStrategy:
+          description: |-
+            The strategy used to select the VirtualMachines which are removed when the pool
+            is scaled in. If not specified, VirtualMachines are selected randomly.
+          properties:
+            type:
+              description: |-
+                Type of the scale-in strategy. Can be "NewestFirst", "OldestFirst", "NotReadyFirst"
+                or "HighestIndexFirst".
+                VirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are never
+                selected, regardless of the strategy.
+              enum:
+              - NewestFirst
+              - OldestFirst
+              - NotReadyFirst
+              - HighestIndexFirst
+              type: string
+          required:
+          - type
+          type: object
         selector:
           description: |-
             Label selector for pods. Existing Poolss whose pods are
@@ -19322,6 +21332,60 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        unhealthyReplicaReplacement:
+          description: |-
+            UnhealthyReplicaReplacement enables the replacement of VirtualMachines which are
+            stuck in the CrashLoopBackOff, ErrorUnschedulable or DataVolumeError status.
+            VirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are
+            never replaced. If not specified, unhealthy VirtualMachines are kept.
+          properties:
+            gracePeriod:
+              description: |-
+                Time a VirtualMachine has to be unhealthy before it gets deleted and recreated.
+                The grace period restarts if the pool controller restarts. Defaults to 5m.
+              type: string
+          type: object
+        updateStrategy:
+          description: |-
+            The strategy used to replace VirtualMachines running an outdated template.
+            If not specified, all outdated VirtualMachines are updated at once.
+          properties:
+            rollingUpdate:
+              description: Rolling update config params. Present only if type is "RollingUpdate".
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VirtualMachines that can be created over the desired number
+                    of replicas during the update. Outdated VirtualMachines are deleted once their
+                    replacements are ready.
+                    Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+                    Absolute number is calculated from percentage by rounding up.
+                    This can not be 0 if maxUnavailable is 0. Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VirtualMachines that can be unavailable during the update.
+                    Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+                    Absolute number is calculated from percentage by rounding down.
+                    This can not be 0 if maxSurge is 0. Defaults to 25%.
+                  x-kubernetes-int-or-string: true
+              type: object
+            type:
+              description: |-
+                Type of the update strategy. Can be "RollingUpdate", "OnDelete" or "Recreate".
+                Defaults to "RollingUpdate".
+              enum:
+              - RollingUpdate
+              - OnDelete
+              - Recreate
+              type: string
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -21506,7 +23570,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           bus:
                                             description: |-
                                               Bus indicates the type of disk device to emulate.
-                                              supported values: virtio, sata, scsi, usb.
+                                              supported values: virtio, sata, scsi, usb, nvme.
                                             type: string
                                           pciAddress:
                                             description: 'If specified, the virtual
@@ -21529,6 +23593,109 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune limits the throughput and the I/O operations per second of the disk.
+                                          It can be changed on a running VMI.
+                                          The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                                        properties:
+                                          burst:
+                                            description: Burst allows the disk to
+                                              exceed the limits for a short time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is the number of seconds a burst can last.
+                                                  Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              readBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesPerSecond is
+                                                  the read throughput allowed during
+                                                  a burst.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPS:
+                                                description: ReadIOPS is the read
+                                                  operations per second allowed during
+                                                  a burst.
+                                                format: int64
+                                                type: integer
+                                              totalBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesPerSecond is
+                                                  the combined read and write throughput
+                                                  allowed during a burst.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalIOPS:
+                                                description: TotalIOPS is the combined
+                                                  read and write operations per second
+                                                  allowed during a burst.
+                                                format: int64
+                                                type: integer
+                                              writeBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesPerSecond is
+                                                  the write throughput allowed during
+                                                  a burst.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPS:
+                                                description: WriteIOPS is the write
+                                                  operations per second allowed during
+                                                  a burst.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          readBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesPerSecond limits
+                                              the read throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPS:
+                                            description: ReadIOPS limits the read
+                                              operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesPerSecond limits
+                                              the combined read and write throughput
+                                              in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalIOPS:
+                                            description: TotalIOPS limits the combined
+                                              read and write operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesPerSecond limits
+                                              the write throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPS:
+                                            description: WriteIOPS limits the write
+                                              operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -22997,6 +25164,37 @@ var CRDsValidation map[string]string = map[string]string{
                           items:
                             description: Volume represents a named volume in a vmi.
                             properties:
+                              backup:
+                                description: Backup is attached to the virt launcher
+                                  and is populated with a backup of the vmi volumes
+                                properties:
+                                  baseCheckpoint:
+                                    description: BaseCheckpoint is the checkpoint
+                                      an incremental backup is based on, a full backup
+                                      is taken if it is empty
+                                    type: string
+                                  checkpoint:
+                                    description: Checkpoint is the name of the checkpoint
+                                      created with the backup
+                                    type: string
+                                  claimName:
+                                    description: |-
+                                      claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                      More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                                    type: string
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
+                                  readOnly:
+                                    description: |-
+                                      readOnly Will force the ReadOnly setting in VolumeMounts.
+                                      Default false.
+                                    type: boolean
+                                required:
+                                - checkpoint
+                                - claimName
+                                type: object
                               cloudInitConfigDrive:
                                 description: |-
                                   CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
@@ -23342,6 +25540,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  format:
+                                    description: Format is the format of the memory
+                                      dump output, defaults to elf
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -23452,6 +25654,20 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                     x-kubernetes-map-type: atomic
                                 type: object
+                              vhostUserBlk:
+                                description: VhostUserBlk attaches a disk which is
+                                  served by a storage daemon of the node over a vhost-user-blk
+                                  socket.
+                                properties:
+                                  socket:
+                                    description: |-
+                                      Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+                                      in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+                                      directory of the namespace of the VirtualMachineInstance can be used.
+                                    type: string
+                                required:
+                                - socket
+                                type: object
                             required:
                             - name
                             type: object
@@ -23475,6 +25691,46 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       properties:
+        autoscaling:
+          description: Autoscaling reports the last decision of the built-in autoscaler.
+          properties:
+            currentMetrics:
+              description: Last observed value of each metric.
+              items:
+                properties:
+                  currentAverageUtilization:
+                    description: |-
+                      Current value of the metric averaged across the VirtualMachines which reported it,
+                      as a percentage.
+                    format: int32
+                    type: integer
+                  samples:
+                    description: Number of VirtualMachines which reported the metric.
+                    format: int32
+                    type: integer
+                  type:
+                    description: Type of the metric.
+                    type: string
+                required:
+                - currentAverageUtilization
+                - samples
+                - type
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            desiredReplicas:
+              description: Number of replicas calculated by the autoscaler from the
+                current metrics.
+              format: int32
+              type: integer
+            lastScaleTime:
+              description: Last time the autoscaler changed the number of replicas.
+              format: date-time
+              nullable: true
+              type: string
+          required:
+          - desiredReplicas
+          type: object
         conditions:
           items:
             properties:
@@ -23507,7 +25763,41 @@ var CRDsValidation map[string]string = map[string]string{
         readyReplicas:
           format: int32
           type: integer
-        replicas:
+        replicas:
+          format: int32
+          type: integer
+        replicasByStatus:
+          description: Number of VirtualMachines in each printable status.
+          items:
+            properties:
+              count:
+                description: Number of VirtualMachines in the status.
+                format: int32
+                type: integer
+              status:
+                description: Printable status of the VirtualMachines.
+                type: string
+            required:
+            - count
+            - status
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - status
+          x-kubernetes-list-type: map
+        unhealthyReplicas:
+          description: |-
+            Number of VirtualMachines in the CrashLoopBackOff, ErrorUnschedulable or
+            DataVolumeError status.
+          format: int32
+          type: integer
+        updateRevision:
+          description: Name of the pool revision the pool is currently rolling out.
+          type: string
+        updatedReplicas:
+          description: |-
+            Number of VirtualMachines whose VirtualMachine and VirtualMachineInstance
+            are in sync with the current pool revision.
           format: int32
           type: integer
       type: object
@@ -24240,6 +26530,13 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetNamespace:
+          description: |-
+            TargetNamespace is the namespace the target is restored to, it defaults to the namespace of the
+            VirtualMachineRestore. Restoring to a different namespace relies on PVCs pulling their data from
+            VolumeSnapshots in another namespace, which requires the CrossNamespaceVolumeDataSource feature
+            of Kubernetes and a ReferenceGrant in the namespace of the VirtualMachineSnapshot.
+          type: string
         targetReadinessPolicy:
           description: |-
             TargetReadinessPolicy defines how to handle the restore in case
@@ -24247,6 +26544,32 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         virtualMachineSnapshotName:
           type: string
+        volumeRestoreOverrides:
+          description: VolumeRestoreOverrides customizes the PVCs restored volumes
+            are written to
+          items:
+            description: VolumeRestoreOverride customizes the PVC of a restored volume
+            properties:
+              restoreName:
+                description: RestoreName is the name of the restored PVC, it replaces
+                  the generated name
+                type: string
+              volumeName:
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        volumes:
+          description: |-
+            Volumes limits the restore to the listed volumes of the snapshot. Volumes that are not listed
+            keep their current source on the target, which therefore has to exist.
+            All volumes are restored if empty.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -26699,7 +29022,7 @@ var CRDsValidation map[string]string = map[string]string{
                                               bus:
                                                 description: |-
                                                   Bus indicates the type of disk device to emulate.
-                                                  supported values: virtio, sata, scsi, usb.
+                                                  supported values: virtio, sata, scsi, usb, nvme.
                                                 type: string
                                               pciAddress:
                                                 description: 'If specified, the virtual
@@ -26723,6 +29046,115 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune limits the throughput and the I/O operations per second of the disk.
+                                              It can be changed on a running VMI.
+                                              The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                                            properties:
+                                              burst:
+                                                description: Burst allows the disk
+                                                  to exceed the limits for a short
+                                                  time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is the number of seconds a burst can last.
+                                                      Defaults to 1.
+                                                    format: int64
+                                                    type: integer
+                                                  readBytesPerSecond:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: ReadBytesPerSecond
+                                                      is the read throughput allowed
+                                                      during a burst.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  readIOPS:
+                                                    description: ReadIOPS is the read
+                                                      operations per second allowed
+                                                      during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  totalBytesPerSecond:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: TotalBytesPerSecond
+                                                      is the combined read and write
+                                                      throughput allowed during a
+                                                      burst.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  totalIOPS:
+                                                    description: TotalIOPS is the
+                                                      combined read and write operations
+                                                      per second allowed during a
+                                                      burst.
+                                                    format: int64
+                                                    type: integer
+                                                  writeBytesPerSecond:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: WriteBytesPerSecond
+                                                      is the write throughput allowed
+                                                      during a burst.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  writeIOPS:
+                                                    description: WriteIOPS is the
+                                                      write operations per second
+                                                      allowed during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              readBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesPerSecond limits
+                                                  the read throughput in bytes per
+                                                  second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPS:
+                                                description: ReadIOPS limits the read
+                                                  operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesPerSecond limits
+                                                  the combined read and write throughput
+                                                  in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalIOPS:
+                                                description: TotalIOPS limits the
+                                                  combined read and write operations
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesPerSecond:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesPerSecond limits
+                                                  the write throughput in bytes per
+                                                  second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPS:
+                                                description: WriteIOPS limits the
+                                                  write operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -28203,6 +30635,37 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: Volume represents a named volume in a
                                   vmi.
                                 properties:
+                                  backup:
+                                    description: Backup is attached to the virt launcher
+                                      and is populated with a backup of the vmi volumes
+                                    properties:
+                                      baseCheckpoint:
+                                        description: BaseCheckpoint is the checkpoint
+                                          an incremental backup is based on, a full
+                                          backup is taken if it is empty
+                                        type: string
+                                      checkpoint:
+                                        description: Checkpoint is the name of the
+                                          checkpoint created with the backup
+                                        type: string
+                                      claimName:
+                                        description: |-
+                                          claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                                        type: string
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      readOnly:
+                                        description: |-
+                                          readOnly Will force the ReadOnly setting in VolumeMounts.
+                                          Default false.
+                                        type: boolean
+                                    required:
+                                    - checkpoint
+                                    - claimName
+                                    type: object
                                   cloudInitConfigDrive:
                                     description: |-
                                       CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
@@ -28555,6 +31018,10 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      format:
+                                        description: Format is the format of the memory
+                                          dump output, defaults to elf
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -28667,6 +31134,20 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                         x-kubernetes-map-type: atomic
                                     type: object
+                                  vhostUserBlk:
+                                    description: VhostUserBlk attaches a disk which
+                                      is served by a storage daemon of the node over
+                                      a vhost-user-blk socket.
+                                    properties:
+                                      socket:
+                                        description: |-
+                                          Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+                                          in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+                                          directory of the namespace of the VirtualMachineInstance can be used.
+                                        type: string
+                                    required:
+                                    - socket
+                                    type: object
                                 required:
                                 - name
                                 type: object
@@ -28764,6 +31245,10 @@ var CRDsValidation map[string]string = map[string]string{
                         dump to the given pvc
                       nullable: true
                       properties:
+                        checksum:
+                          description: Checksum is the sha256 checksum of the memory
+                            dump output
+                          type: string
                         claimName:
                           description: ClaimName is the name of the pvc that will
                             contain the memory dump
@@ -28773,10 +31258,23 @@ var CRDsValidation map[string]string = map[string]string{
                             dump was completed
                           format: date-time
                           type: string
+                        export:
+                          description: Export represents request of exposing the memory
+                            dump pvc through a VirtualMachineExport once the memory
+                            dump completed
+                          type: boolean
+                        exportName:
+                          description: ExportName is the name of the VirtualMachineExport
+                            exposing the memory dump
+                          type: string
                         fileName:
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format is the format of the memory dump output,
+                            defaults to elf
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -28797,6 +31295,59 @@ var CRDsValidation map[string]string = map[string]string{
                       - claimName
                       - phase
                       type: object
+                    migrationHistory:
+                      description: |-
+                        MigrationHistory lists the last 10 finished migrations of the VirtualMachine, oldest first.
+                        It outlives the VirtualMachineInstances and the migration objects.
+                      items:
+                        description: VirtualMachineMigrationRecord records a finished
+                          migration of a VirtualMachine
+                        properties:
+                          dataTransferredBytes:
+                            description: The amount of data the migration transferred
+                              to the target
+                            format: int64
+                            type: integer
+                          downtime:
+                            description: The time the guest was paused to switch over
+                              to the target
+                            type: string
+                          endTimestamp:
+                            description: The time the migration ended
+                            format: date-time
+                            type: string
+                          failed:
+                            description: Indicates that the migration failed
+                            type: boolean
+                          failureReason:
+                            description: The reason the migration failed
+                            type: string
+                          migrationUid:
+                            description: The UID of the migration
+                            type: string
+                          mode:
+                            description: The mode the migration ended in
+                            type: string
+                          sourceNode:
+                            description: The node the VirtualMachineInstance was migrated
+                              from
+                            type: string
+                          startTimestamp:
+                            description: The time the migration started
+                            format: date-time
+                            type: string
+                          targetNode:
+                            description: The node the VirtualMachineInstance was migrated
+                              to
+                            type: string
+                          vmiUid:
+                            description: The UID of the migrated VirtualMachineInstance
+                            type: string
+                        required:
+                        - migrationUid
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     observedGeneration:
                       description: ObservedGeneration is the generation observed by
                         the vmi when started.
@@ -28985,7 +31536,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       bus:
                                         description: |-
                                           Bus indicates the type of disk device to emulate.
-                                          supported values: virtio, sata, scsi, usb.
+                                          supported values: virtio, sata, scsi, usb, nvme.
                                         type: string
                                       pciAddress:
                                         description: 'If specified, the virtual disk
@@ -29008,6 +31559,105 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune limits the throughput and the I/O operations per second of the disk.
+                                      It can be changed on a running VMI.
+                                      The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+                                    properties:
+                                      burst:
+                                        description: Burst allows the disk to exceed
+                                          the limits for a short time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the number of seconds a burst can last.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          readBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesPerSecond is the
+                                              read throughput allowed during a burst.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPS:
+                                            description: ReadIOPS is the read operations
+                                              per second allowed during a burst.
+                                            format: int64
+                                            type: integer
+                                          totalBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesPerSecond is the
+                                              combined read and write throughput allowed
+                                              during a burst.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalIOPS:
+                                            description: TotalIOPS is the combined
+                                              read and write operations per second
+                                              allowed during a burst.
+                                            format: int64
+                                            type: integer
+                                          writeBytesPerSecond:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesPerSecond is the
+                                              write throughput allowed during a burst.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPS:
+                                            description: WriteIOPS is the write operations
+                                              per second allowed during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      readBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesPerSecond limits the
+                                          read throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS limits the read operations
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesPerSecond limits the
+                                          combined read and write throughput in bytes
+                                          per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalIOPS:
+                                        description: TotalIOPS limits the combined
+                                          read and write operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesPerSecond:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesPerSecond limits the
+                                          write throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS limits the write operations
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -29222,6 +31872,10 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: Requests represents the resources
                                           requested by the corresponding PVC spec
                                         type: object
+                                      storageClassName:
+                                        description: StorageClassName is the name
+                                          of the storage class of the PVC
+                                        type: string
                                       volumeMode:
                                         description: |-
                                           VolumeMode defines what type of volume is required by the claim.
@@ -29273,6 +31927,10 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: Requests represents the resources
                                           requested by the corresponding PVC spec
                                         type: object
+                                      storageClassName:
+                                        description: StorageClassName is the name
+                                          of the storage class of the PVC
+                                        type: string
                                       volumeMode:
                                         description: |-
                                           VolumeMode defines what type of volume is required by the claim.
@@ -29569,5 +32227,188 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotSchedule periodically takes snapshots of the selected VMs
+    and prunes the snapshots it took according to the retention rules.
+    The snapshots are labeled with snapshot.kubevirt.io/schedule=<schedule name>.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        retention:
+          description: |-
+            VirtualMachineSnapshotScheduleRetention defines which snapshots of each VM are kept.
+            A snapshot is kept as long as any of the rules selects it, snapshots which are not
+            selected by any rule are deleted. At least one rule has to keep snapshots.
+          properties:
+            keepDaily:
+              description: Number of days for which the most recent snapshot of the
+                day is kept
+              format: int32
+              type: integer
+            keepLast:
+              description: Number of most recent snapshots to keep
+              format: int32
+              type: integer
+            keepWeekly:
+              description: Number of weeks for which the most recent snapshot of the
+                week is kept
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: |-
+            Schedule in cron format: "minute hour day-of-month month day-of-week",
+            or one of @hourly, @daily, @weekly and @monthly. Times are in UTC.
+          type: string
+        selector:
+          description: |-
+            Selector of the VMs to snapshot, in the namespace of the schedule.
+            An empty selector selects all VMs of the namespace.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        snapshotTemplate:
+          description: Template of the VirtualMachineSnapshots created by the schedule
+          properties:
+            deletionPolicy:
+              description: |-
+                DeletionPolicy defines that to do with VirtualMachineSnapshot
+                when VirtualMachineSnapshot is deleted
+              type: string
+            failureDeadline:
+              description: |-
+                This time represents the number of seconds we permit the vm snapshot
+                to take. In case we pass this deadline we mark this snapshot
+                as failed.
+              type: string
+            labels:
+              additionalProperties:
+                type: string
+              description: Labels added to the created VirtualMachineSnapshots
+              type: object
+          type: object
+        suspend:
+          description: Suspend stops taking new snapshots, the retention rules are
+            still applied
+          type: boolean
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          description: Last time snapshots were taken
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          description: Next time snapshots will be taken
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
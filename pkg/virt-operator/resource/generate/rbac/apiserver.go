@@ -231,6 +231,18 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"list",
+					"watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
@@ -23,6 +23,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"kubevirt.io/api/backup"
 	"kubevirt.io/api/clone"
 	"kubevirt.io/api/export"
 	"kubevirt.io/api/pool"
@@ -39,21 +40,24 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMSnapshotSchedules = "virtualmachinesnapshotschedules"
+	apiVMBackups           = "virtualmachinebackups"
+	apiVMBackupTrackers    = "virtualmachinebackuptrackers"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
 
 	apiVMExpandSpec   = "virtualmachines/expand-spec"
 	apiVMPortForward  = "virtualmachines/portforward"
@@ -62,6 +66,8 @@ const (
 	apiVMRestart      = "virtualmachines/restart"
 	apiVMAddVolume    = "virtualmachines/addvolume"
 	apiVMRemoveVolume = "virtualmachines/removevolume"
+	apiVMInsertCDRom  = "virtualmachines/insertcdrom"
+	apiVMEjectCDRom   = "virtualmachines/ejectcdrom"
 	apiVMMigrate      = "virtualmachines/migrate"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
 
@@ -79,6 +85,7 @@ const (
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
+	apiVMInstancesGuestMetrics              = "virtualmachineinstances/guestmetrics"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
@@ -199,6 +206,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesGuestMetrics,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -250,6 +258,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRestart,
 					apiVMAddVolume,
 					apiVMRemoveVolume,
+					apiVMInsertCDRom,
+					apiVMEjectCDRom,
 					apiVMMemoryDump,
 				},
 				Verbs: []string{
@@ -300,6 +310,19 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					backup.GroupName,
+				},
+				Resources: []string{
+					apiVMBackups,
+					apiVMBackupTrackers,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -392,6 +415,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesGuestMetrics,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -443,6 +467,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRestart,
 					apiVMAddVolume,
 					apiVMRemoveVolume,
+					apiVMInsertCDRom,
+					apiVMEjectCDRom,
 					apiVMMemoryDump,
 				},
 				Verbs: []string{
@@ -493,6 +519,19 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					backup.GroupName,
+				},
+				Resources: []string{
+					apiVMBackups,
+					apiVMBackupTrackers,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -643,6 +682,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMExpandSpec,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesGuestMetrics,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
@@ -685,6 +725,19 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					backup.GroupName,
+				},
+				Resources: []string{
+					apiVMBackups,
+					apiVMBackupTrackers,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
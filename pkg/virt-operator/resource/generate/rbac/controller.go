@@ -283,6 +283,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"list",
 				},
 			},
+			{
+				APIGroups: []string{
+					"autoscaling",
+				},
+				Resources: []string{
+					"horizontalpodautoscalers",
+				},
+				Verbs: []string{
+					"list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
@@ -323,6 +334,23 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "create", "update", "delete", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"backup.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinebackups",
+					"virtualmachinebackups/status",
+					"virtualmachinebackups/finalizers",
+					"virtualmachinebackuptrackers",
+					"virtualmachinebackuptrackers/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -405,6 +433,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					"subresources.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstances/guestmetrics",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"cdi.kubevirt.io",
@@ -44,6 +44,8 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -79,6 +81,7 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 	results = append(results, validateCustomizeComponents(newKV.Spec.CustomizeComponents)...)
 	results = append(results, validateCertificates(newKV.Spec.CertificateRotationStrategy.SelfSigned)...)
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
+	results = append(results, validateMaintenanceWindows(field.NewPath("spec", "workloadUpdateStrategy", "maintenanceWindows"), newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -107,6 +110,13 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.MigrationConfiguration, newKV.Spec.Configuration.MigrationConfiguration) {
+		if newKV.Spec.Configuration.MigrationConfiguration != nil {
+			results = append(results,
+				validateMigrationConfiguration(field.NewPath("spec", "configuration", "migrations"), newKV.Spec.Configuration.MigrationConfiguration)...)
+		}
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
@@ -309,6 +319,24 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+func validateMigrationConfiguration(field *field.Path, migrationConfig *v1.MigrationConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if migrationConfig.Compression != nil {
+		causes = append(causes, migrationsutil.ValidateCompression(field.Child("compression"), migrationConfig.Compression)...)
+	}
+
+	if migrationConfig.SwitchoverPolicy != nil {
+		progressTimeout := migrationConfig.ProgressTimeout
+		if progressTimeout == nil {
+			progressTimeout = pointer.P(virtconfig.MigrationProgressTimeout)
+		}
+		causes = append(causes, migrationsutil.ValidateSwitchoverPolicy(field.Child("switchoverPolicy"), migrationConfig.SwitchoverPolicy, progressTimeout)...)
+	}
+
+	return causes
+}
+
 func validateWorkloadPlacement(ctx context.Context, namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -432,6 +460,38 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateMaintenanceWindows(field *field.Path, windows []v1.WorkloadUpdateMaintenanceWindow) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	for i, window := range windows {
+		if _, err := cron.Parse(window.Schedule); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid schedule %q: %v", window.Schedule, err),
+				Field:   field.Index(i).Child("schedule").String(),
+			})
+		}
+		if window.Duration.Duration <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "the duration of a maintenance window must be positive",
+				Field:   field.Index(i).Child("duration").String(),
+			})
+		}
+		if window.TimeZone != nil {
+			if _, err := cron.LoadLocation(*window.TimeZone); err != nil {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("unknown time zone %q", *window.TimeZone),
+					Field:   field.Index(i).Child("timeZone").String(),
+				})
+			}
+		}
+	}
+
+	return statuses
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
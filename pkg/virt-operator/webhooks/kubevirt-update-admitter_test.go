@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -164,6 +165,115 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with maintenance windows", func() {
+		windowsField := field.NewPath("spec", "workloadUpdateStrategy", "maintenanceWindows")
+
+		It("should accept valid windows", func() {
+			causes := validateMaintenanceWindows(windowsField, []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "0 22 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+				{Schedule: "@daily", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: pointer.P("Europe/Berlin"), Namespaces: []string{"tenant"}},
+			})
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(window v1.WorkloadUpdateMaintenanceWindow, expectedField string) {
+			causes := validateMaintenanceWindows(windowsField, []v1.WorkloadUpdateMaintenanceWindow{window})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an invalid schedule",
+				v1.WorkloadUpdateMaintenanceWindow{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				"spec.workloadUpdateStrategy.maintenanceWindows[0].schedule",
+			),
+			Entry("a missing duration",
+				v1.WorkloadUpdateMaintenanceWindow{Schedule: "@daily"},
+				"spec.workloadUpdateStrategy.maintenanceWindows[0].duration",
+			),
+			Entry("an unknown time zone",
+				v1.WorkloadUpdateMaintenanceWindow{Schedule: "@daily", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: pointer.P("Nowhere/Special")},
+				"spec.workloadUpdateStrategy.maintenanceWindows[0].timeZone",
+			),
+		)
+	})
+
+	Context("with migration configuration", func() {
+		migrationsField := field.NewPath("spec", "configuration", "migrations")
+
+		It("should accept a valid configuration", func() {
+			causes := validateMigrationConfiguration(migrationsField, &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Mode: v1.MigrationCompressionMultifdZstd, ZstdLevel: pointer.P(int32(3))},
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverRaiseDowntime, MaxDowntime: &metav1.Duration{Duration: time.Second}, StalledFor: &metav1.Duration{Duration: 30 * time.Second}},
+					{Action: v1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: 5 * time.Minute}},
+				}},
+			})
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(migrationConfig *v1.MigrationConfiguration, expectedField string) {
+			causes := validateMigrationConfiguration(migrationsField, migrationConfig)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown compression mode",
+				&v1.MigrationConfiguration{Compression: &v1.MigrationCompression{Mode: "lz4"}},
+				"spec.configuration.migrations.compression.mode",
+			),
+			Entry("an unknown switchover action",
+				&v1.MigrationConfiguration{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: "Pause", After: &metav1.Duration{Duration: time.Minute}},
+				}}},
+				"spec.configuration.migrations.switchoverPolicy.steps[0].action",
+			),
+			Entry("a switchover step stalled for the default progress timeout",
+				&v1.MigrationConfiguration{SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverPostCopy, StalledFor: &metav1.Duration{Duration: 150 * time.Second}},
+				}}},
+				"spec.configuration.migrations.switchoverPolicy.steps[0].stalledFor",
+			),
+			Entry("a switchover step stalled for the configured progress timeout",
+				&v1.MigrationConfiguration{
+					ProgressTimeout: pointer.P(int64(60)),
+					SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+						{Action: v1.MigrationSwitchoverPostCopy, StalledFor: &metav1.Duration{Duration: 90 * time.Second}},
+					}},
+				},
+				"spec.configuration.migrations.switchoverPolicy.steps[0].stalledFor",
+			),
+		)
+
+		It("should reject an update with an invalid switchover policy", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			kv := v1.KubeVirt{}
+			kvBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+				SwitchoverPolicy: &v1.MigrationSwitchoverPolicy{Steps: []v1.MigrationSwitchoverStep{
+					{Action: v1.MigrationSwitchoverAbort, After: &metav1.Duration{Duration: time.Minute}},
+					{Action: v1.MigrationSwitchoverPostCopy, After: &metav1.Duration{Duration: 2 * time.Minute}},
+				}},
+			}
+			kvUpdatedBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Operation: admissionv1.Update,
+					OldObject: runtime.RawExtension{Raw: kvBytes},
+					Object:    runtime.RawExtension{Raw: kvUpdatedBytes},
+				},
+			})
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.configuration.migrations.switchoverPolicy.steps[0].action"))
+		})
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
@@ -51,6 +51,8 @@ const (
 	FormatFlag       = "format"
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
+	DumpFormatFlag   = "dump-format"
+	ExportFlag       = "export"
 
 	configName         = "config"
 	filesystemOverhead = v1.Percent("0.055")
@@ -69,6 +71,8 @@ var (
 	storageClass string
 	accessMode   string
 	outputFile   string
+	dumpFormat   string
+	export       bool
 )
 
 type command struct{}
@@ -86,6 +90,12 @@ func usageMemoryDump() string {
   #Create and download memory dump to the given output file.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --output=memoryDump.dump.gz
 
+  #Dump memory of a virtual machine instance called 'myvm' as a kdump-compressed file with zlib compression.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --dump-format=kdump-zlib
+
+  #Dump memory and expose the memory dump pvc through a VirtualMachineExport once the dump completed.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --export
+
   #Dump memory again to the same virtual machine with an already associated pvc(existing memory dump on vm status).
   {{ProgramName}} memory-dump get myvm
 
@@ -117,6 +127,8 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
 	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded.")
+	cmd.Flags().StringVar(&dumpFormat, DumpFormatFlag, "", "Specifies the format of the memory dump (elf, kdump-zlib, kdump-lzo or kdump-snappy), defaults to elf.")
+	cmd.Flags().BoolVar(&export, ExportFlag, false, "Expose the memory dump pvc through a VirtualMachineExport once the memory dump completed.")
 
 	return cmd
 }
@@ -265,6 +277,8 @@ func createPVCforMemoryDump(namespace, vmName, claimName string, virtClient kube
 func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
 		ClaimName: claimName,
+		Format:    v1.MemoryDumpFormat(dumpFormat),
+		Export:    export,
 	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
@@ -302,24 +316,32 @@ func downloadMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtCli
 	}
 
 	// Wait for the memorydump to complete
-	claimName, err := WaitForMemoryDumpCompleteFn(virtClient, namespace, vmName, processingWaitInterval, processingWaitTotal)
+	memoryDumpRequest, err := WaitForMemoryDumpCompleteFn(virtClient, namespace, vmName, processingWaitInterval, processingWaitTotal)
 	if err != nil {
 		return err
 	}
-	if claimName == "" {
+	if memoryDumpRequest.ClaimName == "" {
 		return fmt.Errorf("claim name not on vm memory dump request")
 	}
 	exportSource := k8sv1.TypedLocalObjectReference{
 		APIGroup: &k8sv1.SchemeGroupVersion.Group,
 		Kind:     "PersistentVolumeClaim",
-		Name:     claimName,
+		Name:     memoryDumpRequest.ClaimName,
+	}
+	vmexportName := getVMExportName(vmName, memoryDumpRequest.ClaimName)
+	shouldCreate := true
+	keepVme := false
+	// Reuse the export created along with the memory dump
+	if memoryDumpRequest.ExportName != nil {
+		vmexportName = *memoryDumpRequest.ExportName
+		shouldCreate = false
+		keepVme = true
 	}
-	vmexportName := getVMExportName(vmName, claimName)
 	vmExportInfo := &vmexport.VMExportInfo{
 
-		ShouldCreate: true,
+		ShouldCreate: shouldCreate,
 		Insecure:     true,
-		KeepVme:      false,
+		KeepVme:      keepVme,
 		OutputFile:   outputFile,
 		Namespace:    namespace,
 		Name:         vmexportName,
@@ -349,8 +371,8 @@ func downloadMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtCli
 	return vmexport.DownloadVirtualMachineExport(virtClient, vmExportInfo)
 }
 
-func WaitForMemoryDumpComplete(virtClient kubecli.KubevirtClient, namespace, vmName string, interval, timeout time.Duration) (string, error) {
-	var claimName string
+func WaitForMemoryDumpComplete(virtClient kubecli.KubevirtClient, namespace, vmName string, interval, timeout time.Duration) (*v1.VirtualMachineMemoryDumpRequest, error) {
+	var memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest
 	err := virtwait.PollImmediately(interval, timeout, func(ctx context.Context) (bool, error) {
 		vm, err := virtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{})
 		if err != nil {
@@ -362,16 +384,19 @@ func WaitForMemoryDumpComplete(virtClient kubecli.KubevirtClient, namespace, vmN
 		}
 
 		if vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpCompleted {
-			fmt.Printf("Waiting for memorydump %s to complete, current phase: %s...\n", claimName, vm.Status.MemoryDumpRequest.Phase)
+			fmt.Printf("Waiting for memorydump %s to complete, current phase: %s...\n", vm.Status.MemoryDumpRequest.ClaimName, vm.Status.MemoryDumpRequest.Phase)
 			return false, nil
 		}
 
-		claimName = vm.Status.MemoryDumpRequest.ClaimName
+		memoryDumpRequest = vm.Status.MemoryDumpRequest
 		fmt.Println("Memory dump completed successfully")
+		if memoryDumpRequest.Checksum != "" {
+			fmt.Printf("Memory dump checksum: %s\n", memoryDumpRequest.Checksum)
+		}
 		return true, nil
 	})
 
-	return claimName, err
+	return memoryDumpRequest, err
 }
 
 func removeMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtClient) error {
@@ -33,6 +33,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
@@ -147,6 +148,25 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with dump format and export", func() {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			put, ok := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest])
+			Expect(ok).To(BeTrue())
+			request := put.GetOptions()
+			Expect(request.ClaimName).To(Equal(pvcName))
+			Expect(request.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+			Expect(request.Export).To(BeTrue())
+			return true, nil, nil
+		})
+		err := runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.DumpFormatFlag, string(v1.MemoryDumpFormatKdumpZlib)),
+			setFlag(memorydump.ExportFlag, "true"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
@@ -335,8 +355,8 @@ var _ = Describe("MemoryDump", func() {
 				return nil
 			}
 
-			memorydump.WaitForMemoryDumpCompleteFn = func(_ kubecli.KubevirtClient, _, _ string, _, _ time.Duration) (string, error) {
-				return pvcName, nil
+			memorydump.WaitForMemoryDumpCompleteFn = func(_ kubecli.KubevirtClient, _, _ string, _, _ time.Duration) (*v1.VirtualMachineMemoryDumpRequest, error) {
+				return &v1.VirtualMachineMemoryDumpRequest{ClaimName: pvcName, Phase: v1.MemoryDumpCompleted}, nil
 			}
 
 			secret = &k8sv1.Secret{
@@ -473,6 +493,29 @@ var _ = Describe("MemoryDump", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should download memory dump from the export of the memory dump", func() {
+			const exportName = "memorydump-test-vm-test-pvc"
+			memorydump.WaitForMemoryDumpCompleteFn = func(_ kubecli.KubevirtClient, _, _ string, _, _ time.Duration) (*v1.VirtualMachineMemoryDumpRequest, error) {
+				return &v1.VirtualMachineMemoryDumpRequest{
+					ClaimName:  pvcName,
+					Phase:      v1.MemoryDumpCompleted,
+					ExportName: pointer.P(exportName),
+				}, nil
+			}
+
+			vme.Name = exportName
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			virtClient.Fake.ClearActions()
+
+			err = runDownloadCmd(
+				setFlag(memorydump.OutputFileFlag, outputPath),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "create", "virtualmachineexports")).To(BeEmpty())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "delete", "virtualmachineexports")).To(BeEmpty())
+		})
+
 		DescribeTable("should call download memory dump with port-forward", func(extraArgs ...string) {
 			vmexport.HandleHTTPGetRequestFn = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, downloadUrl string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
 				Expect(downloadUrl).To(Equal("https://127.0.0.1:" + localPortStr))
@@ -506,8 +549,8 @@ var _ = Describe("MemoryDump", func() {
 
 		It("should fail download memory dump if not completed succesfully", func() {
 			const errMsg = "memory dump failed: test err"
-			memorydump.WaitForMemoryDumpCompleteFn = func(_ kubecli.KubevirtClient, _, _ string, _, _ time.Duration) (string, error) {
-				return pvcName, errors.New(errMsg)
+			memorydump.WaitForMemoryDumpCompleteFn = func(_ kubecli.KubevirtClient, _, _ string, _, _ time.Duration) (*v1.VirtualMachineMemoryDumpRequest, error) {
+				return nil, errors.New(errMsg)
 			}
 
 			err := runDownloadCmd(
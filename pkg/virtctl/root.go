@@ -118,6 +118,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewFSListCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
+		vm.NewCDRomCommand(),
 		vm.NewExpandCommand(),
 		memorydump.NewMemoryDumpCommand(),
 		pause.NewCommand(),
@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const diskNameArg = "disk-name"
+
+var diskName string
+
+func NewCDRomCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cdrom insert/eject (VM)",
+		Short:   "Insert or eject the media of a CD-ROM of a VM",
+		Example: usageCDRom(),
+		Args:    cobra.ExactArgs(2),
+		RunE:    cdromRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&diskName, diskNameArg, "", "name of the CD-ROM in the disks section of spec")
+	cmd.MarkFlagRequired(diskNameArg)
+	cmd.Flags().StringVar(&volumeName, volumeNameArg, "", "name of the DataVolume or PersistentVolumeClaim to insert")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
+func usageCDRom() string {
+	return `  #Insert the DataVolume 'fedora-iso' into the empty tray of the CD-ROM 'cdrom' of the VM 'myvm'.
+  {{ProgramName}} cdrom insert myvm --disk-name=cdrom --volume-name=fedora-iso
+
+  #Eject the media from the tray of the CD-ROM 'cdrom' of the VM 'myvm'.
+  {{ProgramName}} cdrom eject myvm --disk-name=cdrom
+  `
+}
+
+func cdromRun(cmd *cobra.Command, args []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vmName := args[1]
+	dryRunOption := setDryRunOption(dryRun)
+	switch args[0] {
+	case "insert":
+		return insertCDRom(vmName, namespace, virtClient, dryRunOption)
+	case "eject":
+		return ejectCDRom(vmName, namespace, virtClient, dryRunOption)
+	default:
+		return fmt.Errorf("invalid action type %s", args[0])
+	}
+}
+
+func insertCDRom(vmName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption []string) error {
+	if volumeName == "" {
+		return fmt.Errorf("missing the --%s flag of the media to insert", volumeNameArg)
+	}
+	volumeSource, err := getVolumeSourceFromVolume(volumeName, namespace, virtClient)
+	if err != nil {
+		return fmt.Errorf("error inserting media, %v", err)
+	}
+	err = virtClient.VirtualMachine(namespace).InsertCDRom(context.Background(), vmName, &v1.InsertCDRomOptions{
+		Name:         diskName,
+		VolumeSource: volumeSource,
+		DryRun:       dryRunOption,
+	})
+	if err != nil {
+		return fmt.Errorf("error inserting media, %v", err)
+	}
+	fmt.Printf("Successfully submitted insert media request to VM %s for CD-ROM %s\n", vmName, diskName)
+	return nil
+}
+
+func ejectCDRom(vmName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption []string) error {
+	err := virtClient.VirtualMachine(namespace).EjectCDRom(context.Background(), vmName, &v1.EjectCDRomOptions{
+		Name:   diskName,
+		DryRun: dryRunOption,
+	})
+	if err != nil {
+		return fmt.Errorf("error ejecting media, %v", err)
+	}
+	fmt.Printf("Successfully submitted eject media request to VM %s for CD-ROM %s\n", vmName, diskName)
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+	"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("CD-ROM command", func() {
+	const (
+		vmName     = "testvm"
+		diskName   = "cdrom"
+		volumeName = "testvolume"
+	)
+
+	var cdiClient *cdifake.Clientset
+	var coreClient *k8sfake.Clientset
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		cdiClient = cdifake.NewSimpleClientset()
+		coreClient = k8sfake.NewSimpleClientset()
+		virtClient = kubevirtfake.NewSimpleClientset()
+	})
+
+	expectVMEndpoint := func() {
+		kubecli.MockKubevirtClientInstance.
+			EXPECT().
+			VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).
+			Times(1)
+	}
+
+	DescribeTable("should fail with missing required or invalid parameters", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"cdrom"}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("no args", "accepts 2 arg(s), received 0"),
+		Entry("missing required disk-name", "required flag(s)", "eject", vmName),
+		Entry("invalid action", "invalid action type", "open", vmName, "--disk-name="+diskName),
+		Entry("insert without volume-name", "missing the --volume-name flag", "insert", vmName, "--disk-name="+diskName),
+	)
+
+	DescribeTable("should insert the media", func(createSource func(), expectedSource *v1.HotplugVolumeSource, dryRun bool) {
+		createSource()
+		expectVMEndpoint()
+		virtClient.PrependReactor("put", "virtualmachines/insertcdrom", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			switch action := action.(type) {
+			case kvtesting.PutAction[*v1.InsertCDRomOptions]:
+				opts := action.GetOptions()
+				Expect(opts.Name).To(Equal(diskName))
+				Expect(opts.VolumeSource).To(Equal(expectedSource))
+				if dryRun {
+					Expect(opts.DryRun).To(Equal([]string{metav1.DryRunAll}))
+				} else {
+					Expect(opts.DryRun).To(BeEmpty())
+				}
+				return true, nil, nil
+			default:
+				Fail("unexpected action type on insertcdrom")
+				return false, nil, nil
+			}
+		})
+
+		args := []string{"cdrom", "insert", vmName, "--disk-name=" + diskName, "--volume-name=" + volumeName}
+		if dryRun {
+			args = append(args, "--dry-run")
+		}
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "insertcdrom")).To(HaveLen(1))
+	},
+		Entry("from a DataVolume", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient)
+			_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &v1beta1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: volumeName},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}, &v1.HotplugVolumeSource{
+			DataVolume: &v1.DataVolumeSource{Name: volumeName, Hotpluggable: true},
+		}, false),
+		Entry("from a PersistentVolumeClaim with dry-run", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient)
+			kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(coreClient.CoreV1())
+			_, err := coreClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: volumeName},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}, &v1.HotplugVolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: volumeName},
+				Hotpluggable:                      true,
+			},
+		}, true),
+	)
+
+	It("should fail to insert a media which is neither a DataVolume nor a PersistentVolumeClaim", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(coreClient.CoreV1())
+		cmd := testing.NewRepeatableVirtctlCommand("cdrom", "insert", vmName, "--disk-name="+diskName, "--volume-name="+volumeName)
+		Expect(cmd()).To(MatchError(ContainSubstring("Volume " + volumeName + " is not a DataVolume or PersistentVolumeClaim")))
+	})
+
+	It("should eject the media", func() {
+		expectVMEndpoint()
+		virtClient.PrependReactor("put", "virtualmachines/ejectcdrom", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			switch action := action.(type) {
+			case kvtesting.PutAction[*v1.EjectCDRomOptions]:
+				Expect(action.GetOptions().Name).To(Equal(diskName))
+				return true, nil, nil
+			default:
+				Fail("unexpected action type on ejectcdrom")
+				return false, nil, nil
+			}
+		})
+		Expect(testing.NewRepeatableVirtctlCommand("cdrom", "eject", vmName, "--disk-name="+diskName)()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "ejectcdrom")).To(HaveLen(1))
+	})
+
+	It("should report an error if the media can't be ejected", func() {
+		expectVMEndpoint()
+		virtClient.PrependReactor("put", "virtualmachines/ejectcdrom", func(_ k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, nil, errors.New("tray is already empty")
+		})
+		cmd := testing.NewRepeatableVirtctlCommand("cdrom", "eject", vmName, "--disk-name="+diskName)
+		Expect(cmd()).To(MatchError(ContainSubstring("error ejecting media, tray is already empty")))
+	})
+})
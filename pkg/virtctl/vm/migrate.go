@@ -22,6 +22,9 @@ package vm
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -49,7 +52,7 @@ func NewMigrateCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringToStringVar(&c.addedNodeSelector, "addedNodeSelector", nil, "--addedNodeSelector=key=value1,key2=value2: configure an additional node selector for the one-off migration attempt. AddedNodeSelector can only restrict constraints already set on the VM. By default the scheduler is responsible for finding the best Node, which is the recommended way of migrating VMs.")
-	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, "--dry-run=false: If true, report which nodes could take the VM and why the others are rejected instead of migrating it.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -69,6 +72,14 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 		AddedNodeSelector: c.addedNodeSelector,
 	}
 
+	if dryRun {
+		feasibility, err := virtClient.VirtualMachine(namespace).MigrateDryRun(context.Background(), vmiName, options)
+		if err != nil {
+			return fmt.Errorf("Error evaluating the migration of VirtualMachine %v", err)
+		}
+		return printMigrationFeasibility(cmd.OutOrStdout(), vmiName, feasibility)
+	}
+
 	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
@@ -78,3 +89,21 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func printMigrationFeasibility(out io.Writer, vmName string, feasibility *v1.MigrationFeasibility) error {
+	if feasibility.Migratable {
+		fmt.Fprintf(out, "VM %s can be migrated\n", vmName)
+	} else {
+		fmt.Fprintf(out, "VM %s cannot be migrated: %s\n", vmName, strings.Join(feasibility.Reasons, "; "))
+	}
+	if len(feasibility.Nodes) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tFEASIBLE\tREASONS")
+	for _, node := range feasibility.Nodes {
+		fmt.Fprintf(w, "%s\t%t\t%s\n", node.Name, node.Feasible, strings.Join(node.Reasons, "; "))
+	}
+	return w.Flush()
+}
@@ -21,6 +21,7 @@ package vm_test
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -66,22 +67,11 @@ var _ = Describe("Migrate command", func() {
 		Entry(
 			"with default",
 			&v1.MigrateOptions{}),
-		Entry(
-			"with dry-run option",
-			&v1.MigrateOptions{
-				DryRun: []string{k8smetav1.DryRunAll}},
-			"--dry-run"),
 		Entry(
 			"with addedNodeSelector option",
 			&v1.MigrateOptions{
 				AddedNodeSelector: map[string]string{"key1": "value1", "key2": "value2"}},
 			"--addedNodeSelector", "key1=value1,key2=value2"),
-		Entry(
-			"with dry-run and addedNodeSelector options",
-			&v1.MigrateOptions{
-				AddedNodeSelector: map[string]string{"key1": "value1", "key2": "value2"},
-				DryRun:            []string{k8smetav1.DryRunAll}},
-			"--dry-run", "--addedNodeSelector", "key1=value1,key2=value2"),
 		Entry(
 			"with repeated addedNodeSelector",
 			&v1.MigrateOptions{
@@ -89,6 +79,51 @@ var _ = Describe("Migrate command", func() {
 			"--addedNodeSelector", "key1=value1", "--addedNodeSelector", "key2=value2"),
 	)
 
+	DescribeTable("should report the migration feasibility on dry run", func(expectedMigrateOptions *v1.MigrateOptions, feasibility *v1.MigrationFeasibility, expectedOutput string, extraArgs ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().MigrateDryRun(context.Background(), vmName, expectedMigrateOptions).Return(feasibility, nil).Times(1)
+
+		args := []string{"migrate", vmName, "--dry-run"}
+		args = append(args, extraArgs...)
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(args...)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(expectedOutput))
+	},
+		Entry(
+			"with a migratable VM",
+			&v1.MigrateOptions{
+				DryRun: []string{k8smetav1.DryRunAll}},
+			&v1.MigrationFeasibility{
+				Migratable: true,
+				Nodes: []v1.NodeMigrationFeasibility{
+					{Name: "node01", Feasible: true},
+					{Name: "node02", Reasons: []string{"node is cordoned", "node is not ready"}},
+				},
+			},
+			"VM testvm can be migrated\n"+
+				"NODE    FEASIBLE  REASONS\n"+
+				"node01  true      \n"+
+				"node02  false     node is cordoned; node is not ready\n"),
+		Entry(
+			"with a VM which cannot be migrated and addedNodeSelector option",
+			&v1.MigrateOptions{
+				AddedNodeSelector: map[string]string{"key1": "value1", "key2": "value2"},
+				DryRun:            []string{k8smetav1.DryRunAll}},
+			&v1.MigrationFeasibility{
+				Reasons: []string{"VirtualMachineInstance is not live migratable: cannot migrate VMI"},
+			},
+			"VM testvm cannot be migrated: VirtualMachineInstance is not live migratable: cannot migrate VMI\n",
+			"--addedNodeSelector", "key1=value1,key2=value2"),
+	)
+
+	It("should fail if the migration feasibility cannot be evaluated", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().MigrateDryRun(context.Background(), vmName, gomock.Any()).Return(nil, fmt.Errorf("VM is not running")).Times(1)
+
+		err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--dry-run")()
+		Expect(err).To(MatchError("Error evaluating the migration of VirtualMachine VM is not running"))
+	})
+
 	DescribeTable("should fail with badly formatted addedNodeSelector", func(extraArgs ...string) {
 		args := []string{"migrate", vmName}
 		args = append(args, extraArgs...)
@@ -0,0 +1,480 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/clientcmd"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+// GetSourceClientFn allows overriding the client used to access the source cluster (useful for unit testing)
+var GetSourceClientFn = GetSourceClient
+
+// importObjects holds the objects read from the export manifests, in the order they are created
+type importObjects struct {
+	configMaps  []*k8sv1.ConfigMap
+	secrets     []*k8sv1.Secret
+	dataVolumes []*cdiv1.DataVolume
+	vms         []*virtv1.VirtualMachine
+}
+
+// ImportVirtualMachineExport recreates the VirtualMachine, DataVolumes, Secrets and ConfigMaps of an export in the target namespace.
+// The DataVolumes keep importing the volumes from the export, so the export has to stay available until they succeeded.
+// Secrets and ConfigMaps referenced by the VirtualMachine but missing from the manifests are copied from the source namespace.
+func ImportVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error {
+	manifests, sourceClient, sourceNamespace, err := getImportManifests(client, vmeInfo)
+	if err != nil {
+		return err
+	}
+	objects, err := decodeImportManifests(manifests)
+	if err != nil {
+		return err
+	}
+	if len(objects.vms) == 0 {
+		return fmt.Errorf("unable to find a VirtualMachine in the manifests of '%s' VirtualMachineExport", vmeInfo.Name)
+	}
+	unresolved, err := objects.addReferencedObjects(sourceClient, sourceNamespace)
+	if err != nil {
+		return err
+	}
+	objects.remap(vmeInfo.Namespace, vmeInfo.NameMap, vmeInfo.StorageClassMap)
+	if err := checkReferencedObjects(client, vmeInfo.Namespace, vmeInfo.NameMap, unresolved); err != nil {
+		return err
+	}
+
+	return objects.create(client, vmeInfo.Namespace)
+}
+
+// GetSourceClient returns a client and the namespace of the source cluster from the given kubeconfig and context
+func GetSourceClient(kubeconfig, kubecontext string) (kubecli.KubevirtClient, string, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{CurrentContext: kubecontext})
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, namespace, nil
+}
+
+// getImportManifests reads the manifests from a file or fetches them, including the CDI header secret, from the source export.
+// It also returns the client and the namespace of the source export, the client is nil for manifests read from a file.
+func getImportManifests(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) ([]byte, kubecli.KubevirtClient, string, error) {
+	if vmeInfo.ManifestFile != "" {
+		manifests, err := os.ReadFile(vmeInfo.ManifestFile)
+		return manifests, nil, "", err
+	}
+
+	sourceInfo := *vmeInfo
+	sourceClient := client
+	if vmeInfo.SourceKubeconfig != "" || vmeInfo.SourceContext != "" {
+		var err error
+		sourceClient, sourceInfo.Namespace, err = GetSourceClientFn(vmeInfo.SourceKubeconfig, vmeInfo.SourceContext)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	if vmeInfo.SourceNamespace != "" {
+		sourceInfo.Namespace = vmeInfo.SourceNamespace
+	}
+
+	// The export is never deleted here, the imported DataVolumes still need it
+	if sourceInfo.ShouldCreate {
+		if err := CreateVirtualMachineExport(sourceClient, &sourceInfo); err != nil && !errExportAlreadyExists(err) {
+			return nil, nil, "", err
+		}
+	}
+	if err := WaitForVirtualMachineExportFn(sourceClient, &sourceInfo, processingWaitInterval, sourceInfo.ReadinessTimeout); err != nil {
+		return nil, nil, "", err
+	}
+	vmexport, err := getVirtualMachineExport(sourceClient, &sourceInfo)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if vmexport == nil {
+		return nil, nil, "", fmt.Errorf("unable to get '%s/%s' VirtualMachineExport", sourceInfo.Namespace, sourceInfo.Name)
+	}
+
+	manifestMap, err := GetManifestUrlsFromVirtualMachineExport(vmexport, &sourceInfo)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	var manifests []byte
+	for _, manifestType := range []exportv1.ExportManifestType{exportv1.AllManifests, exportv1.AuthHeader} {
+		body, err := getManifest(sourceClient, vmexport, &sourceInfo, manifestMap[manifestType])
+		if err != nil {
+			return nil, nil, "", err
+		}
+		manifests = append(manifests, body...)
+	}
+	return manifests, sourceClient, sourceInfo.Namespace, nil
+}
+
+func getManifest(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, manifestUrl string) ([]byte, error) {
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, manifestUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, map[string]string{ACCEPT: APPLICATION_YAML})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get the manifests of '%s/%s' VirtualMachineExport: %s", vmexport.Namespace, vmexport.Name, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// decodeImportManifests decodes YAML or JSON manifests, as returned by the export server. Lists are flattened.
+func decodeImportManifests(manifests []byte) (*importObjects, error) {
+	objects := &importObjects{}
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifests), 4096)
+	for {
+		obj := map[string]interface{}{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if err := objects.add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+func (o *importObjects) add(obj map[string]interface{}) error {
+	if len(obj) == 0 {
+		return nil
+	}
+	var target interface{}
+	switch kind, _ := obj["kind"].(string); kind {
+	case "List":
+		items, _ := obj["items"].([]interface{})
+		for _, item := range items {
+			itemObj, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid item in manifest list")
+			}
+			if err := o.add(itemObj); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "ConfigMap":
+		cm := &k8sv1.ConfigMap{}
+		o.configMaps = append(o.configMaps, cm)
+		target = cm
+	case "Secret":
+		secret := &k8sv1.Secret{}
+		o.secrets = append(o.secrets, secret)
+		target = secret
+	case "DataVolume":
+		dv := &cdiv1.DataVolume{}
+		o.dataVolumes = append(o.dataVolumes, dv)
+		target = dv
+	case virtv1.VirtualMachineGroupVersionKind.Kind:
+		vm := &virtv1.VirtualMachine{}
+		o.vms = append(o.vms, vm)
+		target = vm
+	default:
+		return fmt.Errorf("unsupported kind '%s' in manifests", kind)
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj, target)
+}
+
+// referencedObjects returns the names of the ConfigMaps and Secrets referenced by the volumes of the VirtualMachines
+// which are not part of the manifests
+func (o *importObjects) referencedObjects() (configMaps, secrets []string) {
+	knownConfigMaps := map[string]bool{}
+	for _, cm := range o.configMaps {
+		knownConfigMaps[cm.Name] = true
+	}
+	knownSecrets := map[string]bool{}
+	for _, secret := range o.secrets {
+		knownSecrets[secret.Name] = true
+	}
+	addConfigMap := func(name string) {
+		if name != "" && !knownConfigMaps[name] {
+			knownConfigMaps[name] = true
+			configMaps = append(configMaps, name)
+		}
+	}
+	addSecret := func(name string) {
+		if name != "" && !knownSecrets[name] {
+			knownSecrets[name] = true
+			secrets = append(secrets, name)
+		}
+	}
+	addSecretRef := func(ref *k8sv1.LocalObjectReference) {
+		if ref != nil {
+			addSecret(ref.Name)
+		}
+	}
+
+	for _, vm := range o.vms {
+		if vm.Spec.Template == nil {
+			continue
+		}
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			switch {
+			case volume.ConfigMap != nil:
+				addConfigMap(volume.ConfigMap.Name)
+			case volume.Secret != nil:
+				addSecret(volume.Secret.SecretName)
+			case volume.CloudInitNoCloud != nil:
+				addSecretRef(volume.CloudInitNoCloud.UserDataSecretRef)
+				addSecretRef(volume.CloudInitNoCloud.NetworkDataSecretRef)
+			case volume.CloudInitConfigDrive != nil:
+				addSecretRef(volume.CloudInitConfigDrive.UserDataSecretRef)
+				addSecretRef(volume.CloudInitConfigDrive.NetworkDataSecretRef)
+			case volume.Sysprep != nil:
+				addSecretRef(volume.Sysprep.Secret)
+				if volume.Sysprep.ConfigMap != nil {
+					addConfigMap(volume.Sysprep.ConfigMap.Name)
+				}
+			}
+		}
+	}
+	return configMaps, secrets
+}
+
+// unresolvedObjects holds the names of the referenced ConfigMaps and Secrets which could not be found in the source namespace
+type unresolvedObjects struct {
+	configMaps []string
+	secrets    []string
+}
+
+// addReferencedObjects adds the ConfigMaps and Secrets referenced by the VirtualMachines, which are not part of the manifests,
+// from the source namespace. Without a source client all of them are unresolved.
+func (o *importObjects) addReferencedObjects(sourceClient kubecli.KubevirtClient, sourceNamespace string) (*unresolvedObjects, error) {
+	configMaps, secrets := o.referencedObjects()
+	if sourceClient == nil {
+		return &unresolvedObjects{configMaps: configMaps, secrets: secrets}, nil
+	}
+
+	unresolved := &unresolvedObjects{}
+	for _, name := range configMaps {
+		cm, err := sourceClient.CoreV1().ConfigMaps(sourceNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			unresolved.configMaps = append(unresolved.configMaps, name)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		o.configMaps = append(o.configMaps, cm)
+	}
+	for _, name := range secrets {
+		secret, err := sourceClient.CoreV1().Secrets(sourceNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			unresolved.secrets = append(unresolved.secrets, name)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		o.secrets = append(o.secrets, secret)
+	}
+	return unresolved, nil
+}
+
+// checkReferencedObjects fails if unresolved ConfigMaps or Secrets do not exist in the target namespace either.
+// All the missing objects are listed, so they can be created before retrying the import.
+func checkReferencedObjects(client kubecli.KubevirtClient, namespace string, nameMap map[string]string, unresolved *unresolvedObjects) error {
+	var missing []string
+	for _, name := range unresolved.configMaps {
+		_, err := client.CoreV1().ConfigMaps(namespace).Get(context.Background(), importName(nameMap, name), metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			missing = append(missing, fmt.Sprintf("ConfigMap '%s'", importName(nameMap, name)))
+		} else if err != nil {
+			return err
+		}
+	}
+	for _, name := range unresolved.secrets {
+		_, err := client.CoreV1().Secrets(namespace).Get(context.Background(), importName(nameMap, name), metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			missing = append(missing, fmt.Sprintf("Secret '%s'", importName(nameMap, name)))
+		} else if err != nil {
+			return err
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("unable to find the objects referenced by the VirtualMachine in namespace '%s': %s", namespace, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// importName returns the name of an object in the target namespace according to nameMap
+func importName(nameMap map[string]string, name string) string {
+	if newName, ok := nameMap[name]; ok {
+		return newName
+	}
+	return name
+}
+
+// remap moves the objects into the target namespace, renames them according to nameMap and replaces
+// the storage classes according to storageClassMap. References between the objects are updated.
+func (o *importObjects) remap(namespace string, nameMap, storageClassMap map[string]string) {
+	name := func(name string) string {
+		return importName(nameMap, name)
+	}
+	localObjectReference := func(ref *k8sv1.LocalObjectReference) {
+		if ref != nil {
+			ref.Name = name(ref.Name)
+		}
+	}
+	dataVolumeSpec := func(spec *cdiv1.DataVolumeSpec) {
+		if spec.Source != nil && spec.Source.HTTP != nil {
+			spec.Source.HTTP.CertConfigMap = name(spec.Source.HTTP.CertConfigMap)
+			for i, secret := range spec.Source.HTTP.SecretExtraHeaders {
+				spec.Source.HTTP.SecretExtraHeaders[i] = name(secret)
+			}
+			spec.Source.HTTP.SecretRef = name(spec.Source.HTTP.SecretRef)
+		}
+		var storageClassName *string
+		if spec.Storage != nil {
+			storageClassName = spec.Storage.StorageClassName
+		} else if spec.PVC != nil {
+			storageClassName = spec.PVC.StorageClassName
+		}
+		if storageClassName != nil {
+			if newStorageClassName, ok := storageClassMap[*storageClassName]; ok {
+				*storageClassName = newStorageClassName
+			}
+		}
+	}
+
+	for _, cm := range o.configMaps {
+		cm.ObjectMeta = importObjectMeta(cm.ObjectMeta, namespace, name)
+	}
+	for _, secret := range o.secrets {
+		secret.ObjectMeta = importObjectMeta(secret.ObjectMeta, namespace, name)
+	}
+	for _, dv := range o.dataVolumes {
+		dv.ObjectMeta = importObjectMeta(dv.ObjectMeta, namespace, name)
+		dataVolumeSpec(&dv.Spec)
+		dv.Status = cdiv1.DataVolumeStatus{}
+	}
+	for _, vm := range o.vms {
+		vm.ObjectMeta = importObjectMeta(vm.ObjectMeta, namespace, name)
+		for i := range vm.Spec.DataVolumeTemplates {
+			vm.Spec.DataVolumeTemplates[i].Name = name(vm.Spec.DataVolumeTemplates[i].Name)
+			vm.Spec.DataVolumeTemplates[i].Namespace = ""
+			dataVolumeSpec(&vm.Spec.DataVolumeTemplates[i].Spec)
+		}
+		if vm.Spec.Template != nil {
+			for i := range vm.Spec.Template.Spec.Volumes {
+				volume := &vm.Spec.Template.Spec.Volumes[i]
+				switch {
+				case volume.DataVolume != nil:
+					volume.DataVolume.Name = name(volume.DataVolume.Name)
+				case volume.PersistentVolumeClaim != nil:
+					volume.PersistentVolumeClaim.ClaimName = name(volume.PersistentVolumeClaim.ClaimName)
+				case volume.ConfigMap != nil:
+					volume.ConfigMap.Name = name(volume.ConfigMap.Name)
+				case volume.Secret != nil:
+					volume.Secret.SecretName = name(volume.Secret.SecretName)
+				case volume.CloudInitNoCloud != nil:
+					localObjectReference(volume.CloudInitNoCloud.UserDataSecretRef)
+					localObjectReference(volume.CloudInitNoCloud.NetworkDataSecretRef)
+				case volume.CloudInitConfigDrive != nil:
+					localObjectReference(volume.CloudInitConfigDrive.UserDataSecretRef)
+					localObjectReference(volume.CloudInitConfigDrive.NetworkDataSecretRef)
+				case volume.Sysprep != nil:
+					localObjectReference(volume.Sysprep.Secret)
+					localObjectReference(volume.Sysprep.ConfigMap)
+				}
+			}
+		}
+		vm.Status = virtv1.VirtualMachineStatus{}
+	}
+}
+
+// importObjectMeta only keeps the name, labels and annotations of the source object
+func importObjectMeta(meta metav1.ObjectMeta, namespace string, name func(string) string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        name(meta.Name),
+		Namespace:   namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
+
+// create creates the objects, the ConfigMaps and Secrets first as the DataVolumes and VirtualMachines depend on them
+func (o *importObjects) create(client kubecli.KubevirtClient, namespace string) error {
+	for _, cm := range o.configMaps {
+		if _, err := client.CoreV1().ConfigMaps(namespace).Create(context.Background(), cm, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		printToOutput("ConfigMap '%s/%s' created\n", namespace, cm.Name)
+	}
+	for _, secret := range o.secrets {
+		if _, err := client.CoreV1().Secrets(namespace).Create(context.Background(), secret, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		printToOutput("Secret '%s/%s' created\n", namespace, secret.Name)
+	}
+	for _, dv := range o.dataVolumes {
+		if _, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(context.Background(), dv, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		printToOutput("DataVolume '%s/%s' created\n", namespace, dv.Name)
+	}
+	for _, vm := range o.vms {
+		if _, err := client.VirtualMachine(namespace).Create(context.Background(), vm, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		printToOutput("VirtualMachine '%s/%s' created\n", namespace, vm.Name)
+	}
+	return nil
+}
+
+// parseNameMap parses a slice of "old=new" strings
+func parseNameMap(flag string, slice []string) (map[string]string, error) {
+	nameMap := make(map[string]string)
+	for _, item := range slice {
+		oldName, newName, found := strings.Cut(item, "=")
+		if !found || oldName == "" || newName == "" {
+			return nil, fmt.Errorf(ErrInvalidValue, flag, "old=new pairs")
+		}
+		nameMap[oldName] = newName
+	}
+	return nameMap, nil
+}
@@ -0,0 +1,476 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakek8sclient "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	fakecdiclient "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+)
+
+var _ = Describe("vmexport import", func() {
+	const (
+		vmName         = "test-vm"
+		dvName         = "test-dv"
+		cmName         = "export-ca-cm-" + vmeName
+		headerSecret   = "header-secret-" + vmeName
+		sourceNs       = "source-ns"
+		storageClass   = "local"
+		vmCloudInitRef = "test-cloudinit"
+	)
+
+	var (
+		kubeClient   *fakek8sclient.Clientset
+		virtClient   *kubevirtfake.Clientset
+		cdiClient    *fakecdiclient.Clientset
+		manifestPath string
+	)
+
+	newDataVolumeSpec := func(url string) cdiv1.DataVolumeSpec {
+		return cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:                url,
+					CertConfigMap:      cmName,
+					SecretExtraHeaders: []string{headerSecret},
+				},
+			},
+			Storage: &cdiv1.StorageSpec{
+				StorageClassName: pointer.P(storageClass),
+			},
+		}
+	}
+
+	newManifests := func() []runtime.Object {
+		cm := &k8sv1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: cmName},
+			Data:       map[string]string{"ca.pem": "cert"},
+		}
+		vm := &v1.VirtualMachine{
+			TypeMeta: metav1.TypeMeta{Kind: "VirtualMachine", APIVersion: "kubevirt.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            vmName,
+				Namespace:       sourceNs,
+				ResourceVersion: "1",
+				UID:             "1234",
+				Labels:          map[string]string{"app": "test"},
+			},
+			Spec: v1.VirtualMachineSpec{
+				DataVolumeTemplates: []v1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{Name: vmName + "-root"},
+					Spec:       newDataVolumeSpec("https://export/volumes/root/disk.img.gz"),
+				}},
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name:         "root",
+								VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: vmName + "-root"}},
+							},
+							{
+								Name:         "data",
+								VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: dvName}},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+									UserDataSecretRef: &k8sv1.LocalObjectReference{Name: vmCloudInitRef},
+								}},
+							},
+						},
+					},
+				},
+			},
+			Status: v1.VirtualMachineStatus{Ready: true},
+		}
+		dv := &cdiv1.DataVolume{
+			TypeMeta:   metav1.TypeMeta{Kind: "DataVolume", APIVersion: "cdi.kubevirt.io/v1beta1"},
+			ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: sourceNs},
+			Spec:       newDataVolumeSpec("https://export/volumes/data/disk.img.gz"),
+		}
+		secret := &k8sv1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: headerSecret},
+			StringData: map[string]string{"token": "x-kubevirt-export-token:test"},
+		}
+		return []runtime.Object{cm, vm, dv, secret}
+	}
+
+	newCloudInitSecret := func(name, namespace string) *k8sv1.Secret {
+		return &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       map[string][]byte{"userdata": []byte("#cloud-config")},
+		}
+	}
+
+	toYaml := func(objects ...runtime.Object) []byte {
+		var data []byte
+		for _, obj := range objects {
+			objBytes, err := yaml.Marshal(obj)
+			Expect(err).ToNot(HaveOccurred())
+			data = append(data, objBytes...)
+			data = append(data, []byte("---\n")...)
+		}
+		return data
+	}
+
+	writeManifests := func(data []byte) {
+		Expect(os.WriteFile(manifestPath, data, 0600)).To(Succeed())
+	}
+
+	newMockClient := func(kubeClient *fakek8sclient.Clientset, virtClient *kubevirtfake.Clientset, cdiClient *fakecdiclient.Clientset, namespace string) *kubecli.MockKubevirtClient {
+		client := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		client.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		client.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+		client.EXPECT().VirtualMachine(namespace).Return(virtClient.KubevirtV1().VirtualMachines(namespace)).AnyTimes()
+		client.EXPECT().VirtualMachineExport(namespace).Return(virtClient.ExportV1beta1().VirtualMachineExports(namespace)).AnyTimes()
+		return client
+	}
+
+	expectImported := func(vmName, dvName, cmName, secretName, storageClass string) {
+		cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.Background(), cmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cm.Data).To(HaveKeyWithValue("ca.pem", "cert"))
+
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.StringData).To(HaveKeyWithValue("token", "x-kubevirt-export-token:test"))
+
+		dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), dvName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dv.Spec.Source.HTTP.URL).To(Equal("https://export/volumes/data/disk.img.gz"))
+		Expect(dv.Spec.Source.HTTP.CertConfigMap).To(Equal(cmName))
+		Expect(dv.Spec.Source.HTTP.SecretExtraHeaders).To(ConsistOf(secretName))
+		Expect(dv.Spec.Storage.StorageClassName).To(HaveValue(Equal(storageClass)))
+
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.UID).To(BeEmpty())
+		Expect(vm.Labels).To(HaveKeyWithValue("app", "test"))
+		Expect(vm.Status).To(Equal(v1.VirtualMachineStatus{}))
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.HTTP.CertConfigMap).To(Equal(cmName))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.HTTP.SecretExtraHeaders).To(ConsistOf(secretName))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(HaveValue(Equal(storageClass)))
+		volumes := vm.Spec.Template.Spec.Volumes
+		Expect(volumes[0].DataVolume.Name).To(Equal(vm.Spec.DataVolumeTemplates[0].Name))
+		Expect(volumes[1].DataVolume.Name).To(Equal(dvName))
+	}
+
+	BeforeEach(func() {
+		kubeClient = fakek8sclient.NewSimpleClientset()
+		virtClient = kubevirtfake.NewSimpleClientset()
+		cdiClient = fakecdiclient.NewSimpleClientset()
+
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = newMockClient(kubeClient, virtClient, cdiClient, metav1.NamespaceDefault)
+
+		manifestPath = filepath.Join(GinkgoT().TempDir(), "manifests.yaml")
+		vmexport.WaitForVirtualMachineExportFn = func(_ kubecli.KubevirtClient, _ *vmexport.VMExportInfo, _, _ time.Duration) error {
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		vmexport.WaitForVirtualMachineExportFn = vmexport.WaitForVirtualMachineExport
+		vmexport.HandleHTTPGetRequestFn = vmexport.HandleHTTPGetRequest
+		vmexport.GetSourceClientFn = vmexport.GetSourceClient
+	})
+
+	Context("from a manifest file", func() {
+		BeforeEach(func() {
+			_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), newCloudInitSecret(vmCloudInitRef, metav1.NamespaceDefault), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should create the objects in the target namespace", func() {
+			writeManifests(toYaml(newManifests()...))
+
+			Expect(runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))).To(Succeed())
+			expectImported(vmName, dvName, cmName, headerSecret, storageClass)
+		})
+
+		It("should remap names and storage classes", func() {
+			writeManifests(toYaml(newManifests()...))
+			_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), newCloudInitSecret("new-cloudinit", metav1.NamespaceDefault), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(runImportCmd(
+				setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath),
+				setFlag(vmexport.NAME_MAP_FLAG, strings.Join([]string{
+					vmName + "=new-vm",
+					vmName + "-root=new-vm-root",
+					dvName + "=new-dv",
+					cmName + "=new-cm",
+					headerSecret + "=new-secret",
+					vmCloudInitRef + "=new-cloudinit",
+				}, ",")),
+				setFlag(vmexport.STORAGE_CLASS_MAP_FLAG, storageClass+"=ceph-rbd"),
+			)).To(Succeed())
+			expectImported("new-vm", "new-dv", "new-cm", "new-secret", "ceph-rbd")
+
+			vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), "new-vm", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("new-vm-root"))
+			Expect(vm.Spec.Template.Spec.Volumes[2].CloudInitNoCloud.UserDataSecretRef.Name).To(Equal("new-cloudinit"))
+		})
+
+		It("should import manifests in JSON format", func() {
+			list := k8sv1.List{TypeMeta: metav1.TypeMeta{Kind: "List", APIVersion: "v1"}}
+			manifests := newManifests()
+			for _, obj := range manifests[:3] {
+				list.Items = append(list.Items, runtime.RawExtension{Object: obj})
+			}
+			secretList := k8sv1.List{
+				TypeMeta: metav1.TypeMeta{Kind: "List", APIVersion: "v1"},
+				Items:    []runtime.RawExtension{{Object: manifests[3]}},
+			}
+			data, err := json.Marshal(list)
+			Expect(err).ToNot(HaveOccurred())
+			secretData, err := json.Marshal(secretList)
+			Expect(err).ToNot(HaveOccurred())
+			writeManifests(append(data, secretData...))
+
+			Expect(runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))).To(Succeed())
+			expectImported(vmName, dvName, cmName, headerSecret, storageClass)
+		})
+
+		It("should fail if the manifests contain an unsupported kind", func() {
+			pod := &k8sv1.Pod{TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+			writeManifests(toYaml(append(newManifests(), pod)...))
+
+			err := runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))
+			Expect(err).To(MatchError("unsupported kind 'Pod' in manifests"))
+		})
+
+		It("should fail if the manifests do not contain a VirtualMachine", func() {
+			manifests := newManifests()
+			writeManifests(toYaml(manifests[0], manifests[3]))
+
+			err := runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))
+			Expect(err).To(MatchError(ContainSubstring("unable to find a VirtualMachine")))
+		})
+
+		It("should fail before creating the objects if referenced objects are missing", func() {
+			manifests := newManifests()
+			vm := manifests[1].(*v1.VirtualMachine)
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes,
+				v1.Volume{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: k8sv1.LocalObjectReference{Name: "test-config"},
+				}}},
+				v1.Volume{Name: "sysprep", VolumeSource: v1.VolumeSource{Sysprep: &v1.SysprepSource{
+					Secret: &k8sv1.LocalObjectReference{Name: "test-sysprep"},
+				}}},
+			)
+			writeManifests(toYaml(manifests...))
+
+			err := runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))
+			Expect(err).To(MatchError("unable to find the objects referenced by the VirtualMachine in namespace 'default': ConfigMap 'test-config', Secret 'test-sysprep'"))
+			_, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			_, err = cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), dvName, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail if an object already exists", func() {
+			writeManifests(toYaml(newManifests()...))
+			_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataVolume{ObjectMeta: metav1.ObjectMeta{Name: dvName}}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runImportCmd(setFlag(vmexport.MANIFEST_FILE_FLAG, manifestPath))
+			Expect(k8serrors.IsAlreadyExists(err)).To(BeTrue())
+		})
+	})
+
+	Context("from a VirtualMachineExport", func() {
+		var (
+			sourceKubeClient *fakek8sclient.Clientset
+			sourceVirtClient *kubevirtfake.Clientset
+			sourceVme        *exportv1.VirtualMachineExport
+			requestedUrls    []string
+		)
+
+		BeforeEach(func() {
+			sourceKubeClient = fakek8sclient.NewSimpleClientset()
+			sourceVirtClient = kubevirtfake.NewSimpleClientset()
+			sourceClient := newMockClient(sourceKubeClient, sourceVirtClient, fakecdiclient.NewSimpleClientset(), sourceNs)
+			vmexport.GetSourceClientFn = func(kubeconfig, kubecontext string) (kubecli.KubevirtClient, string, error) {
+				Expect(kubecontext).To(Equal("source"))
+				return sourceClient, sourceNs, nil
+			}
+
+			sourceVme = &exportv1.VirtualMachineExport{
+				ObjectMeta: metav1.ObjectMeta{Name: vmeName, Namespace: sourceNs},
+				Status: &exportv1.VirtualMachineExportStatus{
+					Phase: exportv1.Ready,
+					Links: &exportv1.VirtualMachineExportLinks{
+						External: &exportv1.VirtualMachineExportLink{
+							Manifests: []exportv1.VirtualMachineExportManifest{
+								{Type: exportv1.AllManifests, Url: "https://export/all"},
+								{Type: exportv1.AuthHeader, Url: "https://export/secret"},
+							},
+						},
+					},
+				},
+			}
+
+			_, err := sourceKubeClient.CoreV1().Secrets(sourceNs).Create(context.Background(), newCloudInitSecret(vmCloudInitRef, sourceNs), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			manifests := newManifests()
+			requestedUrls = nil
+			vmexport.HandleHTTPGetRequestFn = func(client kubecli.KubevirtClient, vme *exportv1.VirtualMachineExport, url string, _ bool, _ string, headers map[string]string) (*http.Response, error) {
+				Expect(client).To(BeIdenticalTo(sourceClient))
+				Expect(headers).To(HaveKeyWithValue(vmexport.ACCEPT, vmexport.APPLICATION_YAML))
+				requestedUrls = append(requestedUrls, url)
+				body := toYaml(manifests[:3]...)
+				if url == "https://export/secret" {
+					body = toYaml(manifests[3])
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(string(body))),
+				}, nil
+			}
+		})
+
+		It("should import an existing VirtualMachineExport of another cluster", func() {
+			_, err := sourceVirtClient.ExportV1beta1().VirtualMachineExports(sourceNs).Create(context.Background(), sourceVme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(runImportCmd(setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source"))).To(Succeed())
+			Expect(requestedUrls).To(Equal([]string{"https://export/all", "https://export/secret"}))
+			expectImported(vmName, dvName, cmName, headerSecret, storageClass)
+		})
+
+		It("should copy the referenced objects from the source namespace", func() {
+			_, err := sourceVirtClient.ExportV1beta1().VirtualMachineExports(sourceNs).Create(context.Background(), sourceVme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(runImportCmd(
+				setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source"),
+				setFlag(vmexport.NAME_MAP_FLAG, vmCloudInitRef+"=new-cloudinit"),
+			)).To(Succeed())
+
+			secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), "new-cloudinit", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data).To(HaveKeyWithValue("userdata", []byte("#cloud-config")))
+			vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.Template.Spec.Volumes[2].CloudInitNoCloud.UserDataSecretRef.Name).To(Equal("new-cloudinit"))
+		})
+
+		It("should fail if referenced objects are missing in both namespaces", func() {
+			_, err := sourceVirtClient.ExportV1beta1().VirtualMachineExports(sourceNs).Create(context.Background(), sourceVme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceKubeClient.CoreV1().Secrets(sourceNs).Delete(context.Background(), vmCloudInitRef, metav1.DeleteOptions{})).To(Succeed())
+
+			err = runImportCmd(setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source"))
+			Expect(err).To(MatchError("unable to find the objects referenced by the VirtualMachine in namespace 'default': Secret 'test-cloudinit'"))
+			_, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create the VirtualMachineExport in the other cluster and keep it", func() {
+			vmexport.WaitForVirtualMachineExportFn = func(client kubecli.KubevirtClient, vmeInfo *vmexport.VMExportInfo, _, _ time.Duration) error {
+				Expect(vmeInfo.Namespace).To(Equal(sourceNs))
+				vme, err := client.VirtualMachineExport(sourceNs).Get(context.Background(), vmeName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vme.Spec.Source.Kind).To(Equal("VirtualMachine"))
+				vme.Status = sourceVme.Status
+				_, err = client.VirtualMachineExport(sourceNs).Update(context.Background(), vme, metav1.UpdateOptions{})
+				return err
+			}
+
+			Expect(runImportCmd(
+				setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source"),
+				setFlag(vmexport.VM_FLAG, vmName),
+			)).To(Succeed())
+			expectImported(vmName, dvName, cmName, headerSecret, storageClass)
+
+			_, err := sourceVirtClient.ExportV1beta1().VirtualMachineExports(sourceNs).Get(context.Background(), vmeName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = sourceKubeClient.CoreV1().Secrets(sourceNs).Get(context.Background(), "secret-"+vmeName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail if the manifests cannot be fetched", func() {
+			_, err := sourceVirtClient.ExportV1beta1().VirtualMachineExports(sourceNs).Create(context.Background(), sourceVme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmexport.HandleHTTPGetRequestFn = func(_ kubecli.KubevirtClient, _ *exportv1.VirtualMachineExport, _ string, _ bool, _ string, _ map[string]string) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Status:     "500 Internal Server Error",
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			err = runImportCmd(setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source"))
+			Expect(err).To(MatchError(ContainSubstring("unable to get the manifests of 'source-ns/test-vme' VirtualMachineExport: 500 Internal Server Error")))
+		})
+	})
+
+	DescribeTable("should fail with invalid flags", func(expected string, args ...string) {
+		err := runCmd(args...)
+		Expect(err).To(MatchError(expected))
+	},
+		Entry("import with PVC", vmexport.ErrIncompatibleExportTypeManifest, vmexport.IMPORT, vmeName, setFlag(vmexport.PVC_FLAG, "pvc")),
+		Entry("import with port-forward", "the '--port-forward' flag is incompatible with 'import'", vmexport.IMPORT, vmeName, vmexport.PORT_FORWARD_FLAG),
+		Entry("import with output", "the '--output' flag is incompatible with 'import'", vmexport.IMPORT, vmeName, setFlag(vmexport.OUTPUT_FLAG, "out")),
+		Entry("import with manifest file and source context", "the '--source-context' flag is incompatible with '--manifest-file'", vmexport.IMPORT, vmeName, setFlag(vmexport.MANIFEST_FILE_FLAG, "file"), setFlag(vmexport.SOURCE_CONTEXT_FLAG, "source")),
+		Entry("import with manifest file and VM", vmexport.ErrIncompatibleExportType, vmexport.IMPORT, vmeName, setFlag(vmexport.MANIFEST_FILE_FLAG, "file"), setFlag(vmexport.VM_FLAG, vmName)),
+		Entry("import with invalid name map", "--name-map is not a valid value, acceptable values are old=new pairs", vmexport.IMPORT, vmeName, setFlag(vmexport.NAME_MAP_FLAG, "old")),
+		Entry("download with name map", "the '--name-map' flag is incompatible with 'download'", vmexport.DOWNLOAD, vmeName, setFlag(vmexport.OUTPUT_FLAG, "out"), setFlag(vmexport.NAME_MAP_FLAG, "old=new")),
+		Entry("create with manifest file", "the '--manifest-file' flag is incompatible with 'create'", vmexport.CREATE, vmeName, setFlag(vmexport.VM_FLAG, vmName), setFlag(vmexport.MANIFEST_FILE_FLAG, "file")),
+	)
+})
+
+func runImportCmd(args ...string) error {
+	_args := append([]string{"vmexport", vmexport.IMPORT, vmeName}, args...)
+	return testing.NewRepeatableVirtctlCommand(_args...)()
+}
@@ -62,6 +62,7 @@ const (
 	CREATE   = "create"
 	DELETE   = "delete"
 	DOWNLOAD = "download"
+	IMPORT   = "import"
 
 	// Available vmexport flags
 	OUTPUT_FLAG            = "--output"
@@ -84,14 +85,22 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	MANIFEST_FILE_FLAG     = "--manifest-file"
+	SOURCE_KUBECONFIG_FLAG = "--source-kubeconfig"
+	SOURCE_CONTEXT_FLAG    = "--source-context"
+	SOURCE_NAMESPACE_FLAG  = "--source-namespace"
+	NAME_MAP_FLAG          = "--name-map"
+	STORAGE_CLASS_MAP_FLAG = "--storage-class-map"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
 	OUTPUT_FORMAT_YAML = "yaml"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT  = "gzip"
+	RAW_FORMAT   = "raw"
+	ZSTD_FORMAT  = "zstd"
+	QCOW2_FORMAT = "qcow2"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -147,6 +156,12 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	manifestFile         string
+	sourceKubeconfig     string
+	sourceContext        string
+	sourceNamespace      string
+	nameMap              []string
+	storageClassMap      []string
 )
 
 type VMExportInfo struct {
@@ -157,6 +172,7 @@ type VMExportInfo struct {
 	IncludeSecret    bool
 	ExportManifest   bool
 	Decompress       bool
+	VolumeFormat     exportv1.ExportVolumeFormat
 	PortForward      bool
 	LocalPort        string
 	OutputFile       string
@@ -172,6 +188,12 @@ type VMExportInfo struct {
 	ReadinessTimeout time.Duration
 	Labels           map[string]string
 	Annotations      map[string]string
+	ManifestFile     string
+	SourceKubeconfig string
+	SourceContext    string
+	SourceNamespace  string
+	NameMap          map[string]string
+	StorageClassMap  map[string]string
 }
 
 type command struct {
@@ -222,7 +244,17 @@ func usage() string {
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
 	# Get the VirtualMachine manifest in Yaml format from an existing VirtualMachineExport including CDI header secret
-	{{ProgramName}} vmexport download existing-export --include-secret --manifest`
+	{{ProgramName}} vmexport download existing-export --include-secret --manifest
+
+	# Import the VirtualMachine of an existing VirtualMachineExport in another cluster into the current namespace
+	{{ProgramName}} vmexport import vm1-export --source-context=source-cluster --source-namespace=default
+
+	# Create a VirtualMachineExport in another cluster and import it with a new VM name and storage class
+	{{ProgramName}} vmexport import vm1-export --vm=vm1 --source-kubeconfig=source.kubeconfig --name-map=vm1=vm2 --storage-class-map=local=ceph-rbd
+
+	# Import a VirtualMachine from manifests downloaded with 'download --manifest --include-secret'
+	# The Secrets and ConfigMaps referenced by the VirtualMachine have to exist in the current namespace
+	{{ProgramName}} vmexport import vm1-export --manifest-file=vm1.yaml`
 	return usage
 }
 
@@ -245,7 +277,7 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. Valid options are gzip (default), raw, zstd and qcow2.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be retained after the download finishes.")
 	cmd.Flags().BoolVar(&deleteVme, "delete-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be deleted after the download finishes.")
@@ -261,6 +293,12 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().StringVar(&manifestFile, "manifest-file", "", "When used with the 'import' option, specifies a file with the manifests to import instead of fetching them from the vmexport.")
+	cmd.Flags().StringVar(&sourceKubeconfig, "source-kubeconfig", "", "When used with the 'import' option, specifies the kubeconfig of the cluster of the vmexport.")
+	cmd.Flags().StringVar(&sourceContext, "source-context", "", "When used with the 'import' option, specifies the kubeconfig context of the cluster of the vmexport.")
+	cmd.Flags().StringVar(&sourceNamespace, "source-namespace", "", "When used with the 'import' option, specifies the namespace of the vmexport. Defaults to the namespace of the source context.")
+	cmd.Flags().StringSliceVar(&nameMap, "name-map", nil, "When used with the 'import' option, renames the imported objects, specified as old=new pairs.")
+	cmd.Flags().StringSliceVar(&storageClassMap, "storage-class-map", nil, "When used with the 'import' option, replaces the storage classes of the imported DataVolumes, specified as old=new pairs.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -285,7 +323,7 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 	}
 	vmeInfo.Namespace = namespace
 
-	// Finally, run the vmexport function (create|delete|download)
+	// Finally, run the vmexport function (create|delete|download|import)
 	if err := exportFunction(virtClient, &vmeInfo); err != nil {
 		return err
 	}
@@ -294,7 +332,7 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 }
 
 // parseExportArguments parses and validates vmexport arguments and flags. These arguments should always be:
-//  1. The vmexport function (create|delete|download)
+//  1. The vmexport function (create|delete|download|import)
 //  2. The VirtualMachineExport name
 func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) error {
 	funcName := strings.ToLower(args[0])
@@ -316,6 +354,11 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 		if err := handleDownloadFlags(); err != nil {
 			return err
 		}
+	case IMPORT:
+		exportFunction = ImportVirtualMachineExport
+		if err := handleImportFlags(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid function '%s'", funcName)
 	}
@@ -354,6 +397,10 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
 	}
+	// zstd and qcow2 are downloaded as they are
+	if format == ZSTD_FORMAT || format == QCOW2_FORMAT {
+		vmeInfo.VolumeFormat = exportv1.ExportVolumeFormat(format)
+	}
 	vmeInfo.DownloadRetries = downloadRetries
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
@@ -393,6 +440,18 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.Labels = convertSliceToMap(resourceLabels)
 	vmeInfo.Annotations = convertSliceToMap(resourceAnnotations)
 
+	vmeInfo.ManifestFile = manifestFile
+	vmeInfo.SourceKubeconfig = sourceKubeconfig
+	vmeInfo.SourceContext = sourceContext
+	vmeInfo.SourceNamespace = sourceNamespace
+	var err error
+	if vmeInfo.NameMap, err = parseNameMap(NAME_MAP_FLAG, nameMap); err != nil {
+		return err
+	}
+	if vmeInfo.StorageClassMap, err = parseNameMap(STORAGE_CLASS_MAP_FLAG, storageClassMap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -653,6 +712,9 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	for _, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
+			if vmeInfo.VolumeFormat != "" {
+				return getUrlForVolumeFormat(vmexport, exportVolume, vmeInfo)
+			}
 			for _, format = range exportVolume.Formats {
 				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
 					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
@@ -681,6 +743,16 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	return downloadUrl, nil
 }
 
+func getUrlForVolumeFormat(vmexport *exportv1.VirtualMachineExport, exportVolume exportv1.VirtualMachineExportVolume, vmeInfo *VMExportInfo) (string, error) {
+	for _, format := range exportVolume.Formats {
+		if format.Format == vmeInfo.VolumeFormat {
+			vmeInfo.Decompress = false
+			return replaceUrlWithServiceUrl(format.Url, vmeInfo)
+		}
+	}
+	return "", fmt.Errorf("volume '%s' of '%s/%s' VirtualMachineExport is not available in %s format", exportVolume.Name, vmexport.Namespace, vmexport.Name, vmeInfo.VolumeFormat)
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -939,7 +1011,7 @@ func handleCreateFlags() error {
 		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, CREATE)
 	}
 
-	return nil
+	return handleImportOnlyFlags(CREATE)
 }
 
 // handleDeleteFlags ensures that only compatible flag combinations are used with 'delete'
@@ -988,7 +1060,7 @@ func handleDeleteFlags() error {
 		return fmt.Errorf(ErrIncompatibleFlag, ANNOTATIONS_FLAG, DELETE)
 	}
 
-	return nil
+	return handleImportOnlyFlags(DELETE)
 }
 
 // handleDownloadFlags ensures that only compatible flag combinations are used with 'download'
@@ -1005,8 +1077,8 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != ZSTD_FORMAT && format != QCOW2_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/zstd/qcow2")
 	}
 
 	if downloadRetries < 0 {
@@ -1031,6 +1103,105 @@ func handleDownloadFlags() error {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
 	}
 
+	return handleImportOnlyFlags(DOWNLOAD)
+}
+
+// handleImportFlags ensures that only compatible flag combinations are used with 'import'
+func handleImportFlags() error {
+	if pvc != "" {
+		return fmt.Errorf(ErrIncompatibleExportTypeManifest)
+	}
+	// We assume that the vmexport should be created if a source has been specified
+	if hasSource := vm != "" || snapshot != ""; hasSource {
+		shouldCreate = true
+	}
+
+	if outputFile != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_FLAG, IMPORT)
+	}
+	if volumeName != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, IMPORT)
+	}
+	if keepVme {
+		return fmt.Errorf(ErrIncompatibleFlag, KEEP_FLAG, IMPORT)
+	}
+	if deleteVme {
+		return fmt.Errorf(ErrIncompatibleFlag, DELETE_FLAG, IMPORT)
+	}
+	// The imported DataVolumes have to reach the export server themselves
+	if portForward {
+		return fmt.Errorf(ErrIncompatibleFlag, PORT_FORWARD_FLAG, IMPORT)
+	}
+	if localPort != "0" {
+		return fmt.Errorf(ErrIncompatibleFlag, LOCAL_PORT_FLAG, IMPORT)
+	}
+	if format != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG, IMPORT)
+	}
+	if downloadRetries != 0 {
+		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, IMPORT)
+	}
+	if exportManifest {
+		return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, IMPORT)
+	}
+	if includeSecret {
+		return fmt.Errorf(ErrIncompatibleFlag, INCLUDE_SECRET_FLAG, IMPORT)
+	}
+	if manifestOutputFormat != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_FORMAT_FLAG, IMPORT)
+	}
+
+	if manifestFile != "" {
+		if shouldCreate {
+			return fmt.Errorf(ErrIncompatibleExportType)
+		}
+		if sourceKubeconfig != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, SOURCE_KUBECONFIG_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if sourceContext != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, SOURCE_CONTEXT_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if sourceNamespace != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, SOURCE_NAMESPACE_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if serviceUrl != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if insecure {
+			return fmt.Errorf(ErrIncompatibleFlag, INSECURE_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if ttl != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, TTL_FLAG, MANIFEST_FILE_FLAG)
+		}
+		if readinessTimeout != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, READINESS_TIMEOUT_FLAG, MANIFEST_FILE_FLAG)
+		}
+	}
+
+	return nil
+}
+
+// handleImportOnlyFlags ensures that the flags of 'import' are not used with other functions
+func handleImportOnlyFlags(funcName string) error {
+	if manifestFile != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FILE_FLAG, funcName)
+	}
+	if sourceKubeconfig != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, SOURCE_KUBECONFIG_FLAG, funcName)
+	}
+	if sourceContext != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, SOURCE_CONTEXT_FLAG, funcName)
+	}
+	if sourceNamespace != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, SOURCE_NAMESPACE_FLAG, funcName)
+	}
+	if len(nameMap) > 0 {
+		return fmt.Errorf(ErrIncompatibleFlag, NAME_MAP_FLAG, funcName)
+	}
+	if len(storageClassMap) > 0 {
+		return fmt.Errorf(ErrIncompatibleFlag, STORAGE_CLASS_MAP_FLAG, funcName)
+	}
+
 	return nil
 }
 
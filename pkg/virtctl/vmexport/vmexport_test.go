@@ -364,7 +364,7 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw/zstd/qcow2"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -969,6 +969,52 @@ var _ = Describe("vmexport", func() {
 			Expect(err).To(MatchError(ContainSubstring("unable to get a valid URL")))
 			Expect(url).To(Equal(""))
 		})
+
+		It("Should get the URL of the requested format without decompressing", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtGz,
+						Url:    "compressed",
+					},
+					{
+						Format: exportv1.KubeVirtQcow2,
+						Url:    "qcow2",
+					},
+				}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:         vme.Name,
+				VolumeName:   volumeName,
+				VolumeFormat: exportv1.KubeVirtQcow2,
+				Decompress:   true,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("qcow2"))
+			Expect(vmeInfo.Decompress).To(BeFalse())
+		})
+
+		It("Should fail when the requested format is not available", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtGz,
+					Url:    "compressed",
+				}}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:         vme.Name,
+				VolumeName:   volumeName,
+				VolumeFormat: exportv1.KubeVirtZstd,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).To(MatchError(ContainSubstring("is not available in zstd format")))
+			Expect(url).To(Equal(""))
+		})
 	})
 })
 
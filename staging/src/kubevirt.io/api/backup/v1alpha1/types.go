@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// VMBackupProtectionFinalizer makes sure the target volume is detached from the VMI before the backup is deleted
+	VMBackupProtectionFinalizer = "backup.kubevirt.io/vmbackup-protection"
+)
+
+// VirtualMachineBackup defines the operation of backing up the volumes of a running VM
+// into a PVC. Incremental backups only contain the blocks changed since the latest
+// checkpoint recorded in the VirtualMachineBackupTracker of the VM. Only qcow2 volumes
+// track their changed blocks. A backup of a VM with raw volumes is always full and
+// creates no checkpoint, an incremental backup of raw volumes is rejected.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupList is a list of VirtualMachineBackup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackup `json:"items"`
+}
+
+// BackupType is the type of a backup
+type BackupType string
+
+const (
+	// Full backups contain the whole content of the volumes
+	Full BackupType = "Full"
+	// Incremental backups contain the blocks changed since the previous checkpoint
+	Incremental BackupType = "Incremental"
+)
+
+// VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
+//
+// +k8s:openapi-gen=true
+type VirtualMachineBackupSpec struct {
+	// Source is the VirtualMachine to back up, it has to be running
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// PVCName is the name of the filesystem PVC the backup is written to
+	PVCName string `json:"pvcName"`
+
+	// Type of the backup, a full backup is taken instead of an incremental one
+	// when the VM has no checkpoint to base it on. Defaults to Incremental.
+	// +optional
+	// +kubebuilder:validation:Enum=Full;Incremental
+	Type *BackupType `json:"type,omitempty"`
+}
+
+// VirtualMachineBackupPhase is the phase of a VirtualMachineBackup
+type VirtualMachineBackupPhase string
+
+const (
+	BackupPending    VirtualMachineBackupPhase = "Pending"
+	BackupInProgress VirtualMachineBackupPhase = "InProgress"
+	BackupSucceeded  VirtualMachineBackupPhase = "Succeeded"
+	BackupFailed     VirtualMachineBackupPhase = "Failed"
+)
+
+// VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+//
+// +k8s:openapi-gen=true
+type VirtualMachineBackupStatus struct {
+	// +optional
+	Phase VirtualMachineBackupPhase `json:"phase,omitempty"`
+
+	// Type of the backup which was taken
+	// +optional
+	Type BackupType `json:"type,omitempty"`
+
+	// Checkpoint is the name of the checkpoint created with the backup
+	// +optional
+	Checkpoint string `json:"checkpoint,omitempty"`
+
+	// BaseCheckpoint is the checkpoint an incremental backup is based on
+	// +optional
+	BaseCheckpoint string `json:"baseCheckpoint,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// +optional
+	// +nullable
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+
+	// Message describes why the backup failed, or why a full backup was taken
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineBackupTracker tracks the checkpoints of a VM, which incremental
+// backups are based on. It has the same name as the VM and is created when the
+// first backup of the VM succeeds.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineBackupTracker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupTrackerSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupTrackerStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupTrackerList is a list of VirtualMachineBackupTracker resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupTrackerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackupTracker `json:"items"`
+}
+
+// VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker resource
+//
+// +k8s:openapi-gen=true
+type VirtualMachineBackupTrackerSpec struct {
+	// Source is the VirtualMachine whose checkpoints are tracked
+	Source corev1.TypedLocalObjectReference `json:"source"`
+}
+
+// VirtualMachineBackupTrackerStatus is the status for a VirtualMachineBackupTracker resource
+//
+// +k8s:openapi-gen=true
+type VirtualMachineBackupTrackerStatus struct {
+	// VirtualMachineInstanceUID is the VMI the checkpoints were created in. Checkpoints
+	// don't survive a restart of the VM, the next backup is a full one in that case.
+	// +optional
+	VirtualMachineInstanceUID types.UID `json:"virtualMachineInstanceUID,omitempty"`
+
+	// LatestCheckpoint is the checkpoint the next incremental backup is based on
+	// +optional
+	LatestCheckpoint *BackupCheckpoint `json:"latestCheckpoint,omitempty"`
+
+	// Checkpoints is the chain of checkpoints since the latest full backup, oldest first.
+	// Restoring a backup requires the full backup and all incremental backups up to it.
+	// The images of an incremental backup reference the images of the previous backup as
+	// their backing file at ../<checkpoint>/<volume>.qcow2, so the backups of a chain have
+	// to be kept side by side, as they are when they are written to the same PVC.
+	// +optional
+	// +listType=atomic
+	Checkpoints []BackupCheckpoint `json:"checkpoints,omitempty"`
+}
+
+// BackupCheckpoint is a checkpoint created by a backup
+//
+// +k8s:openapi-gen=true
+type BackupCheckpoint struct {
+	// Name of the checkpoint
+	Name string `json:"name"`
+
+	// BackupName is the name of the VirtualMachineBackup which created the checkpoint
+	BackupName string `json:"backupName"`
+
+	// Type of the backup which created the checkpoint
+	Type BackupType `json:"type"`
+
+	// +optional
+	// +nullable
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+}
@@ -0,0 +1,77 @@
+// Code generated by swagger-doc. DO NOT EDIT.
+
+package v1alpha1
+
+func (VirtualMachineBackup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackup defines the operation of backing up the volumes of a running VM\ninto a PVC. Incremental backups only contain the blocks changed since the latest\ncheckpoint recorded in the VirtualMachineBackupTracker of the VM. Only qcow2 volumes\ntrack their changed blocks. A backup of a VM with raw volumes is always full and\ncreates no checkpoint, an incremental backup of raw volumes is rejected.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineBackupList is a list of VirtualMachineBackup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource\n\n+k8s:openapi-gen=true",
+		"source":  "Source is the VirtualMachine to back up, it has to be running",
+		"pvcName": "PVCName is the name of the filesystem PVC the backup is written to",
+		"type":    "Type of the backup, a full backup is taken instead of an incremental one\nwhen the VM has no checkpoint to base it on. Defaults to Incremental.\n+optional\n+kubebuilder:validation:Enum=Full;Incremental",
+	}
+}
+
+func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource\n\n+k8s:openapi-gen=true",
+		"phase":          "+optional",
+		"type":           "Type of the backup which was taken\n+optional",
+		"checkpoint":     "Checkpoint is the name of the checkpoint created with the backup\n+optional",
+		"baseCheckpoint": "BaseCheckpoint is the checkpoint an incremental backup is based on\n+optional",
+		"startTimestamp": "+optional\n+nullable",
+		"endTimestamp":   "+optional\n+nullable",
+		"message":        "Message describes why the backup failed, or why a full backup was taken\n+optional",
+	}
+}
+
+func (VirtualMachineBackupTracker) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupTracker tracks the checkpoints of a VM, which incremental\nbackups are based on. It has the same name as the VM and is created when the\nfirst backup of the VM succeeds.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupTrackerList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineBackupTrackerList is a list of VirtualMachineBackupTracker resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineBackupTrackerSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupTrackerSpec is the spec for a VirtualMachineBackupTracker resource\n\n+k8s:openapi-gen=true",
+		"source": "Source is the VirtualMachine whose checkpoints are tracked",
+	}
+}
+
+func (VirtualMachineBackupTrackerStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VirtualMachineBackupTrackerStatus is the status for a VirtualMachineBackupTracker resource\n\n+k8s:openapi-gen=true",
+		"virtualMachineInstanceUID": "VirtualMachineInstanceUID is the VMI the checkpoints were created in. Checkpoints\ndon't survive a restart of the VM, the next backup is a full one in that case.\n+optional",
+		"latestCheckpoint":          "LatestCheckpoint is the checkpoint the next incremental backup is based on\n+optional",
+		"checkpoints":               "Checkpoints is the chain of checkpoints since the latest full backup, oldest first.\nRestoring a backup requires the full backup and all incremental backups up to it.\nThe images of an incremental backup reference the images of the previous backup as\ntheir backing file at ../<checkpoint>/<volume>.qcow2, so the backups of a chain have\nto be kept side by side, as they are when they are written to the same PVC.\n+optional\n+listType=atomic",
+	}
+}
+
+func (BackupCheckpoint) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "BackupCheckpoint is a checkpoint created by a backup\n\n+k8s:openapi-gen=true",
+		"name":              "Name of the checkpoint",
+		"backupName":        "BackupName is the name of the VirtualMachineBackup which created the checkpoint",
+		"type":              "Type of the backup which created the checkpoint",
+		"creationTimestamp": "+optional\n+nullable",
+	}
+}
@@ -206,6 +206,23 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeSource) DeepCopyInto(out *BackupVolumeSource) {
+	*out = *in
+	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSource.
+func (in *BackupVolumeSource) DeepCopy() *BackupVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -1156,6 +1173,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1221,6 +1243,108 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesPerSecond != nil {
+		in, out := &in.TotalBytesPerSecond, &out.TotalBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesPerSecond != nil {
+		in, out := &in.ReadBytesPerSecond, &out.ReadBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesPerSecond != nil {
+		in, out := &in.WriteBytesPerSecond, &out.WriteBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPS != nil {
+		in, out := &in.TotalIOPS, &out.TotalIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPS != nil {
+		in, out := &in.ReadIOPS, &out.ReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPS != nil {
+		in, out := &in.WriteIOPS, &out.WriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOTuneBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneBurst) DeepCopyInto(out *DiskIOTuneBurst) {
+	*out = *in
+	if in.TotalBytesPerSecond != nil {
+		in, out := &in.TotalBytesPerSecond, &out.TotalBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesPerSecond != nil {
+		in, out := &in.ReadBytesPerSecond, &out.ReadBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesPerSecond != nil {
+		in, out := &in.WriteBytesPerSecond, &out.WriteBytesPerSecond
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPS != nil {
+		in, out := &in.TotalIOPS, &out.TotalIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPS != nil {
+		in, out := &in.ReadIOPS, &out.ReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPS != nil {
+		in, out := &in.WriteIOPS, &out.WriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LengthSeconds != nil {
+		in, out := &in.LengthSeconds, &out.LengthSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneBurst.
+func (in *DiskIOTuneBurst) DeepCopy() *DiskIOTuneBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1258,6 +1382,35 @@ func (in *DiskVerification) DeepCopy() *DiskVerification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupInfo) DeepCopyInto(out *DomainBackupInfo) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.UntrackedVolumes != nil {
+		in, out := &in.UntrackedVolumes, &out.UntrackedVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupInfo.
+func (in *DomainBackupInfo) DeepCopy() *DomainBackupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
 	*out = *in
@@ -1431,6 +1584,27 @@ func (in *EFI) DeepCopy() *EFI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EjectCDRomOptions) DeepCopyInto(out *EjectCDRomOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EjectCDRomOptions.
+func (in *EjectCDRomOptions) DeepCopy() *EjectCDRomOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EjectCDRomOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyDiskSource) DeepCopyInto(out *EmptyDiskSource) {
 	*out = *in
@@ -2166,6 +2340,32 @@ func (in *InstancetypeConfiguration) DeepCopy() *InstancetypeConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsertCDRomOptions) DeepCopyInto(out *InsertCDRomOptions) {
+	*out = *in
+	if in.VolumeSource != nil {
+		in, out := &in.VolumeSource, &out.VolumeSource
+		*out = new(HotplugVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsertCDRomOptions.
+func (in *InsertCDRomOptions) DeepCopy() *InsertCDRomOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InsertCDRomOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeMatcher) DeepCopyInto(out *InstancetypeMatcher) {
 	*out = *in
@@ -2726,6 +2926,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskIOTuneLimits != nil {
+		in, out := &in.DiskIOTuneLimits, &out.DiskIOTuneLimits
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2904,6 +3109,18 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]WorkloadUpdateMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2964,6 +3181,55 @@ func (in *LiveUpdateConfiguration) DeepCopy() *LiveUpdateConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageMigration) DeepCopyInto(out *LocalStorageMigration) {
+	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]LocalStorageMigrationClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageMigration.
+func (in *LocalStorageMigration) DeepCopy() *LocalStorageMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageMigrationClass) DeepCopyInto(out *LocalStorageMigrationClass) {
+	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageMigrationClass.
+func (in *LocalStorageMigrationClass) DeepCopy() *LocalStorageMigrationClass {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageMigrationClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogVerbosity) DeepCopyInto(out *LogVerbosity) {
 	*out = *in
@@ -3176,6 +3442,32 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationCompression) DeepCopyInto(out *MigrationCompression) {
+	*out = *in
+	if in.XBZRLECacheSize != nil {
+		in, out := &in.XBZRLECacheSize, &out.XBZRLECacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ZstdLevel != nil {
+		in, out := &in.ZstdLevel, &out.ZstdLevel
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCompression.
+func (in *MigrationCompression) DeepCopy() *MigrationCompression {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationCompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -3244,6 +3536,31 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrossClusterMigrationPort != nil {
+		in, out := &in.CrossClusterMigrationPort, &out.CrossClusterMigrationPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SwitchoverPolicy != nil {
+		in, out := &in.SwitchoverPolicy, &out.SwitchoverPolicy
+		*out = new(MigrationSwitchoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalStorage != nil {
+		in, out := &in.LocalStorage, &out.LocalStorage
+		*out = new(LocalStorageMigration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3257,6 +3574,154 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationConvergence) DeepCopyInto(out *MigrationConvergence) {
+	*out = *in
+	if in.MeasurementTimestamp != nil {
+		in, out := &in.MeasurementTimestamp, &out.MeasurementTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Converging != nil {
+		in, out := &in.Converging, &out.Converging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PredictedCompletionTimestamp != nil {
+		in, out := &in.PredictedCompletionTimestamp, &out.PredictedCompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationConvergence.
+func (in *MigrationConvergence) DeepCopy() *MigrationConvergence {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationConvergence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationFeasibility) DeepCopyInto(out *MigrationFeasibility) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeMigrationFeasibility, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationFeasibility.
+func (in *MigrationFeasibility) DeepCopy() *MigrationFeasibility {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationFeasibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationLocalVolumeState) DeepCopyInto(out *MigrationLocalVolumeState) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationLocalVolumeState.
+func (in *MigrationLocalVolumeState) DeepCopy() *MigrationLocalVolumeState {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationLocalVolumeState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationLocalVolumesState) DeepCopyInto(out *MigrationLocalVolumesState) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]MigrationLocalVolumeState, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationLocalVolumesState.
+func (in *MigrationLocalVolumesState) DeepCopy() *MigrationLocalVolumesState {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationLocalVolumesState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSwitchoverPolicy) DeepCopyInto(out *MigrationSwitchoverPolicy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]MigrationSwitchoverStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSwitchoverPolicy.
+func (in *MigrationSwitchoverPolicy) DeepCopy() *MigrationSwitchoverPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSwitchoverPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSwitchoverStep) DeepCopyInto(out *MigrationSwitchoverStep) {
+	*out = *in
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StalledFor != nil {
+		in, out := &in.StalledFor, &out.StalledFor
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSwitchoverStep.
+func (in *MigrationSwitchoverStep) DeepCopy() *MigrationSwitchoverStep {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSwitchoverStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3435,6 +3900,27 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMigrationFeasibility) DeepCopyInto(out *NodeMigrationFeasibility) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMigrationFeasibility.
+func (in *NodeMigrationFeasibility) DeepCopy() *NodeMigrationFeasibility {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMigrationFeasibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePlacement) DeepCopyInto(out *NodePlacement) {
 	*out = *in
@@ -4795,6 +5281,22 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserBlkVolumeSource) DeepCopyInto(out *VhostUserBlkVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserBlkVolumeSource.
+func (in *VhostUserBlkVolumeSource) DeepCopy() *VhostUserBlkVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserBlkVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5017,6 +5519,71 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestLoad) DeepCopyInto(out *VirtualMachineInstanceGuestLoad) {
+	*out = *in
+	out.Load1 = in.Load1.DeepCopy()
+	out.Load5 = in.Load5.DeepCopy()
+	out.Load15 = in.Load15.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestLoad.
+func (in *VirtualMachineInstanceGuestLoad) DeepCopy() *VirtualMachineInstanceGuestLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestMetrics) DeepCopyInto(out *VirtualMachineInstanceGuestMetrics) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.VCPUTimeNanoseconds != nil {
+		in, out := &in.VCPUTimeNanoseconds, &out.VCPUTimeNanoseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryUsable != nil {
+		in, out := &in.MemoryUsable, &out.MemoryUsable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(VirtualMachineInstanceGuestLoad)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestMetrics.
+func (in *VirtualMachineInstanceGuestMetrics) DeepCopy() *VirtualMachineInstanceGuestMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestMetrics) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5209,6 +5776,38 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopyInto(out *VirtualMachineInstanceMigrationReceive) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationReceive.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopy() *VirtualMachineInstanceMigrationReceive {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationReceive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopyInto(out *VirtualMachineInstanceMigrationSendTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSendTo.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopy() *VirtualMachineInstanceMigrationSendTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSendTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
@@ -5219,6 +5818,21 @@ func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineI
 			(*out)[key] = val
 		}
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MigrationPriority)
+		**out = **in
+	}
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSendTo)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationReceive)
+		**out = **in
+	}
 	return
 }
 
@@ -5269,6 +5883,26 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(MigrationConvergence)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SetupTime != nil {
+		in, out := &in.SetupTime, &out.SetupTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LocalVolumes != nil {
+		in, out := &in.LocalVolumes, &out.LocalVolumes
+		*out = new(MigrationLocalVolumesState)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5304,6 +5938,11 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MigrationPriority)
+		**out = **in
+	}
 	return
 }
 
@@ -5851,6 +6490,11 @@ func (in *VirtualMachineMemoryDumpRequest) DeepCopyInto(out *VirtualMachineMemor
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExportName != nil {
+		in, out := &in.ExportName, &out.ExportName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -5864,6 +6508,35 @@ func (in *VirtualMachineMemoryDumpRequest) DeepCopy() *VirtualMachineMemoryDumpR
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMigrationRecord) DeepCopyInto(out *VirtualMachineMigrationRecord) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Downtime != nil {
+		in, out := &in.Downtime, &out.Downtime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMigrationRecord.
+func (in *VirtualMachineMigrationRecord) DeepCopy() *VirtualMachineMigrationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMigrationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineOptions) DeepCopyInto(out *VirtualMachineOptions) {
 	*out = *in
@@ -6055,6 +6728,13 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigrationHistory != nil {
+		in, out := &in.MigrationHistory, &out.MigrationHistory
+		*out = make([]VirtualMachineMigrationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6228,6 +6908,16 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupVolumeSource)
+		**out = **in
+	}
+	if in.VhostUserBlk != nil {
+		in, out := &in.VhostUserBlk, &out.VhostUserBlk
+		*out = new(VhostUserBlkVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -6259,6 +6949,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(DomainMemoryDumpInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupVolume != nil {
+		in, out := &in.BackupVolume, &out.BackupVolume
+		*out = new(DomainBackupInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContainerDiskVolume != nil {
 		in, out := &in.ContainerDiskVolume, &out.ContainerDiskVolume
 		*out = new(ContainerDiskInfo)
@@ -6335,3 +7030,30 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopyInto(out *WorkloadUpdateMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateMaintenanceWindow.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopy() *WorkloadUpdateMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -665,6 +665,64 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// IOTune limits the throughput and the I/O operations per second of the disk.
+	// It can be changed on a running VMI.
+	// The cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune limits the I/O of a disk.
+// A total limit can't be combined with the read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesPerSecond limits the combined read and write throughput in bytes per second.
+	// +optional
+	TotalBytesPerSecond *resource.Quantity `json:"totalBytesPerSecond,omitempty"`
+	// ReadBytesPerSecond limits the read throughput in bytes per second.
+	// +optional
+	ReadBytesPerSecond *resource.Quantity `json:"readBytesPerSecond,omitempty"`
+	// WriteBytesPerSecond limits the write throughput in bytes per second.
+	// +optional
+	WriteBytesPerSecond *resource.Quantity `json:"writeBytesPerSecond,omitempty"`
+	// TotalIOPS limits the combined read and write operations per second.
+	// +optional
+	TotalIOPS *int64 `json:"totalIOPS,omitempty"`
+	// ReadIOPS limits the read operations per second.
+	// +optional
+	ReadIOPS *int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS limits the write operations per second.
+	// +optional
+	WriteIOPS *int64 `json:"writeIOPS,omitempty"`
+	// Burst allows the disk to exceed the limits for a short time.
+	// +optional
+	Burst *DiskIOTuneBurst `json:"burst,omitempty"`
+}
+
+// DiskIOTuneBurst holds the limits a disk may reach during a burst.
+// Each burst limit requires the matching limit of DiskIOTune and must not be lower than it.
+type DiskIOTuneBurst struct {
+	// TotalBytesPerSecond is the combined read and write throughput allowed during a burst.
+	// +optional
+	TotalBytesPerSecond *resource.Quantity `json:"totalBytesPerSecond,omitempty"`
+	// ReadBytesPerSecond is the read throughput allowed during a burst.
+	// +optional
+	ReadBytesPerSecond *resource.Quantity `json:"readBytesPerSecond,omitempty"`
+	// WriteBytesPerSecond is the write throughput allowed during a burst.
+	// +optional
+	WriteBytesPerSecond *resource.Quantity `json:"writeBytesPerSecond,omitempty"`
+	// TotalIOPS is the combined read and write operations per second allowed during a burst.
+	// +optional
+	TotalIOPS *int64 `json:"totalIOPS,omitempty"`
+	// ReadIOPS is the read operations per second allowed during a burst.
+	// +optional
+	ReadIOPS *int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS is the write operations per second allowed during a burst.
+	// +optional
+	WriteIOPS *int64 `json:"writeIOPS,omitempty"`
+	// LengthSeconds is the number of seconds a burst can last.
+	// Defaults to 1.
+	// +optional
+	LengthSeconds *int64 `json:"lengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -698,11 +756,12 @@ const (
 	DiskBusSATA   DiskBus = "sata"
 	DiskBusVirtio DiskBus = VirtIO
 	DiskBusUSB    DiskBus = "usb"
+	DiskBusNVMe   DiskBus = "nvme"
 )
 
 type DiskTarget struct {
 	// Bus indicates the type of disk device to emulate.
-	// supported values: virtio, sata, scsi, usb.
+	// supported values: virtio, sata, scsi, usb, nvme.
 	Bus DiskBus `json:"bus,omitempty"`
 	// ReadOnly.
 	// Defaults to false.
@@ -846,6 +905,11 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// Backup is attached to the virt launcher and is populated with a backup of the vmi volumes
+	Backup *BackupVolumeSource `json:"backup,omitempty"`
+	// VhostUserBlk attaches a disk which is served by a storage daemon of the node over a vhost-user-blk socket.
+	// +optional
+	VhostUserBlk *VhostUserBlkVolumeSource `json:"vhostUserBlk,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -886,6 +950,30 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format is the format of the memory dump output, defaults to elf
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+}
+
+type BackupVolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	// Directly attached to the virt launcher
+	// +optional
+	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Checkpoint is the name of the checkpoint created with the backup
+	Checkpoint string `json:"checkpoint"`
+	// BaseCheckpoint is the checkpoint an incremental backup is based on, a full backup is taken if it is empty
+	// +optional
+	BaseCheckpoint string `json:"baseCheckpoint,omitempty"`
+}
+
+// VhostUserBlkVolumeSource represents a disk which is served in userspace by a storage daemon of the node,
+// bypassing the block layer of QEMU. The disk can only be attached to a disk device on the virtio bus.
+type VhostUserBlkVolumeSource struct {
+	// Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon
+	// in the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the
+	// directory of the namespace of the VirtualMachineInstance can be used.
+	Socket string `json:"socket"`
 }
 
 type EphemeralVolumeSource struct {
@@ -353,6 +353,33 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioTune":            "IOTune limits the throughput and the I/O operations per second of the disk.\nIt can be changed on a running VMI.\nThe cluster can cap the limits with diskIOTuneLimits in the KubeVirt configuration.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "DiskIOTune limits the I/O of a disk.\nA total limit can't be combined with the read or write limit of the same kind.",
+		"totalBytesPerSecond": "TotalBytesPerSecond limits the combined read and write throughput in bytes per second.\n+optional",
+		"readBytesPerSecond":  "ReadBytesPerSecond limits the read throughput in bytes per second.\n+optional",
+		"writeBytesPerSecond": "WriteBytesPerSecond limits the write throughput in bytes per second.\n+optional",
+		"totalIOPS":           "TotalIOPS limits the combined read and write operations per second.\n+optional",
+		"readIOPS":            "ReadIOPS limits the read operations per second.\n+optional",
+		"writeIOPS":           "WriteIOPS limits the write operations per second.\n+optional",
+		"burst":               "Burst allows the disk to exceed the limits for a short time.\n+optional",
+	}
+}
+
+func (DiskIOTuneBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "DiskIOTuneBurst holds the limits a disk may reach during a burst.\nEach burst limit requires the matching limit of DiskIOTune and must not be lower than it.",
+		"totalBytesPerSecond": "TotalBytesPerSecond is the combined read and write throughput allowed during a burst.\n+optional",
+		"readBytesPerSecond":  "ReadBytesPerSecond is the read throughput allowed during a burst.\n+optional",
+		"writeBytesPerSecond": "WriteBytesPerSecond is the write throughput allowed during a burst.\n+optional",
+		"totalIOPS":           "TotalIOPS is the combined read and write operations per second allowed during a burst.\n+optional",
+		"readIOPS":            "ReadIOPS is the read operations per second allowed during a burst.\n+optional",
+		"writeIOPS":           "WriteIOPS is the write operations per second allowed during a burst.\n+optional",
+		"lengthSeconds":       "LengthSeconds is the number of seconds a burst can last.\nDefaults to 1.\n+optional",
 	}
 }
 
@@ -379,7 +406,7 @@ func (DiskDevice) SwaggerDoc() map[string]string {
 
 func (DiskTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb.",
+		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb, nvme.",
 		"readonly":   "ReadOnly.\nDefaults to false.",
 		"pciAddress": "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
 	}
@@ -451,6 +478,8 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"backup":                "Backup is attached to the virt launcher and is populated with a backup of the vmi volumes",
+		"vhostUserBlk":          "VhostUserBlk attaches a disk which is served by a storage daemon of the node over a vhost-user-blk socket.\n+optional",
 	}
 }
 
@@ -477,7 +506,23 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format is the format of the memory dump output, defaults to elf\n+optional",
+	}
+}
+
+func (BackupVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"checkpoint":     "Checkpoint is the name of the checkpoint created with the backup",
+		"baseCheckpoint": "BaseCheckpoint is the checkpoint an incremental backup is based on, a full backup is taken if it is empty\n+optional",
+	}
+}
+
+func (VhostUserBlkVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VhostUserBlkVolumeSource represents a disk which is served in userspace by a storage daemon of the node,\nbypassing the block layer of QEMU. The disk can only be attached to a disk device on the virtio bus.",
+		"socket": "Socket is the name of the vhost-user socket of the disk, which is created by the storage daemon\nin the /var/run/kubevirt-vhost-user-blk/{namespace} directory of the node. Only the sockets in the\ndirectory of the namespace of the VirtualMachineInstance can be used.",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
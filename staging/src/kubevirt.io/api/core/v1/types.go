@@ -341,6 +341,10 @@ type PersistentVolumeClaimInfo struct {
 	// Percentage of filesystem's size to be reserved when resizing the PVC
 	// +optional
 	FilesystemOverhead *Percent `json:"filesystemOverhead,omitempty"`
+
+	// StorageClassName is the name of the storage class of the PVC
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
 }
 
 // Percent is a string that can only be a value between [0,1)
@@ -367,6 +371,8 @@ type VolumeStatus struct {
 	Size int64 `json:"size,omitempty"`
 	// If the volume is memorydump volume, this will contain the memorydump info.
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
+	// If the volume is a backup volume, this will contain the backup info.
+	BackupVolume *DomainBackupInfo `json:"backupVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
 }
@@ -401,6 +407,29 @@ type DomainMemoryDumpInfo struct {
 	ClaimName string `json:"claimName,omitempty"`
 	// TargetFileName is the name of the memory dump output
 	TargetFileName string `json:"targetFileName,omitempty"`
+	// Checksum is the sha256 checksum of the memory dump output
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// DomainBackupInfo represents the backup information
+type DomainBackupInfo struct {
+	// StartTimestamp is the time when the backup started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time when the backup completed
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// ClaimName is the name of the pvc the volumes were backed up to
+	ClaimName string `json:"claimName,omitempty"`
+	// Checkpoint is the name of the checkpoint created with the backup
+	Checkpoint string `json:"checkpoint,omitempty"`
+	// Incremental is true if only the blocks changed since the base checkpoint were backed up
+	Incremental bool `json:"incremental,omitempty"`
+	// UntrackedVolumes are the backed up volumes which cannot track their changed blocks.
+	// No checkpoint is created when a volume cannot track its changed blocks.
+	// +listType=atomic
+	// +optional
+	UntrackedVolumes []string `json:"untrackedVolumes,omitempty"`
+	// TargetDirectory is the directory in the pvc the volumes were backed up to
+	TargetDirectory string `json:"targetDirectory,omitempty"`
 }
 
 // HotplugVolumeStatus represents the hotplug status of the volume
@@ -441,6 +470,12 @@ const (
 	MemoryDumpVolumeInProgress VolumePhase = "MemoryDumpInProgress"
 	// MemoryDumpVolumeInProgress means that the volume for the memory dump was attached, and now the command is being triggered
 	MemoryDumpVolumeFailed VolumePhase = "MemoryDumpFailed"
+	// BackupVolumeCompleted means that the requested backup was completed and the backup is ready in the volume
+	BackupVolumeCompleted VolumePhase = "BackupCompleted"
+	// BackupVolumeInProgress means that the volume for the backup was attached, and now the backup is being taken
+	BackupVolumeInProgress VolumePhase = "BackupInProgress"
+	// BackupVolumeFailed means that the backup to the volume failed
+	BackupVolumeFailed VolumePhase = "BackupFailed"
 )
 
 func (v *VirtualMachineInstance) IsScheduling() bool {
@@ -790,6 +825,75 @@ type VirtualMachineInstanceMigrationState struct {
 	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
 	// If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here
 	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+	// The ID the sending and the receiving side of a cross-cluster migration meet with
+	// at the cross-cluster migration endpoint of the target node
+	// +optional
+	CrossClusterMigrationID string `json:"crossClusterMigrationID,omitempty"`
+	// The guest memory dirty rate measured during the migration and the completion predicted from it
+	// +optional
+	Convergence *MigrationConvergence `json:"convergence,omitempty"`
+	// The amount of data the migration transferred to the target so far
+	// +optional
+	DataTransferredBytes int64 `json:"dataTransferredBytes,omitempty"`
+	// The time the guest was paused to switch over to the target, as measured by the hypervisor
+	// once the migration completed
+	// +optional
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+	// The time the hypervisor spent setting up the migration before transferring the guest state,
+	// as measured once the migration completed
+	// +optional
+	SetupTime *metav1.Duration `json:"setupTime,omitempty"`
+	// The copy progress of the local volumes migrated with the VMI
+	// +optional
+	LocalVolumes *MigrationLocalVolumesState `json:"localVolumes,omitempty"`
+}
+
+// MigrationLocalVolumesState reports the copy progress of the local volumes migrated with the VMI
+type MigrationLocalVolumesState struct {
+	// TransferredBytes is the amount of data of the volumes copied to the target so far
+	TransferredBytes int64 `json:"transferredBytes"`
+	// TotalBytes is the amount of data of the volumes to copy, it grows with the guest writes during the copy
+	TotalBytes int64 `json:"totalBytes"`
+	// BandwidthBytesPerSecond is the bandwidth limit of the copy, it is not set if the copy is not limited
+	// +optional
+	BandwidthBytesPerSecond int64 `json:"bandwidthBytesPerSecond,omitempty"`
+	// Volumes reports the copy progress of each local volume once its copy started
+	// +listType=map
+	// +listMapKey=volumeName
+	// +optional
+	Volumes []MigrationLocalVolumeState `json:"volumes,omitempty"`
+}
+
+// MigrationLocalVolumeState reports the copy progress of a local volume migrated with the VMI
+type MigrationLocalVolumeState struct {
+	// VolumeName is the name of the volume
+	VolumeName string `json:"volumeName"`
+	// TransferredBytes is the amount of data of the volume copied to the target so far
+	TransferredBytes int64 `json:"transferredBytes"`
+	// TotalBytes is the amount of data of the volume to copy, it grows with the guest writes during the copy
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// MigrationConvergence reports the measured guest memory dirty rate of a migration
+// and whether the migration is predicted to complete at its transfer rate
+type MigrationConvergence struct {
+	// DirtyRateBytesPerSecond is the rate at which the guest dirties its memory
+	DirtyRateBytesPerSecond int64 `json:"dirtyRateBytesPerSecond"`
+	// MeasurementTimestamp is the time of the last dirty rate measurement
+	// +optional
+	MeasurementTimestamp *metav1.Time `json:"measurementTimestamp,omitempty"`
+	// TransferRateBytesPerSecond is the rate at which the migration transfers the guest memory.
+	// The configured bandwidth is used until the transfer started.
+	// +optional
+	TransferRateBytesPerSecond int64 `json:"transferRateBytesPerSecond,omitempty"`
+	// Converging is false if the guest dirties its memory faster than it is transferred.
+	// Such a migration only completes with post-copy or by pausing the guest.
+	// +optional
+	Converging *bool `json:"converging,omitempty"`
+	// PredictedCompletionTimestamp is the time the migration is predicted to complete at,
+	// it is only set for a converging migration
+	// +optional
+	PredictedCompletionTimestamp *metav1.Time `json:"predictedCompletionTimestamp,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -910,6 +1014,10 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated evacuation
 	EvacuationMigrationAnnotation string = "kubevirt.io/evacuationMigration"
+	// This annotation marks a VirtualMachineInstance as the receiving side of a
+	// cross-cluster migration. The VMI is not started, it waits in the Pending
+	// phase until a migration with spec.receive brings in the running guest.
+	CrossClusterMigrationReceiverAnnotation string = "kubevirt.io/crossClusterMigrationReceiver"
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
@@ -1072,6 +1180,10 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolScaleInProtectionAnnotation protects a VirtualMachine from being
+	// removed when its pool is scaled in, if set to "true".
+	VirtualMachinePoolScaleInProtectionAnnotation string = "kubevirt.io/vm-pool-scale-in-protection"
+
 	// VirtualMachineNameLabel is the name of the Virtual Machine
 	VirtualMachineNameLabel string = "vm.kubevirt.io/name"
 
@@ -1394,8 +1506,54 @@ type VirtualMachineInstanceMigrationSpec struct {
 	// can only restrict but not bypass constraints already set on the VM object.
 	// +optional
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
+
+	// Priority defines the order in which pending migrations are started once the
+	// parallel migration limits are reached. Defaults to user-triggered.
+	// Pending migrations gain priority while they wait, so that migrations with a
+	// low priority are not starved. The system priorities are reserved for KubeVirt.
+	// +kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance
+	// +optional
+	Priority *MigrationPriority `json:"priority,omitempty"`
+
+	// SendTo makes the migration the sending side of a cross-cluster migration.
+	// The VMI is migrated to the cluster behind the connect URL, where a migration
+	// receiving the same migration ID has to be ready.
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSendTo `json:"sendTo,omitempty"`
+
+	// Receive makes the migration the receiving side of a cross-cluster migration.
+	// The VMI has to be created with the kubevirt.io/crossClusterMigrationReceiver
+	// annotation, so that it waits for the migration instead of being started.
+	// +optional
+	Receive *VirtualMachineInstanceMigrationReceive `json:"receive,omitempty"`
 }
 
+// VirtualMachineInstanceMigrationSendTo defines where a cross-cluster migration sends the VMI to
+type VirtualMachineInstanceMigrationSendTo struct {
+	// MigrationID identifies the cross-cluster migration on both sides
+	MigrationID string `json:"migrationID"`
+	// ConnectURL is the routable host:port of the cross-cluster migration endpoint of the target node
+	ConnectURL string `json:"connectURL"`
+}
+
+// VirtualMachineInstanceMigrationReceive defines which cross-cluster migration the migration receives
+type VirtualMachineInstanceMigrationReceive struct {
+	// MigrationID identifies the cross-cluster migration on both sides
+	MigrationID string `json:"migrationID"`
+}
+
+// MigrationPriority defines the order in which pending migrations are started
+type MigrationPriority string
+
+const (
+	// MigrationPrioritySystemCritical is used for evacuations of nodes which are drained
+	MigrationPrioritySystemCritical MigrationPriority = "system-critical"
+	// MigrationPriorityUserTriggered is used for migrations requested by users
+	MigrationPriorityUserTriggered MigrationPriority = "user-triggered"
+	// MigrationPrioritySystemMaintenance is used for automated workload updates and descheduler moves
+	MigrationPrioritySystemMaintenance MigrationPriority = "system-maintenance"
+)
+
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
 type VirtualMachineInstanceMigrationPhaseTransitionTimestamp struct {
 	// Phase is the status of the VirtualMachineInstanceMigrationPhase in kubernetes world. It is not the VirtualMachineInstanceMigrationPhase status, but partially correlates to it.
@@ -1414,6 +1572,10 @@ type VirtualMachineInstanceMigrationStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstanceMigrationPhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// Priority is the priority the pending migration is started with, the higher one
+	// of the priority of the migration and of its migration policy
+	// +optional
+	Priority *MigrationPriority `json:"priority,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -1726,6 +1888,38 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// MigrationHistory lists the last 10 finished migrations of the VirtualMachine, oldest first.
+	// It outlives the VirtualMachineInstances and the migration objects.
+	// +listType=atomic
+	// +optional
+	MigrationHistory []VirtualMachineMigrationRecord `json:"migrationHistory,omitempty"`
+}
+
+// VirtualMachineMigrationRecord records a finished migration of a VirtualMachine
+type VirtualMachineMigrationRecord struct {
+	// The UID of the migration
+	MigrationUID types.UID `json:"migrationUid"`
+	// The UID of the migrated VirtualMachineInstance
+	VMIUID types.UID `json:"vmiUid,omitempty"`
+	// The node the VirtualMachineInstance was migrated from
+	SourceNode string `json:"sourceNode,omitempty"`
+	// The node the VirtualMachineInstance was migrated to
+	TargetNode string `json:"targetNode,omitempty"`
+	// The time the migration started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The time the migration ended
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// The mode the migration ended in
+	Mode MigrationMode `json:"mode,omitempty"`
+	// The amount of data the migration transferred to the target
+	DataTransferredBytes int64 `json:"dataTransferredBytes,omitempty"`
+	// The time the guest was paused to switch over to the target
+	Downtime *metav1.Duration `json:"downtime,omitempty"`
+	// Indicates that the migration failed
+	Failed bool `json:"failed,omitempty"`
+	// The reason the migration failed
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 type ControllerRevisionRef struct {
@@ -2018,6 +2212,46 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts automated workload updates of outdated VMIs to the
+	// times one of the windows applying to their namespace is open.
+	// Windows listing namespaces apply to these namespaces only, windows without
+	// namespaces apply to all other namespaces. VMIs in namespaces without an applying
+	// window are updated at any time.
+	// Migrations required to apply hotplug or volume updates are not restricted.
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []WorkloadUpdateMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// ExcludedNamespaces lists the namespaces whose outdated VMIs are never
+	// updated automatically.
+	//
+	// +listType=set
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+// WorkloadUpdateMaintenanceWindow is a recurring window in which outdated workloads may be updated
+type WorkloadUpdateMaintenanceWindow struct {
+	// Schedule is the cron expression with the fields minute, hour, day of month, month
+	// and day of week at which the window opens, e.g. "0 22 * * sat"
+	Schedule string `json:"schedule"`
+
+	// Duration defines how long the window stays open
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the name of the IANA time zone the schedule is evaluated in, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	//
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Namespaces lists the namespaces the window applies to
+	//
+	// +listType=set
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2334,6 +2568,40 @@ type MigrateOptions struct {
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
 }
 
+// MigrationFeasibility is returned by a dry run migrate request and reports
+// whether the VirtualMachineInstance could be migrated and which nodes could take it.
+//
+// +k8s:openapi-gen=true
+type MigrationFeasibility struct {
+	// Migratable is true if the VirtualMachineInstance is live migratable
+	// and at least one node satisfies the constraints of the migration target pod
+	Migratable bool `json:"migratable"`
+	// Reasons why the VirtualMachineInstance cannot be migrated to any node
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+	// Nodes evaluated as migration target, the source node is not included.
+	// Only reported to users who are allowed to list nodes
+	// +optional
+	// +listType=atomic
+	Nodes []NodeMigrationFeasibility `json:"nodes,omitempty"`
+}
+
+// NodeMigrationFeasibility reports whether a node can take a migrating VirtualMachineInstance
+//
+// +k8s:openapi-gen=true
+type NodeMigrationFeasibility struct {
+	// Name of the node
+	Name string `json:"name"`
+	// Feasible is true if the node satisfies the constraints of the migration target pod.
+	// Resource availability is not evaluated and is left to the scheduler.
+	Feasible bool `json:"feasible"`
+	// Reasons why the node is rejected
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2411,6 +2679,39 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceGuestMetrics represents a sample of the resource usage of the guest
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestMetrics struct {
+	metav1.TypeMeta `json:",inline"`
+	// Timestamp is the time at which the sample was taken
+	Timestamp metav1.MicroTime `json:"timestamp"`
+	// VCPUCount is the number of vCPUs of the guest
+	VCPUCount int32 `json:"vcpuCount,omitempty"`
+	// VCPUTimeNanoseconds is the cumulative time spent by all vCPUs of the guest
+	// +optional
+	VCPUTimeNanoseconds *int64 `json:"vcpuTimeNanoseconds,omitempty"`
+	// MemoryAvailable is the amount of memory usable by the guest
+	// +optional
+	MemoryAvailable *resource.Quantity `json:"memoryAvailable,omitempty"`
+	// MemoryUsable is the amount of memory which can be reclaimed by the guest without swapping
+	// +optional
+	MemoryUsable *resource.Quantity `json:"memoryUsable,omitempty"`
+	// Load contains the load averages reported by the guest agent
+	// +optional
+	Load *VirtualMachineInstanceGuestLoad `json:"load,omitempty"`
+}
+
+// VirtualMachineInstanceGuestLoad represents the load averages of the guest
+type VirtualMachineInstanceGuestLoad struct {
+	// Load1 is the load average over the last minute
+	Load1 resource.Quantity `json:"load1"`
+	// Load5 is the load average over the last 5 minutes
+	Load5 resource.Quantity `json:"load5"`
+	// Load15 is the load average over the last 15 minutes
+	Load15 resource.Quantity `json:"load15"`
+}
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -2437,8 +2738,33 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format is the format of the memory dump output, defaults to elf
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+	// Export represents request of exposing the memory dump pvc through a VirtualMachineExport once the memory dump completed
+	// +optional
+	Export bool `json:"export,omitempty"`
+	// ExportName is the name of the VirtualMachineExport exposing the memory dump
+	// +optional
+	ExportName *string `json:"exportName,omitempty"`
+	// Checksum is the sha256 checksum of the memory dump output
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
 }
 
+type MemoryDumpFormat string
+
+const (
+	// The memory dump is an ELF core file
+	MemoryDumpFormatELF MemoryDumpFormat = "elf"
+	// The memory dump is a kdump-compressed file with zlib compression
+	MemoryDumpFormatKdumpZlib MemoryDumpFormat = "kdump-zlib"
+	// The memory dump is a kdump-compressed file with lzo compression
+	MemoryDumpFormatKdumpLzo MemoryDumpFormat = "kdump-lzo"
+	// The memory dump is a kdump-compressed file with snappy compression
+	MemoryDumpFormatKdumpSnappy MemoryDumpFormat = "kdump-snappy"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2500,6 +2826,37 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// InsertCDRomOptions is provided when inserting a media into the tray of a CD-ROM disk
+type InsertCDRomOptions struct {
+	// Name represents the name of the CD-ROM disk the media is inserted into.
+	// The volume backing the media gets the same name.
+	Name string `json:"name"`
+	// VolumeSource represents the source of the media to insert.
+	VolumeSource *HotplugVolumeSource `json:"volumeSource"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// EjectCDRomOptions is provided when ejecting the media from the tray of a CD-ROM disk
+type EjectCDRomOptions struct {
+	// Name represents the name of the CD-ROM disk the media is ejected from
+	Name string `json:"name"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -2606,6 +2963,12 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// DiskIOTuneLimits are the highest I/O limits the disks of a VMI may have.
+	// Disks without a limit of a kind get the limits of this kind, VMIs with disks
+	// above the limits are rejected. CD-ROMs are not limited.
+	// +optional
+	DiskIOTuneLimits *DiskIOTune `json:"diskIOTuneLimits,omitempty"`
 }
 
 type InstancetypeConfiguration struct {
@@ -2802,6 +3165,126 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// Compression configures the compression of the guest memory sent during live migrations.
+	// Compression trades CPU time for bandwidth and helps memory heavy VMIs to converge on slow networks.
+	// Defaults to no compression
+	// +optional
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// CrossClusterMigrationPort is the port virt-handler listens on for cross-cluster migrations.
+	// It has to be routable from the clusters sending VMIs. Defaults to 49154
+	// +optional
+	CrossClusterMigrationPort *int32 `json:"crossClusterMigrationPort,omitempty"`
+	// MaxDowntime is the maximum time the VMI may be paused to switch over to the target.
+	// The migration only completes once the remaining memory can be sent within it.
+	// Defaults to the hypervisor default (300ms)
+	// +optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+	// SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own.
+	// A plan with an Abort step replaces the handling of CompletionTimeoutPerGiB and AllowWorkloadDisruption.
+	// Migrations which make no progress for ProgressTimeout seconds are still aborted, so StalledFor has to be shorter.
+	// +optional
+	SwitchoverPolicy *MigrationSwitchoverPolicy `json:"switchoverPolicy,omitempty"`
+	// LocalStorage configures the copy of the local volumes of the VMI during live migrations.
+	// By default the volumes are copied within BandwidthPerMigration.
+	// +optional
+	LocalStorage *LocalStorageMigration `json:"localStorage,omitempty"`
+}
+
+// LocalStorageMigration configures the copy of local volumes during live migrations.
+// The copy cannot be given an I/O priority, QEMU copies the volumes in the same I/O threads as the guest
+// uses for them, so its load on the node storage is only limited by its bandwidth.
+type LocalStorageMigration struct {
+	// Bandwidth limits the copy of each local volume, in bytes per second.
+	// It is independent of BandwidthPerMigration: the volumes are copied before
+	// the memory, and the migration bandwidth is set to BandwidthPerMigration
+	// once they are copied. Defaults to BandwidthPerMigration
+	// +optional
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+	// StorageClasses overrides the bandwidth for the volumes of the given storage classes.
+	// The bandwidth applies to all volumes of a migration, the lowest bandwidth of the copied volumes is used.
+	// +listType=map
+	// +listMapKey=storageClassName
+	// +optional
+	StorageClasses []LocalStorageMigrationClass `json:"storageClasses,omitempty"`
+}
+
+// LocalStorageMigrationClass overrides the copy settings for the local volumes of a storage class
+type LocalStorageMigrationClass struct {
+	// StorageClassName is the name of the storage class of the volume claim
+	StorageClassName string `json:"storageClassName"`
+	// Bandwidth limits the copy of the volumes of the storage class, in bytes per second.
+	// It is not applied per volume, the lowest bandwidth of the copied volumes limits the copy of all of them
+	// +optional
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+}
+
+// MigrationSwitchoverAction is an action taken to help a live migration to complete
+type MigrationSwitchoverAction string
+
+const (
+	// MigrationSwitchoverRaiseDowntime raises the maximum downtime of the migration
+	MigrationSwitchoverRaiseDowntime MigrationSwitchoverAction = "RaiseDowntime"
+	// MigrationSwitchoverPostCopy switches the migration to post-copy
+	MigrationSwitchoverPostCopy MigrationSwitchoverAction = "PostCopy"
+	// MigrationSwitchoverAbort aborts the migration
+	MigrationSwitchoverAbort MigrationSwitchoverAction = "Abort"
+)
+
+// MigrationSwitchoverPolicy is an escalation plan for live migrations
+type MigrationSwitchoverPolicy struct {
+	// Steps are taken one after the other. A step is only considered once the previous one was taken.
+	// Abort can only be the last step.
+	// +listType=atomic
+	Steps []MigrationSwitchoverStep `json:"steps"`
+}
+
+// MigrationSwitchoverStep is a step of the escalation plan of live migrations.
+// The step is taken as soon as one of its conditions is met.
+type MigrationSwitchoverStep struct {
+	// Action is the action taken, one of RaiseDowntime, PostCopy or Abort
+	// +kubebuilder:validation:Enum=RaiseDowntime;PostCopy;Abort
+	Action MigrationSwitchoverAction `json:"action"`
+	// After takes the step once the migration runs for the given time
+	// +optional
+	After *metav1.Duration `json:"after,omitempty"`
+	// StalledFor takes the step once the remaining data of the migration did not shrink for the given time
+	// +optional
+	StalledFor *metav1.Duration `json:"stalledFor,omitempty"`
+	// MaxDowntime is the maximum downtime set by the RaiseDowntime action
+	// +optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+}
+
+// MigrationCompressionMode is the method used to compress the guest memory during live migrations
+type MigrationCompressionMode string
+
+const (
+	// MigrationCompressionNone disables compression
+	MigrationCompressionNone MigrationCompressionMode = "none"
+	// MigrationCompressionXBZRLE only sends the changes of pages which were already sent,
+	// using a cache of the sent pages on the source
+	MigrationCompressionXBZRLE MigrationCompressionMode = "xbzrle"
+	// MigrationCompressionMultifdZstd compresses the memory with zstd in the parallel migration threads.
+	// It is only used when parallel migration threads are available, which is not the case with post-copy.
+	MigrationCompressionMultifdZstd MigrationCompressionMode = "multifd-zstd"
+)
+
+// MigrationCompression holds the compression options of live migrations
+type MigrationCompression struct {
+	// Mode is the compression method, one of none, xbzrle or multifd-zstd. Defaults to none
+	// +kubebuilder:validation:Enum=none;xbzrle;multifd-zstd
+	// +optional
+	Mode MigrationCompressionMode `json:"mode,omitempty"`
+	// XBZRLECacheSize is the size of the page cache used by the xbzrle mode.
+	// Defaults to the hypervisor default (64Mi)
+	// +optional
+	XBZRLECacheSize *resource.Quantity `json:"xbzrleCacheSize,omitempty"`
+	// ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.
+	// Higher levels compress better but use more CPU. Defaults to the hypervisor default (1)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	ZstdLevel *int32 `json:"zstdLevel,omitempty"`
 }
 
 // DiskVerification holds container disks verification limits
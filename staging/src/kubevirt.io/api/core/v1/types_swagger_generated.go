@@ -105,6 +105,7 @@ func (PersistentVolumeClaimInfo) SwaggerDoc() map[string]string {
 		"requests":           "Requests represents the resources requested by the corresponding PVC spec\n+optional",
 		"preallocated":       "Preallocated indicates if the PVC's storage is preallocated or not\n+optional",
 		"filesystemOverhead": "Percentage of filesystem's size to be reserved when resizing the PVC\n+optional",
+		"storageClassName":   "StorageClassName is the name of the storage class of the PVC\n+optional",
 	}
 }
 
@@ -120,6 +121,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"hotplugVolume":             "If the volume is hotplug, this will contain the hotplug status.",
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
+		"backupVolume":              "If the volume is a backup volume, this will contain the backup info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
 	}
 }
@@ -153,6 +155,20 @@ func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp is the time when the memory dump completed",
 		"claimName":      "ClaimName is the name of the pvc the memory was dumped to",
 		"targetFileName": "TargetFileName is the name of the memory dump output",
+		"checksum":       "Checksum is the sha256 checksum of the memory dump output",
+	}
+}
+
+func (DomainBackupInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DomainBackupInfo represents the backup information",
+		"startTimestamp":   "StartTimestamp is the time when the backup started",
+		"endTimestamp":     "EndTimestamp is the time when the backup completed",
+		"claimName":        "ClaimName is the name of the pvc the volumes were backed up to",
+		"checkpoint":       "Checkpoint is the name of the checkpoint created with the backup",
+		"incremental":      "Incremental is true if only the blocks changed since the base checkpoint were backed up",
+		"untrackedVolumes": "UntrackedVolumes are the backed up volumes which cannot track their changed blocks.\nNo checkpoint is created when a volume cannot track its changed blocks.\n+listType=atomic\n+optional",
+		"targetDirectory":  "TargetDirectory is the directory in the pvc the volumes were backed up to",
 	}
 }
 
@@ -238,6 +254,42 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
 		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
 		"targetPersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"crossClusterMigrationID":        "The ID the sending and the receiving side of a cross-cluster migration meet with\nat the cross-cluster migration endpoint of the target node\n+optional",
+		"convergence":                    "The guest memory dirty rate measured during the migration and the completion predicted from it\n+optional",
+		"dataTransferredBytes":           "The amount of data the migration transferred to the target so far\n+optional",
+		"downtime":                       "The time the guest was paused to switch over to the target, as measured by the hypervisor\nonce the migration completed\n+optional",
+		"setupTime":                      "The time the hypervisor spent setting up the migration before transferring the guest state,\nas measured once the migration completed\n+optional",
+		"localVolumes":                   "The copy progress of the local volumes migrated with the VMI\n+optional",
+	}
+}
+
+func (MigrationLocalVolumesState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "MigrationLocalVolumesState reports the copy progress of the local volumes migrated with the VMI",
+		"transferredBytes":        "TransferredBytes is the amount of data of the volumes copied to the target so far",
+		"totalBytes":              "TotalBytes is the amount of data of the volumes to copy, it grows with the guest writes during the copy",
+		"bandwidthBytesPerSecond": "BandwidthBytesPerSecond is the bandwidth limit of the copy, it is not set if the copy is not limited\n+optional",
+		"volumes":                 "Volumes reports the copy progress of each local volume once its copy started\n+listType=map\n+listMapKey=volumeName\n+optional",
+	}
+}
+
+func (MigrationLocalVolumeState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "MigrationLocalVolumeState reports the copy progress of a local volume migrated with the VMI",
+		"volumeName":       "VolumeName is the name of the volume",
+		"transferredBytes": "TransferredBytes is the amount of data of the volume copied to the target so far",
+		"totalBytes":       "TotalBytes is the amount of data of the volume to copy, it grows with the guest writes during the copy",
+	}
+}
+
+func (MigrationConvergence) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                             "MigrationConvergence reports the measured guest memory dirty rate of a migration\nand whether the migration is predicted to complete at its transfer rate",
+		"dirtyRateBytesPerSecond":      "DirtyRateBytesPerSecond is the rate at which the guest dirties its memory",
+		"measurementTimestamp":         "MeasurementTimestamp is the time of the last dirty rate measurement\n+optional",
+		"transferRateBytesPerSecond":   "TransferRateBytesPerSecond is the rate at which the migration transfers the guest memory.\nThe configured bandwidth is used until the transfer started.\n+optional",
+		"converging":                   "Converging is false if the guest dirties its memory faster than it is transferred.\nSuch a migration only completes with post-copy or by pausing the guest.\n+optional",
+		"predictedCompletionTimestamp": "PredictedCompletionTimestamp is the time the migration is predicted to complete at,\nit is only set for a converging migration\n+optional",
 	}
 }
 
@@ -319,6 +371,24 @@ func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"vmiName":           "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
 		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to\ncomplement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects\nare going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
+		"priority":          "Priority defines the order in which pending migrations are started once the\nparallel migration limits are reached. Defaults to user-triggered.\nPending migrations gain priority while they wait, so that migrations with a\nlow priority are not starved. The system priorities are reserved for KubeVirt.\n+kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance\n+optional",
+		"sendTo":            "SendTo makes the migration the sending side of a cross-cluster migration.\nThe VMI is migrated to the cluster behind the connect URL, where a migration\nreceiving the same migration ID has to be ready.\n+optional",
+		"receive":           "Receive makes the migration the receiving side of a cross-cluster migration.\nThe VMI has to be created with the kubevirt.io/crossClusterMigrationReceiver\nannotation, so that it waits for the migration instead of being started.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSendTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationSendTo defines where a cross-cluster migration sends the VMI to",
+		"migrationID": "MigrationID identifies the cross-cluster migration on both sides",
+		"connectURL":  "ConnectURL is the routable host:port of the cross-cluster migration endpoint of the target node",
+	}
+}
+
+func (VirtualMachineInstanceMigrationReceive) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationReceive defines which cross-cluster migration the migration receives",
+		"migrationID": "MigrationID identifies the cross-cluster migration on both sides",
 	}
 }
 
@@ -335,6 +405,7 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"":                          "VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.",
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
+		"priority":                  "Priority is the priority the pending migration is started with, the higher one\nof the priority of the migration and of its migration policy\n+optional",
 	}
 }
 
@@ -411,6 +482,24 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"migrationHistory":       "MigrationHistory lists the last 10 finished migrations of the VirtualMachine, oldest first.\nIt outlives the VirtualMachineInstances and the migration objects.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineMigrationRecord) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineMigrationRecord records a finished migration of a VirtualMachine",
+		"migrationUid":         "The UID of the migration",
+		"vmiUid":               "The UID of the migrated VirtualMachineInstance",
+		"sourceNode":           "The node the VirtualMachineInstance was migrated from",
+		"targetNode":           "The node the VirtualMachineInstance was migrated to",
+		"startTimestamp":       "The time the migration started",
+		"endTimestamp":         "The time the migration ended",
+		"mode":                 "The mode the migration ended in",
+		"dataTransferredBytes": "The amount of data the migration transferred to the target",
+		"downtime":             "The time the guest was paused to switch over to the target",
+		"failed":               "Indicates that the migration failed",
+		"failureReason":        "The reason the migration failed",
 	}
 }
 
@@ -534,6 +623,18 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":    "MaintenanceWindows restricts automated workload updates of outdated VMIs to the\ntimes one of the windows applying to their namespace is open.\nWindows listing namespaces apply to these namespaces only, windows without\nnamespaces apply to all other namespaces. VMIs in namespaces without an applying\nwindow are updated at any time.\nMigrations required to apply hotplug or volume updates are not restricted.\n\n+listType=atomic\n+optional",
+		"excludedNamespaces":    "ExcludedNamespaces lists the namespaces whose outdated VMIs are never\nupdated automatically.\n\n+listType=set\n+optional",
+	}
+}
+
+func (WorkloadUpdateMaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "WorkloadUpdateMaintenanceWindow is a recurring window in which outdated workloads may be updated",
+		"schedule":   "Schedule is the cron expression with the fields minute, hour, day of month, month\nand day of week at which the window opens, e.g. \"0 22 * * sat\"",
+		"duration":   "Duration defines how long the window stays open",
+		"timeZone":   "TimeZone is the name of the IANA time zone the schedule is evaluated in, e.g. \"Europe/Berlin\".\nDefaults to UTC.\n\n+optional",
+		"namespaces": "Namespaces lists the namespaces the window applies to\n\n+listType=set\n+optional",
 	}
 }
 
@@ -650,6 +751,24 @@ func (MigrateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MigrationFeasibility) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "MigrationFeasibility is returned by a dry run migrate request and reports\nwhether the VirtualMachineInstance could be migrated and which nodes could take it.\n\n+k8s:openapi-gen=true",
+		"migratable": "Migratable is true if the VirtualMachineInstance is live migratable\nand at least one node satisfies the constraints of the migration target pod",
+		"reasons":    "Reasons why the VirtualMachineInstance cannot be migrated to any node\n+optional\n+listType=atomic",
+		"nodes":      "Nodes evaluated as migration target, the source node is not included.\nOnly reported to users who are allowed to list nodes\n+optional\n+listType=atomic",
+	}
+}
+
+func (NodeMigrationFeasibility) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "NodeMigrationFeasibility reports whether a node can take a migrating VirtualMachineInstance\n\n+k8s:openapi-gen=true",
+		"name":     "Name of the node",
+		"feasible": "Feasible is true if the node satisfies the constraints of the migration target pod.\nResource availability is not evaluated and is left to the scheduler.",
+		"reasons":  "Reasons why the node is rejected\n+optional\n+listType=atomic",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -706,6 +825,27 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceGuestMetrics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VirtualMachineInstanceGuestMetrics represents a sample of the resource usage of the guest\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"timestamp":           "Timestamp is the time at which the sample was taken",
+		"vcpuCount":           "VCPUCount is the number of vCPUs of the guest",
+		"vcpuTimeNanoseconds": "VCPUTimeNanoseconds is the cumulative time spent by all vCPUs of the guest\n+optional",
+		"memoryAvailable":     "MemoryAvailable is the amount of memory usable by the guest\n+optional",
+		"memoryUsable":        "MemoryUsable is the amount of memory which can be reclaimed by the guest without swapping\n+optional",
+		"load":                "Load contains the load averages reported by the guest agent\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestLoad) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineInstanceGuestLoad represents the load averages of the guest",
+		"load1":  "Load1 is the load average over the last minute",
+		"load5":  "Load5 is the load average over the last 5 minutes",
+		"load15": "Load15 is the load average over the last 15 minutes",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -722,6 +862,10 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format is the format of the memory dump output, defaults to elf\n+optional",
+		"export":         "Export represents request of exposing the memory dump pvc through a VirtualMachineExport once the memory dump completed\n+optional",
+		"exportName":     "ExportName is the name of the VirtualMachineExport exposing the memory dump\n+optional",
+		"checksum":       "Checksum is the sha256 checksum of the memory dump output\n+optional",
 	}
 }
 
@@ -751,6 +895,23 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InsertCDRomOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InsertCDRomOptions is provided when inserting a media into the tray of a CD-ROM disk",
+		"name":         "Name represents the name of the CD-ROM disk the media is inserted into.\nThe volume backing the media gets the same name.",
+		"volumeSource": "VolumeSource represents the source of the media to insert.",
+		"dryRun":       "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (EjectCDRomOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "EjectCDRomOptions is provided when ejecting the media from the tray of a CD-ROM disk",
+		"name":   "Name represents the name of the CD-ROM disk the media is ejected from",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -793,6 +954,7 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"diskIOTuneLimits":                   "DiskIOTuneLimits are the highest I/O limits the disks of a VMI may have.\nDisks without a limit of a kind get the limits of this kind, VMIs with disks\nabove the limits are rejected. CD-ROMs are not limited.\n+optional",
 	}
 }
 
@@ -885,6 +1047,53 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"compression":                       "Compression configures the compression of the guest memory sent during live migrations.\nCompression trades CPU time for bandwidth and helps memory heavy VMIs to converge on slow networks.\nDefaults to no compression\n+optional",
+		"crossClusterMigrationPort":         "CrossClusterMigrationPort is the port virt-handler listens on for cross-cluster migrations.\nIt has to be routable from the clusters sending VMIs. Defaults to 49154\n+optional",
+		"maxDowntime":                       "MaxDowntime is the maximum time the VMI may be paused to switch over to the target.\nThe migration only completes once the remaining memory can be sent within it.\nDefaults to the hypervisor default (300ms)\n+optional",
+		"switchoverPolicy":                  "SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own.\nA plan with an Abort step replaces the handling of CompletionTimeoutPerGiB and AllowWorkloadDisruption.\nMigrations which make no progress for ProgressTimeout seconds are still aborted, so StalledFor has to be shorter.\n+optional",
+		"localStorage":                      "LocalStorage configures the copy of the local volumes of the VMI during live migrations.\nBy default the volumes are copied within BandwidthPerMigration.\n+optional",
+	}
+}
+
+func (LocalStorageMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "LocalStorageMigration configures the copy of local volumes during live migrations.\nThe copy cannot be given an I/O priority, QEMU copies the volumes in the same I/O threads as the guest\nuses for them, so its load on the node storage is only limited by its bandwidth.",
+		"bandwidth":      "Bandwidth limits the copy of each local volume, in bytes per second.\nIt is independent of BandwidthPerMigration: the volumes are copied before\nthe memory, and the migration bandwidth is set to BandwidthPerMigration\nonce they are copied. Defaults to BandwidthPerMigration\n+optional",
+		"storageClasses": "StorageClasses overrides the bandwidth for the volumes of the given storage classes.\nThe bandwidth applies to all volumes of a migration, the lowest bandwidth of the copied volumes is used.\n+listType=map\n+listMapKey=storageClassName\n+optional",
+	}
+}
+
+func (LocalStorageMigrationClass) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "LocalStorageMigrationClass overrides the copy settings for the local volumes of a storage class",
+		"storageClassName": "StorageClassName is the name of the storage class of the volume claim",
+		"bandwidth":        "Bandwidth limits the copy of the volumes of the storage class, in bytes per second.\nIt is not applied per volume, the lowest bandwidth of the copied volumes limits the copy of all of them\n+optional",
+	}
+}
+
+func (MigrationSwitchoverPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MigrationSwitchoverPolicy is an escalation plan for live migrations",
+		"steps": "Steps are taken one after the other. A step is only considered once the previous one was taken.\nAbort can only be the last step.\n+listType=atomic",
+	}
+}
+
+func (MigrationSwitchoverStep) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "MigrationSwitchoverStep is a step of the escalation plan of live migrations.\nThe step is taken as soon as one of its conditions is met.",
+		"action":      "Action is the action taken, one of RaiseDowntime, PostCopy or Abort.\nAuto-converge is not a step, it is enabled for the whole migration with AllowAutoConverge\n+kubebuilder:validation:Enum=RaiseDowntime;PostCopy;Abort",
+		"after":       "After takes the step once the migration runs for the given time\n+optional",
+		"stalledFor":  "StalledFor takes the step once the remaining data of the migration did not shrink for the given time\n+optional",
+		"maxDowntime": "MaxDowntime is the maximum downtime set by the RaiseDowntime action\n+optional",
+	}
+}
+
+func (MigrationCompression) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MigrationCompression holds the compression options of live migrations",
+		"mode":            "Mode is the compression method, one of none, xbzrle or multifd-zstd. Defaults to none\n+kubebuilder:validation:Enum=none;xbzrle;multifd-zstd\n+optional",
+		"xbzrleCacheSize": "XBZRLECacheSize is the size of the page cache used by the xbzrle mode.\nDefaults to the hypervisor default (64Mi)\n+optional",
+		"zstdLevel":       "ZstdLevel is the compression level of the multifd-zstd mode, from 1 to 20.\nHigher levels compress better but use more CPU. Defaults to the hypervisor default (1)\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=20\n+optional",
 	}
 }
 
@@ -76,6 +76,48 @@ func (in *VirtualMachineExport) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportDestination) DeepCopyInto(out *VirtualMachineExportDestination) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(VirtualMachineExportS3Destination)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportDestination.
+func (in *VirtualMachineExportDestination) DeepCopy() *VirtualMachineExportDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportDestinationStatus) DeepCopyInto(out *VirtualMachineExportDestinationStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineExportVolumeUploadStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportDestinationStatus.
+func (in *VirtualMachineExportDestinationStatus) DeepCopy() *VirtualMachineExportDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineExportLink) DeepCopyInto(out *VirtualMachineExportLink) {
 	*out = *in
@@ -179,6 +221,22 @@ func (in *VirtualMachineExportManifest) DeepCopy() *VirtualMachineExportManifest
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportS3Destination) DeepCopyInto(out *VirtualMachineExportS3Destination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportS3Destination.
+func (in *VirtualMachineExportS3Destination) DeepCopy() *VirtualMachineExportS3Destination {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportS3Destination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec) {
 	*out = *in
@@ -193,6 +251,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(VirtualMachineExportDestination)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -235,6 +298,11 @@ func (in *VirtualMachineExportStatus) DeepCopyInto(out *VirtualMachineExportStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(VirtualMachineExportDestinationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -284,3 +352,19 @@ func (in *VirtualMachineExportVolumeFormat) DeepCopy() *VirtualMachineExportVolu
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportVolumeUploadStatus) DeepCopyInto(out *VirtualMachineExportVolumeUploadStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportVolumeUploadStatus.
+func (in *VirtualMachineExportVolumeUploadStatus) DeepCopy() *VirtualMachineExportVolumeUploadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportVolumeUploadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
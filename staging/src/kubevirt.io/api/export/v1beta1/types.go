@@ -67,6 +67,45 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// Destination, if set, makes the export server upload the exported volumes and the
+	// VirtualMachine manifest, in addition to serving them
+	// +optional
+	Destination *VirtualMachineExportDestination `json:"destination,omitempty"`
+}
+
+// VirtualMachineExportDestination is the location the export is uploaded to
+type VirtualMachineExportDestination struct {
+	// S3 is a bucket of an S3 compatible object storage
+	// +optional
+	S3 *VirtualMachineExportS3Destination `json:"s3,omitempty"`
+}
+
+// VirtualMachineExportS3Destination is a bucket of an S3 compatible object storage
+type VirtualMachineExportS3Destination struct {
+	// Endpoint is the URL of the object storage, the bucket is addressed path style
+	Endpoint string `json:"endpoint"`
+
+	// Bucket is the name of the bucket the objects are uploaded to
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to the key of every uploaded object. The objects of an export
+	// are stored under <prefix>/<export name>/
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region is the region used to sign the requests, defaults to us-east-1
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// CredentialsSecretRef is the name of a secret in the namespace of the export which
+	// contains the accessKeyId and secretAccessKey keys
+	CredentialsSecretRef string `json:"credentialsSecretRef"`
+
+	// Format is the format of the uploaded disk images, one of raw, gzip, zstd or qcow2.
+	// Defaults to gzip. Volumes not containing a disk image are always uploaded as tar.gz
+	// +optional
+	Format ExportVolumeFormat `json:"format,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -113,6 +152,67 @@ type VirtualMachineExportStatus struct {
 	// +optional
 	// +listType=atomic
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// +optional
+	// Destination reports the progress of the upload to the destination
+	Destination *VirtualMachineExportDestinationStatus `json:"destination,omitempty"`
+}
+
+// VirtualMachineExportUploadPhase is the phase of the upload to the destination
+type VirtualMachineExportUploadPhase string
+
+const (
+	// UploadPending means the upload has not started yet
+	UploadPending VirtualMachineExportUploadPhase = "Pending"
+	// UploadInProgress means the volumes are being uploaded
+	UploadInProgress VirtualMachineExportUploadPhase = "InProgress"
+	// UploadSucceeded means all volumes and the manifest were uploaded
+	UploadSucceeded VirtualMachineExportUploadPhase = "Succeeded"
+	// UploadFailed means the upload failed
+	UploadFailed VirtualMachineExportUploadPhase = "Failed"
+)
+
+// VirtualMachineExportDestinationStatus is the status of the upload to the destination
+type VirtualMachineExportDestinationStatus struct {
+	// +optional
+	Phase VirtualMachineExportUploadPhase `json:"phase,omitempty"`
+
+	// +optional
+	// Message explains why the upload failed
+	Message string `json:"message,omitempty"`
+
+	// +optional
+	// ManifestKey is the key of the uploaded VirtualMachine manifest
+	ManifestKey string `json:"manifestKey,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VirtualMachineExportVolumeUploadStatus `json:"volumes,omitempty"`
+}
+
+// VirtualMachineExportVolumeUploadStatus is the status of the upload of a single volume
+type VirtualMachineExportVolumeUploadStatus struct {
+	// Name is the name of the exported volume
+	Name string `json:"name"`
+
+	// Key is the key of the uploaded object
+	Key string `json:"key"`
+
+	// Format is the format of the uploaded object
+	Format ExportVolumeFormat `json:"format"`
+
+	// +optional
+	// Progress is the share of the volume read so far, e.g. 42.00%
+	Progress string `json:"progress,omitempty"`
+
+	// +optional
+	// UploadedBytes is the number of bytes uploaded so far
+	UploadedBytes int64 `json:"uploadedBytes,omitempty"`
+
+	// +optional
+	// Checksum is the SHA-256 of the uploaded object in the form sha256:<hex>, it is set once the upload completed
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // VirtualMachineExportLinks contains the links that point the exported VM resources
@@ -176,6 +276,11 @@ const (
 	KubeVirtRaw ExportVolumeFormat = "raw"
 	// KubeVirtGZ is the volume in gzipped RAW format.
 	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtZstd is the volume in zstd compressed RAW format.
+	KubeVirtZstd ExportVolumeFormat = "zstd"
+	// KubeVirtQcow2 is the volume in sparse qcow2 format, generated from the RAW volume on the fly.
+	// Unlike the compressed formats it supports HTTP range requests.
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
 	// Dir is an uncompressed directory, which points to the root of a PersistentVolumeClaim, exposed using a FileServer https://pkg.go.dev/net/http#FileServer
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
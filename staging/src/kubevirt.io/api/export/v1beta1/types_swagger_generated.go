@@ -21,6 +21,26 @@ func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"destination":    "Destination, if set, makes the export server upload the exported volumes and the\nVirtualMachine manifest, in addition to serving them\n+optional",
+	}
+}
+
+func (VirtualMachineExportDestination) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":   "VirtualMachineExportDestination is the location the export is uploaded to",
+		"s3": "S3 is a bucket of an S3 compatible object storage\n+optional",
+	}
+}
+
+func (VirtualMachineExportS3Destination) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineExportS3Destination is a bucket of an S3 compatible object storage",
+		"endpoint":             "Endpoint is the URL of the object storage, the bucket is addressed path style",
+		"bucket":               "Bucket is the name of the bucket the objects are uploaded to",
+		"prefix":               "Prefix is prepended to the key of every uploaded object. The objects of an export\nare stored under <prefix>/<export name>/\n+optional",
+		"region":               "Region is the region used to sign the requests, defaults to us-east-1\n+optional",
+		"credentialsSecretRef": "CredentialsSecretRef is the name of a secret in the namespace of the export which\ncontains the accessKeyId and secretAccessKey keys",
+		"format":               "Format is the format of the uploaded disk images, one of raw, gzip, zstd or qcow2.\nDefaults to gzip. Volumes not containing a disk image are always uploaded as tar.gz\n+optional",
 	}
 }
 
@@ -34,6 +54,29 @@ func (VirtualMachineExportStatus) SwaggerDoc() map[string]string {
 		"serviceName":        "+optional\nServiceName is the name of the service created associated with the Virtual Machine export. It will be used to\ncreate the internal URLs for downloading the images",
 		"virtualMachineName": "+optional\nVirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or\na VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a\nVirtualMachineSnapshot",
 		"conditions":         "+optional\n+listType=atomic",
+		"destination":        "+optional\nDestination reports the progress of the upload to the destination",
+	}
+}
+
+func (VirtualMachineExportDestinationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineExportDestinationStatus is the status of the upload to the destination",
+		"phase":       "+optional",
+		"message":     "+optional\nMessage explains why the upload failed",
+		"manifestKey": "+optional\nManifestKey is the key of the uploaded VirtualMachine manifest",
+		"volumes":     "+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (VirtualMachineExportVolumeUploadStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineExportVolumeUploadStatus is the status of the upload of a single volume",
+		"name":          "Name is the name of the exported volume",
+		"key":           "Key is the key of the uploaded object",
+		"format":        "Format is the format of the uploaded object",
+		"progress":      "+optional\nProgress is the share of the volume read so far, e.g. 42.00%",
+		"uploadedBytes": "+optional\nUploadedBytes is the number of bytes uploaded so far",
+		"checksum":      "+optional\nChecksum is the SHA-256 of the uploaded object in the form sha256:<hex>, it is set once the upload completed",
 	}
 }
 
@@ -22,7 +22,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -141,6 +143,26 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SwitchoverPolicy != nil {
+		in, out := &in.SwitchoverPolicy, &out.SwitchoverPolicy
+		*out = new(v1.MigrationSwitchoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(v1.MigrationPriority)
+		**out = **in
+	}
 	return
 }
 
@@ -53,6 +53,18 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	// MaxDowntime is the maximum time the VMI may be paused to switch over to the target
+	//+optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+	// SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own
+	//+optional
+	SwitchoverPolicy *k6tv1.MigrationSwitchoverPolicy `json:"switchoverPolicy,omitempty"`
+	// Priority raises the priority of the migrations of matched VMIs to at least the given priority
+	//+kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance
+	//+optional
+	Priority *k6tv1.MigrationPriority `json:"priority,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -109,6 +121,18 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.Compression != nil {
+		changed = true
+		clusterMigrationConfigurations.Compression = policySpec.Compression.DeepCopy()
+	}
+	if policySpec.MaxDowntime != nil {
+		changed = true
+		clusterMigrationConfigurations.MaxDowntime = policySpec.MaxDowntime.DeepCopy()
+	}
+	if policySpec.SwitchoverPolicy != nil {
+		changed = true
+		clusterMigrationConfigurations.SwitchoverPolicy = policySpec.SwitchoverPolicy.DeepCopy()
+	}
 
 	return changed, nil
 }
@@ -16,6 +16,10 @@ func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 		"completionTimeoutPerGiB": "+optional",
 		"allowPostCopy":           "+optional",
 		"allowWorkloadDisruption": "+optional",
+		"compression":             "+optional",
+		"maxDowntime":             "MaxDowntime is the maximum time the VMI may be paused to switch over to the target\n+optional",
+		"switchoverPolicy":        "SwitchoverPolicy is the escalation plan followed by migrations which do not complete on their own\n+optional",
+		"priority":                "Priority raises the priority of the migrations of matched VMIs to at least the given priority\n+kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance\n+optional",
 	}
 }
 
@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -54,6 +55,67 @@ func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutoscaling) DeepCopyInto(out *VirtualMachinePoolAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]VirtualMachinePoolMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleUpCooldown != nil {
+		in, out := &in.ScaleUpCooldown, &out.ScaleUpCooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownCooldown != nil {
+		in, out := &in.ScaleDownCooldown, &out.ScaleDownCooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutoscaling.
+func (in *VirtualMachinePoolAutoscaling) DeepCopy() *VirtualMachinePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutoscalingStatus) DeepCopyInto(out *VirtualMachinePoolAutoscalingStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make([]VirtualMachinePoolMetricStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutoscalingStatus.
+func (in *VirtualMachinePoolAutoscalingStatus) DeepCopy() *VirtualMachinePoolAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolCondition) DeepCopyInto(out *VirtualMachinePoolCondition) {
 	*out = *in
@@ -105,6 +167,38 @@ func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolMetric) DeepCopyInto(out *VirtualMachinePoolMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolMetric.
+func (in *VirtualMachinePoolMetric) DeepCopy() *VirtualMachinePoolMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolMetricStatus) DeepCopyInto(out *VirtualMachinePoolMetricStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolMetricStatus.
+func (in *VirtualMachinePoolMetricStatus) DeepCopy() *VirtualMachinePoolMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolNameGeneration) DeepCopyInto(out *VirtualMachinePoolNameGeneration) {
 	*out = *in
@@ -131,6 +225,48 @@ func (in *VirtualMachinePoolNameGeneration) DeepCopy() *VirtualMachinePoolNameGe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopyInto(out *VirtualMachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolRollingUpdate.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopy() *VirtualMachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolScaleInStrategy) DeepCopyInto(out *VirtualMachinePoolScaleInStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolScaleInStrategy.
+func (in *VirtualMachinePoolScaleInStrategy) DeepCopy() *VirtualMachinePoolScaleInStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolScaleInStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 	*out = *in
@@ -154,6 +290,26 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachinePoolNameGeneration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(VirtualMachinePoolUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleInStrategy != nil {
+		in, out := &in.ScaleInStrategy, &out.ScaleInStrategy
+		*out = new(VirtualMachinePoolScaleInStrategy)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VirtualMachinePoolAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnhealthyReplicaReplacement != nil {
+		in, out := &in.UnhealthyReplicaReplacement, &out.UnhealthyReplicaReplacement
+		*out = new(VirtualMachinePoolUnhealthyReplicaReplacement)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -177,6 +333,16 @@ func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VirtualMachinePoolAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicasByStatus != nil {
+		in, out := &in.ReplicasByStatus, &out.ReplicasByStatus
+		*out = make([]VirtualMachinePoolStatusCount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -190,6 +356,64 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolStatusCount) DeepCopyInto(out *VirtualMachinePoolStatusCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolStatusCount.
+func (in *VirtualMachinePoolStatusCount) DeepCopy() *VirtualMachinePoolStatusCount {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolStatusCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUnhealthyReplicaReplacement) DeepCopyInto(out *VirtualMachinePoolUnhealthyReplicaReplacement) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUnhealthyReplicaReplacement.
+func (in *VirtualMachinePoolUnhealthyReplicaReplacement) DeepCopy() *VirtualMachinePoolUnhealthyReplicaReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUnhealthyReplicaReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopyInto(out *VirtualMachinePoolUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUpdateStrategy.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopy() *VirtualMachinePoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
@@ -64,6 +65,10 @@ const (
 	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
 	// After this condition was added, it is safe to remove or add vms by hand and adjust the replica count manually
 	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+
+	// VirtualMachinePoolAutoscalingYielded is added in a pool with autoscaling while a HorizontalPodAutoscaler
+	// targets it. The replicas of the pool are then left to the HorizontalPodAutoscaler.
+	VirtualMachinePoolAutoscalingYielded VirtualMachinePoolConditionType = "AutoscalingYielded"
 )
 
 // +k8s:openapi-gen=true
@@ -89,6 +94,68 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Number of VirtualMachines whose VirtualMachine and VirtualMachineInstance
+	// are in sync with the current pool revision.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// Name of the pool revision the pool is currently rolling out.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty" optional:"true"`
+
+	// Autoscaling reports the last decision of the built-in autoscaler.
+	// +optional
+	Autoscaling *VirtualMachinePoolAutoscalingStatus `json:"autoscaling,omitempty" optional:"true"`
+
+	// Number of VirtualMachines in each printable status.
+	// +optional
+	// +listType=map
+	// +listMapKey=status
+	ReplicasByStatus []VirtualMachinePoolStatusCount `json:"replicasByStatus,omitempty" optional:"true"`
+
+	// Number of VirtualMachines in the CrashLoopBackOff, ErrorUnschedulable or
+	// DataVolumeError status.
+	// +optional
+	UnhealthyReplicas int32 `json:"unhealthyReplicas,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolStatusCount struct {
+	// Printable status of the VirtualMachines.
+	Status virtv1.VirtualMachinePrintableStatus `json:"status"`
+
+	// Number of VirtualMachines in the status.
+	Count int32 `json:"count"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutoscalingStatus struct {
+	// Number of replicas calculated by the autoscaler from the current metrics.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// Last time the autoscaler changed the number of replicas.
+	// +optional
+	// +nullable
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// Last observed value of each metric.
+	// +optional
+	// +listType=atomic
+	CurrentMetrics []VirtualMachinePoolMetricStatus `json:"currentMetrics,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetricStatus struct {
+	// Type of the metric.
+	Type VirtualMachinePoolMetricType `json:"type"`
+
+	// Current value of the metric averaged across the VirtualMachines which reported it,
+	// as a percentage.
+	CurrentAverageUtilization int32 `json:"currentAverageUtilization"`
+
+	// Number of VirtualMachines which reported the metric.
+	Samples int32 `json:"samples"`
 }
 
 // +k8s:openapi-gen=true
@@ -112,6 +179,158 @@ type VirtualMachinePoolSpec struct {
 	// Options for the name generation in a pool.
 	// +optional
 	NameGeneration *VirtualMachinePoolNameGeneration `json:"nameGeneration,omitempty"`
+
+	// The strategy used to replace VirtualMachines running an outdated template.
+	// If not specified, all outdated VirtualMachines are updated at once.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// The strategy used to select the VirtualMachines which are removed when the pool
+	// is scaled in. If not specified, VirtualMachines are selected randomly.
+	// +optional
+	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
+
+	// Autoscaling adjusts the number of replicas based on the resource usage
+	// reported by the guests. When set, the pool controller manages the replicas
+	// and no other autoscaler should target the pool.
+	// +optional
+	Autoscaling *VirtualMachinePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// UnhealthyReplicaReplacement enables the replacement of VirtualMachines which are
+	// stuck in the CrashLoopBackOff, ErrorUnschedulable or DataVolumeError status.
+	// VirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are
+	// never replaced. If not specified, unhealthy VirtualMachines are kept.
+	// +optional
+	UnhealthyReplicaReplacement *VirtualMachinePoolUnhealthyReplicaReplacement `json:"unhealthyReplicaReplacement,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUnhealthyReplicaReplacement struct {
+	// Time a VirtualMachine has to be unhealthy before it gets deleted and recreated.
+	// The grace period restarts if the pool controller restarts. Defaults to 5m.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutoscaling struct {
+	// Lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// Upper limit for the number of replicas. Can not be lower than minReplicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics used to calculate the desired number of replicas.
+	// The highest number of replicas calculated from any metric is used.
+	// +listType=atomic
+	Metrics []VirtualMachinePoolMetric `json:"metrics"`
+
+	// Minimum time between the last scaling and a scale out. Defaults to 3m.
+	// +optional
+	ScaleUpCooldown *metav1.Duration `json:"scaleUpCooldown,omitempty"`
+
+	// Minimum time between the last scaling and a scale in. Defaults to 5m.
+	// +optional
+	ScaleDownCooldown *metav1.Duration `json:"scaleDownCooldown,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetricType string
+
+const (
+	// VirtualMachinePoolCPUMetricType is the time spent by the vCPUs of a guest
+	// relative to the number of its vCPUs.
+	VirtualMachinePoolCPUMetricType VirtualMachinePoolMetricType = "CPU"
+	// VirtualMachinePoolMemoryMetricType is the memory in use by a guest relative
+	// to the memory available to it. Requires the memory balloon.
+	VirtualMachinePoolMemoryMetricType VirtualMachinePoolMetricType = "Memory"
+	// VirtualMachinePoolGuestLoadMetricType is the 1 minute load average reported by
+	// the guest agent relative to the number of vCPUs of the guest.
+	VirtualMachinePoolGuestLoadMetricType VirtualMachinePoolMetricType = "GuestLoad"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetric struct {
+	// Type of the metric. Can be "CPU", "Memory" or "GuestLoad".
+	// +kubebuilder:validation:Enum=CPU;Memory;GuestLoad
+	Type VirtualMachinePoolMetricType `json:"type"`
+
+	// Target value of the metric averaged across the ready VirtualMachines, as a percentage.
+	TargetAverageUtilization int32 `json:"targetAverageUtilization"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// VirtualMachinePoolRollingUpdateStrategyType replaces outdated VirtualMachines
+	// gradually, respecting maxUnavailable and maxSurge.
+	VirtualMachinePoolRollingUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+	// VirtualMachinePoolOnDeleteStrategyType only updates the VirtualMachine objects.
+	// Running VirtualMachineInstances pick up the new template once they are
+	// stopped or deleted by someone else.
+	VirtualMachinePoolOnDeleteStrategyType VirtualMachinePoolUpdateStrategyType = "OnDelete"
+	// VirtualMachinePoolRecreateStrategyType restarts all outdated
+	// VirtualMachineInstances at once.
+	VirtualMachinePoolRecreateStrategyType VirtualMachinePoolUpdateStrategyType = "Recreate"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy. Can be "RollingUpdate", "OnDelete" or "Recreate".
+	// Defaults to "RollingUpdate".
+	// +optional
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete;Recreate
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if type is "RollingUpdate".
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// The maximum number of VirtualMachines that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if maxSurge is 0. Defaults to 25%.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of VirtualMachines that can be created over the desired number
+	// of replicas during the update. Outdated VirtualMachines are deleted once their
+	// replacements are ready.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// This can not be 0 if maxUnavailable is 0. Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInStrategyType string
+
+const (
+	// VirtualMachinePoolNewestFirstScaleInStrategyType removes the most recently created VirtualMachines first.
+	VirtualMachinePoolNewestFirstScaleInStrategyType VirtualMachinePoolScaleInStrategyType = "NewestFirst"
+	// VirtualMachinePoolOldestFirstScaleInStrategyType removes the least recently created VirtualMachines first.
+	VirtualMachinePoolOldestFirstScaleInStrategyType VirtualMachinePoolScaleInStrategyType = "OldestFirst"
+	// VirtualMachinePoolNotReadyFirstScaleInStrategyType removes VirtualMachines which are not ready first.
+	VirtualMachinePoolNotReadyFirstScaleInStrategyType VirtualMachinePoolScaleInStrategyType = "NotReadyFirst"
+	// VirtualMachinePoolHighestIndexFirstScaleInStrategyType removes the VirtualMachines with the highest
+	// pool index first.
+	VirtualMachinePoolHighestIndexFirstScaleInStrategyType VirtualMachinePoolScaleInStrategyType = "HighestIndexFirst"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInStrategy struct {
+	// Type of the scale-in strategy. Can be "NewestFirst", "OldestFirst", "NotReadyFirst"
+	// or "HighestIndexFirst".
+	// VirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are never
+	// selected, regardless of the strategy.
+	// +kubebuilder:validation:Enum=NewestFirst;OldestFirst;NotReadyFirst;HighestIndexFirst
+	Type VirtualMachinePoolScaleInStrategyType `json:"type"`
 }
 
 // +k8s:openapi-gen=true
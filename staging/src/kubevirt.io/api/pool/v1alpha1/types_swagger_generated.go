@@ -26,20 +26,104 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                  "+k8s:openapi-gen=true",
+		"conditions":        "+listType=atomic",
+		"labelSelector":     "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas":   "Number of VirtualMachines whose VirtualMachine and VirtualMachineInstance\nare in sync with the current pool revision.\n+optional",
+		"updateRevision":    "Name of the pool revision the pool is currently rolling out.\n+optional",
+		"autoscaling":       "Autoscaling reports the last decision of the built-in autoscaler.\n+optional",
+		"replicasByStatus":  "Number of VirtualMachines in each printable status.\n+optional\n+listType=map\n+listMapKey=status",
+		"unhealthyReplicas": "Number of VirtualMachines in the CrashLoopBackOff, ErrorUnschedulable or\nDataVolumeError status.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolStatusCount) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "+k8s:openapi-gen=true",
+		"status": "Printable status of the VirtualMachines.",
+		"count":  "Number of VirtualMachines in the status.",
+	}
+}
+
+func (VirtualMachinePoolAutoscalingStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"desiredReplicas": "Number of replicas calculated by the autoscaler from the current metrics.",
+		"lastScaleTime":   "Last time the autoscaler changed the number of replicas.\n+optional\n+nullable",
+		"currentMetrics":  "Last observed value of each metric.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachinePoolMetricStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "+k8s:openapi-gen=true",
+		"type":                      "Type of the metric.",
+		"currentAverageUtilization": "Current value of the metric averaged across the VirtualMachines which reported it,\nas a percentage.",
+		"samples":                   "Number of VirtualMachines which reported the metric.",
 	}
 }
 
 func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "+k8s:openapi-gen=true",
-		"replicas":               "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
-		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
-		"virtualMachineTemplate": "Template describes the VM that will be created.",
-		"paused":                 "Indicates that the pool is paused.\n+optional",
-		"nameGeneration":         "Options for the name generation in a pool.\n+optional",
+		"":                            "+k8s:openapi-gen=true",
+		"replicas":                    "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
+		"selector":                    "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
+		"virtualMachineTemplate":      "Template describes the VM that will be created.",
+		"paused":                      "Indicates that the pool is paused.\n+optional",
+		"nameGeneration":              "Options for the name generation in a pool.\n+optional",
+		"updateStrategy":              "The strategy used to replace VirtualMachines running an outdated template.\nIf not specified, all outdated VirtualMachines are updated at once.\n+optional",
+		"scaleInStrategy":             "The strategy used to select the VirtualMachines which are removed when the pool\nis scaled in. If not specified, VirtualMachines are selected randomly.\n+optional",
+		"autoscaling":                 "Autoscaling adjusts the number of replicas based on the resource usage\nreported by the guests. When set, the pool controller manages the replicas\nand no other autoscaler should target the pool.\n+optional",
+		"unhealthyReplicaReplacement": "UnhealthyReplicaReplacement enables the replacement of VirtualMachines which are\nstuck in the CrashLoopBackOff, ErrorUnschedulable or DataVolumeError status.\nVirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are\nnever replaced. If not specified, unhealthy VirtualMachines are kept.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolUnhealthyReplicaReplacement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "+k8s:openapi-gen=true",
+		"gracePeriod": "Time a VirtualMachine has to be unhealthy before it gets deleted and recreated.\nThe grace period restarts if the pool controller restarts. Defaults to 5m.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolAutoscaling) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "+k8s:openapi-gen=true",
+		"minReplicas":       "Lower limit for the number of replicas. Defaults to 1.\n+optional",
+		"maxReplicas":       "Upper limit for the number of replicas. Can not be lower than minReplicas.",
+		"metrics":           "Metrics used to calculate the desired number of replicas.\nThe highest number of replicas calculated from any metric is used.\n+listType=atomic",
+		"scaleUpCooldown":   "Minimum time between the last scaling and a scale out. Defaults to 3m.\n+optional",
+		"scaleDownCooldown": "Minimum time between the last scaling and a scale in. Defaults to 5m.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolMetric) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "+k8s:openapi-gen=true",
+		"type":                     "Type of the metric. Can be \"CPU\", \"Memory\" or \"GuestLoad\".\n+kubebuilder:validation:Enum=CPU;Memory;GuestLoad",
+		"targetAverageUtilization": "Target value of the metric averaged across the ready VirtualMachines, as a percentage.",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "+k8s:openapi-gen=true",
+		"type":          "Type of the update strategy. Can be \"RollingUpdate\", \"OnDelete\" or \"Recreate\".\nDefaults to \"RollingUpdate\".\n+optional\n+kubebuilder:validation:Enum=RollingUpdate;OnDelete;Recreate",
+		"rollingUpdate": "Rolling update config params. Present only if type is \"RollingUpdate\".\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"maxUnavailable": "The maximum number of VirtualMachines that can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding down.\nThis can not be 0 if maxSurge is 0. Defaults to 25%.\n+optional",
+		"maxSurge":       "The maximum number of VirtualMachines that can be created over the desired number\nof replicas during the update. Outdated VirtualMachines are deleted once their\nreplacements are ready.\nValue can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding up.\nThis can not be 0 if maxUnavailable is 0. Defaults to 0.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolScaleInStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "+k8s:openapi-gen=true",
+		"type": "Type of the scale-in strategy. Can be \"NewestFirst\", \"OldestFirst\", \"NotReadyFirst\"\nor \"HighestIndexFirst\".\nVirtualMachines annotated with kubevirt.io/vm-pool-scale-in-protection=true are never\nselected, regardless of the strategy.\n+kubebuilder:validation:Enum=NewestFirst;OldestFirst;NotReadyFirst;HighestIndexFirst",
 	}
 }
 
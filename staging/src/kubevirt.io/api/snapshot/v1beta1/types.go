@@ -353,6 +353,34 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// TargetNamespace is the namespace the target is restored to, it defaults to the namespace of the
+	// VirtualMachineRestore. Restoring to a different namespace relies on PVCs pulling their data from
+	// VolumeSnapshots in another namespace, which requires the CrossNamespaceVolumeDataSource feature
+	// of Kubernetes and a ReferenceGrant in the namespace of the VirtualMachineSnapshot.
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
+	// Volumes limits the restore to the listed volumes of the snapshot. Volumes that are not listed
+	// keep their current source on the target, which therefore has to exist.
+	// All volumes are restored if empty.
+	// +optional
+	// +listType=set
+	Volumes []string `json:"volumes,omitempty"`
+
+	// VolumeRestoreOverrides customizes the PVCs restored volumes are written to
+	// +optional
+	// +listType=atomic
+	VolumeRestoreOverrides []VolumeRestoreOverride `json:"volumeRestoreOverrides,omitempty"`
+}
+
+// VolumeRestoreOverride customizes the PVC of a restored volume
+type VolumeRestoreOverride struct {
+	VolumeName string `json:"volumeName"`
+
+	// RestoreName is the name of the restored PVC, it replaces the generated name
+	// +optional
+	RestoreName string `json:"restoreName,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -396,3 +424,106 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// SnapshotScheduleLabel is set on the VirtualMachineSnapshots created by a
+// VirtualMachineSnapshotSchedule, its value is the name of the schedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// VirtualMachineSnapshotSchedule periodically takes snapshots of the selected VMs
+// and prunes the snapshots it took according to the retention rules.
+// The snapshots are labeled with snapshot.kubevirt.io/schedule=<schedule name>.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Selector of the VMs to snapshot, in the namespace of the schedule.
+	// An empty selector selects all VMs of the namespace.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Schedule in cron format: "minute hour day-of-month month day-of-week",
+	// or one of @hourly, @daily, @weekly and @monthly. Times are in UTC.
+	Schedule string `json:"schedule"`
+
+	// Suspend stops taking new snapshots, the retention rules are still applied
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+
+	// Template of the VirtualMachineSnapshots created by the schedule
+	// +optional
+	SnapshotTemplate *VirtualMachineSnapshotScheduleTemplate `json:"snapshotTemplate,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines which snapshots of each VM are kept.
+// A snapshot is kept as long as any of the rules selects it, snapshots which are not
+// selected by any rule are deleted. At least one rule has to keep snapshots.
+type VirtualMachineSnapshotScheduleRetention struct {
+	// Number of most recent snapshots to keep
+	// +optional
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// Number of days for which the most recent snapshot of the day is kept
+	// +optional
+	KeepDaily *int32 `json:"keepDaily,omitempty"`
+
+	// Number of weeks for which the most recent snapshot of the week is kept
+	// +optional
+	KeepWeekly *int32 `json:"keepWeekly,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleTemplate holds the settings of the created VirtualMachineSnapshots
+type VirtualMachineSnapshotScheduleTemplate struct {
+	// Labels added to the created VirtualMachineSnapshots
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the vm snapshot
+	// to take. In case we pass this deadline we mark this snapshot
+	// as failed.
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// Last time snapshots were taken
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Next time snapshots will be taken
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
@@ -139,10 +139,20 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":                "initially only VirtualMachine type supported",
-		"targetReadinessPolicy": "+optional",
-		"patches":               "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                       "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":                 "initially only VirtualMachine type supported",
+		"targetReadinessPolicy":  "+optional",
+		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"targetNamespace":        "TargetNamespace is the namespace the target is restored to, it defaults to the namespace of the\nVirtualMachineRestore. Restoring to a different namespace relies on PVCs pulling their data from\nVolumeSnapshots in another namespace, which requires the CrossNamespaceVolumeDataSource feature\nof Kubernetes and a ReferenceGrant in the namespace of the VirtualMachineSnapshot.\n+optional",
+		"volumes":                "Volumes limits the restore to the listed volumes of the snapshot. Volumes that are not listed\nkeep their current source on the target, which therefore has to exist.\nAll volumes are restored if empty.\n+optional\n+listType=set",
+		"volumeRestoreOverrides": "VolumeRestoreOverrides customizes the PVCs restored volumes are written to\n+optional\n+listType=atomic",
+	}
+}
+
+func (VolumeRestoreOverride) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VolumeRestoreOverride customizes the PVC of a restored volume",
+		"restoreName": "RestoreName is the name of the restored PVC, it replaces the generated name\n+optional",
 	}
 }
 
@@ -169,3 +179,55 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically takes snapshots of the selected VMs\nand prunes the snapshots it took according to the retention rules.\nThe snapshots are labeled with snapshot.kubevirt.io/schedule=<schedule name>.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"selector":         "Selector of the VMs to snapshot, in the namespace of the schedule.\nAn empty selector selects all VMs of the namespace.",
+		"schedule":         "Schedule in cron format: \"minute hour day-of-month month day-of-week\",\nor one of @hourly, @daily, @weekly and @monthly. Times are in UTC.",
+		"suspend":          "Suspend stops taking new snapshots, the retention rules are still applied\n+optional",
+		"retention":        "+optional",
+		"snapshotTemplate": "Template of the VirtualMachineSnapshots created by the schedule\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineSnapshotScheduleRetention defines which snapshots of each VM are kept.\nA snapshot is kept as long as any of the rules selects it, snapshots which are not\nselected by any rule are deleted. At least one rule has to keep snapshots.",
+		"keepLast":   "Number of most recent snapshots to keep\n+optional",
+		"keepDaily":  "Number of days for which the most recent snapshot of the day is kept\n+optional",
+		"keepWeekly": "Number of weeks for which the most recent snapshot of the week is kept\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleTemplate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleTemplate holds the settings of the created VirtualMachineSnapshots",
+		"labels":          "Labels added to the created VirtualMachineSnapshots\n+optional",
+		"deletionPolicy":  "+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "Last time snapshots were taken\n+optional\n+nullable",
+		"nextScheduleTime": "Next time snapshots will be taken\n+optional\n+nullable",
+		"error":            "+optional",
+		"conditions":       "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
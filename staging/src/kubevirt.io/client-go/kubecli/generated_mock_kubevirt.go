@@ -75,12 +75,13 @@ import (
 	containerizeddataimporter "kubevirt.io/client-go/containerizeddataimporter"
 	externalsnapshotter "kubevirt.io/client-go/externalsnapshotter"
 	kubevirt "kubevirt.io/client-go/kubevirt"
+	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
 	v1beta116 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	v122 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	v1beta117 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	v1beta118 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
-	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
-	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
+	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	v1beta119 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkattachmentdefinitionclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
 	prometheusoperator "kubevirt.io/client-go/prometheusoperator"
@@ -138,9 +139,9 @@ func (_mr *_MockKubevirtClientRecorder) ReplicaSet(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplicaSet", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha111.VirtualMachinePoolInterface {
+func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha112.VirtualMachinePoolInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachinePool", namespace)
-	ret0, _ := ret[0].(v1alpha111.VirtualMachinePoolInterface)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachinePoolInterface)
 	return ret0
 }
 
@@ -208,6 +209,36 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta119.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineBackup(namespace string) v1alpha110.VirtualMachineBackupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", namespace)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineBackupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineBackupTracker(namespace string) v1alpha110.VirtualMachineBackupTrackerInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackupTracker", namespace)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineBackupTrackerInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineBackupTracker(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackupTracker", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta117.VirtualMachineExportInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
 	ret0, _ := ret[0].(v1beta117.VirtualMachineExportInterface)
@@ -258,9 +289,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterPreference() *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterPreference")
 }
 
-func (_m *MockKubevirtClient) MigrationPolicy() v1alpha110.MigrationPolicyInterface {
+func (_m *MockKubevirtClient) MigrationPolicy() v1alpha111.MigrationPolicyInterface {
 	ret := _m.ctrl.Call(_m, "MigrationPolicy")
-	ret0, _ := ret[0].(v1alpha110.MigrationPolicyInterface)
+	ret0, _ := ret[0].(v1alpha111.MigrationPolicyInterface)
 	return ret0
 }
 
@@ -428,9 +459,9 @@ func (_mr *_MockKubevirtClientRecorder) DynamicClient() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DynamicClient")
 }
 
-func (_m *MockKubevirtClient) MigrationPolicyClient() *v1alpha110.MigrationsV1alpha1Client {
+func (_m *MockKubevirtClient) MigrationPolicyClient() *v1alpha111.MigrationsV1alpha1Client {
 	ret := _m.ctrl.Call(_m, "MigrationPolicyClient")
-	ret0, _ := ret[0].(*v1alpha110.MigrationsV1alpha1Client)
+	ret0, _ := ret[0].(*v1alpha111.MigrationsV1alpha1Client)
 	return ret0
 }
 
@@ -1270,6 +1301,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestMetrics(ctx context.Context, name string) (v121.VirtualMachineInstanceGuestMetrics, error) {
+	ret := _m.ctrl.Call(_m, "GuestMetrics", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceGuestMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestMetrics(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestMetrics", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1796,6 +1838,17 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) Migrate(arg0, arg1, arg2 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Migrate", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInterface) MigrateDryRun(ctx context.Context, name string, migrateOptions *v121.MigrateOptions) (*v121.MigrationFeasibility, error) {
+	ret := _m.ctrl.Call(_m, "MigrateDryRun", ctx, name, migrateOptions)
+	ret0, _ := ret[0].(*v121.MigrationFeasibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) MigrateDryRun(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateDryRun", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1816,6 +1869,26 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveVolume(arg0, arg1, arg2 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInterface) InsertCDRom(ctx context.Context, name string, insertCDRomOptions *v121.InsertCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "InsertCDRom", ctx, name, insertCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) InsertCDRom(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "InsertCDRom", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) EjectCDRom(ctx context.Context, name string, ejectCDRomOptions *v121.EjectCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "EjectCDRom", ctx, name, ejectCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) EjectCDRom(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EjectCDRom", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInterface) PortForward(name string, port int, protocol string) (v122.StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
 	ret0, _ := ret[0].(v122.StreamInterface)
@@ -31,6 +31,7 @@ const (
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestMetricsTemplateURI   = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestmetrics"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -73,6 +74,7 @@ type VirtHandlerConn interface {
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestMetricsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -298,6 +300,10 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestMetricsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestMetricsTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
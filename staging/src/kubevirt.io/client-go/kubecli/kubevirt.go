@@ -43,6 +43,7 @@ import (
 	cdiclient "kubevirt.io/client-go/containerizeddataimporter"
 	k8ssnapshotclient "kubevirt.io/client-go/externalsnapshotter"
 	generatedclient "kubevirt.io/client-go/kubevirt"
+	backupv1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
@@ -65,6 +66,9 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineBackup(namespace string) backupv1.VirtualMachineBackupInterface
+	VirtualMachineBackupTracker(namespace string) backupv1.VirtualMachineBackupTrackerInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
@@ -183,6 +187,18 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineBackup(namespace string) backupv1.VirtualMachineBackupInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineBackupTracker(namespace string) backupv1.VirtualMachineBackupTrackerInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
@@ -25,6 +25,7 @@ import (
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
+	backupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
@@ -41,6 +42,7 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface
 	CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface
 	CloneV1beta1() clonev1beta1.CloneV1beta1Interface
 	KubevirtV1() kubevirtv1.KubevirtV1Interface
@@ -58,6 +60,7 @@ type Interface interface {
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	backupV1alpha1       *backupv1alpha1.BackupV1alpha1Client
 	cloneV1alpha1        *clonev1alpha1.CloneV1alpha1Client
 	cloneV1beta1         *clonev1beta1.CloneV1beta1Client
 	kubevirtV1           *kubevirtv1.KubevirtV1Client
@@ -72,6 +75,11 @@ type Clientset struct {
 	snapshotV1beta1      *snapshotv1beta1.SnapshotV1beta1Client
 }
 
+// BackupV1alpha1 retrieves the BackupV1alpha1Client
+func (c *Clientset) BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface {
+	return c.backupV1alpha1
+}
+
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
 func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
 	return c.cloneV1alpha1
@@ -176,6 +184,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.backupV1alpha1, err = backupv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.cloneV1alpha1, err = clonev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -245,6 +257,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.backupV1alpha1 = backupv1alpha1.New(c)
 	cs.cloneV1alpha1 = clonev1alpha1.New(c)
 	cs.cloneV1beta1 = clonev1beta1.New(c)
 	cs.kubevirtV1 = kubevirtv1.New(c)
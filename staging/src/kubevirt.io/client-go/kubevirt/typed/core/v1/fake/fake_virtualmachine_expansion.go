@@ -73,6 +73,16 @@ func (c *FakeVirtualMachines) Migrate(ctx context.Context, name string, migrateO
 	return err
 }
 
+func (c *FakeVirtualMachines) MigrateDryRun(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrationFeasibility, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "migrate", name, migrateOptions), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MigrationFeasibility{}, nil
+}
+
 func (c *FakeVirtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "memorydump", name, memoryDumpRequest), nil)
@@ -101,6 +111,20 @@ func (c *FakeVirtualMachines) RemoveVolume(ctx context.Context, name string, rem
 	return err
 }
 
+func (c *FakeVirtualMachines) InsertCDRom(ctx context.Context, name string, insertCDRomOptions *v1.InsertCDRomOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "insertcdrom", name, insertCDRomOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) EjectCDRom(ctx context.Context, name string, ejectCDRomOptions *v1.EjectCDRomOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "ejectcdrom", name, ejectCDRomOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachines) PortForward(name string, port int, protocol string) (kubevirtv1.StreamInterface, error) {
 	return nil, nil
 }
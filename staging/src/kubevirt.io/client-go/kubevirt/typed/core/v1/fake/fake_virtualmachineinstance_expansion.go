@@ -114,6 +114,16 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) GuestMetrics(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestMetrics, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "guestmetrics", name), &v1.VirtualMachineInstanceGuestMetrics{})
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceGuestMetrics{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceGuestMetrics), err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -41,8 +41,11 @@ type VirtualMachineExpansion interface {
 	Start(ctx context.Context, name string, startOptions *v1.StartOptions) error
 	Stop(ctx context.Context, name string, stopOptions *v1.StopOptions) error
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
+	MigrateDryRun(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrationFeasibility, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	InsertCDRom(ctx context.Context, name string, insertCDRomOptions *v1.InsertCDRomOptions) error
+	EjectCDRom(ctx context.Context, name string, ejectCDRomOptions *v1.EjectCDRomOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
@@ -130,6 +133,31 @@ func (c *virtualMachines) Migrate(ctx context.Context, name string, migrateOptio
 		Error()
 }
 
+func (c *virtualMachines) MigrateDryRun(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrationFeasibility, error) {
+	optsJson, err := json.Marshal(migrateOptions)
+	if err != nil {
+		return nil, err
+	}
+	rawFeasibility, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("migrate").
+		Param("dryRun", "true").
+		Body(optsJson).
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+	feasibility := &v1.MigrationFeasibility{}
+	if err := json.Unmarshal(rawFeasibility, feasibility); err != nil {
+		return nil, err
+	}
+	return feasibility, nil
+}
+
 func (c *virtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
@@ -164,6 +192,40 @@ func (c *virtualMachines) RemoveVolume(ctx context.Context, name string, removeV
 		Error()
 }
 
+func (c *virtualMachines) InsertCDRom(ctx context.Context, name string, insertCDRomOptions *v1.InsertCDRomOptions) error {
+	body, err := json.Marshal(insertCDRomOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("insertcdrom").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) EjectCDRom(ctx context.Context, name string, ejectCDRomOptions *v1.EjectCDRomOptions) error {
+	body, err := json.Marshal(ejectCDRomOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("ejectcdrom").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
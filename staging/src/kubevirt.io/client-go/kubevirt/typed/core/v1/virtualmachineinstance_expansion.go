@@ -52,6 +52,7 @@ type VirtualMachineInstanceExpansion interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestMetrics(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestMetrics, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
@@ -275,6 +276,20 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) GuestMetrics(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestMetrics, error) {
+	guestMetrics := v1.VirtualMachineInstanceGuestMetrics{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestmetrics").
+		Do(ctx).
+		Into(&guestMetrics)
+
+	return guestMetrics, err
+}
+
 func (c *virtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {